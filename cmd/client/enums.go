@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"PWZ1.0/internal/models"
+	desc "PWZ1.0/pkg/pwz"
+)
+
+// в командах статусы и упаковки пишутся как в models: expects, box+tape
+
+var pbStatuses = map[models.OrderStatus]desc.OrderStatus{
+	models.StatusExpects:  desc.OrderStatus_ORDER_STATUS_EXPECTS,
+	models.StatusAccepted: desc.OrderStatus_ORDER_STATUS_ACCEPTED,
+	models.StatusReturned: desc.OrderStatus_ORDER_STATUS_RETURNED,
+	models.StatusDeleted:  desc.OrderStatus_ORDER_STATUS_DELETED,
+}
+
+var pbPackages = map[models.PackageType]desc.PackageType{
+	models.PackageUnspecified: desc.PackageType_PACKAGE_TYPE_UNSPECIFIED,
+	models.PackageBag:         desc.PackageType_PACKAGE_TYPE_BAG,
+	models.PackageBox:         desc.PackageType_PACKAGE_TYPE_BOX,
+	models.PackageTape:        desc.PackageType_PACKAGE_TYPE_TAPE,
+	models.PackageBagTape:     desc.PackageType_PACKAGE_TYPE_BAG_TAPE,
+	models.PackageBoxTape:     desc.PackageType_PACKAGE_TYPE_BOX_TAPE,
+}
+
+var pbActors = map[models.ActorType]desc.ActorType{
+	models.ActorCourier:  desc.ActorType_ACTOR_TYPE_COURIER,
+	models.ActorOperator: desc.ActorType_ACTOR_TYPE_OPERATOR,
+	models.ActorAdmin:    desc.ActorType_ACTOR_TYPE_ADMIN,
+	models.ActorTerminal: desc.ActorType_ACTOR_TYPE_TERMINAL,
+}
+
+var packageOrder = []models.PackageType{
+	models.PackageBag, models.PackageBox, models.PackageTape, models.PackageBagTape, models.PackageBoxTape,
+}
+
+var statusOrder = []models.OrderStatus{
+	models.StatusExpects, models.StatusAccepted, models.StatusReturned, models.StatusDeleted,
+}
+
+func toModelStatus(s desc.OrderStatus) models.OrderStatus {
+	for m, pb := range pbStatuses {
+		if pb == s {
+			return m
+		}
+	}
+	return models.StatusUnspecified
+}
+
+func statusName(s desc.OrderStatus) string {
+	return strings.ToLower(string(toModelStatus(s)))
+}
+
+func statusNames() []string {
+	names := make([]string, 0, len(statusOrder))
+	for _, s := range statusOrder {
+		names = append(names, strings.ToLower(string(s)))
+	}
+	return names
+}
+
+// parseStatuses принимает и expects, и EXPECTS; пустой список - без фильтра
+func parseStatuses(names []string) ([]desc.OrderStatus, error) {
+	statuses := make([]desc.OrderStatus, 0, len(names))
+	for _, name := range names {
+		s, ok := pbStatuses[models.OrderStatus(strings.ToUpper(strings.TrimSpace(name)))]
+		if !ok {
+			return nil, usagef("unknown status %q, expected one of %s", name, strings.Join(statusNames(), ", "))
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+func toPbPackage(pkg models.PackageType) desc.PackageType {
+	return pbPackages[pkg]
+}
+
+func toModelPackage(pkg desc.PackageType) models.PackageType {
+	for m, pb := range pbPackages {
+		if pb == pkg {
+			return m
+		}
+	}
+	return models.PackageUnspecified
+}
+
+func packageName(pkg desc.PackageType) string {
+	return string(toModelPackage(pkg))
+}
+
+func packageNames() []string {
+	names := make([]string, 0, len(packageOrder))
+	for _, p := range packageOrder {
+		names = append(names, string(p))
+	}
+	return names
+}
+
+// parsePackageFlag пустая строка - упаковка не указана
+func parsePackageFlag(s string) (*desc.PackageType, error) {
+	if s == "" {
+		return nil, nil
+	}
+	pkg, ok := pbPackages[models.PackageType(strings.ToLower(s))]
+	if !ok {
+		return nil, usagef("unknown package %q, expected one of %s", s, strings.Join(packageNames(), ", "))
+	}
+	return &pkg, nil
+}
+
+func toModelActor(a *desc.Actor) models.Actor {
+	actor := models.Actor{Type: models.ActorUnknown, ID: a.GetId(), Subject: a.GetSubject()}
+	for m, pb := range pbActors {
+		if pb == a.GetType() {
+			actor.Type = m
+			break
+		}
+	}
+	return actor
+}
+
+// actorName тип и имя автора для таблицы: "courier 42", "terminal pvz-1"
+func actorName(a *desc.Actor) string {
+	actor := toModelActor(a)
+	switch {
+	case actor.Subject != "":
+		return fmt.Sprintf("%s %s", actor.Type, actor.Subject)
+	case actor.ID != 0:
+		return fmt.Sprintf("%s %d", actor.Type, actor.ID)
+	}
+	return string(actor.Type)
+}
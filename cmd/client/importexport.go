@@ -0,0 +1,379 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"PWZ1.0/internal/importexport"
+	"PWZ1.0/internal/models"
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var importExtensions = []string{"csv", "json", "ndjson", "jsonl"}
+
+func newImportCmd(a *app) *cobra.Command {
+	var (
+		formatName string
+		jobID      string
+		dryRun     bool
+		batch      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Принять заказы из накладной в CSV, JSON или NDJSON",
+		Long: "Заказы отправляются потоком и сохраняются пачками. Если импорт прервался, повторный запуск\n" +
+			"с тем же --job продолжит с первого необработанного заказа.",
+		Example: "  pwz import orders.csv --dry-run\n  pwz import orders.csv --job courier-2025-08-01",
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return importExtensions, cobra.ShellCompDirectiveFilterFileExt
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := formatFlag(formatName, args[0])
+			if err != nil {
+				return usageError{err: err}
+			}
+			if batch && (dryRun || jobID != "") {
+				return usagef("--batch can not be used with --dry-run or --job")
+			}
+
+			records, recordErrs, err := importexport.ReadOrdersFile(args[0], format)
+			if err != nil {
+				return err
+			}
+			stderr := cmd.ErrOrStderr()
+			fmt.Fprintf(stderr, "Read %d orders from %s, rejected %d lines\n", len(records), args[0], len(recordErrs))
+			for _, e := range recordErrs {
+				fmt.Fprintf(stderr, "- %v\n", e)
+			}
+
+			if len(records) > 0 {
+				orders := acceptRequests(records)
+				if batch {
+					err = a.importBatch(cmd.Context(), orders)
+				} else {
+					err = a.importStream(cmd.Context(), stderr, jobID, dryRun, orders)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if len(recordErrs) > 0 {
+				return errPartial
+			}
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&formatName, "format", "", "формат файла, по умолчанию по расширению")
+	f.StringVar(&jobID, "job", "", "ID задания, чтобы продолжить прерванный импорт")
+	f.BoolVar(&dryRun, "dry-run", false, "только проверить заказы, ничего не сохранять")
+	f.BoolVar(&batch, "batch", false, "отправить все заказы одним запросом ImportOrders, без продолжения после сбоя")
+	_ = cmd.RegisterFlagCompletionFunc("format", fixedCompletion(string(importexport.FormatCSV), string(importexport.FormatJSON), string(importexport.FormatNDJSON)))
+	return cmd
+}
+
+func acceptRequests(records []importexport.OrderRecord) []*desc.AcceptOrderRequest {
+	orders := make([]*desc.AcceptOrderRequest, 0, len(records))
+	for _, r := range records {
+		orders = append(orders, &desc.AcceptOrderRequest{
+			OrderId:     r.OrderID,
+			UserId:      r.UserID,
+			ExpiresAt:   timestamppb.New(r.ExpiresAt),
+			Package:     ptr(toPbPackage(r.Package)),
+			WeightGrams: int64(r.Weight),
+			PriceMinor:  r.Price.Amount,
+			Currency:    string(r.Price.Currency),
+		})
+	}
+	return orders
+}
+
+func (a *app) importBatch(ctx context.Context, orders []*desc.AcceptOrderRequest) error {
+	client, err := a.dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.writeCtx(ctx)
+	defer cancel()
+
+	res, err := client.ImportOrders(ctx, &desc.ImportOrdersRequest{Orders: orders})
+	if err != nil {
+		return err
+	}
+
+	if err := a.print(res, func(t *table) {
+		t.header = []string{"order id", "result"}
+		fillOrderErrors(t, res.GetErrors(), res.GetErrorDetails())
+		t.footer = append(t.footer, fmt.Sprintf("Imported: %d, failed: %d", res.GetImported(), len(res.GetErrors())))
+	}); err != nil {
+		return err
+	}
+	if len(res.GetErrors()) > 0 {
+		return errPartial
+	}
+	return nil
+}
+
+// importStream отправляет заказы потоком; при повторном запуске с тем же jobID сервер говорит, сколько уже обработано, и эти заказы не отправляются.
+// С dryRun сервер только проверяет заказы и ничего не сохраняет. Ход импорта пишется в progress, итог - в выбранном формате
+func (a *app) importStream(ctx context.Context, progress io.Writer, jobID string, dryRun bool, orders []*desc.AcceptOrderRequest) error {
+	client, err := a.dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := a.streamCtx(ctx)
+	defer cancel()
+
+	stream, err := client.ImportOrdersStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&desc.ImportOrdersStreamRequest{JobId: jobID, DryRun: dryRun}); err != nil {
+		return err
+	}
+	start, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		fmt.Fprintln(progress, "Dry run: orders are checked but not saved")
+	} else {
+		fmt.Fprintf(progress, "Import job %s: resume from %d\n", start.GetJobId(), start.GetProcessed()+1)
+	}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		for i := start.GetProcessed(); i < uint64(len(orders)); i++ {
+			if err := stream.Send(&desc.ImportOrdersStreamRequest{Seq: i + 1, Order: orders[i]}); err != nil {
+				sendErr <- err
+				return
+			}
+		}
+		sendErr <- stream.CloseSend()
+	}()
+
+	// ошибки приходят по пачкам, в итог собираем все
+	var itemErrors []*desc.OrderError
+	last := start
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		last = res
+		itemErrors = append(itemErrors, res.GetErrors()...)
+		fmt.Fprintf(progress, "Processed: %d, imported: %d, failed: %d\n", res.GetProcessed(), res.GetImported(), res.GetFailed())
+		if res.GetDone() {
+			break
+		}
+	}
+	if err := <-sendErr; err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	last.Errors = itemErrors
+	if err := a.print(last, func(t *table) {
+		t.header = []string{"order id", "result"}
+		fillOrderErrors(t, nil, itemErrors)
+		t.footer = append(t.footer, fmt.Sprintf("Job %s: processed %d, imported %d, failed %d, skipped %d",
+			last.GetJobId(), last.GetProcessed(), last.GetImported(), last.GetFailed(), last.GetSkipped()))
+	}); err != nil {
+		return err
+	}
+	if len(itemErrors) > 0 {
+		return errPartial
+	}
+	return nil
+}
+
+func newExportCmd(a *app) *cobra.Command {
+	var (
+		formatName     string
+		out            string
+		userID         uint64
+		statuses       []string
+		from, to       string
+		includeDeleted bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export orders|history",
+		Short: "Выгрузить заказы или историю статусов в CSV, JSON или NDJSON",
+		Long: "Для orders --from и --to ограничивают срок хранения, для history - время смены статуса.\n" +
+			"Цена в выгрузке заказов итоговая, с надбавками тарифа.",
+		Example:   "  pwz export orders --status expects --out expects.csv\n  pwz export history --user 1 --from 2025-07-01 --format ndjson",
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: []string{"orders", "history"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if formatName == "" && out == "" {
+				formatName = string(importexport.FormatCSV)
+			}
+			format, err := formatFlag(formatName, out)
+			if err != nil {
+				return usageError{err: err}
+			}
+			pbStatuses, err := parseStatuses(statuses)
+			if err != nil {
+				return err
+			}
+			fromTs, err := parseDateFlag(from)
+			if err != nil {
+				return err
+			}
+			toTs, err := parseDateFlag(to)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+
+			w := a.out
+			if out != "" {
+				f, err := os.Create(out)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			var n int
+			if args[0] == "orders" {
+				n, err = a.exportOrders(cmd.Context(), client, importexport.NewOrderWriter(w, format), &desc.ListOrdersRequest{
+					UserId:         userID,
+					IncludeDeleted: includeDeleted,
+					Statuses:       pbStatuses,
+					ExpiresFrom:    fromTs,
+					ExpiresTo:      toTs,
+				})
+			} else {
+				n, err = a.exportHistory(cmd.Context(), client, importexport.NewHistoryWriter(w, format), &desc.GetHistoryRequest{
+					UserId:      userID,
+					Statuses:    pbStatuses,
+					CreatedFrom: fromTs,
+					CreatedTo:   toTs,
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d %s\n", n, args[0])
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&formatName, "format", "", "csv, json или ndjson, по умолчанию по расширению --out, иначе csv")
+	f.StringVar(&out, "out", "", "файл выгрузки, по умолчанию stdout")
+	f.Uint64Var(&userID, "user", 0, "только заказы клиента; orders без --user - только admin")
+	f.StringSliceVar(&statuses, "status", nil, "статусы через запятую: expects, accepted, returned, deleted")
+	f.StringVar(&from, "from", "", "начало периода, включительно")
+	f.StringVar(&to, "to", "", "конец периода, не включительно")
+	f.BoolVar(&includeDeleted, "include-deleted", false, "orders: вместе с заказами, возвращенными курьеру")
+	_ = cmd.RegisterFlagCompletionFunc("format", fixedCompletion(string(importexport.FormatCSV), string(importexport.FormatJSON), string(importexport.FormatNDJSON)))
+	_ = cmd.RegisterFlagCompletionFunc("status", fixedCompletion(statusNames()...))
+	return cmd
+}
+
+func (a *app) exportOrders(ctx context.Context, client desc.NotifierClient, w *importexport.OrderWriter, req *desc.ListOrdersRequest) (int, error) {
+	var n int
+	for page := uint32(0); ; page++ {
+		req.Pagination = &desc.Pagination{Page: page, CountOnPage: maxPageSize}
+
+		pageCtx, cancel := a.readCtx(ctx)
+		resp, err := client.ListOrders(pageCtx, req)
+		cancel()
+		if err != nil {
+			return n, err
+		}
+
+		for _, o := range resp.GetOrders() {
+			if err := w.Write(orderFromPb(o)); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if len(resp.GetOrders()) < maxPageSize || int32(n) >= resp.GetTotal() {
+			break
+		}
+	}
+
+	return n, w.Close()
+}
+
+func (a *app) exportHistory(ctx context.Context, client desc.NotifierClient, w *importexport.HistoryWriter, req *desc.GetHistoryRequest) (int, error) {
+	var n int
+	for page := uint32(0); ; page++ {
+		req.Pagination = &desc.Pagination{Page: page, CountOnPage: maxPageSize}
+
+		pageCtx, cancel := a.readCtx(ctx)
+		resp, err := client.GetHistory(pageCtx, req)
+		cancel()
+		if err != nil {
+			return n, err
+		}
+
+		for _, h := range resp.GetHistory() {
+			if err := w.Write(models.OrderHistory{
+				OrderID:   h.GetOrderId(),
+				Status:    toModelStatus(h.GetStatus()),
+				CreatedAt: h.GetCreatedAt().AsTime(),
+				Actor:     toModelActor(h.GetActor()),
+			}); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if len(resp.GetHistory()) < maxPageSize {
+			break
+		}
+	}
+
+	return n, w.Close()
+}
+
+func formatFlag(name, path string) (importexport.Format, error) {
+	if name != "" {
+		return importexport.ParseFormat(name)
+	}
+	return importexport.FormatFromPath(path)
+}
+
+func orderFromPb(o *desc.Order) models.Order {
+	order := models.Order{
+		ID:            o.GetOrderId(),
+		UserID:        o.GetUserId(),
+		Status:        toModelStatus(o.GetStatus()),
+		ExpiresAt:     o.GetExpiresAt().AsTime(),
+		PackageType:   toModelPackage(o.GetPackage()),
+		Weight:        models.Grams(o.GetWeightGrams()),
+		Price:         models.NewMoney(o.GetTotalPriceMinor(), models.Currency(o.GetCurrency())),
+		TariffVersion: o.GetTariffVersion(),
+	}
+	if o.DeletedAt != nil {
+		deletedAt := o.GetDeletedAt().AsTime()
+		order.DeletedAt = &deletedAt
+	}
+	return order
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
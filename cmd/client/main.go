@@ -2,241 +2,316 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	desc "PWZ1.0/pkg/pwz"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	grpcAddress    = "localhost:50051"
+	defaultAddress = "localhost:50051"
+	clientVersion  = "1.0"
 	DateTimeFormat = "2006-01-02 15:04:05"
 )
 
-func main() {
-	conn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect: %v", err)
-	}
-	defer conn.Close()
+// коды выхода для скриптов; 2 - как у flag при неверных аргументах
+const (
+	exitOK          = 0
+	exitError       = 1 // ошибка клиента или сервера без отдельного кода
+	exitUsage       = 2 // неверные аргументы или флаги
+	exitInvalid     = 3 // сервер отклонил запрос: InvalidArgument, FailedPrecondition, OutOfRange
+	exitNotFound    = 4
+	exitConflict    = 5 // AlreadyExists, Aborted
+	exitDenied      = 6 // Unauthenticated, PermissionDenied
+	exitUnavailable = 7 // Unavailable, DeadlineExceeded, ResourceExhausted - запрос можно повторить
+	exitPartial     = 8 // запрос выполнен, но часть заказов не обработана
+)
 
-	client := desc.NewNotifierClient(conn)
+// errPartial часть заказов не обработана, причины уже напечатаны
+var errPartial = errors.New("some orders were not processed")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+// runError ошибка, которую вернула сама команда; все остальные ошибки cobra находит
+// до запуска команды (неизвестная команда, аргументы, обязательные флаги), это ошибки использования
+type runError struct {
+	err error
+}
 
-	if err := acceptOrder(ctx, client, 60006, 1, time.Now().Add(24*time.Hour), ptr(desc.PackageType_PACKAGE_TYPE_BOX), 1.0, 100.0); err != nil {
-		log.Fatalf("failed to accept order: %v", err)
-	}
+func (e runError) Error() string { return e.err.Error() }
+func (e runError) Unwrap() error { return e.err }
 
-	if err := processOrders(ctx, client, 1, desc.ActionType_ACTION_TYPE_ISSUE, []uint64{60006}); err != nil {
-		log.Fatalf("failed to process orders: %v", err)
-	}
+// usageError неверные аргументы команды
+type usageError struct {
+	err error
 }
 
-func addReadMetadata(ctx context.Context) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "mode", "read")
-}
+func (e usageError) Error() string { return e.err.Error() }
 
-func addWriteMetadata(ctx context.Context) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "mode", "write")
+func usagef(format string, args ...any) error {
+	return usageError{err: fmt.Errorf(format, args...)}
 }
 
-func acceptOrder(ctx context.Context, client desc.NotifierClient, orderID uint64, userID uint64, expiresAt time.Time, pkg *desc.PackageType, weight float32, price float32) error {
-	ctx = addWriteMetadata(ctx)
+// settings общие флаги команд
+type settings struct {
+	addr    string
+	timeout time.Duration
+	sender  string
+	output  string
+	token   string // JWT, уходит в authorization
+	apiKey  string
 
-	req := &desc.AcceptOrderRequest{
-		OrderId:   orderID,
-		UserId:    userID,
-		ExpiresAt: timestamppb.New(expiresAt),
-		Package:   pkg,
-		Weight:    weight,
-		Price:     price,
-	}
+	idempotencyKey string // для изменяющих запросов, повтор с тем же ключом получит первый ответ
+}
 
-	res, err := client.AcceptOrder(ctx, req)
-	if err != nil {
-		return err
-	}
+// app общие флаги и соединения с сервером, соединение открывается при первом запросе к адресу
+type app struct {
+	settings
 
-	log.Printf("Order accepted: %v", res)
-	return nil
-}
+	out    io.Writer
+	errOut io.Writer
+	// conns по адресу: в REPL --addr меняет сервер только для одной строки, остальные идут в прежнее соединение
+	conns map[string]*grpc.ClientConn
 
-func ptr[T any](v T) *T {
-	return &v
+	// onResponse видит каждый выведенный ответ сервера, REPL запоминает по нему ID для дополнения
+	onResponse func(proto.Message)
 }
 
-func listOrders(ctx context.Context, client desc.NotifierClient, userID uint64, inPvz bool, lastN *uint32, page uint32, limit uint32) error {
-	ctx = addReadMetadata(ctx)
-
-	req := &desc.ListOrdersRequest{
-		UserId: userID,
-		InPvz:  inPvz,
-		LastN:  lastN,
-		Pagination: &desc.Pagination{
-			Page:        page,
-			CountOnPage: limit,
-		},
+func newApp(stdout, stderr io.Writer) *app {
+	addr := os.Getenv("PWZ_ADDR")
+	if addr == "" {
+		addr = defaultAddress
 	}
-
-	resp, err := client.ListOrders(ctx, req)
-	if err != nil {
-		return fmt.Errorf("ListOrders failed: %w", err)
-	}
-
-	for _, order := range resp.Orders {
-		fmt.Printf("Order ID: %d, User ID: %d, Status: %s, Expires: %v, Weight: %.2f, Price: %.2f",
-			order.OrderId,
-			order.UserId,
-			order.Status.String(),
-			order.ExpiresAt.AsTime().Format(time.RFC3339),
-			order.Weight,
-			order.TotalPrice)
-
-		if order.Package != nil {
-			fmt.Printf(", Package: %s\n", order.Package.String())
-		} else {
-			fmt.Println(", Package: none")
-		}
+	return &app{
+		settings: settings{
+			addr:    addr,
+			timeout: 5 * time.Second,
+			sender:  "go-client",
+			output:  outputTable,
+			token:   os.Getenv("PWZ_TOKEN"),
+			apiKey:  os.Getenv("PWZ_API_KEY"),
+		},
+		out:    stdout,
+		errOut: stderr,
 	}
-	fmt.Printf("Total orders: %d\n", resp.Total)
+}
 
-	return nil
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
 }
 
-func processOrders(ctx context.Context, client desc.NotifierClient, userID uint64, action desc.ActionType, orderIDs []uint64) error {
-	ctx = addWriteMetadata(ctx)
-	ctx = metadata.AppendToOutgoingContext(ctx, "sender", "go-client", "client-version", "1.0")
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	a := newApp(stdout, stderr)
+	defer a.close()
 
-	//todo: замедление
-	time.Sleep(1 * time.Second)
+	err := a.execute(ctx, args)
+	a.printError(err)
+	return exitCode(err)
+}
 
-	req := &desc.ProcessOrdersRequest{
-		UserId:   userID,
-		Action:   action,
-		OrderIds: orderIDs,
-	}
+// execute выполняет одну команду; дерево команд каждый раз новое, потому что cobra хранит в нем разобранные флаги
+func (a *app) execute(ctx context.Context, args []string) error {
+	root := newRootCmd(a)
+	root.SetArgs(args)
+	root.SetOut(a.out)
+	root.SetErr(a.errOut)
+	return root.ExecuteContext(ctx)
+}
 
-	res, err := client.ProcessOrders(ctx, req)
-	if err != nil {
-		return err
+func (a *app) printError(err error) {
+	var reported reportedError
+	if err != nil && !errors.Is(err, errPartial) && !errors.As(err, &reported) {
+		fmt.Fprintln(a.errOut, "Error:", errorMessage(err))
 	}
+}
 
-	fmt.Println("Processed orders:")
-	for _, id := range res.Processed {
-		fmt.Printf("- %d\n", id)
+func newRootCmd(a *app) *cobra.Command {
+	root := &cobra.Command{
+		Use:           "pwz",
+		Short:         "Клиент пункта выдачи заказов",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			switch a.output {
+			case outputTable, outputJSON, outputYAML:
+				return nil
+			}
+			return usagef("unknown output %q, expected table, json or yaml", a.output)
+		},
 	}
 
-	if len(res.Errors) > 0 {
-		fmt.Println("Failed orders:")
-		for _, id := range res.Errors {
-			fmt.Printf("- %d\n", id)
+	// значения по умолчанию - текущие, чтобы в REPL флаги запуска действовали на каждую строку
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.addr, "addr", a.addr, "адрес gRPC сервера, по умолчанию из PWZ_ADDR")
+	flags.DurationVar(&a.timeout, "timeout", a.timeout, "таймаут одного запроса")
+	flags.StringVar(&a.sender, "sender", a.sender, "имя клиента в метаданных, по нему считается rate limit")
+	flags.StringVarP(&a.output, "output", "o", a.output, "формат вывода: table, json или yaml")
+	flags.StringVar(&a.token, "token", a.token, "JWT для аутентификации, по умолчанию из PWZ_TOKEN")
+	flags.StringVar(&a.apiKey, "api-key", a.apiKey, "API-ключ вместо JWT, по умолчанию из PWZ_API_KEY")
+	flags.StringVar(&a.idempotencyKey, "idempotency-key", a.idempotencyKey, "ключ идемпотентности изменяющего запроса: повтор команды с тем же ключом не выполнит ее дважды")
+	_ = root.RegisterFlagCompletionFunc("output", fixedCompletion(outputTable, outputJSON, outputYAML))
+
+	root.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return usageError{err: err}
+	})
+
+	root.AddCommand(
+		newOrderCmd(a),
+		newHistoryCmd(a),
+		newTariffsCmd(a),
+		newMessageCmd(a),
+		newImportCmd(a),
+		newExportCmd(a),
+		newReplCmd(a),
+	)
+	markRunErrors(root)
+	return root
+}
+
+func markRunErrors(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			if err := run(cmd, args); err != nil {
+				return runError{err: err}
+			}
+			return nil
 		}
 	}
-
-	return nil
+	for _, c := range cmd.Commands() {
+		markRunErrors(c)
+	}
 }
 
-func returnOrder(ctx context.Context, client desc.NotifierClient, orderID uint64) error {
-	ctx = addWriteMetadata(ctx)
-	ctx = metadata.AppendToOutgoingContext(ctx, "sender", "go-client", "client-version", "1.0")
+// newGroupCmd команда, которая только объединяет подкоманды
+func newGroupCmd(use, short string, commands ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+	cmd.AddCommand(commands...)
+	return cmd
+}
 
-	req := &desc.OrderIdRequest{
-		OrderId: orderID,
+func (a *app) dial() (desc.NotifierClient, error) {
+	if conn, ok := a.conns[a.addr]; ok {
+		return desc.NewNotifierClient(conn), nil
 	}
 
-	res, err := client.ReturnOrder(ctx, req)
+	conn, err := grpc.NewClient(a.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return fmt.Errorf("ReturnOrder failed: %w", err)
+		return nil, fmt.Errorf("connect to %s: %w", a.addr, err)
 	}
-
-	fmt.Printf("Order returned to courier: ID=%d, Status=%s\n", res.OrderId, res.Status.String())
-	return nil
+	if a.conns == nil {
+		a.conns = make(map[string]*grpc.ClientConn)
+	}
+	a.conns[a.addr] = conn
+	return desc.NewNotifierClient(conn), nil
 }
 
-func listReturns(ctx context.Context, client desc.NotifierClient, page uint32, limit uint32) error {
-	ctx = addReadMetadata(ctx)
-	req := &desc.ListReturnsRequest{
-		Pagination: &desc.Pagination{
-			Page:        page,
-			CountOnPage: limit,
-		},
+func (a *app) close() {
+	for _, conn := range a.conns {
+		_ = conn.Close()
 	}
+}
 
-	ctx = metadata.AppendToOutgoingContext(ctx, "sender", "go-client", "client-version", "1.0")
+// readCtx и writeCtx контекст одного запроса с таймаутом и метаданными клиента
+func (a *app) readCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return a.requestCtx(ctx, "read", a.timeout)
+}
 
-	resp, err := client.ListReturns(ctx, req)
-	if err != nil {
-		return fmt.Errorf("ListReturns failed: %w", err)
-	}
-
-	fmt.Println("Returns list:")
-	for _, ret := range resp.Returns {
-		fmt.Printf("Order ID: %d, User ID: %d, Status: %s, Expires: %v, Weight: %.2f, Price: %.2f",
-			ret.OrderId,
-			ret.UserId,
-			ret.Status.String(),
-			ret.ExpiresAt.AsTime().Format(time.RFC3339),
-			ret.Weight,
-			ret.TotalPrice)
-
-		if ret.Package != nil {
-			fmt.Printf(", Package: %s\n", ret.Package.String())
-		} else {
-			fmt.Println(", Package: none")
-		}
+func (a *app) writeCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	if a.idempotencyKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "idempotency-key", a.idempotencyKey)
 	}
+	return a.requestCtx(ctx, "write", a.timeout)
+}
 
-	fmt.Printf("PAGE: %d LIMIT: %d\n", page, limit)
-	return nil
+// streamCtx без таймаута: потоковый импорт длится столько, сколько нужно, и прерывается по Ctrl+C
+func (a *app) streamCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return a.requestCtx(ctx, "write", 0)
 }
 
-func getHistory(ctx context.Context, client desc.NotifierClient, page, count uint32) error {
-	ctx = addReadMetadata(ctx)
-	req := &desc.GetHistoryRequest{
-		Pagination: &desc.Pagination{
-			Page:        page,
-			CountOnPage: count,
-		},
+func (a *app) requestCtx(ctx context.Context, mode string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "mode", mode, "sender", a.sender, "client-version", clientVersion)
+	switch {
+	case a.token != "":
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+a.token)
+	case a.apiKey != "":
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", a.apiKey)
 	}
-
-	resp, err := client.GetHistory(ctx, req)
-	if err != nil {
-		return fmt.Errorf("GetHistory failed: %w", err)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeout(ctx, timeout)
+}
 
-	fmt.Println("Order History:")
-	for _, h := range resp.GetHistory() {
-		createdAt := h.GetCreatedAt().AsTime().Format(DateTimeFormat)
-		fmt.Printf("OrderID: %d, Status: %s, CreatedAt: %s\n",
-			h.GetOrderId(), h.GetStatus().String(), createdAt)
+func exitCode(err error) int {
+	var (
+		usage usageError
+		run   runError
+	)
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &usage), !errors.As(err, &run):
+		return exitUsage
+	case errors.Is(err, errPartial):
+		return exitPartial
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitUnavailable
 	}
 
-	return nil
+	st, ok := status.FromError(err)
+	if !ok {
+		return exitError
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return exitInvalid
+	case codes.NotFound:
+		return exitNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return exitConflict
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return exitDenied
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return exitUnavailable
+	default:
+		return exitError
+	}
 }
 
-func getOrderHistory(ctx context.Context, client desc.NotifierClient, orderID uint64) error {
-	ctx = addReadMetadata(ctx)
-	ctx = metadata.AppendToOutgoingContext(ctx, "sender", "go-client", "client-version", "1.0")
-
-	req := &desc.OrderHistoryRequest{
-		OrderId: orderID,
+// errorMessage для ошибок сервера печатаем код gRPC, по нему понятно, что делать дальше
+func errorMessage(err error) string {
+	var run runError
+	if errors.As(err, &run) {
+		err = run.err
 	}
-
-	res, err := client.GetOrderHistory(ctx, req)
-	if err != nil {
-		return fmt.Errorf("failed to get order history: %w", err)
+	if st, ok := status.FromError(err); ok {
+		return fmt.Sprintf("%s: %s", st.Code(), st.Message())
 	}
+	return err.Error()
+}
 
-	fmt.Printf("История заказа %d:\n", orderID)
-	for _, h := range res.History {
-		fmt.Printf("%s Время: %s\n", h.Status.String(), h.CreatedAt.AsTime().Format(DateTimeFormat))
+func fixedCompletion(values ...string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveNoFileComp
 	}
-	return nil
 }
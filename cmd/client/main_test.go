@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeNotifier struct {
+	desc.UnimplementedNotifierServer
+}
+
+func (fakeNotifier) ListOrders(_ context.Context, req *desc.ListOrdersRequest) (*desc.OrdersList, error) {
+	if req.GetUserId() == 404 {
+		return nil, status.Error(codes.NotFound, "ORDER_NOT_FOUND: no such user")
+	}
+	return &desc.OrdersList{
+		Orders: []*desc.Order{{
+			OrderId:         60006,
+			UserId:          req.GetUserId(),
+			Status:          desc.OrderStatus_ORDER_STATUS_EXPECTS,
+			ExpiresAt:       timestamppb.New(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)),
+			Package:         ptr(desc.PackageType_PACKAGE_TYPE_BOX),
+			WeightGrams:     1500,
+			TotalPriceMinor: 12099,
+			Currency:        "RUB",
+		}},
+		Total: 1,
+	}, nil
+}
+
+func (fakeNotifier) ProcessOrders(_ context.Context, req *desc.ProcessOrdersRequest) (*desc.ProcessResult, error) {
+	return &desc.ProcessResult{
+		Processed:    req.GetOrderIds()[:1],
+		Errors:       req.GetOrderIds()[1:],
+		ErrorDetails: []*desc.OrderError{{OrderId: req.GetOrderIds()[1], Code: "STORAGE_EXPIRED", Message: "storage period expired"}},
+	}, nil
+}
+
+func startServer(t *testing.T) string {
+	t.Helper()
+	return startNotifier(t, fakeNotifier{})
+}
+
+func startNotifier(t *testing.T, notifier desc.NotifierServer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	desc.RegisterNotifierServer(srv, notifier)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func runClient(t *testing.T, args ...string) (string, string, int) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func TestRun_Output(t *testing.T) {
+	addr := startServer(t)
+
+	out, _, code := runClient(t, "--addr", addr, "order", "list", "--user", "7")
+	require.Equal(t, exitOK, code)
+	assert.Contains(t, out, "ORDER ID")
+	assert.Contains(t, out, "60006")
+	assert.Contains(t, out, "1.500 kg")
+	assert.Contains(t, out, "120.99 RUB")
+	assert.Contains(t, out, "Total: 1")
+
+	out, _, code = runClient(t, "--addr", addr, "-o", "json", "order", "list", "--user", "7")
+	require.Equal(t, exitOK, code)
+	assert.Contains(t, out, `"order_id": "60006"`)
+	assert.Contains(t, out, `"status": "ORDER_STATUS_EXPECTS"`)
+
+	out, _, code = runClient(t, "--addr", addr, "-o", "yaml", "order", "list", "--user", "7")
+	require.Equal(t, exitOK, code)
+	assert.True(t, strings.HasPrefix(out, "orders:\n"), out)
+	assert.Contains(t, out, "total: 1")
+}
+
+func TestRun_ExitCodes(t *testing.T) {
+	addr := startServer(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"unknown command", []string{"frobnicate"}, exitUsage},
+		{"missing required flag", []string{"order", "accept", "--id", "1"}, exitUsage},
+		{"bad status", []string{"--addr", addr, "order", "list", "--status", "lost"}, exitUsage},
+		{"server error code", []string{"--addr", addr, "order", "list", "--user", "404"}, exitNotFound},
+		{"partial result", []string{"--addr", addr, "order", "issue", "--user", "1", "1", "2"}, exitPartial},
+		{"unimplemented rpc", []string{"--addr", addr, "tariffs"}, exitError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, code := runClient(t, tt.args...)
+			assert.Equal(t, tt.want, code)
+		})
+	}
+
+	out, _, _ := runClient(t, "--addr", addr, "order", "issue", "--user", "1", "1", "2")
+	assert.Contains(t, out, "STORAGE_EXPIRED: storage period expired")
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, exitUnavailable, exitCode(runError{err: status.Error(codes.ResourceExhausted, "rate limited")}))
+	assert.Equal(t, exitConflict, exitCode(runError{err: status.Error(codes.AlreadyExists, "duplicate")}))
+	assert.Equal(t, exitInvalid, exitCode(runError{err: status.Error(codes.FailedPrecondition, "expired")}))
+	assert.Equal(t, exitUnavailable, exitCode(runError{err: context.DeadlineExceeded}))
+	assert.Equal(t, exitError, exitCode(runError{err: errors.New("disk full")}))
+}
+
+func TestParseExpires(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.Local)
+	got, err := parseExpires("72h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(72*time.Hour), got)
+
+	got, err = parseExpires("2025-08-01 18:00", now)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 8, 1, 18, 0, 0, 0, time.Local).Equal(got), got)
+
+	_, err = parseExpires("-1h", now)
+	assert.Error(t, err)
+	_, err = parseExpires("tomorrow", now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newMessageCmd(a *app) *cobra.Command {
+	return newGroupCmd("message", "Очередь уведомлений",
+		newSendMessageCmd(a),
+		newMessageStatusCmd(a, "status", "Статус уведомления", false, desc.NotifierClient.GetMessageStatus),
+		newMessageStatusCmd(a, "cancel", "Отменить уведомление, которое еще не отправлено", true, desc.NotifierClient.CancelMessage),
+	)
+}
+
+func newSendMessageCmd(a *app) *cobra.Command {
+	var (
+		req      desc.MessageRequest
+		priority string
+		delay    time.Duration
+		comment  string
+		userID   uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:     "send",
+		Short:   "Поставить уведомление в очередь",
+		Example: "  pwz message send --text \"Заказ 60006 ждет вас\" --user 1 --priority high --delay 10m",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, ok := desc.Priority_value["PRIORITY_"+strings.ToUpper(priority)]
+			if !ok || p == 0 {
+				return usagef("unknown priority %q, expected one of %s", priority, strings.Join(priorityNames(), ", "))
+			}
+			req.Priority = desc.Priority(p)
+			if delay > 0 {
+				req.Delay = durationpb.New(delay)
+			}
+			if cmd.Flags().Changed("comment") {
+				req.Comment = &comment
+			}
+			if cmd.Flags().Changed("user") {
+				req.UserId = &userID
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.writeCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.SendMessage(ctx, &req)
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				t.header = []string{"message id"}
+				t.row(res.GetId())
+			})
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&req.Text, "text", "", "текст, до 200 символов")
+	f.StringVar(&req.Title, "title", "", "заголовок, до 50 символов")
+	f.StringVar(&priority, "priority", "default", "приоритет: "+strings.Join(priorityNames(), ", "))
+	f.DurationVar(&delay, "delay", 0, "отправить не раньше чем через")
+	f.StringSliceVar(&req.Tags, "tag", nil, "теги, до 10")
+	f.StringVar(&comment, "comment", "", "комментарий")
+	f.Uint64Var(&userID, "user", 0, "ID клиента, если уведомление адресовано клиенту")
+	_ = cmd.MarkFlagRequired("text")
+	_ = cmd.RegisterFlagCompletionFunc("priority", fixedCompletion(priorityNames()...))
+	return cmd
+}
+
+type messageCall func(desc.NotifierClient, context.Context, *desc.MessageIdRequest, ...grpc.CallOption) (*desc.MessageStatusResponse, error)
+
+func newMessageStatusCmd(a *app, use, short string, write bool, call messageCall) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " MESSAGE_ID",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || id == 0 {
+				return usagef("bad message id %q", args[0])
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			requestCtx := a.readCtx
+			if write {
+				requestCtx = a.writeCtx
+			}
+			ctx, cancel := requestCtx(cmd.Context())
+			defer cancel()
+
+			res, err := call(client, ctx, &desc.MessageIdRequest{Id: uint32(id)})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				t.header = []string{"message id", "status", "priority", "scheduled at", "sent at", "error"}
+				sentAt := "-"
+				if res.SentAt != nil {
+					sentAt = res.GetSentAt().AsTime().Local().Format(DateTimeFormat)
+				}
+				t.row(res.GetId(), enumName(res.GetStatus().String(), "MESSAGE_STATUS_"), enumName(res.GetPriority().String(), "PRIORITY_"),
+					res.GetScheduledAt().AsTime().Local().Format(DateTimeFormat), sentAt, res.GetError())
+			})
+		},
+	}
+}
+
+func priorityNames() []string {
+	return []string{"min", "low", "default", "high", "max"}
+}
+
+func enumName(s, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(s, prefix))
+}
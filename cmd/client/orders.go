@@ -0,0 +1,472 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"PWZ1.0/internal/models"
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxPageSize больше сервер за раз не отдает
+const maxPageSize = 100
+
+func newOrderCmd(a *app) *cobra.Command {
+	return newGroupCmd("order", "Приемка, выдача и возвраты заказов",
+		newAcceptCmd(a),
+		newProcessCmd(a, "issue", "Выдать заказы клиенту", desc.ActionType_ACTION_TYPE_ISSUE),
+		newProcessCmd(a, "client-return", "Принять возврат заказов от клиента", desc.ActionType_ACTION_TYPE_RETURN),
+		newReturnToCourierCmd(a),
+		newListOrdersCmd(a),
+		newListReturnsCmd(a),
+		newOrderHistoryCmd(a),
+	)
+}
+
+func newAcceptCmd(a *app) *cobra.Command {
+	var (
+		orderID, userID uint64
+		expires         string
+		pkg             string
+		weight, price   float64
+		currency        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "accept",
+		Short: "Принять заказ от курьера",
+		Example: "  pwz order accept --id 60006 --user 1 --expires 72h --package box --weight 1.5 --price 999.90\n" +
+			"  pwz order accept --id 60007 --user 1 --expires \"2025-08-01 18:00\" --weight 0.3 --price 150",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			expiresAt, err := parseExpires(expires, time.Now())
+			if err != nil {
+				return usageError{err: err}
+			}
+			pbPkg, err := parsePackageFlag(pkg)
+			if err != nil {
+				return usageError{err: err}
+			}
+			if weight <= 0 {
+				return usagef("--weight must be positive")
+			}
+			if price < 0 {
+				return usagef("--price must not be negative")
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.writeCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.AcceptOrder(ctx, &desc.AcceptOrderRequest{
+				OrderId:     orderID,
+				UserId:      userID,
+				ExpiresAt:   timestamppb.New(expiresAt),
+				Package:     pbPkg,
+				WeightGrams: int64(models.GramsFromKg(weight)),
+				PriceMinor:  models.MoneyFromFloat(price, "").Amount,
+				Currency:    strings.ToUpper(currency),
+			})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				t.header = []string{"order id", "status"}
+				t.row(res.GetOrderId(), statusName(res.GetStatus()))
+			})
+		},
+	}
+
+	f := cmd.Flags()
+	f.Uint64Var(&orderID, "id", 0, "ID заказа")
+	f.Uint64Var(&userID, "user", 0, "ID клиента")
+	f.StringVar(&expires, "expires", "", "срок хранения: дата (2006-01-02, \"2006-01-02 15:04\") или длительность от текущего момента (72h)")
+	f.StringVar(&pkg, "package", "", "упаковка: bag, box, tape, bag+tape, box+tape")
+	f.Float64Var(&weight, "weight", 0, "вес в кг")
+	f.Float64Var(&price, "price", 0, "цена без надбавок за упаковку в рублях")
+	f.StringVar(&currency, "currency", string(models.DefaultCurrency), "валюта цены")
+	for _, name := range []string{"id", "user", "expires", "weight", "price"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	_ = cmd.RegisterFlagCompletionFunc("package", fixedCompletion(packageNames()...))
+	return cmd
+}
+
+func newProcessCmd(a *app, use, short string, action desc.ActionType) *cobra.Command {
+	var userID uint64
+
+	cmd := &cobra.Command{
+		Use:     use + " ORDER_ID...",
+		Short:   short,
+		Example: fmt.Sprintf("  pwz order %s --user 1 60006 60007", use),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderIDs, err := parseIDs(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.writeCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.ProcessOrders(ctx, &desc.ProcessOrdersRequest{
+				UserId:   userID,
+				Action:   action,
+				OrderIds: orderIDs,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := a.print(res, func(t *table) {
+				t.header = []string{"order id", "result"}
+				for _, id := range res.GetProcessed() {
+					t.row(id, "ok")
+				}
+				fillOrderErrors(t, res.GetErrors(), res.GetErrorDetails())
+			}); err != nil {
+				return err
+			}
+			if len(res.GetErrors()) > 0 {
+				return errPartial
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&userID, "user", 0, "ID клиента")
+	_ = cmd.MarkFlagRequired("user")
+	return cmd
+}
+
+// fillOrderErrors причина по каждому заказу, старый сервер присылает только ID
+func fillOrderErrors(t *table, ids []uint64, details []*desc.OrderError) {
+	if len(details) == 0 {
+		for _, id := range ids {
+			t.row(id, "failed")
+		}
+		return
+	}
+	for _, d := range details {
+		t.row(d.GetOrderId(), fmt.Sprintf("%s: %s", d.GetCode(), d.GetMessage()))
+	}
+}
+
+func newReturnToCourierCmd(a *app) *cobra.Command {
+	return &cobra.Command{
+		Use:     "return-to-courier ORDER_ID",
+		Aliases: []string{"return"},
+		Short:   "Вернуть заказ курьеру",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderID, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.writeCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.ReturnOrder(ctx, &desc.OrderIdRequest{OrderId: orderID})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				t.header = []string{"order id", "status"}
+				t.row(res.GetOrderId(), statusName(res.GetStatus()))
+			})
+		},
+	}
+}
+
+func newListOrdersCmd(a *app) *cobra.Command {
+	var (
+		req                    desc.ListOrdersRequest
+		lastN                  uint32
+		page, limit            uint32
+		statuses               []string
+		expiresFrom, expiresTo string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Заказы клиента или всего ПВЗ",
+		Example: "  pwz order list --user 1 --in-pvz\n  pwz order list --status expects,returned --expires-to 2025-08-01 -o json",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if limit == 0 || limit > maxPageSize {
+				return usagef("--limit must be between 1 and %d", maxPageSize)
+			}
+			var err error
+			if req.Statuses, err = parseStatuses(statuses); err != nil {
+				return err
+			}
+			if req.ExpiresFrom, err = parseDateFlag(expiresFrom); err != nil {
+				return err
+			}
+			if req.ExpiresTo, err = parseDateFlag(expiresTo); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("last-n") {
+				req.LastN = &lastN
+			}
+			req.Pagination = &desc.Pagination{Page: page, CountOnPage: limit}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.readCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.ListOrders(ctx, &req)
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				fillOrders(t, res.GetOrders())
+				t.footer = append(t.footer, fmt.Sprintf("Total: %d, page %d", res.GetTotal(), page))
+			})
+		},
+	}
+
+	f := cmd.Flags()
+	f.Uint64Var(&req.UserId, "user", 0, "ID клиента, 0 - все клиенты (только admin)")
+	f.BoolVar(&req.InPvz, "in-pvz", false, "только заказы, которые лежат в ПВЗ")
+	f.BoolVar(&req.IncludeDeleted, "include-deleted", false, "вместе с заказами, возвращенными курьеру")
+	f.Uint32Var(&lastN, "last-n", 0, "только последние N заказов")
+	f.StringSliceVar(&statuses, "status", nil, "статусы через запятую: expects, accepted, returned, deleted")
+	f.StringVar(&expiresFrom, "expires-from", "", "срок хранения не раньше, включительно")
+	f.StringVar(&expiresTo, "expires-to", "", "срок хранения раньше, не включительно")
+	addPageFlags(cmd, &page, &limit)
+	_ = cmd.RegisterFlagCompletionFunc("status", fixedCompletion(statusNames()...))
+	return cmd
+}
+
+func newListReturnsCmd(a *app) *cobra.Command {
+	var page, limit uint32
+
+	cmd := &cobra.Command{
+		Use:   "returns",
+		Short: "Заказы, которые клиенты вернули в ПВЗ",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if limit == 0 || limit > maxPageSize {
+				return usagef("--limit must be between 1 and %d", maxPageSize)
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.readCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.ListReturns(ctx, &desc.ListReturnsRequest{
+				Pagination: &desc.Pagination{Page: page, CountOnPage: limit},
+			})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				fillOrders(t, res.GetReturns())
+			})
+		},
+	}
+
+	addPageFlags(cmd, &page, &limit)
+	return cmd
+}
+
+func fillOrders(t *table, orders []*desc.Order) {
+	t.header = []string{"order id", "user id", "status", "expires at", "package", "weight", "price", "tariff"}
+	for _, o := range orders {
+		pkg := "none"
+		if o.Package != nil {
+			pkg = packageName(o.GetPackage())
+		}
+		t.row(o.GetOrderId(), o.GetUserId(), statusName(o.GetStatus()),
+			o.GetExpiresAt().AsTime().Local().Format(DateTimeFormat), pkg, formatWeight(o), formatPrice(o), o.GetTariffVersion())
+	}
+}
+
+func newOrderHistoryCmd(a *app) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history ORDER_ID",
+		Short: "История статусов заказа",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderID, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.readCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.GetOrderHistory(ctx, &desc.OrderHistoryRequest{OrderId: orderID})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				fillHistory(t, res.GetHistory())
+			})
+		},
+	}
+}
+
+func newHistoryCmd(a *app) *cobra.Command {
+	var (
+		req                    desc.GetHistoryRequest
+		page, limit            uint32
+		statuses               []string
+		createdFrom, createdTo string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "История смены статусов всех заказов, новые сначала",
+		Example: "  pwz history --user 1 --from 2025-07-01 --to 2025-08-01",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if limit == 0 || limit > maxPageSize {
+				return usagef("--limit must be between 1 and %d", maxPageSize)
+			}
+			var err error
+			if req.Statuses, err = parseStatuses(statuses); err != nil {
+				return err
+			}
+			if req.CreatedFrom, err = parseDateFlag(createdFrom); err != nil {
+				return err
+			}
+			if req.CreatedTo, err = parseDateFlag(createdTo); err != nil {
+				return err
+			}
+			req.Pagination = &desc.Pagination{Page: page, CountOnPage: limit}
+
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.readCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.GetHistory(ctx, &req)
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				fillHistory(t, res.GetHistory())
+			})
+		},
+	}
+
+	f := cmd.Flags()
+	f.Uint64Var(&req.UserId, "user", 0, "ID клиента, 0 - все клиенты")
+	f.StringSliceVar(&statuses, "status", nil, "статусы через запятую: expects, accepted, returned, deleted")
+	f.StringVar(&createdFrom, "from", "", "начало периода, включительно")
+	f.StringVar(&createdTo, "to", "", "конец периода, не включительно")
+	addPageFlags(cmd, &page, &limit)
+	_ = cmd.RegisterFlagCompletionFunc("status", fixedCompletion(statusNames()...))
+	return cmd
+}
+
+func fillHistory(t *table, history []*desc.OrderHistory) {
+	t.header = []string{"order id", "status", "created at", "actor"}
+	for _, h := range history {
+		t.row(h.GetOrderId(), statusName(h.GetStatus()), h.GetCreatedAt().AsTime().Local().Format(DateTimeFormat), actorName(h.GetActor()))
+	}
+}
+
+func addPageFlags(cmd *cobra.Command, page, limit *uint32) {
+	cmd.Flags().Uint32Var(page, "page", 0, "номер страницы с 0")
+	cmd.Flags().Uint32Var(limit, "limit", 10, fmt.Sprintf("заказов на странице, не больше %d", maxPageSize))
+}
+
+// formatWeight вес в кг, старый сервер присылает только float
+func formatWeight(o *desc.Order) string {
+	if o.GetWeightGrams() == 0 {
+		return fmt.Sprintf("%.3f kg", o.GetWeight())
+	}
+	return fmt.Sprintf("%d.%03d kg", o.GetWeightGrams()/1000, o.GetWeightGrams()%1000)
+}
+
+// formatPrice цена без ошибок округления float
+func formatPrice(o *desc.Order) string {
+	if o.GetCurrency() == "" {
+		return fmt.Sprintf("%.2f", o.GetTotalPrice())
+	}
+	return fmt.Sprintf("%d.%02d %s", o.GetTotalPriceMinor()/100, o.GetTotalPriceMinor()%100, o.GetCurrency())
+}
+
+func parseID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || id == 0 {
+		return 0, usagef("bad order id %q", s)
+	}
+	return id, nil
+}
+
+func parseIDs(args []string) ([]uint64, error) {
+	ids := make([]uint64, 0, len(args))
+	for _, arg := range args {
+		id, err := parseID(arg)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseExpires срок хранения датой или длительностью от now
+func parseExpires(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("--expires must be in the future")
+		}
+		return now.Add(d), nil
+	}
+	ts, err := parseDateFlag(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ts.AsTime(), nil
+}
+
+func parseDateFlag(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, DateTimeFormat, "2006-01-02 15:04", time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, usagef("bad date %q, expected 2006-01-02 or %q", s, DateTimeFormat)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table ответ сервера в виде таблицы для оператора
+type table struct {
+	header []string
+	rows   [][]string
+	footer []string // строки после таблицы, например итог
+}
+
+func (t *table) row(cells ...any) {
+	row := make([]string, 0, len(cells))
+	for _, c := range cells {
+		row = append(row, fmt.Sprint(c))
+	}
+	t.rows = append(t.rows, row)
+}
+
+// print выводит ответ сервера: json и yaml - сообщение целиком, чтобы скрипты видели все поля, table - то, что соберет fill
+func (a *app) print(msg proto.Message, fill func(t *table)) error {
+	if a.onResponse != nil {
+		a.onResponse(msg)
+	}
+
+	switch a.output {
+	case outputJSON:
+		data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		// protojson намеренно добавляет случайные пробелы, отступы расставляем сами, чтобы вывод не менялся от сборки к сборке
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(a.out, "%s\n", buf.Bytes())
+		return err
+	case outputYAML:
+		data, err := protoYAML(msg)
+		if err != nil {
+			return err
+		}
+		_, err = a.out.Write(data)
+		return err
+	}
+
+	var t table
+	fill(&t)
+	return t.render(a)
+}
+
+func (t *table) render(a *app) error {
+	if len(t.header) > 0 {
+		tw := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(t.header, "\t")))
+		for _, r := range t.rows {
+			fmt.Fprintln(tw, strings.Join(r, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	for _, line := range t.footer {
+		if _, err := fmt.Fprintln(a.out, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// protoYAML YAML с тем же порядком и именами полей, что и в JSON
+func protoYAML(msg proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON - подмножество YAML, через yaml.Node сохраняется порядок полей
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
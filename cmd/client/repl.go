@@ -0,0 +1,441 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/peterh/liner"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxRecentIDs сколько последних ID заказов и клиентов предлагать при дополнении
+const maxRecentIDs = 50
+
+// errExit команда exit
+var errExit = errors.New("exit")
+
+// reportedError ошибка уже напечатана, нужен только код выхода
+type reportedError struct {
+	err error
+}
+
+func (e reportedError) Error() string { return e.err.Error() }
+func (e reportedError) Unwrap() error { return e.err }
+
+// replCommands команды консоли, которых нет в pwz; остальные строки выполняются как команды pwz
+var replCommands = []struct {
+	name, usage, help string
+}{
+	{"user", "user [ID|-]", "показать, выбрать или сбросить клиента сессии"},
+	{"accept", "accept ORDER_ID EXPIRES WEIGHT_KG PRICE [PACKAGE]", "принять заказ от курьера для клиента сессии"},
+	{"issue", "issue ORDER_ID...", "выдать заказы клиенту сессии"},
+	{"return", "return ORDER_ID...", "принять возврат заказов от клиента сессии"},
+	{"courier", "courier ORDER_ID", "вернуть заказ курьеру"},
+	{"list", "list [флаги order list]", "заказы клиента сессии"},
+	{"returns", "returns [--page N]", "заказы, которые клиенты вернули в ПВЗ"},
+	{"history", "history [ORDER_ID]", "история заказа или всех заказов клиента сессии"},
+	{"help", "help", "эта справка"},
+	{"exit", "exit", "выйти, как и Ctrl+D"},
+}
+
+func newReplCmd(a *app) *cobra.Command {
+	var (
+		script      string
+		historyFile string
+		userID      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "repl",
+		Short: "Интерактивная консоль оператора ПВЗ",
+		Long: "Консоль с историей команд и дополнением по Tab. Клиент сессии (user ID) подставляется\n" +
+			"в accept, issue, return, list и history. Любая команда pwz тоже работает, например: order list --in-pvz.\n" +
+			"С --script или со стандартного ввода без терминала команды читаются построчно и выполнение\n" +
+			"останавливается на первой ошибке, код выхода - как у этой команды.",
+		Example: "  pwz repl --user 42\n  pwz repl --script session.txt",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if a.onResponse != nil {
+				return usagef("already in REPL")
+			}
+
+			r := &repl{a: a, user: userID}
+			if userID != 0 {
+				r.recent.addUser(userID)
+			}
+
+			switch {
+			case script != "" && script != "-":
+				f, err := os.Open(script)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r.in = newScriptReader(f, a.out)
+			case script == "-" || !isTerminal(os.Stdin):
+				r.in = newScriptReader(cmd.InOrStdin(), a.out)
+			default:
+				r.in = newTerminalReader(historyFile, r.complete)
+			}
+			defer r.in.close()
+
+			a.onResponse = r.recent.observe
+			defer func() { a.onResponse = nil }()
+
+			return r.loop(cmd.Context())
+		},
+	}
+
+	home, _ := os.UserHomeDir()
+	f := cmd.Flags()
+	f.StringVar(&script, "script", "", "выполнить команды из файла, - для стандартного ввода")
+	f.StringVar(&historyFile, "history-file", filepath.Join(home, ".pwz_history"), "файл истории команд, пустой - не сохранять")
+	f.Uint64Var(&userID, "user", 0, "клиент сессии")
+	return cmd
+}
+
+type repl struct {
+	a      *app
+	in     lineReader
+	user   uint64 // клиент сессии, 0 - не выбран
+	recent recentIDs
+}
+
+func (r *repl) prompt() string {
+	if r.user == 0 {
+		return "pwz> "
+	}
+	return fmt.Sprintf("pwz[user %d]> ", r.user)
+}
+
+func (r *repl) loop(ctx context.Context) error {
+	_, scripted := r.in.(*scriptReader)
+	if !scripted {
+		fmt.Fprintln(r.a.out, "PWZ operator console, help - list of commands, Ctrl+D - exit")
+	}
+
+	for {
+		line, err := r.in.readLine(r.prompt())
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, liner.ErrPromptAborted) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		err = r.exec(ctx, line)
+		if errors.Is(err, errExit) {
+			return nil
+		}
+		if err != nil {
+			r.a.printError(err)
+			if scripted {
+				return reportedError{err: err}
+			}
+		}
+	}
+}
+
+// exec выполняет строку; Ctrl+C прерывает только текущую команду, а не консоль
+func (r *repl) exec(ctx context.Context, line string) error {
+	args, err := splitArgs(line)
+	if err != nil {
+		return usageError{err: err}
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return errExit
+	case "help", "?":
+		r.printHelp()
+		return nil
+	case "user":
+		return r.setUser(args[1:])
+	}
+
+	args, err = r.expand(args[0], args[1:])
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.WithoutCancel(ctx), os.Interrupt)
+	defer stop()
+
+	// флаги вроде -o json действуют только на эту строку
+	saved := r.a.settings
+	defer func() { r.a.settings = saved }()
+
+	// ошибки разбора строки cobra возвращает до запуска команды
+	err = r.a.execute(ctx, args)
+	var run runError
+	if err != nil && !errors.As(err, &run) {
+		return usageError{err: err}
+	}
+	return err
+}
+
+func (r *repl) setUser(args []string) error {
+	switch {
+	case len(args) == 0:
+		if r.user == 0 {
+			fmt.Fprintln(r.a.out, "No session user")
+		} else {
+			fmt.Fprintf(r.a.out, "Session user: %d\n", r.user)
+		}
+	case len(args) == 1 && args[0] == "-":
+		r.user = 0
+	case len(args) == 1:
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil || id == 0 {
+			return usagef("bad user id %q", args[0])
+		}
+		r.user = id
+		r.recent.addUser(id)
+	default:
+		return usagef("usage: user [ID|-]")
+	}
+	return nil
+}
+
+// expand переводит команду консоли в аргументы pwz
+func (r *repl) expand(name string, args []string) ([]string, error) {
+	switch name {
+	case "issue", "return":
+		if r.user == 0 {
+			return nil, errNoUser
+		}
+		sub := "issue"
+		if name == "return" {
+			sub = "client-return"
+		}
+		return append([]string{"order", sub, "--user", r.userArg()}, args...), nil
+	case "courier":
+		return append([]string{"order", "return-to-courier"}, args...), nil
+	case "accept":
+		if r.user == 0 {
+			return nil, errNoUser
+		}
+		if len(args) < 4 || len(args) > 5 || strings.HasPrefix(args[0], "-") {
+			// с флагами - как у order accept
+			return append([]string{"order", "accept", "--user", r.userArg()}, args...), nil
+		}
+		expanded := []string{"order", "accept", "--user", r.userArg(), "--id", args[0], "--expires", args[1], "--weight", args[2], "--price", args[3]}
+		if len(args) == 5 {
+			expanded = append(expanded, "--package", args[4])
+		}
+		return expanded, nil
+	case "list":
+		return append(append([]string{"order", "list"}, r.userFlag(args)...), args...), nil
+	case "returns":
+		return append([]string{"order", "returns"}, args...), nil
+	case "history":
+		if len(args) == 1 && !strings.HasPrefix(args[0], "-") {
+			return []string{"order", "history", args[0]}, nil
+		}
+		return append(append([]string{"history"}, r.userFlag(args)...), args...), nil
+	}
+	return append([]string{name}, args...), nil
+}
+
+var errNoUser = usageError{err: errors.New("no session user, choose one with: user ID")}
+
+func (r *repl) userArg() string {
+	return strconv.FormatUint(r.user, 10)
+}
+
+// userFlag клиент сессии, если в команде клиент не указан явно
+func (r *repl) userFlag(args []string) []string {
+	if r.user == 0 || slices.Contains(args, "--user") {
+		return nil
+	}
+	return []string{"--user", r.userArg()}
+}
+
+func (r *repl) printHelp() {
+	t := table{header: []string{"command", "description"}}
+	for _, c := range replCommands {
+		t.row(c.usage, c.help)
+	}
+	t.footer = []string{"", "Other lines run as pwz commands: order, history, tariffs, message, import, export. Flags like -o json apply to one line."}
+	_ = t.render(r.a)
+}
+
+// commandNames команды консоли и pwz для дополнения первого слова
+func (r *repl) commandNames() []string {
+	names := make([]string, 0, len(replCommands)+8)
+	for _, c := range replCommands {
+		names = append(names, c.name)
+	}
+	for _, c := range newRootCmd(r.a).Commands() {
+		if c.IsAvailableCommand() && c.Name() != "repl" && c.Name() != "completion" && !slices.Contains(names, c.Name()) {
+			names = append(names, c.Name())
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// complete дополнение по Tab: первое слово - команда, после order и message - подкоманда,
+// после user и --user - недавние клиенты, иначе - недавние заказы
+func (r *repl) complete(line string, pos int) (string, []string, string) {
+	head, tail := line[:pos], line[pos:]
+	start := strings.LastIndexAny(head, " \t") + 1
+	prefix, word := head[:start], head[start:]
+	fields := strings.Fields(prefix)
+
+	var candidates []string
+	switch {
+	case len(fields) == 0:
+		candidates = r.commandNames()
+	case fields[0] == "user" || fields[len(fields)-1] == "--user":
+		candidates = r.recent.usersNewestFirst()
+	case len(fields) == 1 && (fields[0] == "order" || fields[0] == "message"):
+		if group, _, err := newRootCmd(r.a).Find(fields); err == nil {
+			for _, c := range group.Commands() {
+				candidates = append(candidates, c.Name())
+			}
+		}
+	default:
+		candidates = r.recent.ordersNewestFirst()
+	}
+
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, word) {
+			matches = append(matches, c+" ")
+		}
+	}
+	return prefix, matches, tail
+}
+
+// recentIDs ID из ответов сервера, новые в конце
+type recentIDs struct {
+	orders []uint64
+	users  []uint64
+}
+
+func (r *recentIDs) addOrder(id uint64) { r.orders = pushRecent(r.orders, id) }
+func (r *recentIDs) addUser(id uint64)  { r.users = pushRecent(r.users, id) }
+
+func pushRecent(ids []uint64, id uint64) []uint64 {
+	if id == 0 {
+		return ids
+	}
+	ids = slices.DeleteFunc(ids, func(v uint64) bool { return v == id })
+	ids = append(ids, id)
+	if len(ids) > maxRecentIDs {
+		ids = ids[len(ids)-maxRecentIDs:]
+	}
+	return ids
+}
+
+func (r *recentIDs) ordersNewestFirst() []string { return newestFirst(r.orders) }
+func (r *recentIDs) usersNewestFirst() []string  { return newestFirst(r.users) }
+
+func newestFirst(ids []uint64) []string {
+	out := make([]string, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		out = append(out, strconv.FormatUint(ids[i], 10))
+	}
+	return out
+}
+
+func (r *recentIDs) observe(msg proto.Message) {
+	switch m := msg.(type) {
+	case *desc.OrdersList:
+		r.observeOrders(m.GetOrders())
+	case *desc.ReturnsList:
+		r.observeOrders(m.GetReturns())
+	case *desc.OrderResponse:
+		r.addOrder(m.GetOrderId())
+	case *desc.ProcessResult:
+		for _, id := range m.GetProcessed() {
+			r.addOrder(id)
+		}
+		for _, id := range m.GetErrors() {
+			r.addOrder(id)
+		}
+	case *desc.OrderHistoryList:
+		r.observeHistory(m.GetHistory())
+	case *desc.OrderHistoryResponse:
+		r.observeHistory(m.GetHistory())
+	}
+}
+
+func (r *recentIDs) observeOrders(orders []*desc.Order) {
+	for _, o := range orders {
+		r.addOrder(o.GetOrderId())
+		r.addUser(o.GetUserId())
+	}
+}
+
+func (r *recentIDs) observeHistory(history []*desc.OrderHistory) {
+	for _, h := range history {
+		r.addOrder(h.GetOrderId())
+	}
+}
+
+// splitArgs разбивает строку как shell: пробелы, кавычки и обратный слеш
+func splitArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		cur     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range line {
+		switch {
+		case escaped:
+			cur.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote, inArg = c, true
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(c)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return args, nil
+}
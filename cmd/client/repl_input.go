@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/peterh/liner"
+)
+
+// lineReader источник строк консоли: терминал или скрипт
+type lineReader interface {
+	readLine(prompt string) (string, error)
+	close() error
+}
+
+// terminalReader строка с редактированием, историей и дополнением по Tab
+type terminalReader struct {
+	state       *liner.State
+	historyFile string
+}
+
+func newTerminalReader(historyFile string, complete liner.WordCompleter) *terminalReader {
+	state := liner.NewLiner()
+	state.SetCtrlCAborts(true)
+	state.SetTabCompletionStyle(liner.TabPrints)
+	state.SetWordCompleter(complete)
+
+	if historyFile != "" {
+		if f, err := os.Open(historyFile); err == nil {
+			_, _ = state.ReadHistory(f)
+			f.Close()
+		}
+	}
+	return &terminalReader{state: state, historyFile: historyFile}
+}
+
+func (t *terminalReader) readLine(prompt string) (string, error) {
+	line, err := t.state.Prompt(prompt)
+	if err == nil && strings.TrimSpace(line) != "" {
+		t.state.AppendHistory(line)
+	}
+	return line, err
+}
+
+func (t *terminalReader) close() error {
+	defer t.state.Close()
+	if t.historyFile == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(t.historyFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = t.state.WriteHistory(f)
+	return err
+}
+
+// scriptReader строки из файла; каждая печатается после приглашения, чтобы вывод читался как сессия оператора
+type scriptReader struct {
+	sc   *bufio.Scanner
+	echo io.Writer
+}
+
+func newScriptReader(r io.Reader, echo io.Writer) *scriptReader {
+	return &scriptReader{sc: bufio.NewScanner(r), echo: echo}
+}
+
+func (s *scriptReader) readLine(prompt string) (string, error) {
+	if !s.sc.Scan() {
+		if err := s.sc.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+
+	line := s.sc.Text()
+	if strings.TrimSpace(line) != "" {
+		fmt.Fprintf(s.echo, "%s%s\n", prompt, line)
+	}
+	return line, nil
+}
+
+func (s *scriptReader) close() error {
+	return nil
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
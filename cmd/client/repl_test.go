@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, lines ...string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "session.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+	return path
+}
+
+func TestRepl_Script(t *testing.T) {
+	addr := startServer(t)
+
+	script := writeScript(t,
+		"# смена оператора",
+		"user 7",
+		"list",
+		"list -o json",
+		"list",
+		"exit",
+		"list",
+	)
+	out, stderr, code := runClient(t, "--addr", addr, "repl", "--script", script)
+	require.Equal(t, exitOK, code, stderr)
+
+	assert.Contains(t, out, "pwz> user 7\n")
+	assert.Contains(t, out, "pwz[user 7]> list\n")
+	assert.Contains(t, out, `"order_id": "60006"`)
+	// -o json действует только на свою строку, после exit ничего не выполняется
+	assert.Equal(t, 2, strings.Count(out, "Total: 1"))
+	assert.Equal(t, 3, strings.Count(out, "pwz[user 7]> list"))
+}
+
+// secondNotifier другой сервер: тот же заказ, но другой Total
+type secondNotifier struct {
+	fakeNotifier
+}
+
+func (n secondNotifier) ListOrders(ctx context.Context, req *desc.ListOrdersRequest) (*desc.OrdersList, error) {
+	res, err := n.fakeNotifier.ListOrders(ctx, req)
+	if err == nil {
+		res.Total = 2
+	}
+	return res, err
+}
+
+func TestRepl_ScriptAddrPerLine(t *testing.T) {
+	addr := startServer(t)
+	other := startNotifier(t, secondNotifier{})
+
+	script := writeScript(t,
+		"user 7",
+		"list",
+		"list --addr "+other,
+		"list",
+	)
+	out, stderr, code := runClient(t, "--addr", addr, "repl", "--script", script)
+	require.Equal(t, exitOK, code, stderr)
+
+	// --addr действует только на свою строку, следующая снова идет на первый сервер
+	assert.Equal(t, 2, strings.Count(out, "Total: 1"))
+	assert.Equal(t, 1, strings.Count(out, "Total: 2"))
+}
+
+func TestRepl_ScriptStopsOnError(t *testing.T) {
+	addr := startServer(t)
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  int
+	}{
+		{"no session user", []string{"issue 1 2", "tariffs"}, exitUsage},
+		{"unknown command", []string{"frobnicate", "tariffs"}, exitUsage},
+		{"server error", []string{"user 404", "list"}, exitNotFound},
+		{"partial result", []string{"user 1", "issue 1 2", "tariffs"}, exitPartial},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, _, code := runClient(t, "--addr", addr, "repl", "--script", writeScript(t, tt.lines...))
+			assert.Equal(t, tt.want, code)
+			assert.NotContains(t, out, "tariffs", "после ошибки скрипт не продолжается")
+		})
+	}
+}
+
+func TestRepl_Complete(t *testing.T) {
+	r := &repl{a: newApp(nil, nil)}
+	r.recent.addUser(7)
+	r.recent.addOrder(60006)
+	r.recent.addOrder(60100)
+	r.recent.addOrder(60006)
+
+	_, got, _ := r.complete("is", 2)
+	assert.Equal(t, []string{"issue "}, got)
+
+	head, got, tail := r.complete("issue 60 --now", 8)
+	assert.Equal(t, "issue ", head)
+	assert.Equal(t, []string{"60006 ", "60100 "}, got)
+	assert.Equal(t, " --now", tail)
+
+	_, got, _ = r.complete("order list --user ", 18)
+	assert.Equal(t, []string{"7 "}, got)
+
+	_, got, _ = r.complete("order acc", 9)
+	assert.Equal(t, []string{"accept "}, got)
+}
+
+func TestRepl_Expand(t *testing.T) {
+	r := &repl{user: 7}
+
+	got, err := r.expand("accept", []string{"60006", "72h", "1.5", "999.90", "box"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order", "accept", "--user", "7", "--id", "60006", "--expires", "72h", "--weight", "1.5", "--price", "999.90", "--package", "box"}, got)
+
+	got, err = r.expand("list", []string{"--user", "8"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order", "list", "--user", "8"}, got)
+
+	got, err = r.expand("history", []string{"60006"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order", "history", "60006"}, got)
+
+	got, err = r.expand("history", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"history", "--user", "7"}, got)
+
+	r.user = 0
+	_, err = r.expand("issue", []string{"1"})
+	assert.Equal(t, errNoUser, err)
+}
+
+func TestSplitArgs(t *testing.T) {
+	t.Parallel()
+
+	got, err := splitArgs(`message send --text "Заказ ждет" --comment 'a "b"' c\ d`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message", "send", "--text", "Заказ ждет", "--comment", `a "b"`, "c d"}, got)
+
+	_, err = splitArgs(`send "oops`)
+	assert.Error(t, err)
+}
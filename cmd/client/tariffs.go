@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/spf13/cobra"
+)
+
+func newTariffsCmd(a *app) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tariffs",
+		Short: "Версии тарифов и действующая версия",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := a.dial()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := a.readCtx(cmd.Context())
+			defer cancel()
+
+			res, err := client.GetTariffs(ctx, &desc.GetTariffsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return a.print(res, func(t *table) {
+				t.header = []string{"version", "valid from", "currency", "packages", "weight tiers"}
+				for _, tariff := range res.GetTariffs() {
+					version := tariff.GetVersion()
+					if version == res.GetActiveVersion() {
+						version += " *"
+					}
+					t.row(version, tariff.GetValidFrom().AsTime().Local().Format(DateTimeFormat), tariff.GetCurrency(),
+						formatPackageTariffs(tariff.GetPackages()), formatWeightTiers(tariff.GetWeightTiers()))
+				}
+				t.footer = append(t.footer, "* - active version")
+			})
+		},
+	}
+}
+
+// formatPackageTariffs box +20.00 <30.000kg, ...
+func formatPackageTariffs(packages []*desc.PackageTariff) string {
+	parts := make([]string, 0, len(packages))
+	for _, p := range packages {
+		part := fmt.Sprintf("%s +%s", packageName(p.GetPackage()), formatMinor(p.GetSurchargeMinor()))
+		if p.GetWeightLimitGrams() > 0 {
+			part += fmt.Sprintf(" <%s kg", formatGrams(p.GetWeightLimitGrams()))
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatWeightTiers(tiers []*desc.WeightTier) string {
+	parts := make([]string, 0, len(tiers))
+	for _, w := range tiers {
+		parts = append(parts, fmt.Sprintf(">=%s kg +%s", formatGrams(w.GetFromWeightGrams()), formatMinor(w.GetSurchargeMinor())))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatMinor(v int64) string {
+	return fmt.Sprintf("%d.%02d", v/100, v%100)
+}
+
+func formatGrams(v int64) string {
+	return fmt.Sprintf("%d.%03d", v/1000, v%1000)
+}
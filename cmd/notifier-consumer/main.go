@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+
+	"PWZ1.0/internal/config"
+	"PWZ1.0/internal/consumer"
+	"PWZ1.0/internal/lifecycle"
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tools/logger"
+	"PWZ1.0/internal/tracing"
+)
+
+func main() {
+	cfg := config.MustLoad("notifier-consumer")
+	if err := logger.Init(os.Stdout, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to init logger: %v", err)
+	}
+	if err := cfg.RequirePostgres(); err != nil {
+		log.Fatal(err)
+	}
+
+	runner := lifecycle.New(cfg.Shutdown.DrainTimeout)
+
+	closeTracing, err := tracing.Init(context.Background(), "pvz-notifier-consumer", tracing.Config(cfg.Tracing))
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	runner.OnClose("tracing", closeTracing)
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), cfg.Postgres.ConnectTimeout)
+	defer cancel()
+
+	db, err := storage.NewPool(connectCtx, cfg.Postgres.DSN, cfg.Log.SlowQuery)
+	if err != nil {
+		log.Fatalf("failed to create pgxpool: %v", err)
+	}
+	runner.OnClose("postgres", func() error {
+		db.Close()
+		return nil
+	})
+
+	if err := db.Ping(connectCtx); err != nil {
+		log.Fatalf("failed to ping database: %v", err)
+	}
+
+	dlq, err := outbox.NewKafkaPublisher(cfg.Kafka.Brokers, cfg.Notifier.DLQTopic)
+	if err != nil {
+		log.Fatalf("failed to create dead-letter producer: %v", err)
+	}
+	runner.OnClose("dead-letter producer", dlq.Close)
+
+	handler := newHandler(cfg.Notifier)
+
+	group, err := consumer.NewConsumerGroup(cfg.Kafka.Brokers, cfg.Notifier.Group)
+	if err != nil {
+		log.Fatalf("failed to create consumer group: %v", err)
+	}
+	runner.OnClose("consumer group", group.Close)
+
+	processor := consumer.NewProcessor(storage.NewPgStorage(db), handler, dlq, consumer.DefaultConfig())
+
+	runner.Go("consumer", func(ctx context.Context) error {
+		return consumer.Run(ctx, group, []string{cfg.Kafka.Topic}, consumer.NewGroupHandler(processor))
+	})
+
+	slog.Info("notifier consumer started", "topic", cfg.Kafka.Topic)
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatalf("notifier consumer stopped: %v", err)
+	}
+	slog.Info("notifier consumer stopped")
+}
+
+// newHandler обработчик из notifier.handler, значение и URL webhook уже проверены в config.Validate
+func newHandler(cfg config.NotifierConfig) consumer.Handler {
+	switch cfg.Handler {
+	case "webhook":
+		return consumer.NewWebhookHandler(cfg.WebhookURL, cfg.WebhookTimeout)
+	case "customer":
+		return consumer.NewCustomerNotificationHandler()
+	default:
+		return consumer.NewLogHandler()
+	}
+}
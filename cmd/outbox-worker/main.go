@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"PWZ1.0/internal/config"
+	"PWZ1.0/internal/lifecycle"
+	"PWZ1.0/internal/metrics"
+	"PWZ1.0/internal/mw"
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tools/logger"
+	"PWZ1.0/internal/tracing"
+)
+
+func main() {
+	cfg := config.MustLoad("outbox-worker")
+	if err := logger.Init(os.Stdout, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to init logger: %v", err)
+	}
+	if err := cfg.RequirePostgres(); err != nil {
+		log.Fatal(err)
+	}
+	metrics.InitOutbox()
+
+	runner := lifecycle.New(cfg.Shutdown.DrainTimeout)
+
+	closeTracing, err := tracing.Init(context.Background(), "pvz-outbox-worker", tracing.Config(cfg.Tracing))
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	runner.OnClose("tracing", closeTracing)
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), cfg.Postgres.ConnectTimeout)
+	defer cancel()
+
+	db, err := storage.NewPool(connectCtx, cfg.Postgres.DSN, cfg.Log.SlowQuery)
+	if err != nil {
+		log.Fatalf("failed to create pgxpool: %v", err)
+	}
+	metrics.Registry.MustRegister(metrics.NewPoolCollector(db))
+	runner.OnClose("postgres", func() error {
+		db.Close()
+		return nil
+	})
+
+	if err := db.Ping(connectCtx); err != nil {
+		log.Fatalf("failed to ping database: %v", err)
+	}
+
+	publisher, err := outbox.NewKafkaPublisher(cfg.Kafka.Brokers, cfg.Kafka.Topic)
+	if err != nil {
+		log.Fatalf("failed to create kafka producer: %v", err)
+	}
+	runner.OnClose("kafka producer", publisher.Close)
+
+	relay := outbox.NewRelay(storage.NewPgStorage(db), publisher, outbox.DefaultConfig())
+	runner.Go("outbox relay", relay.Run)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/readyz", runner.ReadyHandler())
+	// ключей аутентификации у воркера нет, поэтому уровень только смотреть; поменять - через log.level и перезапуск
+	mux.Handle("/loglevel", mw.ReadOnlyHTTPHandler(logger.LevelHandler()))
+	metricsServer, err := lifecycle.ListenHTTP(cfg.Outbox.MetricsAddr, mux)
+	if err != nil {
+		log.Fatalf("failed to start metrics server: %v", err)
+	}
+	slog.Info("prometheus metrics server listening", "addr", cfg.Outbox.MetricsAddr)
+	runner.Serve("metrics server", metricsServer)
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatalf("outbox worker stopped: %v", err)
+	}
+}
@@ -3,34 +3,114 @@ package main
 import (
 	"context"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"PWZ1.0/internal/config"
+	"PWZ1.0/internal/health"
+	"PWZ1.0/internal/lifecycle"
 	"PWZ1.0/internal/mw"
+	"PWZ1.0/internal/tools/logger"
+	"PWZ1.0/internal/tracing"
 	desc "PWZ1.0/pkg/pwz"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-)
-
-const (
-	grpcAddress = "localhost:50051"
-	httpAddress = "localhost:50052"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 func main() {
-	ctx := context.Background()
-	mux := runtime.NewServeMux(
+	cfg := config.MustLoad("server-gw")
+	if err := logger.Init(os.Stdout, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to init logger: %v", err)
+	}
+
+	runner := lifecycle.New(cfg.Shutdown.DrainTimeout)
+
+	closeTracing, err := tracing.Init(context.Background(), "pvz-gateway", tracing.Config(cfg.Tracing))
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	runner.OnClose("tracing", closeTracing)
+
+	// соединение с gRPC сервером закрывается по отмене ctx, уже после остановки HTTP сервера
+	ctx, cancel := context.WithCancel(context.Background())
+	runner.OnClose("gRPC connection", func() error {
+		cancel()
+		return nil
+	})
+
+	gwMux := runtime.NewServeMux(
 		runtime.WithErrorHandler(mw.CustomErrorHandler),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
 	)
-	err := desc.RegisterNotifierHandlerFromEndpoint(ctx, mux, grpcAddress, []grpc.DialOption{
+	err = desc.RegisterNotifierHandlerFromEndpoint(ctx, gwMux, cfg.Gateway.ServerAddr, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// traceparent уходит в метаданные gRPC, и сервер продолжает трассировку gateway
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	})
 	if err != nil {
 		log.Fatalf("RegisterNotifierHandlerFromEndpoint err: %v", err)
 	}
 
-	log.Printf("http server running on %v", httpAddress)
-	if err := http.ListenAndServe(httpAddress, mux); err != nil {
+	healthConn, err := grpc.NewClient(cfg.Gateway.ServerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create health client: %v", err)
+	}
+	runner.OnClose("health connection", healthConn.Close)
+	healthClient := healthgrpc.NewHealthClient(healthConn)
+
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", health.LiveHandler())
+	mux.Handle("/readyz", health.ReadyHandler(runner.Ready, func(ctx context.Context) health.Report {
+		return serverReport(ctx, healthClient, cfg.Health.Timeout)
+	}))
+	mux.Handle("/", mw.RequestIDHandler(otelhttp.NewHandler(gwMux, "gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method
+		}),
+	)))
+
+	httpServer, err := lifecycle.ListenHTTP(cfg.Gateway.Addr, mux)
+	if err != nil {
 		log.Fatalf("http server running err: %v", err)
 	}
+	slog.Info("http server listening", "addr", cfg.Gateway.Addr)
+	runner.Serve("http server", httpServer)
+
+	if err := runner.Run(ctx); err != nil {
+		log.Fatalf("http server stopped: %v", err)
+	}
+}
+
+// serverReport готовность gateway - это готовность gRPC сервера за ним вместе с его зависимостями
+func serverReport(ctx context.Context, client healthgrpc.HealthClient, timeout time.Duration) health.Report {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := client.List(ctx, &healthgrpc.HealthListRequest{})
+	if err != nil {
+		return health.Report{Status: health.StatusUnavailable, Error: "grpc server: " + status.Convert(err).Message()}
+	}
+	return health.FromList(resp)
+}
+
+// incomingHeader кроме стандартных заголовков (Authorization уходит как authorization) передает API-ключ,
+// ключ идемпотентности и ID запроса
+func incomingHeader(key string) (string, bool) {
+	switch {
+	case strings.EqualFold(key, "X-Api-Key"):
+		return "x-api-key", true
+	case strings.EqualFold(key, "Idempotency-Key"):
+		return "idempotency-key", true
+	case strings.EqualFold(key, logger.RequestIDHeader):
+		return logger.RequestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
 }
@@ -1,31 +1,38 @@
 package main
 
 import (
+	"context"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 
+	"PWZ1.0/internal/config"
+	"PWZ1.0/internal/lifecycle"
+	"PWZ1.0/internal/tools/logger"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/swaggo/http-swagger"
 )
 
-const (
-	swaggerAddress = "localhost:7002"
-)
-
 func main() {
+	cfg := config.MustLoad("server-swagger")
+	if err := logger.Init(os.Stdout, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to init logger: %v", err)
+	}
+
 	mux := chi.NewMux()
 
 	mux.HandleFunc("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
-		b, err := os.ReadFile("./pkg/pwz/pwz.swagger.json")
+		b, err := os.ReadFile(cfg.Swagger.SpecFile)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			log.Printf("failed to read swagger.json: %v", err)
+			slog.ErrorContext(r.Context(), "failed to read swagger.json", "err", err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
 		if _, err := w.Write(b); err != nil {
-			log.Printf("failed to write response: %v", err)
+			slog.WarnContext(r.Context(), "failed to write response", "err", err)
 		}
 	})
 
@@ -33,8 +40,15 @@ func main() {
 		httpSwagger.URL("/swagger.json"),
 	))
 
-	log.Printf("Listening on %s", swaggerAddress)
-	if err := http.ListenAndServe(swaggerAddress, mux); err != nil {
+	server, err := lifecycle.ListenHTTP(cfg.Swagger.Addr, mux)
+	if err != nil {
 		log.Fatalf("failed to listen and serve: %v", err)
 	}
+	slog.Info("swagger server listening", "addr", cfg.Swagger.Addr)
+
+	runner := lifecycle.New(cfg.Shutdown.DrainTimeout)
+	runner.Serve("swagger server", server)
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatalf("swagger server stopped: %v", err)
+	}
 }
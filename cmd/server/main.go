@@ -2,110 +2,220 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
 	"time"
 
 	"PWZ1.0/internal/app/order"
+	"PWZ1.0/internal/auth"
+	"PWZ1.0/internal/config"
+	pwzhealth "PWZ1.0/internal/health"
+	"PWZ1.0/internal/idempotency"
+	"PWZ1.0/internal/lifecycle"
 	"PWZ1.0/internal/metrics"
+	"PWZ1.0/internal/models"
 	"PWZ1.0/internal/mw"
+	"PWZ1.0/internal/notification"
 	"PWZ1.0/internal/order_cache"
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/retention"
 	"PWZ1.0/internal/service"
 	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tariff"
 	"PWZ1.0/internal/tools/logger"
+	"PWZ1.0/internal/tracing"
 	desc "PWZ1.0/pkg/pwz"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/joho/godotenv"
 	"github.com/ulule/limiter/v3"
 	"github.com/ulule/limiter/v3/drivers/store/memory"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-const (
-	grpcAddress    = "localhost:50051"
-	metricsAddress = ":2112"
-)
-
 func main() {
-	logger.InitLogger()
-	metrics.Init()
-
-	if err := godotenv.Load(); err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
+	cfg := config.MustLoad("server")
+	if err := logger.Init(os.Stdout, cfg.Log.Format, cfg.Log.Level); err != nil {
+		log.Fatalf("failed to init logger: %v", err)
 	}
-
-	if err := godotenv.Load(); err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
+	if err := cfg.RequirePostgres(); err != nil {
+		log.Fatal(err)
 	}
+	metrics.Init()
+
+	runner := lifecycle.New(cfg.Shutdown.DrainTimeout)
 
-	dsn := os.Getenv("DB_DSN")
-	fmt.Println("DSN:", dsn)
-	if dsn == "" {
-		log.Fatal("Postgres DSN is empty")
+	closeTracing, err := tracing.Init(context.Background(), "pvz-server", tracing.Config(cfg.Tracing))
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
 	}
+	// закрывается последним, чтобы досланы были и спаны остановки
+	runner.OnClose("tracing", closeTracing)
 
-	//todo: увеличиваю время
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.StartupTimeout)
 	defer cancel()
 
-	db, err := pgxpool.New(ctx, dsn)
+	db, err := storage.NewPool(ctx, cfg.Postgres.DSN, cfg.Log.SlowQuery)
 	if err != nil {
 		log.Fatalf("failed to create pgxpool: %v", err)
 	}
-	defer db.Close()
+	metrics.Registry.MustRegister(metrics.NewPoolCollector(db))
+	runner.OnClose("postgres", func() error {
+		db.Close()
+		return nil
+	})
 
 	if err := db.Ping(ctx); err != nil {
 		log.Fatalf("failed to ping database: %v", err)
 	}
 
 	redisClient := redis.NewClient(&redis.Options{
-		Addr: "localhost:6379",
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
 	})
-	cache := order_cache.New(redisClient, 1*time.Minute)
+	redisClient.AddHook(metrics.RedisHook{})
+	redisClient.AddHook(tracing.RedisHook{})
+	runner.OnClose("redis", redisClient.Close)
+	cache := order_cache.New(redisClient, cfg.Server.CacheTTL)
 
 	storage := storage.NewPgStorage(db)
-	orderService := service.NewOrderService(storage, cache)
-	orderServer := order.NewHandler(orderService)
 
-	//todo: увеличиваю лимит
-	rate := limiter.Rate{Period: 10 * time.Second, Limit: 100}
+	tariffs, err := tariff.Init(ctx, storage, cfg.Server.TariffsFile)
+	if err != nil {
+		log.Fatalf("failed to load tariffs: %v", err)
+	}
+
+	notificationService := notification.NewService(storage)
+	orderService := service.NewOrderService(storage, cache, notificationService, tariffs, models.NewStateMachine(cfg.Orders.ReturnWindow))
+	orderServer := order.NewHandler(orderService, notificationService, tariffs)
+
+	scheduler := notification.NewScheduler(storage, notification.NewLogDispatcher(), notification.DefaultSchedulerConfig())
+	runner.Go("notification scheduler", scheduler.Run)
+
+	reminder := notification.NewExpiryReminder(storage, notificationService, cfg.Orders.ReminderInterval, cfg.Orders.ReminderAhead)
+	runner.Go("expiry reminder", reminder.Run)
+
+	purger := retention.NewPurger(storage, cfg.Orders.PurgeInterval, cfg.Orders.Retention)
+	runner.Go("retention purger", purger.Run)
+
+	business := metrics.NewBusinessCollector(storage, cfg.Metrics.BusinessRefreshInterval)
+	metrics.Registry.MustRegister(business)
+	runner.Go("business metrics", business.Run)
+
+	rate := limiter.Rate{Period: cfg.Server.RateLimit.Period, Limit: cfg.Server.RateLimit.Limit}
 	store := memory.NewStore()
 	rateLimiter := mw.RateLimiterInterceptor(limiter.New(store, rate))
 
+	// ID запроса кладется в контекст первым, чтобы попасть во все строки лога запроса
+	unary := []grpc.UnaryServerInterceptor{mw.RequestIDInterceptor, mw.MetricsInterceptor, mw.LoggingInterceptor}
+	stream := []grpc.StreamServerInterceptor{mw.RequestIDStreamInterceptor, mw.LoggingStreamInterceptor}
+	authenticator := loadAuthenticator(cfg.Auth)
+	if authenticator != nil {
+		unary = append(unary, mw.AuthInterceptor(authenticator, auth.DefaultPolicy))
+		stream = append(stream, mw.AuthStreamInterceptor(authenticator, auth.DefaultPolicy))
+	}
+	unary = append(unary, mw.ValidateInterceptor, rateLimiter, mw.IdempotencyInterceptor(idempotencyStore(cfg.Idempotency, storage, redisClient)))
+
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			mw.LoggingInterceptor,
-			mw.ValidateInterceptor,
-			rateLimiter,
-		),
+		// спан запроса открывается до интерцепторов, и в нем видны отказы авторизации и rate limit
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
 	)
 
 	reflection.Register(grpcServer)
 	desc.RegisterNotifierServer(grpcServer, orderServer)
 
-	go func() {
-		http.Handle("/metrics", promhttp.Handler())
-		log.Printf("prometheus metrics server listening on %s", metricsAddress)
-		if err := http.ListenAndServe(metricsAddress, nil); err != nil {
-			log.Fatalf("failed to start metrics server: %v", err)
-		}
-	}()
+	healthServer := health.NewServer()
+	healthgrpc.RegisterHealthServer(grpcServer, healthServer)
+	checker := newChecker(cfg, healthServer, db, redisClient)
+	runner.Go("health checker", checker.Run)
+	// при остановке health сразу отвечает NOT_SERVING, новые запросы уходят на другие реплики
+	runner.OnDrain(healthServer.Shutdown)
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	// уровень лога меняет только admin, смотреть может любой, у кого есть доступ к порту метрик
+	metricsMux.Handle("/loglevel", mw.AdminHTTPHandler(authenticator, logger.LevelHandler()))
+	metricsMux.Handle("/healthz", pwzhealth.LiveHandler())
+	metricsMux.Handle("/readyz", pwzhealth.ReadyHandler(runner.Ready, func(context.Context) pwzhealth.Report {
+		return checker.Report()
+	}))
+	metricsServer, err := lifecycle.ListenHTTP(cfg.Server.MetricsAddr, metricsMux)
+	if err != nil {
+		log.Fatalf("failed to start metrics server: %v", err)
+	}
+	slog.Info("prometheus metrics server listening", "addr", cfg.Server.MetricsAddr)
+	runner.Serve("metrics server", metricsServer)
 
-	lis, err := net.Listen("tcp", grpcAddress)
+	lis, err := net.Listen("tcp", cfg.Server.GRPCAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
+	slog.Info("gRPC server listening", "addr", lis.Addr().String())
+	// gRPC регистрируется последним и при остановке дообрабатывает запросы первым, пока /readyz еще отвечает 503
+	runner.Serve("gRPC server", lifecycle.GRPC(grpcServer, lis))
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatalf("server stopped: %v", err)
+	}
+}
+
+// newChecker проверки зависимостей для grpc.health.v1 и /readyz; Kafka проверяется, только если включен health.outbox
+func newChecker(cfg config.Config, server *health.Server, db *pgxpool.Pool, redisClient *redis.Client) *pwzhealth.Checker {
+	checker := pwzhealth.NewChecker(server, cfg.Health.Interval, cfg.Health.Timeout, desc.Notifier_ServiceDesc.ServiceName)
+	checker.Add("postgres", db.Ping)
+	checker.Add("redis", func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	})
+	if cfg.Health.Outbox {
+		checker.Add("outbox", func(ctx context.Context) error {
+			return outbox.PingKafka(ctx, cfg.Kafka.Brokers, cfg.Kafka.Topic)
+		})
+	}
+	return checker
+}
+
+// loadAuthenticator ключи из auth.config_file, секрет HS256 можно передать отдельно в auth.jwt_hs256_secret;
+// nil - аутентификация выключена через auth.disabled, только для локального запуска
+func loadAuthenticator(cfg config.AuthConfig) *auth.Authenticator {
+	if cfg.Disabled {
+		slog.Warn("authentication is disabled")
+		return nil
+	}
+
+	var authCfg auth.Config
+	if cfg.ConfigFile != "" {
+		var err error
+		if authCfg, err = auth.LoadConfig(cfg.ConfigFile); err != nil {
+			log.Fatalf("failed to load auth config: %v", err)
+		}
+	}
+	if cfg.HS256Secret != "" {
+		authCfg.JWT.HS256Secret = cfg.HS256Secret
+	}
+
+	authenticator, err := auth.NewAuthenticator(authCfg)
+	if err != nil {
+		log.Fatalf("failed to init auth (set auth.config_file or auth.disabled): %v", err)
+	}
+	return authenticator
+}
 
-	log.Printf("gRPC server listening on %v", lis.Addr())
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+// idempotencyStore ключи идемпотентности в Postgres или в Redis, значение уже проверено в config.Validate
+func idempotencyStore(cfg config.IdempotencyConfig, pg storage.IdempotencyStorage, redisClient *redis.Client) (idempotency.Store, time.Duration, time.Duration) {
+	if cfg.Store == "redis" {
+		return idempotency.NewRedisStore(redisClient), cfg.TTL, cfg.InFlightTTL
 	}
+	return idempotency.NewPgStore(pg), cfg.TTL, cfg.InFlightTTL
 }
@@ -0,0 +1,142 @@
+//go:build integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// syncBuffer вывод процесса пишется из другой горутины
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// TestServerGracefulShutdown собранный cmd/server на каждый сигнал дообрабатывает запросы, закрывает ресурсы и выходит с кодом 0
+func TestServerGracefulShutdown(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	root := filepath.Join(filepath.Dir(filename), "..", "..")
+	bin := filepath.Join(t.TempDir(), "server")
+
+	build := exec.Command("go", "build", "-o", bin, "./cmd/server")
+	build.Dir = root
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	dsn := startPostgres(t, root)
+
+	for _, sig := range []syscall.Signal{syscall.SIGTERM, syscall.SIGINT} {
+		t.Run(sig.String(), func(t *testing.T) {
+			metricsAddr := freeAddr(t)
+			cmd := exec.Command(bin,
+				"--server.grpc-addr", freeAddr(t),
+				"--server.metrics-addr", metricsAddr,
+				"--auth.disabled",
+				"--shutdown.drain-timeout", "5s",
+			)
+			// в каталоге теста нет .env, конфиг только из окружения и флагов
+			cmd.Dir = t.TempDir()
+			cmd.Env = append(os.Environ(), "DB_DSN="+dsn, "PWZ_CONFIG=")
+			var output syncBuffer
+			cmd.Stdout = &output
+			cmd.Stderr = &output
+			require.NoError(t, cmd.Start())
+
+			exited := make(chan error, 1)
+			go func() { exited <- cmd.Wait() }()
+
+			// /readyz ждет еще и Redis, которого в тесте нет, поэтому ждем только запуска
+			require.Eventually(t, func() bool {
+				resp, err := http.Get("http://" + metricsAddr + "/healthz")
+				if err != nil {
+					return false
+				}
+				_ = resp.Body.Close()
+				return resp.StatusCode == http.StatusOK
+			}, 30*time.Second, 100*time.Millisecond, output.String())
+
+			require.NoError(t, cmd.Process.Signal(sig))
+			select {
+			case err := <-exited:
+				require.NoError(t, err, output.String())
+			case <-time.After(15 * time.Second):
+				_ = cmd.Process.Kill()
+				require.FailNow(t, "server did not stop", output.String())
+			}
+			require.Contains(t, output.String(), "shutdown complete")
+		})
+	}
+}
+
+// startPostgres поднимает Postgres со схемой из тестов хранилища и возвращает DSN
+func startPostgres(t *testing.T, root string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_PASSWORD": "test",
+				"POSTGRES_USER":     "test",
+				"POSTGRES_DB":       "testdb",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+	dsn := "postgres://test:test@" + host + ":" + port.Port() + "/testdb?sslmode=disable"
+
+	schema, err := os.ReadFile(filepath.Join(root, "internal", "storage", "integrationtest", "test_migrations.sql"))
+	require.NoError(t, err)
+	conn, err := pgx.Connect(ctx, dsn)
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, string(schema))
+	require.NoError(t, err)
+
+	return dsn
+}
+
+func freeAddr(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	return lis.Addr().String()
+}
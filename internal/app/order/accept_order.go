@@ -4,18 +4,24 @@ import (
 	"context"
 
 	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
 	desc "PWZ1.0/pkg/pwz"
 )
 
 func (i *Implementation) AcceptOrder(ctx context.Context, req *desc.AcceptOrderRequest) (*desc.OrderResponse, error) {
 	expiresAt := req.GetExpiresAt().AsTime()
 
+	weight, price, err := orderAmountsFromPb(req)
+	if err != nil {
+		return nil, err
+	}
+
 	order, err := i.orderService.AcceptOrder(
 		ctx,
 		req.GetOrderId(),
 		req.GetUserId(),
-		req.GetWeight(),
-		req.GetPrice(),
+		weight,
+		price,
 		expiresAt,
 		toInternalPackage(req.GetPackage()),
 	)
@@ -30,6 +36,25 @@ func (i *Implementation) AcceptOrder(ctx context.Context, req *desc.AcceptOrderR
 	}, nil
 }
 
+// orderAmountsFromPb берет вес и цену из новых полей, старые клиенты присылают только float
+func orderAmountsFromPb(req *desc.AcceptOrderRequest) (models.Grams, models.Money, error) {
+	currency := models.Currency(req.GetCurrency()).OrDefault()
+
+	weight := models.Grams(req.GetWeightGrams())
+	if weight == 0 {
+		weight = models.GramsFromKg(float64(req.GetWeight()))
+	}
+	if weight <= 0 {
+		return 0, models.Money{}, domainErrors.ErrValidationFailed
+	}
+
+	price := models.NewMoney(req.GetPriceMinor(), currency)
+	if price.Amount == 0 {
+		price = models.MoneyFromFloat(float64(req.GetPrice()), currency)
+	}
+	return weight, price, nil
+}
+
 func toInternalPackage(pt desc.PackageType) models.PackageType {
 	switch pt {
 	case desc.PackageType_PACKAGE_TYPE_BAG:
@@ -24,6 +24,23 @@ func convertOrderStatus(s models.OrderStatus) desc.OrderStatus {
 	}
 }
 
+func convertActor(a models.Actor) *desc.Actor {
+	pb := &desc.Actor{Id: a.ID, Subject: a.Subject}
+	switch a.Type {
+	case models.ActorCourier:
+		pb.Type = desc.ActorType_ACTOR_TYPE_COURIER
+	case models.ActorOperator:
+		pb.Type = desc.ActorType_ACTOR_TYPE_OPERATOR
+	case models.ActorAdmin:
+		pb.Type = desc.ActorType_ACTOR_TYPE_ADMIN
+	case models.ActorTerminal:
+		pb.Type = desc.ActorType_ACTOR_TYPE_TERMINAL
+	default:
+		pb.Type = desc.ActorType_ACTOR_TYPE_UNSPECIFIED
+	}
+	return pb
+}
+
 func (i *Implementation) GetHistory(ctx context.Context, req *desc.GetHistoryRequest) (*desc.OrderHistoryList, error) {
 	page := uint32(0)
 	count := uint32(0)
@@ -32,7 +49,18 @@ func (i *Implementation) GetHistory(ctx context.Context, req *desc.GetHistoryReq
 		count = req.GetPagination().GetCountOnPage()
 	}
 
-	history, err := i.orderService.GetHistory(ctx, page, count)
+	filter := models.HistoryFilter{
+		UserID:   req.GetUserId(),
+		Statuses: toInternalStatuses(req.GetStatuses()),
+	}
+	if req.CreatedFrom != nil {
+		filter.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.CreatedTo != nil {
+		filter.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+
+	history, err := i.orderService.GetHistory(ctx, filter, page, count)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +71,7 @@ func (i *Implementation) GetHistory(ctx context.Context, req *desc.GetHistoryReq
 			OrderId:   hItem.OrderID,
 			Status:    convertOrderStatus(hItem.Status),
 			CreatedAt: timestamppb.New(hItem.CreatedAt),
+			Actor:     convertActor(hItem.Actor),
 		})
 	}
 
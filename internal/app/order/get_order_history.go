@@ -27,6 +27,7 @@ func (i *Implementation) GetOrderHistory(ctx context.Context, req *desc.OrderHis
 			OrderId:   h.OrderID,
 			Status:    convertOrderStatus(h.Status),
 			CreatedAt: timestamppb.New(h.CreatedAt),
+			Actor:     convertActor(h.Actor),
 		})
 	}
 	return resp, nil
@@ -0,0 +1,69 @@
+package order
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"PWZ1.0/internal/models"
+	desc "PWZ1.0/pkg/pwz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *Implementation) GetTariffs(ctx context.Context, req *desc.GetTariffsRequest) (*desc.TariffsList, error) {
+	active, err := i.tariffs.Active(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	all := i.tariffs.All()
+	pbTariffs := make([]*desc.Tariff, 0, len(all))
+	for _, t := range all {
+		pbTariffs = append(pbTariffs, mapTariffToPb(t))
+	}
+
+	return &desc.TariffsList{
+		ActiveVersion: active.Version,
+		Tariffs:       pbTariffs,
+	}, nil
+}
+
+func mapTariffToPb(t models.Tariff) *desc.Tariff {
+	packages := make([]*desc.PackageTariff, 0, len(t.Packages))
+	for pkg, p := range t.Packages {
+		packages = append(packages, &desc.PackageTariff{
+			Package:          mapPackageTypeToPb(pkg),
+			Surcharge:        minorToFloat(p.Surcharge),
+			WeightLimit:      float32(p.WeightLimit.Kg()),
+			SurchargeMinor:   p.Surcharge,
+			WeightLimitGrams: int64(p.WeightLimit),
+		})
+	}
+	// порядок map случаен, отдаем в порядке enum
+	sort.Slice(packages, func(a, b int) bool {
+		return packages[a].Package < packages[b].Package
+	})
+
+	tiers := make([]*desc.WeightTier, 0, len(t.WeightTiers))
+	for _, tier := range t.WeightTiers {
+		tiers = append(tiers, &desc.WeightTier{
+			FromWeight:      float32(tier.FromWeight.Kg()),
+			Surcharge:       minorToFloat(tier.Surcharge),
+			FromWeightGrams: int64(tier.FromWeight),
+			SurchargeMinor:  tier.Surcharge,
+		})
+	}
+
+	return &desc.Tariff{
+		Version:     t.Version,
+		ValidFrom:   timestamppb.New(t.ValidFrom),
+		Currency:    string(t.Currency),
+		Packages:    packages,
+		WeightTiers: tiers,
+	}
+}
+
+// minorToFloat сумма в рублях для устаревших float-полей
+func minorToFloat(amount int64) float32 {
+	return float32(models.NewMoney(amount, models.DefaultCurrency).Float())
+}
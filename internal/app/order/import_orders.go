@@ -3,38 +3,48 @@ package order
 import (
 	"context"
 
+	"PWZ1.0/internal/service"
 	desc "PWZ1.0/pkg/pwz"
 )
 
 func (i *Implementation) ImportOrders(ctx context.Context, req *desc.ImportOrdersRequest) (*desc.ImportResult, error) {
 	var (
 		importedCount int32
-		errorIDs      []uint64
+		itemErrors    []service.ItemError
 	)
 
 	for _, orderReq := range req.Orders {
 		expiresAt := orderReq.GetExpiresAt().AsTime()
 
-		_, err := i.orderService.AcceptOrder(
+		weight, price, err := orderAmountsFromPb(orderReq)
+		if err != nil {
+			itemErrors = append(itemErrors, service.ItemError{OrderID: orderReq.GetOrderId(), Err: err})
+			continue
+		}
+
+		_, err = i.orderService.AcceptOrder(
 			ctx,
 			orderReq.GetOrderId(),
 			orderReq.GetUserId(),
-			orderReq.GetWeight(),
-			orderReq.GetPrice(),
+			weight,
+			price,
 			expiresAt,
 			toInternalPackage(orderReq.GetPackage()),
 		)
 
 		if err != nil {
-			errorIDs = append(errorIDs, orderReq.GetOrderId())
+			itemErrors = append(itemErrors, service.ItemError{OrderID: orderReq.GetOrderId(), Err: err})
 			continue
 		}
 
 		importedCount++
 	}
 
+	errorIDs, details := convertItemErrorsToProto(itemErrors)
+
 	return &desc.ImportResult{
-		Imported: importedCount,
-		Errors:   errorIDs,
+		Imported:     importedCount,
+		Errors:       errorIDs,
+		ErrorDetails: details,
 	}, nil
 }
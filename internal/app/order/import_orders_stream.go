@@ -0,0 +1,142 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/service"
+	desc "PWZ1.0/pkg/pwz"
+)
+
+func (i *Implementation) ImportOrdersStream(stream desc.Notifier_ImportOrdersStreamServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dryRun := req.GetDryRun()
+
+	// при проверке задание не создается и продолжать нечего
+	var job models.ImportJob
+	if !dryRun {
+		job, err = i.orderService.StartImport(ctx, req.GetJobId())
+		if err != nil {
+			return err
+		}
+	}
+	// сразу сообщаем клиенту, с какого заказа продолжать
+	if err := stream.Send(importProgressToPb(job, 0, nil, false)); err != nil {
+		return err
+	}
+
+	var (
+		position uint64 // номер последнего полученного заказа
+		skipped  uint64
+		chunk    = make([]service.ImportOrder, 0, service.DefaultImportChunkSize)
+	)
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		var itemErrors []service.ItemError
+		if dryRun {
+			itemErrors, err = i.orderService.CheckImportChunk(ctx, chunk)
+			job.Processed += uint64(len(chunk))
+			job.Imported += uint64(len(chunk) - len(itemErrors))
+			job.Failed += uint64(len(itemErrors))
+		} else {
+			job, itemErrors, err = i.orderService.ImportChunk(ctx, job, chunk)
+		}
+		if err != nil {
+			return err
+		}
+		chunk = chunk[:0]
+		return stream.Send(importProgressToPb(job, skipped, itemErrors, false))
+	}
+
+	for {
+		if req.GetOrder() != nil || req.GetSeq() != 0 {
+			next, err := nextImportPosition(position, job.Processed, req.GetSeq())
+			if err != nil {
+				return err
+			}
+			position = next
+
+			// обработанные прошлыми запусками заказы пропускаем
+			if position <= job.Processed {
+				skipped++
+			} else {
+				chunk = append(chunk, importOrderFromPb(req.GetOrder()))
+				if len(chunk) >= service.DefaultImportChunkSize {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+	return stream.Send(importProgressToPb(job, skipped, nil, true))
+}
+
+// nextImportPosition первый заказ потока может начинаться с любого уже обработанного или следующего номера, дальше номера идут подряд
+func nextImportPosition(position, processed, seq uint64) (uint64, error) {
+	if seq == 0 {
+		return position + 1, nil
+	}
+	if seq == position+1 || (position == 0 && seq <= processed+1) {
+		return seq, nil
+	}
+	return 0, fmt.Errorf("%w: ожидался заказ %d, получен %d", domainErrors.ErrImportOutOfOrder, position+1, seq)
+}
+
+func importOrderFromPb(req *desc.AcceptOrderRequest) service.ImportOrder {
+	if req == nil {
+		return service.ImportOrder{Err: domainErrors.ErrValidationFailed}
+	}
+
+	item := service.ImportOrder{
+		OrderID:     req.GetOrderId(),
+		UserID:      req.GetUserId(),
+		ExpiresAt:   req.GetExpiresAt().AsTime(),
+		PackageType: toInternalPackage(req.GetPackage()),
+	}
+	if err := req.ValidateAll(); err != nil {
+		item.Err = fmt.Errorf("%w: %v", domainErrors.ErrValidationFailed, err)
+		return item
+	}
+	item.Weight, item.Price, item.Err = orderAmountsFromPb(req)
+	return item
+}
+
+func importProgressToPb(job models.ImportJob, skipped uint64, itemErrors []service.ItemError, done bool) *desc.ImportProgress {
+	_, details := convertItemErrorsToProto(itemErrors)
+	return &desc.ImportProgress{
+		JobId:     job.ID,
+		Processed: job.Processed,
+		Imported:  job.Imported,
+		Failed:    job.Failed,
+		Skipped:   skipped,
+		Errors:    details,
+		Done:      done,
+	}
+}
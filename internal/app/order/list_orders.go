@@ -2,13 +2,21 @@ package order
 
 import (
 	"context"
+	"fmt"
 
+	"PWZ1.0/internal/auth"
 	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/service"
 	desc "PWZ1.0/pkg/pwz"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (i *Implementation) ListOrders(ctx context.Context, req *desc.ListOrdersRequest) (*desc.OrdersList, error) {
+	if req.GetUserId() == 0 && !allClientsAllowed(ctx) {
+		return nil, fmt.Errorf("%w: user_id is required", domainErrors.ErrValidationFailed)
+	}
+
 	var lastId uint32
 	if req.LastN != nil {
 		lastId = *req.LastN
@@ -23,17 +31,36 @@ func (i *Implementation) ListOrders(ctx context.Context, req *desc.ListOrdersReq
 		limit = 10
 	}
 
-	orders, total := i.orderService.ListOrders(ctx, req.UserId, req.InPvz, lastId, page, limit)
+	listReq := service.ListOrdersRequest{
+		UserID:         req.GetUserId(),
+		InPvzOnly:      req.GetInPvz(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+		Statuses:       toInternalStatuses(req.GetStatuses()),
+		LastN:          lastId,
+		Pagination:     service.Pagination{Page: page, CountOnPage: limit},
+	}
+	if req.ExpiresFrom != nil {
+		listReq.ExpiresFrom = req.GetExpiresFrom().AsTime()
+	}
+	if req.ExpiresTo != nil {
+		listReq.ExpiresTo = req.GetExpiresTo().AsTime()
+	}
+
+	orders, total := i.orderService.ListOrders(ctx, listReq)
 
 	pbOrders := make([]*desc.Order, 0, len(orders))
 	for _, o := range orders {
 		pbOrder := &desc.Order{
-			OrderId:    o.ID,
-			UserId:     o.UserID,
-			Status:     mapOrderStatusToPb(o.Status),
-			ExpiresAt:  timestamppb.New(o.ExpiresAt),
-			Weight:     o.Weight,
-			TotalPrice: o.Price,
+			OrderId:         o.ID,
+			UserId:          o.UserID,
+			Status:          mapOrderStatusToPb(o.Status),
+			ExpiresAt:       timestamppb.New(o.ExpiresAt),
+			Weight:          float32(o.Weight.Kg()),
+			TotalPrice:      float32(o.Price.Float()),
+			WeightGrams:     int64(o.Weight),
+			TotalPriceMinor: o.Price.Amount,
+			Currency:        string(o.Price.Currency),
+			TariffVersion:   o.TariffVersion,
 		}
 
 		if o.PackageType != "" && o.PackageType != models.PackageUnspecified {
@@ -41,6 +68,10 @@ func (i *Implementation) ListOrders(ctx context.Context, req *desc.ListOrdersReq
 			pbOrder.Package = &pbPackage
 		}
 
+		if o.DeletedAt != nil {
+			pbOrder.DeletedAt = timestamppb.New(*o.DeletedAt)
+		}
+
 		pbOrders = append(pbOrders, pbOrder)
 	}
 
@@ -50,6 +81,13 @@ func (i *Implementation) ListOrders(ctx context.Context, req *desc.ListOrdersReq
 	}, nil
 }
 
+// allClientsAllowed заказы всех клиентов сразу (user_id = 0) видит только admin, например для выгрузки;
+// без аутентификации (AUTH_DISABLED) проверять некого
+func allClientsAllowed(ctx context.Context) bool {
+	p, ok := auth.FromContext(ctx)
+	return !ok || p.Role == auth.RoleAdmin
+}
+
 func mapOrderStatusToPb(status models.OrderStatus) desc.OrderStatus {
 	switch status {
 	case models.StatusExpects:
@@ -65,6 +103,27 @@ func mapOrderStatusToPb(status models.OrderStatus) desc.OrderStatus {
 	}
 }
 
+func toInternalStatuses(statuses []desc.OrderStatus) []models.OrderStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	result := make([]models.OrderStatus, 0, len(statuses))
+	for _, st := range statuses {
+		switch st {
+		case desc.OrderStatus_ORDER_STATUS_EXPECTS:
+			result = append(result, models.StatusExpects)
+		case desc.OrderStatus_ORDER_STATUS_ACCEPTED:
+			result = append(result, models.StatusAccepted)
+		case desc.OrderStatus_ORDER_STATUS_RETURNED:
+			result = append(result, models.StatusReturned)
+		case desc.OrderStatus_ORDER_STATUS_DELETED:
+			result = append(result, models.StatusDeleted)
+		}
+	}
+	return result
+}
+
 func mapPackageTypeToPb(pkgType models.PackageType) desc.PackageType {
 	switch pkgType {
 	case models.PackageBag:
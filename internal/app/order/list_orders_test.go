@@ -0,0 +1,50 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"PWZ1.0/internal/auth"
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/service"
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listOrdersService запоминает запрос ListOrders; остальные методы в тесте не вызываются
+type listOrdersService struct {
+	service.OrderService
+	calls []service.ListOrdersRequest
+}
+
+func (s *listOrdersService) ListOrders(_ context.Context, req service.ListOrdersRequest) ([]models.Order, uint32) {
+	s.calls = append(s.calls, req)
+	return nil, 0
+}
+
+func TestListOrders_AllClients(t *testing.T) {
+	t.Parallel()
+
+	svc := &listOrdersService{}
+	h := NewHandler(svc, nil, nil)
+	operator := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "op", Role: auth.RoleOperator})
+	admin := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "root", Role: auth.RoleAdmin})
+
+	_, err := h.ListOrders(operator, &desc.ListOrdersRequest{})
+	require.ErrorIs(t, err, domainErrors.ErrValidationFailed)
+	assert.Empty(t, svc.calls)
+
+	_, err = h.ListOrders(operator, &desc.ListOrdersRequest{UserId: 10})
+	require.NoError(t, err)
+	_, err = h.ListOrders(admin, &desc.ListOrdersRequest{})
+	require.NoError(t, err)
+	// без аутентификации проверять роль некому
+	_, err = h.ListOrders(context.Background(), &desc.ListOrdersRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, svc.calls, 3)
+	assert.Equal(t, uint64(10), svc.calls[0].UserID)
+	assert.Zero(t, svc.calls[1].UserID)
+}
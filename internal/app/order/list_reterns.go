@@ -22,12 +22,15 @@ func (i *Implementation) ListReturns(ctx context.Context, req *pwz.ListReturnsRe
 	var returns []*pwz.Order
 	for _, o := range serviceResp.Returns {
 		order := &pwz.Order{
-			OrderId:    o.ID,
-			UserId:     o.UserID,
-			Status:     convertStatusToProto(o.Status),
-			ExpiresAt:  timestamppb.New(o.ExpiresAt),
-			Weight:     o.Weight,
-			TotalPrice: o.Price,
+			OrderId:         o.ID,
+			UserId:          o.UserID,
+			Status:          convertStatusToProto(o.Status),
+			ExpiresAt:       timestamppb.New(o.ExpiresAt),
+			Weight:          float32(o.Weight.Kg()),
+			TotalPrice:      float32(o.Price.Float()),
+			WeightGrams:     int64(o.Weight),
+			TotalPriceMinor: o.Price.Amount,
+			Currency:        string(o.Price.Currency),
 		}
 
 		if o.PackageType != models.PackageUnspecified {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/service"
 	desc "PWZ1.0/pkg/pwz"
 )
 
@@ -14,12 +16,31 @@ func (i *Implementation) ProcessOrders(ctx context.Context, req *desc.ProcessOrd
 
 	result := i.orderService.ProcessOrders(ctx, userID, actionType, orderIDs)
 
+	errorIDs, details := convertItemErrorsToProto(result.Errors)
+
 	return &desc.ProcessResult{
-		Processed: result.Processed,
-		Errors:    result.Errors,
+		Processed:    result.Processed,
+		Errors:       errorIDs,
+		ErrorDetails: details,
 	}, nil
 }
 
+// convertItemErrorsToProto отдает клиенту только коды из справочника, внутренние ошибки не раскрываются
+func convertItemErrorsToProto(items []service.ItemError) ([]uint64, []*desc.OrderError) {
+	ids := make([]uint64, 0, len(items))
+	details := make([]*desc.OrderError, 0, len(items))
+	for _, item := range items {
+		public := domainErrors.Public(item.Err)
+		ids = append(ids, item.OrderID)
+		details = append(details, &desc.OrderError{
+			OrderId: item.OrderID,
+			Code:    domainErrors.ErrorCodes[public],
+			Message: public.Error(),
+		})
+	}
+	return ids, details
+}
+
 func convertActionTypeFromProto(actionType desc.ActionType) models.ActionType {
 	switch actionType {
 	case desc.ActionType_ACTION_TYPE_ISSUE:
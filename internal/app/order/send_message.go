@@ -0,0 +1,82 @@
+package order
+
+import (
+	"context"
+
+	"PWZ1.0/internal/models"
+	desc "PWZ1.0/pkg/pwz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *Implementation) SendMessage(ctx context.Context, req *desc.MessageRequest) (*desc.MessageResponse, error) {
+	n := models.Notification{
+		UserID:   req.GetUserId(),
+		Kind:     models.NotificationKindManual,
+		Title:    req.GetTitle(),
+		Text:     req.GetText(),
+		Comment:  req.GetComment(),
+		Tags:     req.GetTags(),
+		Priority: toInternalPriority(req.GetPriority()),
+	}
+
+	id, err := i.notificationService.Send(ctx, n, req.GetDelay().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.MessageResponse{Id: id}, nil
+}
+
+func (i *Implementation) GetMessageStatus(ctx context.Context, req *desc.MessageIdRequest) (*desc.MessageStatusResponse, error) {
+	n, err := i.notificationService.Status(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toMessageStatusResponse(n), nil
+}
+
+func (i *Implementation) CancelMessage(ctx context.Context, req *desc.MessageIdRequest) (*desc.MessageStatusResponse, error) {
+	n, err := i.notificationService.Cancel(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toMessageStatusResponse(n), nil
+}
+
+func toMessageStatusResponse(n models.Notification) *desc.MessageStatusResponse {
+	resp := &desc.MessageStatusResponse{
+		Id:          n.ID,
+		Status:      toProtoMessageStatus(n.Status),
+		Priority:    desc.Priority(n.Priority),
+		ScheduledAt: timestamppb.New(n.ScheduledAt),
+	}
+	if n.SentAt != nil {
+		resp.SentAt = timestamppb.New(*n.SentAt)
+	}
+	if n.Error != "" {
+		resp.Error = &n.Error
+	}
+	return resp
+}
+
+func toInternalPriority(p desc.Priority) models.NotificationPriority {
+	if p == desc.Priority_PRIORITY_UNKNOWN {
+		return models.PriorityDefault
+	}
+	return models.NotificationPriority(p)
+}
+
+func toProtoMessageStatus(s models.NotificationStatus) desc.MessageStatus {
+	switch s {
+	case models.NotificationPending:
+		return desc.MessageStatus_MESSAGE_STATUS_PENDING
+	case models.NotificationSent:
+		return desc.MessageStatus_MESSAGE_STATUS_SENT
+	case models.NotificationCancelled:
+		return desc.MessageStatus_MESSAGE_STATUS_CANCELLED
+	case models.NotificationFailed:
+		return desc.MessageStatus_MESSAGE_STATUS_FAILED
+	default:
+		return desc.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+}
@@ -1,15 +1,23 @@
 package order
 
 import (
+	"PWZ1.0/internal/notification"
 	"PWZ1.0/internal/service"
+	"PWZ1.0/internal/tariff"
 	desc "PWZ1.0/pkg/pwz"
 )
 
 type Implementation struct {
 	desc.UnimplementedNotifierServer
-	orderService service.OrderService
+	orderService        service.OrderService
+	notificationService notification.Service
+	tariffs             tariff.Provider
 }
 
-func NewHandler(orderService service.OrderService) *Implementation {
-	return &Implementation{orderService: orderService}
+func NewHandler(orderService service.OrderService, notificationService notification.Service, tariffs tariff.Provider) *Implementation {
+	return &Implementation{
+		orderService:        orderService,
+		notificationService: notificationService,
+		tariffs:             tariffs,
+	}
 }
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidToken  = errors.New("invalid token")
+	ErrUnknownAPIKey = errors.New("unknown api key")
+)
+
+// claims JWT, которые выдает наш сервис авторизации: sub - ID сотрудника, role - его роль
+type claims struct {
+	Role Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Authenticator проверяет JWT и API-ключи
+type Authenticator struct {
+	hsSecret []byte
+	rsKey    *rsa.PublicKey
+	parser   *jwt.Parser
+	apiKeys  map[[sha256.Size]byte]Principal
+}
+
+func NewAuthenticator(cfg Config) (*Authenticator, error) {
+	a := &Authenticator{
+		apiKeys: make(map[[sha256.Size]byte]Principal, len(cfg.APIKeys)),
+	}
+
+	var methods []string
+	if cfg.JWT.HS256Secret != "" {
+		a.hsSecret = []byte(cfg.JWT.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if cfg.JWT.RS256PublicKeyFile != "" {
+		pem, err := os.ReadFile(cfg.JWT.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read rs256 public key: %w", err)
+		}
+		if a.rsKey, err = jwt.ParseRSAPublicKeyFromPEM(pem); err != nil {
+			return nil, fmt.Errorf("parse rs256 public key: %w", err)
+		}
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if cfg.JWT.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.JWT.Issuer))
+	}
+	if cfg.JWT.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.JWT.Audience))
+	}
+	a.parser = jwt.NewParser(opts...)
+
+	for _, k := range cfg.APIKeys {
+		if k.Name == "" {
+			return nil, errors.New("api key without name")
+		}
+		if !k.Role.Valid() {
+			return nil, fmt.Errorf("api key %q: unknown role %q", k.Name, k.Role)
+		}
+		sum, err := hex.DecodeString(k.SHA256)
+		if err != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("api key %q: sha256 must be %d hex bytes", k.Name, sha256.Size)
+		}
+		a.apiKeys[[sha256.Size]byte(sum)] = Principal{Subject: k.Name, ID: k.ID, Role: k.Role, Terminal: k.Terminal}
+	}
+
+	if len(methods) == 0 && len(a.apiKeys) == 0 {
+		return nil, errors.New("no jwt keys and no api keys configured")
+	}
+	return a, nil
+}
+
+// Token проверяет подпись, срок действия, issuer и audience JWT
+func (a *Authenticator) Token(token string) (Principal, error) {
+	// только API-ключи: WithValidMethods(nil) не ограничивает алгоритм, поэтому токен не разбираем вовсе
+	if len(a.hsSecret) == 0 && a.rsKey == nil {
+		return Principal{}, fmt.Errorf("%w: jwt is not configured", ErrInvalidToken)
+	}
+
+	var c claims
+	_, err := a.parser.ParseWithClaims(token, &c, func(t *jwt.Token) (any, error) {
+		// ключ не настроен - пустой секрет или nil дали бы проверить подпись, сделанную кем угодно
+		switch t.Method.Alg() {
+		case jwt.SigningMethodHS256.Alg():
+			if len(a.hsSecret) == 0 {
+				return nil, errors.New("hs256 is not configured")
+			}
+			return a.hsSecret, nil
+		case jwt.SigningMethodRS256.Alg():
+			if a.rsKey == nil {
+				return nil, errors.New("rs256 is not configured")
+			}
+			return a.rsKey, nil
+		}
+		return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+	})
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if c.Subject == "" {
+		return Principal{}, fmt.Errorf("%w: no sub", ErrInvalidToken)
+	}
+	if !c.Role.Valid() {
+		return Principal{}, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, c.Role)
+	}
+
+	p := Principal{Subject: c.Subject, Role: c.Role}
+	p.ID, _ = strconv.ParseUint(c.Subject, 10, 64)
+	return p, nil
+}
+
+// APIKey ищет ключ по хешу, поэтому время ответа не зависит от совпавшего префикса
+func (a *Authenticator) APIKey(key string) (Principal, error) {
+	p, ok := a.apiKeys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return Principal{}, ErrUnknownAPIKey
+	}
+	return p, nil
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret"
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, c claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, c).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func validClaims(sub string, role Role) claims {
+	return claims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   sub,
+			Issuer:    "pvz-auth",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestAuthenticator_TokenHS256(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAuthenticator(Config{JWT: JWTConfig{HS256Secret: testSecret, Issuer: "pvz-auth"}})
+	require.NoError(t, err)
+
+	p, err := a.Token(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("42", RoleCourier)))
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "42", ID: 42, Role: RoleCourier}, p)
+
+	p, err = a.Token(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("ivanov", RoleOperator)))
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "ivanov", Role: RoleOperator}, p)
+}
+
+func TestAuthenticator_TokenRejected(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAuthenticator(Config{JWT: JWTConfig{HS256Secret: testSecret, Issuer: "pvz-auth"}})
+	require.NoError(t, err)
+
+	expired := validClaims("1", RoleCourier)
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	noExp := validClaims("1", RoleCourier)
+	noExp.ExpiresAt = nil
+
+	otherIssuer := validClaims("1", RoleCourier)
+	otherIssuer.Issuer = "someone"
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"wrong secret", sign(t, jwt.SigningMethodHS256, []byte("other"), validClaims("1", RoleCourier))},
+		{"expired", sign(t, jwt.SigningMethodHS256, []byte(testSecret), expired)},
+		{"no exp", sign(t, jwt.SigningMethodHS256, []byte(testSecret), noExp)},
+		{"other issuer", sign(t, jwt.SigningMethodHS256, []byte(testSecret), otherIssuer)},
+		{"unknown role", sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("1", "root"))},
+		{"no sub", sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("", RoleCourier))},
+		{"alg none", sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims("1", RoleAdmin))},
+		{"garbage", "not.a.token"},
+	}
+	for _, tt := range tests {
+		_, err := a.Token(tt.token)
+		assert.ErrorIs(t, err, ErrInvalidToken, tt.name)
+	}
+}
+
+func TestAuthenticator_TokenRS256(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwt.pub")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	a, err := NewAuthenticator(Config{JWT: JWTConfig{RS256PublicKeyFile: path}})
+	require.NoError(t, err)
+
+	p, err := a.Token(sign(t, jwt.SigningMethodRS256, key, validClaims("7", RoleAdmin)))
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "7", ID: 7, Role: RoleAdmin}, p)
+
+	// HS256 не настроен, подпись публичным ключом как секретом не принимается
+	_, err = a.Token(sign(t, jwt.SigningMethodHS256, der, validClaims("7", RoleAdmin)))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestAuthenticator_APIKey(t *testing.T) {
+	t.Parallel()
+
+	sum := sha256.Sum256([]byte("terminal-secret"))
+	a, err := NewAuthenticator(Config{APIKeys: []APIKey{
+		{Name: "pvz-terminal-1", ID: 1001, Role: RoleOperator, SHA256: hex.EncodeToString(sum[:])},
+	}})
+	require.NoError(t, err)
+
+	p, err := a.APIKey("terminal-secret")
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "pvz-terminal-1", ID: 1001, Role: RoleOperator}, p)
+
+	_, err = a.APIKey("terminal-secret2")
+	assert.ErrorIs(t, err, ErrUnknownAPIKey)
+
+	// без JWT ключей любой токен отклоняется
+	_, err = a.Token(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("1", RoleAdmin)))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestAuthenticator_APIKeysOnlyRejectsEmptySecret(t *testing.T) {
+	t.Parallel()
+
+	sum := sha256.Sum256([]byte("terminal-secret"))
+	a, err := NewAuthenticator(Config{APIKeys: []APIKey{
+		{Name: "pvz-terminal-1", ID: 1001, Role: RoleOperator, SHA256: hex.EncodeToString(sum[:])},
+	}})
+	require.NoError(t, err)
+
+	// подпись пустым ключом совпала бы с ненастроенным секретом HS256
+	_, err = a.Token(sign(t, jwt.SigningMethodHS256, []byte{}, validClaims("1", RoleAdmin)))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// то же через keyfunc: RS256 настроен, а HS256 нет
+	a.rsKey = &rsa.PublicKey{}
+	_, err = a.Token(sign(t, jwt.SigningMethodHS256, []byte{}, validClaims("1", RoleAdmin)))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestNewAuthenticator_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"empty", Config{}},
+		{"bad role", Config{APIKeys: []APIKey{{Name: "k", Role: "root", SHA256: hex.EncodeToString(make([]byte, 32))}}}},
+		{"bad hash", Config{APIKeys: []APIKey{{Name: "k", Role: RoleAdmin, SHA256: "abc"}}}},
+		{"no name", Config{APIKeys: []APIKey{{Role: RoleAdmin, SHA256: hex.EncodeToString(make([]byte, 32))}}}},
+		{"no key file", Config{JWT: JWTConfig{RS256PublicKeyFile: filepath.Join(t.TempDir(), "missing.pem")}}},
+	}
+	for _, tt := range tests {
+		_, err := NewAuthenticator(tt.cfg)
+		assert.Error(t, err, tt.name)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+jwt:
+  hs256_secret: s
+  issuer: pvz-auth
+api_keys:
+  - name: import-script
+    role: admin
+    sha256: "00"
+`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "s", cfg.JWT.HS256Secret)
+	assert.Equal(t, "pvz-auth", cfg.JWT.Issuer)
+	require.Len(t, cfg.APIKeys, 1)
+	assert.Equal(t, RoleAdmin, cfg.APIKeys[0].Role)
+}
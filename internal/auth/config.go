@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config ключи для проверки JWT и статические API-ключи терминалов и скриптов
+type Config struct {
+	JWT     JWTConfig `json:"jwt" yaml:"jwt"`
+	APIKeys []APIKey  `json:"api_keys" yaml:"api_keys"`
+}
+
+type JWTConfig struct {
+	HS256Secret        string `json:"hs256_secret" yaml:"hs256_secret"`
+	RS256PublicKeyFile string `json:"rs256_public_key_file" yaml:"rs256_public_key_file"` // PEM
+	Issuer             string `json:"issuer" yaml:"issuer"`                               // пустой - не проверяется
+	Audience           string `json:"audience" yaml:"audience"`                           // пустой - не проверяется
+}
+
+// APIKey в конфиге хранится только SHA-256 ключа, сам ключ знает владелец
+type APIKey struct {
+	Name   string `json:"name" yaml:"name"`
+	ID     uint64 `json:"id" yaml:"id"`
+	Role   Role   `json:"role" yaml:"role"`
+	SHA256 string `json:"sha256" yaml:"sha256"` // hex, например из sha256sum
+	// Terminal ключ терминала ПВЗ, а не человека: в истории заказа автором будет терминал
+	Terminal bool `json:"terminal" yaml:"terminal"`
+}
+
+// LoadConfig читает конфиг из YAML (.yaml, .yml) или JSON файла
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &cfg)
+	default:
+		err = json.Unmarshal(data, &cfg)
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("parse auth config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
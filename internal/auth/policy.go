@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"slices"
+
+	desc "PWZ1.0/pkg/pwz"
+)
+
+// Policy роли, которым разрешен метод gRPC; admin разрешено все, методы не из списка - только ему
+type Policy map[string][]Role
+
+var DefaultPolicy = Policy{
+	desc.Notifier_AcceptOrder_FullMethodName: {RoleCourier},
+	desc.Notifier_ReturnOrder_FullMethodName: {RoleCourier},
+	desc.Notifier_ListReturns_FullMethodName: {RoleCourier, RoleOperator},
+	desc.Notifier_GetTariffs_FullMethodName:  {RoleCourier, RoleOperator},
+
+	desc.Notifier_ProcessOrders_FullMethodName:   {RoleOperator},
+	desc.Notifier_ListOrders_FullMethodName:      {RoleOperator},
+	desc.Notifier_GetHistory_FullMethodName:      {RoleOperator},
+	desc.Notifier_GetOrderHistory_FullMethodName: {RoleOperator},
+
+	desc.Notifier_SendMessage_FullMethodName:      {RoleOperator},
+	desc.Notifier_GetMessageStatus_FullMethodName: {RoleOperator},
+	desc.Notifier_CancelMessage_FullMethodName:    {RoleOperator},
+
+	// ImportOrders и ImportOrdersStream - только admin
+}
+
+func (p Policy) Allowed(method string, role Role) bool {
+	return role == RoleAdmin || slices.Contains(p[method], role)
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"testing"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		role   Role
+		want   bool
+	}{
+		{desc.Notifier_AcceptOrder_FullMethodName, RoleCourier, true},
+		{desc.Notifier_AcceptOrder_FullMethodName, RoleOperator, false},
+		{desc.Notifier_ReturnOrder_FullMethodName, RoleCourier, true},
+		{desc.Notifier_ProcessOrders_FullMethodName, RoleOperator, true},
+		{desc.Notifier_ProcessOrders_FullMethodName, RoleCourier, false},
+		{desc.Notifier_ListReturns_FullMethodName, RoleCourier, true},
+		{desc.Notifier_ImportOrders_FullMethodName, RoleOperator, false},
+		{desc.Notifier_ImportOrdersStream_FullMethodName, RoleCourier, false},
+		{desc.Notifier_ImportOrders_FullMethodName, RoleAdmin, true},
+		{desc.Notifier_ProcessOrders_FullMethodName, RoleAdmin, true},
+		{"/pwz.Notifier/Unknown", RoleOperator, false},
+		{"/pwz.Notifier/Unknown", RoleAdmin, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, DefaultPolicy.Allowed(tt.method, tt.role), "%s %s", tt.method, tt.role)
+	}
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+)
+
+type Role string
+
+const (
+	RoleCourier  Role = "courier"  // привозит и забирает заказы
+	RoleOperator Role = "operator" // сотрудник ПВЗ, работает с клиентами
+	RoleAdmin    Role = "admin"    // все, включая импорт
+)
+
+func (r Role) Valid() bool {
+	switch r {
+	case RoleCourier, RoleOperator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Principal тот, кто выполняет запрос
+type Principal struct {
+	Subject string // sub из JWT или имя API-ключа
+	ID      uint64 // ID сотрудника или терминала, 0 - если sub не число
+	Role    Role
+	// Terminal запрос от терминала ПВЗ по API-ключу
+	Terminal bool
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext principal запроса; false - запрос без аутентификации, например когда она выключена
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
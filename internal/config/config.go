@@ -0,0 +1,252 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/models"
+)
+
+// Config настройки всех бинарников. Значения берутся по порядку: значения по умолчанию, файл из --config
+// или PWZ_CONFIG, переменные окружения, флаги; каждое следующее перекрывает предыдущее.
+//
+// Поле path.to.field в файле задается как path: {to: {field: ...}}, в окружении - PWZ_PATH_TO_FIELD
+// или старым именем из тега env, флагом - --path.to-field. secret - значение не печатается.
+type Config struct {
+	Postgres    PostgresConfig    `yaml:"postgres"`
+	Redis       RedisConfig       `yaml:"redis"`
+	Kafka       KafkaConfig       `yaml:"kafka"`
+	Server      ServerConfig      `yaml:"server"`
+	Gateway     GatewayConfig     `yaml:"gateway"`
+	Swagger     SwaggerConfig     `yaml:"swagger"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Orders      OrdersConfig      `yaml:"orders"`
+	Outbox      OutboxConfig      `yaml:"outbox"`
+	Notifier    NotifierConfig    `yaml:"notifier"`
+	Shutdown    ShutdownConfig    `yaml:"shutdown"`
+	Health      HealthConfig      `yaml:"health"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Log         LogConfig         `yaml:"log"`
+}
+
+type PostgresConfig struct {
+	DSN            string        `yaml:"dsn" env:"DB_DSN" secret:"dsn" usage:"строка подключения к Postgres"`
+	ConnectTimeout time.Duration `yaml:"connect_timeout" usage:"таймаут подключения воркеров к Postgres"`
+}
+
+type RedisConfig struct {
+	Addr     string `yaml:"addr" env:"REDIS_ADDR" usage:"адрес Redis"`
+	Password string `yaml:"password" env:"REDIS_PASSWORD" secret:"true" usage:"пароль Redis"`
+	DB       int    `yaml:"db" usage:"номер базы Redis"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers" env:"KAFKA_BROKERS" usage:"брокеры Kafka через запятую"`
+	Topic   string   `yaml:"topic" env:"OUTBOX_TOPIC,NOTIFIER_TOPIC" usage:"топик событий заказов"`
+}
+
+type ServerConfig struct {
+	GRPCAddr       string          `yaml:"grpc_addr" usage:"адрес gRPC сервера"`
+	MetricsAddr    string          `yaml:"metrics_addr" usage:"адрес /metrics"`
+	StartupTimeout time.Duration   `yaml:"startup_timeout" usage:"таймаут подключения к Postgres и загрузки тарифов при запуске"`
+	CacheTTL       time.Duration   `yaml:"cache_ttl" usage:"время жизни истории заказа в кэше"`
+	TariffsFile    string          `yaml:"tariffs_file" env:"TARIFFS_FILE" usage:"файл тарифов, загружается при запуске"`
+	RateLimit      RateLimitConfig `yaml:"rate_limit"`
+}
+
+// RateLimitConfig не больше Limit запросов одного клиента за Period
+type RateLimitConfig struct {
+	Limit  int64         `yaml:"limit" usage:"запросов одного клиента за период"`
+	Period time.Duration `yaml:"period" usage:"период rate limit"`
+}
+
+type GatewayConfig struct {
+	Addr       string `yaml:"addr" usage:"адрес HTTP gateway"`
+	ServerAddr string `yaml:"server_addr" usage:"адрес gRPC сервера, к которому подключается gateway"`
+}
+
+type SwaggerConfig struct {
+	Addr     string `yaml:"addr" usage:"адрес Swagger UI"`
+	SpecFile string `yaml:"spec_file" usage:"файл swagger.json"`
+}
+
+type AuthConfig struct {
+	Disabled    bool   `yaml:"disabled" env:"AUTH_DISABLED" usage:"выключить аутентификацию, только для локального запуска"`
+	ConfigFile  string `yaml:"config_file" env:"AUTH_CONFIG" usage:"файл с ключами JWT и API-ключами"`
+	HS256Secret string `yaml:"jwt_hs256_secret" env:"AUTH_JWT_HS256_SECRET" secret:"true" usage:"секрет HS256, перекрывает секрет из auth.config_file"`
+}
+
+type IdempotencyConfig struct {
+	Store       string        `yaml:"store" env:"IDEMPOTENCY_STORE" usage:"где хранить ключи идемпотентности: postgres или redis"`
+	TTL         time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" usage:"сколько хранится ответ по ключу идемпотентности"`
+	InFlightTTL time.Duration `yaml:"in_flight_ttl" env:"IDEMPOTENCY_IN_FLIGHT_TTL" usage:"на сколько ключ занимается под выполняющийся запрос; после этого повтор выполнит его заново"`
+}
+
+type OrdersConfig struct {
+	ReturnWindow     time.Duration `yaml:"return_window" usage:"сколько клиент может вернуть заказ после выдачи"`
+	Retention        time.Duration `yaml:"retention" env:"ORDER_RETENTION" usage:"через сколько удаляются заказы, возвращенные курьеру"`
+	PurgeInterval    time.Duration `yaml:"purge_interval" usage:"как часто удалять старые заказы"`
+	ReminderInterval time.Duration `yaml:"reminder_interval" usage:"как часто искать заказы с истекающим хранением"`
+	ReminderAhead    time.Duration `yaml:"reminder_ahead" usage:"за сколько до конца хранения напоминать клиенту"`
+}
+
+type OutboxConfig struct {
+	MetricsAddr string `yaml:"metrics_addr" usage:"адрес /metrics outbox-worker"`
+}
+
+type NotifierConfig struct {
+	Group          string        `yaml:"group" env:"NOTIFIER_GROUP" usage:"consumer group"`
+	DLQTopic       string        `yaml:"dlq_topic" env:"NOTIFIER_DLQ_TOPIC" usage:"топик для событий, которые не удалось обработать"`
+	Handler        string        `yaml:"handler" env:"NOTIFIER_HANDLER" usage:"обработчик событий: log, webhook или customer"`
+	WebhookURL     string        `yaml:"webhook_url" env:"NOTIFIER_WEBHOOK_URL" secret:"true" usage:"URL для обработчика webhook"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout" usage:"таймаут запроса webhook"`
+}
+
+type ShutdownConfig struct {
+	DrainTimeout time.Duration `yaml:"drain_timeout" usage:"сколько ждать завершения текущих запросов и фоновых задач при остановке"`
+}
+
+type HealthConfig struct {
+	Interval time.Duration `yaml:"interval" usage:"как часто проверять Postgres, Redis и Kafka"`
+	Timeout  time.Duration `yaml:"timeout" usage:"таймаут одной проверки"`
+	Outbox   bool          `yaml:"outbox" usage:"проверять брокеры Kafka, в которые outbox-worker отправляет события"`
+}
+
+type MetricsConfig struct {
+	BusinessRefreshInterval time.Duration `yaml:"business_refresh_interval" usage:"как часто пересчитывать показатели заказов для дашборда, каждый пересчет - проход по всем заказам"`
+}
+
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" usage:"куда отправлять трассировку: none, stdout или otlp"`
+	Endpoint    string  `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT" usage:"host:port коллектора OTLP gRPC"`
+	Insecure    bool    `yaml:"insecure" usage:"подключаться к коллектору OTLP без TLS"`
+	SampleRatio float64 `yaml:"sample_ratio" usage:"доля записываемых трасс от 0 до 1"`
+}
+
+type LogConfig struct {
+	Format    string        `yaml:"format" env:"LOG_FORMAT" usage:"формат логов: text или json"`
+	Level     string        `yaml:"level" env:"LOG_LEVEL" usage:"уровень логов: debug, info, warn или error; у сервера меняется на лету через PUT /loglevel (только admin)"`
+	SlowQuery time.Duration `yaml:"slow_query" usage:"запросы к Postgres дольше этого пишутся в лог как медленные, 0 - не выделять"`
+}
+
+// Default значения, с которыми бинарники работали до появления конфига
+func Default() Config {
+	return Config{
+		Postgres: PostgresConfig{ConnectTimeout: 10 * time.Second},
+		Redis:    RedisConfig{Addr: "localhost:6379"},
+		Kafka:    KafkaConfig{Brokers: []string{"localhost:9092"}, Topic: "pvz.events"},
+		Server: ServerConfig{
+			GRPCAddr:       "localhost:50051",
+			MetricsAddr:    ":2112",
+			StartupTimeout: 100 * time.Second,
+			CacheTTL:       time.Minute,
+			RateLimit:      RateLimitConfig{Limit: 100, Period: 10 * time.Second},
+		},
+		Gateway:     GatewayConfig{Addr: "localhost:50052", ServerAddr: "localhost:50051"},
+		Swagger:     SwaggerConfig{Addr: "localhost:7002", SpecFile: "./pkg/pwz/pwz.swagger.json"},
+		Idempotency: IdempotencyConfig{Store: "postgres", TTL: 24 * time.Hour, InFlightTTL: time.Minute},
+		Orders: OrdersConfig{
+			ReturnWindow:     models.DefaultReturnWindow,
+			Retention:        30 * 24 * time.Hour,
+			PurgeInterval:    time.Hour,
+			ReminderInterval: 10 * time.Minute,
+			ReminderAhead:    24 * time.Hour,
+		},
+		Outbox: OutboxConfig{MetricsAddr: ":2113"},
+		Notifier: NotifierConfig{
+			Group:          "pvz-notifier",
+			DLQTopic:       "pvz.events.dlq",
+			Handler:        "log",
+			WebhookTimeout: 5 * time.Second,
+		},
+		Shutdown: ShutdownConfig{DrainTimeout: 15 * time.Second},
+		Health:   HealthConfig{Interval: 5 * time.Second, Timeout: 2 * time.Second},
+		Metrics:  MetricsConfig{BusinessRefreshInterval: time.Minute},
+		Tracing:  TracingConfig{Exporter: "none", Endpoint: "localhost:4317", Insecure: true, SampleRatio: 1},
+		Log:      LogConfig{Format: "text", Level: "info", SlowQuery: 200 * time.Millisecond},
+	}
+}
+
+// Validate проверяет то, что нужно всем бинарникам; DSN нужен не всем - см. RequirePostgres
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	positive := func(name string, d time.Duration) {
+		check(d > 0, "%s must be positive, got %s", name, d)
+	}
+
+	check(c.Server.GRPCAddr != "", "server.grpc_addr is empty")
+	check(c.Gateway.Addr != "", "gateway.addr is empty")
+	check(c.Gateway.ServerAddr != "", "gateway.server_addr is empty")
+	check(c.Swagger.Addr != "", "swagger.addr is empty")
+	check(c.Redis.Addr != "", "redis.addr is empty")
+	check(c.Redis.DB >= 0, "redis.db must not be negative")
+	check(len(c.Kafka.Brokers) > 0, "kafka.brokers is empty")
+	check(c.Kafka.Topic != "", "kafka.topic is empty")
+	check(c.Server.RateLimit.Limit > 0, "server.rate_limit.limit must be positive")
+	check(c.Log.SlowQuery >= 0, "log.slow_query must not be negative, got %s", c.Log.SlowQuery)
+	check(c.Log.Level != "" && new(slog.Level).UnmarshalText([]byte(c.Log.Level)) == nil,
+		"log.level must be debug, info, warn or error, got %q", c.Log.Level)
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1, got %v", c.Tracing.SampleRatio)
+
+	positive("postgres.connect_timeout", c.Postgres.ConnectTimeout)
+	positive("server.startup_timeout", c.Server.StartupTimeout)
+	positive("server.cache_ttl", c.Server.CacheTTL)
+	positive("server.rate_limit.period", c.Server.RateLimit.Period)
+	positive("idempotency.ttl", c.Idempotency.TTL)
+	positive("idempotency.in_flight_ttl", c.Idempotency.InFlightTTL)
+	check(c.Idempotency.InFlightTTL <= c.Idempotency.TTL, "idempotency.in_flight_ttl must not exceed idempotency.ttl, got %s > %s", c.Idempotency.InFlightTTL, c.Idempotency.TTL)
+	positive("orders.return_window", c.Orders.ReturnWindow)
+	positive("orders.retention", c.Orders.Retention)
+	positive("orders.purge_interval", c.Orders.PurgeInterval)
+	positive("orders.reminder_interval", c.Orders.ReminderInterval)
+	positive("orders.reminder_ahead", c.Orders.ReminderAhead)
+	positive("notifier.webhook_timeout", c.Notifier.WebhookTimeout)
+	positive("shutdown.drain_timeout", c.Shutdown.DrainTimeout)
+	positive("health.interval", c.Health.Interval)
+	positive("health.timeout", c.Health.Timeout)
+	positive("metrics.business_refresh_interval", c.Metrics.BusinessRefreshInterval)
+
+	switch c.Idempotency.Store {
+	case "postgres", "redis":
+	default:
+		check(false, "idempotency.store must be postgres or redis, got %q", c.Idempotency.Store)
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		check(c.Tracing.Endpoint != "", "tracing.endpoint is required for otlp exporter")
+	default:
+		check(false, "tracing.exporter must be none, stdout or otlp, got %q", c.Tracing.Exporter)
+	}
+	switch c.Log.Format {
+	case "text", "json":
+	default:
+		check(false, "log.format must be text or json, got %q", c.Log.Format)
+	}
+	switch c.Notifier.Handler {
+	case "log", "customer":
+	case "webhook":
+		check(c.Notifier.WebhookURL != "", "notifier.webhook_url is required for webhook handler")
+	default:
+		check(false, "notifier.handler must be log, webhook or customer, got %q", c.Notifier.Handler)
+	}
+
+	return errors.Join(errs...)
+}
+
+// RequirePostgres для бинарников, которые работают с базой
+func (c Config) RequirePostgres() error {
+	if c.Postgres.DSN == "" {
+		return errors.New("postgres.dsn is empty (DB_DSN)")
+	}
+	return nil
+}
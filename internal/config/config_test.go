@@ -0,0 +1,145 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// тесты меняют окружение, поэтому без t.Parallel; .env в каталоге пакета нет
+
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+
+	cfg, err := Load("test", nil, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	path := writeConfig(t, "pwz.yaml", `
+server:
+  grpc_addr: file:1
+  metrics_addr: file:2
+  cache_ttl: 5m
+  rate_limit:
+    limit: 5
+orders:
+  return_window: 72h
+`)
+	t.Setenv("PWZ_SERVER_METRICS_ADDR", "env:2")
+	t.Setenv("PWZ_SERVER_CACHE_TTL", "2m")
+	t.Setenv("DB_DSN", "postgres://u:p@db:5432/pvz")
+
+	cfg, err := Load("test", []string{"--config", path, "--server.cache-ttl=30s", "--auth.disabled", "--tracing.sample-ratio=0.25", "--log.slow-query=1s"}, io.Discard)
+	require.NoError(t, err)
+
+	assert.Equal(t, "file:1", cfg.Server.GRPCAddr)
+	assert.Equal(t, "env:2", cfg.Server.MetricsAddr)
+	assert.Equal(t, 30*time.Second, cfg.Server.CacheTTL)
+	assert.Equal(t, int64(5), cfg.Server.RateLimit.Limit)
+	assert.Equal(t, 10*time.Second, cfg.Server.RateLimit.Period)
+	assert.Equal(t, 72*time.Hour, cfg.Orders.ReturnWindow)
+	assert.Equal(t, "postgres://u:p@db:5432/pvz", cfg.Postgres.DSN)
+	assert.True(t, cfg.Auth.Disabled)
+	assert.Equal(t, 0.25, cfg.Tracing.SampleRatio)
+	assert.Equal(t, time.Second, cfg.Log.SlowQuery)
+}
+
+func TestLoad_JSONFile(t *testing.T) {
+	path := writeConfig(t, "pwz.json", `{"kafka": {"brokers": ["k1:9092", "k2:9092"]}, "idempotency": {"store": "redis", "ttl": "1h"}}`)
+
+	cfg, err := Load("test", []string{"--config", path}, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k1:9092", "k2:9092"}, cfg.Kafka.Brokers)
+	assert.Equal(t, "redis", cfg.Idempotency.Store)
+	assert.Equal(t, time.Hour, cfg.Idempotency.TTL)
+}
+
+func TestLoad_LegacyEnv(t *testing.T) {
+	t.Setenv("KAFKA_BROKERS", "a:1, b:2")
+	t.Setenv("NOTIFIER_TOPIC", "legacy")
+	t.Setenv("ORDER_RETENTION", "240h")
+	t.Setenv("LOG_FORMAT", "json")
+
+	cfg, err := Load("test", nil, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:1", "b:2"}, cfg.Kafka.Brokers)
+	assert.Equal(t, "legacy", cfg.Kafka.Topic)
+	assert.Equal(t, 240*time.Hour, cfg.Orders.Retention)
+	assert.Equal(t, "json", cfg.Log.Format)
+
+	// новое имя важнее старого
+	t.Setenv("PWZ_KAFKA_TOPIC", "new")
+	cfg, err = Load("test", nil, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, "new", cfg.Kafka.Topic)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		args []string
+		file string
+	}{
+		{name: "unknown flag", args: []string{"--server.grpc"}},
+		{name: "bad duration flag", args: []string{"--server.cache-ttl=soon"}},
+		{name: "negative duration", args: []string{"--orders.return-window=-1h"}},
+		{name: "unknown store", args: []string{"--idempotency.store=memcached"}},
+		{name: "in-flight lease above ttl", args: []string{"--idempotency.in-flight-ttl=48h"}},
+		{name: "webhook without url", args: []string{"--notifier.handler=webhook"}},
+		{name: "unknown exporter", args: []string{"--tracing.exporter=jaeger"}},
+		{name: "sample ratio above one", args: []string{"--tracing.sample-ratio=1.5"}},
+		{name: "unknown log format", args: []string{"--log.format=xml"}},
+		{name: "unknown log level", args: []string{"--log.level=verbose"}},
+		{name: "negative slow query", args: []string{"--log.slow-query=-1s"}},
+		{name: "unknown key in file", file: "server:\n  grpc_adr: x\n"},
+		{name: "missing file", args: []string{"--config", "/nonexistent/pwz.yaml"}},
+	}
+	for _, tt := range tests {
+		args := tt.args
+		if tt.file != "" {
+			args = append(args, "--config", writeConfig(t, "bad.yaml", tt.file))
+		}
+		_, err := Load("test", args, io.Discard)
+		assert.Error(t, err, tt.name)
+	}
+}
+
+func TestLoad_PrintConfig(t *testing.T) {
+	t.Setenv("DB_DSN", "postgres://pvz:s3cret@db:5432/pvz?sslmode=disable")
+	t.Setenv("AUTH_JWT_HS256_SECRET", "jwt-secret")
+
+	var out bytes.Buffer
+	_, err := Load("test", []string{"--print-config"}, &out)
+	require.ErrorIs(t, err, ErrPrinted)
+
+	printed := out.String()
+	assert.NotContains(t, printed, "s3cret")
+	assert.NotContains(t, printed, "jwt-secret")
+	assert.Contains(t, printed, "dsn: postgres://pvz:***@db:5432/pvz?sslmode=disable")
+	assert.Contains(t, printed, "jwt_hs256_secret: '***'")
+	assert.Contains(t, printed, "cache_ttl: 1m0s")
+	assert.Contains(t, printed, "brokers: ['localhost:9092']")
+}
+
+func TestRedactDSN(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "host=db user=pvz password=*** dbname=pvz", redact("dsn", "host=db user=pvz password=s3cret dbname=pvz"))
+	assert.Equal(t, "postgres://pvz@db/pvz", redact("dsn", "postgres://pvz@db/pvz"))
+	assert.Equal(t, "", redact("true", ""))
+}
@@ -0,0 +1,216 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrPrinted конфиг напечатан по --print-config, бинарнику нужно просто завершиться
+var ErrPrinted = errors.New("config printed")
+
+// Load собирает конфиг из значений по умолчанию, файла, окружения (и .env, если он есть) и флагов args.
+// С --print-config печатает итоговый конфиг без секретов в out и возвращает ErrPrinted
+func Load(name string, args []string, out io.Writer) (Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Config{}, fmt.Errorf("load .env: %w", err)
+	}
+
+	cfg := Default()
+	fields := collectFields(reflect.ValueOf(&cfg).Elem(), "")
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(out)
+	path := fs.String("config", os.Getenv("PWZ_CONFIG"), "файл конфига YAML или JSON, по умолчанию из PWZ_CONFIG")
+	printConfig := fs.Bool("print-config", false, "напечатать итоговый конфиг без секретов и выйти")
+
+	// флаги применяются последними, поэтому при разборе только запоминаем значения
+	type flagValue struct {
+		field field
+		value string
+	}
+	var flagValues []flagValue
+	for _, f := range fields {
+		set := func(s string) error {
+			if err := setValue(reflect.New(f.value.Type()).Elem(), s); err != nil {
+				return err
+			}
+			flagValues = append(flagValues, flagValue{field: f, value: s})
+			return nil
+		}
+		if f.value.Kind() == reflect.Bool {
+			fs.BoolFunc(f.flagName(), f.usage, set)
+		} else {
+			fs.Func(f.flagName(), f.usage, set)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if fs.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	if *path != "" {
+		if err := loadFile(*path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+	for _, f := range fields {
+		name, value, ok := f.lookupEnv()
+		if !ok {
+			continue
+		}
+		if err := setValue(f.value, value); err != nil {
+			return Config{}, fmt.Errorf("env %s: %w", name, err)
+		}
+	}
+	for _, fv := range flagValues {
+		_ = setValue(fv.field.value, fv.value) // значение уже проверено при разборе
+	}
+
+	// неверный конфиг тоже печатаем, чтобы было видно, откуда взялось значение
+	if *printConfig {
+		if err := Print(out, cfg); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	if *printConfig {
+		return cfg, ErrPrinted
+	}
+	return cfg, nil
+}
+
+// MustLoad Load для main: после --print-config и --help завершает процесс, при ошибке - log.Fatal
+func MustLoad(name string) Config {
+	cfg, err := Load(name, os.Args[1:], os.Stdout)
+	switch {
+	case errors.Is(err, ErrPrinted), errors.Is(err, flag.ErrHelp):
+		os.Exit(0)
+	case err != nil:
+		log.Fatalf("failed to load config: %v", err)
+	}
+	return cfg
+}
+
+// loadFile YAML или JSON; JSON - подмножество YAML, поэтому разбирается тем же декодером, и длительности пишутся как "10s"
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return nil
+}
+
+// field одно значение конфига, path - как в файле: server.rate_limit.limit
+type field struct {
+	path   string
+	env    []string
+	secret string
+	usage  string
+	value  reflect.Value
+}
+
+func collectFields(v reflect.Value, prefix string) []field {
+	var fields []field
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		path := prefix + sf.Tag.Get("yaml")
+		if sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, collectFields(v.Field(i), path+".")...)
+			continue
+		}
+
+		f := field{
+			path:   path,
+			env:    []string{"PWZ_" + strings.ToUpper(strings.ReplaceAll(path, ".", "_"))},
+			secret: sf.Tag.Get("secret"),
+			usage:  sf.Tag.Get("usage"),
+			value:  v.Field(i),
+		}
+		if legacy := sf.Tag.Get("env"); legacy != "" {
+			f.env = append(f.env, strings.Split(legacy, ",")...)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// flagName server.grpc_addr -> server.grpc-addr
+func (f field) flagName() string {
+	return strings.ReplaceAll(f.path, "_", "-")
+}
+
+// lookupEnv первое непустое значение: сначала PWZ_..., потом старые имена
+func (f field) lookupEnv() (string, string, bool) {
+	for _, name := range f.env {
+		if v := os.Getenv(name); v != "" {
+			return name, v, true
+		}
+	}
+	return "", "", false
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setValue(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Int, v.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported config type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "***"
+
+// Print итоговый конфиг в YAML, секреты заменены на ***
+func Print(w io.Writer, cfg Config) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node(reflect.ValueOf(cfg))); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// node поля в порядке объявления, длительности строкой: 10s, а не в наносекундах
+func node(v reflect.Value) *yaml.Node {
+	n := &yaml.Node{Kind: yaml.MappingNode}
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: sf.Tag.Get("yaml")}
+
+		fv := v.Field(i)
+		var value *yaml.Node
+		switch {
+		case fv.Kind() == reflect.Struct:
+			value = node(fv)
+		case fv.Kind() == reflect.Slice:
+			value = &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+			for j := 0; j < fv.Len(); j++ {
+				value.Content = append(value.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: fmt.Sprint(fv.Index(j))})
+			}
+		default:
+			value = &yaml.Node{Kind: yaml.ScalarNode, Value: redact(sf.Tag.Get("secret"), scalar(fv))}
+		}
+		n.Content = append(n.Content, key, value)
+	}
+	return n
+}
+
+func scalar(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+var dsnPassword = regexp.MustCompile(`(password=)\S+`)
+
+func redact(secret, value string) string {
+	switch {
+	case secret == "" || value == "":
+		return value
+	case secret == "dsn":
+		// в DSN прячем только пароль, адрес базы нужен при разборе проблем
+		if u, err := url.Parse(value); err == nil && u.User != nil {
+			if _, ok := u.User.Password(); ok {
+				u.User = url.UserPassword(u.User.Username(), redacted)
+			}
+			// url экранирует звездочки в пароле
+			return strings.Replace(u.String(), url.QueryEscape(redacted), redacted, 1)
+		}
+		return dsnPassword.ReplaceAllString(value, "${1}"+redacted)
+	default:
+		return redacted
+	}
+}
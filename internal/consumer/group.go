@@ -0,0 +1,109 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/outbox"
+	"github.com/IBM/sarama"
+)
+
+// GroupHandler реализует sarama.ConsumerGroupHandler поверх Processor
+type GroupHandler struct {
+	processor *Processor
+}
+
+func NewGroupHandler(processor *Processor) *GroupHandler {
+	return &GroupHandler{processor: processor}
+}
+
+func (h *GroupHandler) Setup(session sarama.ConsumerGroupSession) error {
+	slog.InfoContext(session.Context(), "consumer: partitions assigned", "claims", session.Claims(), "generation", session.GenerationID())
+	return nil
+}
+
+func (h *GroupHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	// фиксируем отмеченные оффсеты до того, как партиции уйдут другому участнику группы
+	session.Commit()
+	slog.InfoContext(session.Context(), "consumer: partitions revoked", "claims", session.Claims())
+	return nil
+}
+
+func (h *GroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			if err := h.process(session.Context(), msg); err != nil {
+				// сессия закончилась: оффсет не отмечаем, сообщение получит следующий владелец партиции
+				slog.WarnContext(session.Context(), "consumer: message not committed", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "err", err)
+				return nil
+			}
+			session.MarkMessage(msg, "")
+		}
+	}
+}
+
+// process повторяет сообщение, пока оно не обработано или не ушло в dead-letter топик, до конца сессии.
+// Выход из ConsumeClaim остановил бы чтение партиции до следующего ребаланса
+func (h *GroupHandler) process(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	for attempt := 1; ; attempt++ {
+		err := h.processor.Process(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+
+		slog.WarnContext(ctx, "consumer: message failed, retrying", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(outbox.Backoff(attempt, h.processor.cfg.RetryBackoff, time.Minute)):
+		}
+	}
+}
+
+func NewConsumerGroup(brokers []string, group string) (sarama.ConsumerGroup, error) {
+	cfg := sarama.NewConfig()
+	cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	cfg.Consumer.Offsets.AutoCommit.Enable = true
+	cfg.Consumer.Offsets.AutoCommit.Interval = time.Second
+	cfg.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategySticky()}
+	cfg.Consumer.Return.Errors = true
+
+	return sarama.NewConsumerGroup(brokers, group, cfg)
+}
+
+// Run читает топики до отмены контекста, переподключаясь после каждого ребаланса
+func Run(ctx context.Context, group sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler) error {
+	go func() {
+		for err := range group.Errors() {
+			slog.ErrorContext(ctx, "consumer group error", "err", err)
+		}
+	}()
+
+	for {
+		if err := group.Consume(ctx, topics, handler); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			slog.ErrorContext(ctx, "consumer: consume failed", "err", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
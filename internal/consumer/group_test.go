@@ -0,0 +1,55 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyPublisher отказывает первые failures раз
+type flakyPublisher struct {
+	failures int32
+	calls    atomic.Int32
+	outbox.MemoryPublisher
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, msg outbox.Message) error {
+	if p.calls.Add(1) <= p.failures {
+		return errors.New("broker is down")
+	}
+	return p.MemoryPublisher.Publish(ctx, msg)
+}
+
+func TestGroupHandler_ProcessRetries(t *testing.T) {
+	t.Parallel()
+
+	dlq := &flakyPublisher{failures: 2}
+	p := NewProcessor(mocks.NewProcessedEventStorageMock(t), &recordingHandler{}, dlq, Config{MaxRetries: 1, RetryBackoff: time.Millisecond})
+	h := NewGroupHandler(p)
+
+	// ошибка dead-letter топика не пропускает сообщение и не бросает партицию
+	require.NoError(t, h.process(context.Background(), &sarama.ConsumerMessage{Value: []byte("{}")}))
+	assert.Equal(t, int32(3), dlq.calls.Load())
+	assert.Len(t, dlq.Messages(), 1)
+}
+
+func TestGroupHandler_ProcessStopsWithSession(t *testing.T) {
+	t.Parallel()
+
+	dlq := &flakyPublisher{failures: 1 << 30}
+	p := NewProcessor(mocks.NewProcessedEventStorageMock(t), &recordingHandler{}, dlq, Config{MaxRetries: 1, RetryBackoff: time.Millisecond})
+	h := NewGroupHandler(p)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Error(t, h.process(ctx, &sarama.ConsumerMessage{Value: []byte("{}")}))
+	assert.Empty(t, dlq.Messages())
+}
@@ -0,0 +1,96 @@
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/tracing"
+)
+
+// Handler получатель событий заказов
+type Handler interface {
+	Handle(ctx context.Context, event models.Event) error
+}
+
+// LogHandler пишет события в лог
+type LogHandler struct{}
+
+func NewLogHandler() *LogHandler {
+	return &LogHandler{}
+}
+
+func (h *LogHandler) Handle(ctx context.Context, event models.Event) error {
+	slog.InfoContext(ctx, "order event", "event_id", event.EventID, "type", event.EventType, "order_id", event.Order.ID,
+		"user_id", event.Order.UserID, "status", event.Order.Status, "actor_type", event.Actor.Type, "actor_id", event.Actor.ID)
+	return nil
+}
+
+// WebhookHandler отправляет событие POST-запросом на внешний адрес
+type WebhookHandler struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookHandler(url string, timeout time.Duration) *WebhookHandler {
+	return &WebhookHandler{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (h *WebhookHandler) Handle(ctx context.Context, event models.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", event.EventID.String())
+	for k, v := range tracing.Inject(ctx) {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// CustomerNotificationHandler заглушка уведомлений клиента о смене статуса заказа
+type CustomerNotificationHandler struct{}
+
+func NewCustomerNotificationHandler() *CustomerNotificationHandler {
+	return &CustomerNotificationHandler{}
+}
+
+func (h *CustomerNotificationHandler) Handle(ctx context.Context, event models.Event) error {
+	text, ok := customerMessages[event.EventType]
+	if !ok {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "notify customer", "user_id", event.Order.UserID, "order_id", event.Order.ID, "text", fmt.Sprintf(text, event.Order.ID))
+	return nil
+}
+
+var customerMessages = map[string]string{
+	models.EventOrderAccepted:         "заказ %d прибыл в пункт выдачи",
+	models.EventOrderIssued:           "заказ %d выдан",
+	models.EventOrderReturnedByClient: "возврат заказа %d принят",
+}
@@ -0,0 +1,167 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tracing"
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errInvalidEvent = errors.New("invalid event payload")
+
+type Config struct {
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries:   5,
+		RetryBackoff: 500 * time.Millisecond,
+	}
+}
+
+// Processor обрабатывает одно сообщение: разбор, дедупликация по EventID, вызов обработчика.
+// Сообщения, которые не удалось разобрать или обработать, уходят в dead-letter топик.
+type Processor struct {
+	storage storage.ProcessedEventStorage
+	handler Handler
+	dlq     outbox.Publisher
+	cfg     Config
+}
+
+func NewProcessor(storage storage.ProcessedEventStorage, handler Handler, dlq outbox.Publisher, cfg Config) *Processor {
+	return &Processor{
+		storage: storage,
+		handler: handler,
+		dlq:     dlq,
+		cfg:     cfg,
+	}
+}
+
+// Process возвращает ошибку, только если сообщение нельзя коммитить (контекст отменён при ребалансе)
+func (p *Processor) Process(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	event, err := parseEvent(msg.Value)
+	ctx, span := startSpan(ctx, msg, event)
+	defer span.End()
+
+	if err != nil {
+		slog.WarnContext(ctx, "consumer: invalid event", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "err", err)
+		return p.deadLetter(ctx, msg, err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = p.handleOnce(ctx, event)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if attempt >= p.cfg.MaxRetries {
+			break
+		}
+
+		slog.WarnContext(ctx, "consumer: event attempt failed", "event_id", event.EventID, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(outbox.Backoff(attempt, p.cfg.RetryBackoff, time.Minute)):
+		}
+	}
+
+	slog.ErrorContext(ctx, "consumer: event failed, sending to dead-letter topic", "event_id", event.EventID, "attempts", p.cfg.MaxRetries, "err", err)
+	return p.deadLetter(ctx, msg, err)
+}
+
+// handleOnce помечает событие обработанным и вызывает обработчик в одной транзакции:
+// если обработчик упал, отметка откатывается и событие будет обработано повторно
+func (p *Processor) handleOnce(ctx context.Context, event models.Event) error {
+	return p.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		fresh, err := p.storage.MarkEventProcessedTx(ctx, tx, event.EventID, event.EventType)
+		if err != nil {
+			return err
+		}
+		if !fresh {
+			slog.DebugContext(ctx, "consumer: event already processed, skipping", "event_id", event.EventID)
+			return nil
+		}
+
+		return p.handler.Handle(ctx, event)
+	})
+}
+
+func (p *Processor) deadLetter(ctx context.Context, msg *sarama.ConsumerMessage, reason error) error {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(reason)
+	span.SetStatus(codes.Error, "sent to dead-letter topic: "+reason.Error())
+
+	headers := map[string]string{
+		"original_topic":     msg.Topic,
+		"original_partition": strconv.Itoa(int(msg.Partition)),
+		"original_offset":    strconv.FormatInt(msg.Offset, 10),
+		"error":              reason.Error(),
+	}
+
+	err := p.dlq.Publish(ctx, outbox.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("publish to dead-letter topic: %w", err)
+	}
+	return nil
+}
+
+// startSpan продолжает трассировку из заголовков, которые добавил relay, а если их нет - из trace_context события
+func startSpan(ctx context.Context, msg *sarama.ConsumerMessage, event models.Event) (context.Context, trace.Span) {
+	carrier := event.TraceContext
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	if _, ok := headers["traceparent"]; ok {
+		carrier = headers
+	}
+
+	name := "consume"
+	if event.EventType != "" {
+		name += " " + event.EventType
+	}
+	return tracing.Start(tracing.Extract(ctx, carrier), name,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", msg.Topic),
+			attribute.Int("messaging.kafka.partition", int(msg.Partition)),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+			attribute.String("messaging.message.id", event.EventID.String()),
+		),
+	)
+}
+
+func parseEvent(payload []byte) (models.Event, error) {
+	var event models.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return event, fmt.Errorf("%w: %v", errInvalidEvent, err)
+	}
+	if event.EventID == uuid.Nil || event.EventType == "" {
+		return event, fmt.Errorf("%w: missing event_id or event_type", errInvalidEvent)
+	}
+	return event, nil
+}
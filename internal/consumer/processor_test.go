@@ -0,0 +1,183 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage/mocks"
+	"PWZ1.0/internal/tracing"
+	"PWZ1.0/internal/tracing/tracingtest"
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recordingHandler struct {
+	events []models.Event
+	spans  []trace.SpanContext
+	err    error
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, event models.Event) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.events = append(h.events, event)
+	h.spans = append(h.spans, trace.SpanContextFromContext(ctx))
+	return nil
+}
+
+func newMessage(t *testing.T, event models.Event) *sarama.ConsumerMessage {
+	t.Helper()
+
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{Topic: "pvz.events", Partition: 1, Offset: 7, Value: payload}
+}
+
+func newStorageMock(t *testing.T, fresh bool) *mocks.ProcessedEventStorageMock {
+	m := mocks.NewProcessedEventStorageMock(t)
+	m.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	m.MarkEventProcessedTxMock.Return(fresh, nil)
+	return m
+}
+
+func TestProcessor_Process(t *testing.T) {
+	event := models.Event{
+		EventID:   uuid.New(),
+		EventType: "order_accepted",
+		Order:     models.EventOrder{ID: 1, UserID: 10, Status: models.StatusExpects},
+	}
+
+	tests := []struct {
+		name       string
+		msg        func(t *testing.T) *sarama.ConsumerMessage
+		fresh      bool
+		handlerErr error
+		wantEvents int
+		wantDLQ    int
+	}{
+		{
+			name:       "new event is handled",
+			msg:        func(t *testing.T) *sarama.ConsumerMessage { return newMessage(t, event) },
+			fresh:      true,
+			wantEvents: 1,
+		},
+		{
+			name:  "duplicate event is skipped",
+			msg:   func(t *testing.T) *sarama.ConsumerMessage { return newMessage(t, event) },
+			fresh: false,
+		},
+		{
+			name:       "handler failure goes to dead-letter topic",
+			msg:        func(t *testing.T) *sarama.ConsumerMessage { return newMessage(t, event) },
+			fresh:      true,
+			handlerErr: errors.New("webhook is down"),
+			wantDLQ:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := &recordingHandler{err: tt.handlerErr}
+			dlq := outbox.NewMemoryPublisher()
+			p := NewProcessor(newStorageMock(t, tt.fresh), handler, dlq, Config{MaxRetries: 2, RetryBackoff: time.Millisecond})
+
+			err := p.Process(context.Background(), tt.msg(t))
+			require.NoError(t, err)
+			assert.Len(t, handler.events, tt.wantEvents)
+			assert.Len(t, dlq.Messages(), tt.wantDLQ)
+		})
+	}
+}
+
+// TestProcessor_Process_ContinuesTrace обработка продолжает трассировку из заголовков relay,
+// а у сообщений без заголовков - из trace_context события
+func TestProcessor_Process_ContinuesTrace(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+
+	requestCtx, request := tracing.Start(context.Background(), "request")
+	publishCtx, publish := tracing.Start(requestCtx, "outbox publish")
+	event := models.Event{
+		EventID:      uuid.New(),
+		EventType:    "order_accepted",
+		Order:        models.EventOrder{ID: 1, UserID: 10, Status: models.StatusExpects},
+		TraceContext: tracing.Inject(requestCtx),
+	}
+	publish.End()
+	request.End()
+
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		wantParent trace.SpanID
+	}{
+		{name: "from headers", headers: tracing.Inject(publishCtx), wantParent: publish.SpanContext().SpanID()},
+		{name: "from payload", wantParent: request.SpanContext().SpanID()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder.Reset()
+			msg := newMessage(t, event)
+			for k, v := range tt.headers {
+				msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+			}
+
+			handler := &recordingHandler{}
+			p := NewProcessor(newStorageMock(t, true), handler, outbox.NewMemoryPublisher(), DefaultConfig())
+			require.NoError(t, p.Process(context.Background(), msg))
+
+			consume := tracingtest.Find(recorder, "consume order_accepted")
+			require.NotNil(t, consume)
+			assert.Equal(t, request.SpanContext().TraceID(), consume.SpanContext().TraceID())
+			assert.Equal(t, tt.wantParent, consume.Parent().SpanID())
+			assert.Equal(t, trace.SpanKindConsumer, consume.SpanKind())
+
+			require.Len(t, handler.spans, 1)
+			assert.Equal(t, consume.SpanContext().SpanID(), handler.spans[0].SpanID())
+		})
+	}
+}
+
+func TestProcessor_Process_InvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	handler := &recordingHandler{}
+	dlq := outbox.NewMemoryPublisher()
+	p := NewProcessor(mocks.NewProcessedEventStorageMock(t), handler, dlq, DefaultConfig())
+
+	msg := &sarama.ConsumerMessage{Topic: "pvz.events", Partition: 2, Offset: 15, Value: []byte("not a json")}
+	require.NoError(t, p.Process(context.Background(), msg))
+
+	assert.Empty(t, handler.events)
+	dead := dlq.Messages()
+	require.Len(t, dead, 1)
+	assert.Equal(t, msg.Value, dead[0].Value)
+	assert.Equal(t, "pvz.events", dead[0].Headers["original_topic"])
+	assert.Equal(t, "2", dead[0].Headers["original_partition"])
+	assert.Equal(t, "15", dead[0].Headers["original_offset"])
+}
+
+func TestProcessor_Process_DeadLetterUnavailable(t *testing.T) {
+	t.Parallel()
+
+	dlq := outbox.NewMemoryPublisher()
+	dlq.Err = errors.New("broker is down")
+	p := NewProcessor(mocks.NewProcessedEventStorageMock(t), &recordingHandler{}, dlq, DefaultConfig())
+
+	err := p.Process(context.Background(), &sarama.ConsumerMessage{Value: []byte("{}")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check проверка одной зависимости, ошибка - зависимость недоступна
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker периодически проверяет зависимости и выставляет статусы в gRPC health-сервисе: у каждой зависимости
+// свой статус под ее именем, а общий ("") и статус сервисов из services - SERVING, только если доступны все
+type Checker struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+	checks   []namedCheck
+
+	mu      sync.RWMutex
+	results map[string]Result
+}
+
+// NewChecker до первой проверки все статусы NOT_SERVING
+func NewChecker(server *health.Server, interval, timeout time.Duration, services ...string) *Checker {
+	c := &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+		timeout:  timeout,
+	}
+	for _, s := range c.services {
+		server.SetServingStatus(s, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return c
+}
+
+// Add регистрирует зависимость, вызывать до Run
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+	c.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Run проверяет зависимости сразу и затем раз в interval до отмены ctx
+func (c *Checker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll один раунд проверок, зависимости проверяются параллельно, каждая не дольше timeout
+func (c *Checker) CheckAll(ctx context.Context) {
+	results := make(map[string]Result, len(c.checks))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := c.check(ctx, nc)
+			mu.Lock()
+			results[nc.name] = res
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	prev := c.results
+	c.results = results
+	c.mu.Unlock()
+
+	serving := true
+	for name, res := range results {
+		if !res.ok() {
+			serving = false
+		}
+		if old, ok := prev[name]; !ok || old.Status != res.Status {
+			level := slog.LevelInfo
+			if !res.ok() {
+				level = slog.LevelWarn
+			}
+			slog.Log(ctx, level, "health: status changed", "check", name, "status", res.Status, "error", res.Error)
+		}
+		c.server.SetServingStatus(name, servingStatus(res.ok()))
+	}
+	for _, s := range c.services {
+		c.server.SetServingStatus(s, servingStatus(serving))
+	}
+}
+
+func (c *Checker) check(ctx context.Context, nc namedCheck) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := nc.check(ctx)
+	res := Result{
+		Status:    StatusServing,
+		Latency:   time.Since(start).Round(time.Microsecond).String(),
+		CheckedAt: start.UTC().Format(time.RFC3339),
+	}
+	if err != nil {
+		res.Status = StatusNotServing
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// Report последние результаты; до первой проверки зависимости в отчете нет, и он не ok
+func (c *Checker) Report() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(c.checks))}
+	if c.results == nil {
+		report.Status = StatusUnavailable
+	}
+	for name, res := range c.results {
+		report.Checks[name] = res
+		if !res.ok() {
+			report.Status = StatusUnavailable
+		}
+	}
+	return report
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,133 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const service = "pwz.Notifier"
+
+func servingStatusOf(t *testing.T, server *health.Server, name string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: name})
+	require.NoError(t, err)
+	return resp.GetStatus()
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	server := health.NewServer()
+	checker := NewChecker(server, time.Minute, time.Second, service)
+
+	var redisDown atomic.Bool
+	redisDown.Store(true)
+	checker.Add("postgres", func(context.Context) error { return nil })
+	checker.Add("redis", func(context.Context) error {
+		if redisDown.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	// до первой проверки трафик не принимаем
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatusOf(t, server, ""))
+	assert.Equal(t, StatusUnavailable, checker.Report().Status)
+
+	checker.CheckAll(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatusOf(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatusOf(t, server, service))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatusOf(t, server, "postgres"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatusOf(t, server, "redis"))
+
+	report := checker.Report()
+	assert.Equal(t, StatusUnavailable, report.Status)
+	assert.Equal(t, StatusServing, report.Checks["postgres"].Status)
+	assert.Equal(t, StatusNotServing, report.Checks["redis"].Status)
+	assert.Equal(t, "connection refused", report.Checks["redis"].Error)
+
+	redisDown.Store(false)
+	checker.CheckAll(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatusOf(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatusOf(t, server, service))
+	assert.Equal(t, StatusOK, checker.Report().Status)
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	t.Parallel()
+
+	server := health.NewServer()
+	checker := NewChecker(server, time.Minute, 10*time.Millisecond)
+	checker.Add("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	checker.CheckAll(context.Background())
+	res := checker.Report().Checks["postgres"]
+	assert.Equal(t, StatusNotServing, res.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), res.Error)
+}
+
+func TestFromList(t *testing.T) {
+	t.Parallel()
+
+	report := FromList(&healthpb.HealthListResponse{Statuses: map[string]*healthpb.HealthCheckResponse{
+		"":         {Status: healthpb.HealthCheckResponse_SERVING},
+		"postgres": {Status: healthpb.HealthCheckResponse_SERVING},
+		"redis":    {Status: healthpb.HealthCheckResponse_SERVING},
+	}})
+	assert.Equal(t, Report{Status: StatusOK, Checks: map[string]Result{
+		"postgres": {Status: StatusServing},
+		"redis":    {Status: StatusServing},
+	}}, report)
+
+	report = FromList(&healthpb.HealthListResponse{Statuses: map[string]*healthpb.HealthCheckResponse{
+		"":      {Status: healthpb.HealthCheckResponse_NOT_SERVING},
+		"redis": {Status: healthpb.HealthCheckResponse_NOT_SERVING},
+	}})
+	assert.Equal(t, StatusUnavailable, report.Status)
+	assert.Equal(t, StatusNotServing, report.Checks["redis"].Status)
+}
+
+func TestReadyHandler(t *testing.T) {
+	t.Parallel()
+
+	var ready atomic.Bool
+	report := Report{Status: StatusOK, Checks: map[string]Result{"postgres": {Status: StatusServing}}}
+	handler := ReadyHandler(ready.Load, func(context.Context) Report { return report })
+
+	get := func() (int, Report) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var got Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		return rec.Code, got
+	}
+
+	code, got := get()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusDraining, got.Status)
+
+	ready.Store(true)
+	code, got = get()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, report, got)
+
+	report = Report{Status: StatusUnavailable, Checks: map[string]Result{"postgres": {Status: StatusNotServing, Error: "down"}}}
+	code, got = get()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, report, got)
+}
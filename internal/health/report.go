@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// статусы отчета целиком
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+	StatusDraining    = "draining"
+)
+
+// статусы зависимости, как в grpc.health.v1
+const (
+	StatusServing    = "serving"
+	StatusNotServing = "not_serving"
+)
+
+// Report ответ /readyz
+type Report struct {
+	Status string            `json:"status"`
+	Error  string            `json:"error,omitempty"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+// Result последняя проверка зависимости; Error, Latency и CheckedAt есть только у локальных проверок
+type Result struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Latency   string `json:"latency,omitempty"`
+	CheckedAt string `json:"checked_at,omitempty"`
+}
+
+func (r Result) ok() bool {
+	return r.Status == StatusServing
+}
+
+// FromList отчет по ответу Health/List другого процесса: общий статус - у сервиса "", остальные - зависимости и сервисы
+func FromList(resp *healthpb.HealthListResponse) Report {
+	report := Report{Status: StatusUnavailable, Checks: make(map[string]Result, len(resp.GetStatuses()))}
+	for name, st := range resp.GetStatuses() {
+		if name == "" {
+			if st.GetStatus() == healthpb.HealthCheckResponse_SERVING {
+				report.Status = StatusOK
+			}
+			continue
+		}
+		report.Checks[name] = Result{Status: strings.ToLower(st.GetStatus().String())}
+	}
+	return report
+}
+
+// LiveHandler /healthz: процесс жив и отвечает, зависимости не проверяются, иначе оркестратор перезапустит
+// процесс из-за упавшей базы
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(r.Context(), w, Report{Status: StatusOK})
+	})
+}
+
+// ReadyHandler /readyz: 200, только если процесс не останавливается и report ok
+func ReadyHandler(ready func() bool, report func(ctx context.Context) Report) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !ready() {
+			writeReport(r.Context(), w, Report{Status: StatusDraining})
+			return
+		}
+		writeReport(r.Context(), w, report(r.Context()))
+	})
+}
+
+func writeReport(ctx context.Context, w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	if report.Status != StatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		slog.WarnContext(ctx, "health: write report", "err", err)
+	}
+}
@@ -0,0 +1,62 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	desc "PWZ1.0/pkg/pwz"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// Header метаданные gRPC (и заголовок HTTP) с ключом, который клиент повторяет при ретраях
+	Header = "idempotency-key"
+	// ReplayedHeader выставляется в ответе, который взят из сохраненного
+	ReplayedHeader = "idempotent-replayed"
+
+	MaxKeyLength = 128
+)
+
+// Methods изменяющие RPC, для которых учитывается ключ идемпотентности; потоковый импорт продолжается по job_id
+var Methods = map[string]bool{
+	desc.Notifier_AcceptOrder_FullMethodName:   true,
+	desc.Notifier_ReturnOrder_FullMethodName:   true,
+	desc.Notifier_ProcessOrders_FullMethodName: true,
+	desc.Notifier_ImportOrders_FullMethodName:  true,
+	desc.Notifier_SendMessage_FullMethodName:   true,
+	desc.Notifier_CancelMessage_FullMethodName: true,
+}
+
+// ScopedKey ключ в хранилище: одинаковые ключи разных методов и клиентов не пересекаются
+func ScopedKey(method, caller, key string) string {
+	sum := sha256.Sum256([]byte(method + "\x00" + caller + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// RequestHash хеш тела запроса, по нему повтор отличается от другого запроса с тем же ключом
+func RequestHash(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// EncodeResponse ответ хранится вместе с типом, чтобы при повторе восстановить его без знания метода
+func EncodeResponse(resp proto.Message) ([]byte, error) {
+	a, err := anypb.New(resp)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+func DecodeResponse(data []byte) (proto.Message, error) {
+	var a anypb.Any
+	if err := proto.Unmarshal(data, &a); err != nil {
+		return nil, err
+	}
+	return a.UnmarshalNew()
+}
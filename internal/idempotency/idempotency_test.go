@@ -0,0 +1,46 @@
+package idempotency
+
+import (
+	"testing"
+
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEncodeDecodeResponse(t *testing.T) {
+	t.Parallel()
+
+	resp := &desc.ProcessResult{Processed: []uint64{1, 2}, Errors: []uint64{3}}
+	data, err := EncodeResponse(resp)
+	require.NoError(t, err)
+
+	got, err := DecodeResponse(data)
+	require.NoError(t, err)
+	assert.IsType(t, &desc.ProcessResult{}, got)
+	assert.True(t, proto.Equal(resp, got))
+}
+
+func TestRequestHash(t *testing.T) {
+	t.Parallel()
+
+	h1, err := RequestHash(&desc.ProcessOrdersRequest{UserId: 1, OrderIds: []uint64{1, 2}})
+	require.NoError(t, err)
+	h2, err := RequestHash(&desc.ProcessOrdersRequest{UserId: 1, OrderIds: []uint64{1, 2}})
+	require.NoError(t, err)
+	h3, err := RequestHash(&desc.ProcessOrdersRequest{UserId: 1, OrderIds: []uint64{2, 1}})
+	require.NoError(t, err)
+
+	assert.Equal(t, h1, h2)
+	assert.NotEqual(t, h1, h3)
+}
+
+func TestScopedKey(t *testing.T) {
+	t.Parallel()
+
+	key := ScopedKey(desc.Notifier_AcceptOrder_FullMethodName, "courier:1", "k")
+	assert.Equal(t, key, ScopedKey(desc.Notifier_AcceptOrder_FullMethodName, "courier:1", "k"))
+	assert.NotEqual(t, key, ScopedKey(desc.Notifier_AcceptOrder_FullMethodName, "courier:2", "k"))
+	assert.NotEqual(t, key, ScopedKey(desc.Notifier_ReturnOrder_FullMethodName, "courier:1", "k"))
+}
@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisPrefix = "idempotency:"
+
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore ключи живут в Redis со своим TTL, очищать их не нужно
+func NewRedisStore(client *redis.Client) Store {
+	return redisStore{client: client}
+}
+
+func (s redisStore) Reserve(ctx context.Context, key, requestHash string, lease time.Duration) (models.IdempotencyRecord, bool, error) {
+	record := models.IdempotencyRecord{Key: key, RequestHash: requestHash}
+	value, err := json.Marshal(record)
+	if err != nil {
+		return models.IdempotencyRecord{}, false, err
+	}
+
+	// незавершенная бронь живет только lease и сама исчезает, если запрос не доработал;
+	// между SETNX и GET чужой ключ может истечь, тогда пробуем еще раз
+	for attempt := 0; attempt < 2; attempt++ {
+		ok, err := s.client.SetNX(ctx, redisPrefix+key, value, lease).Result()
+		if err != nil {
+			return models.IdempotencyRecord{}, false, err
+		}
+		if ok {
+			return record, true, nil
+		}
+
+		data, err := s.client.Get(ctx, redisPrefix+key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return models.IdempotencyRecord{}, false, err
+		}
+
+		var existing models.IdempotencyRecord
+		if err := json.Unmarshal(data, &existing); err != nil {
+			return models.IdempotencyRecord{}, false, err
+		}
+		return existing, false, nil
+	}
+	return models.IdempotencyRecord{}, false, errors.New("idempotency key changed concurrently")
+}
+
+func (s redisStore) Complete(ctx context.Context, record models.IdempotencyRecord, ttl time.Duration) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	// XX: если бронь уже истекла, ответ не сохраняем, ключ мог занять повтор
+	err = s.client.SetArgs(ctx, redisPrefix+record.Key, value, redis.SetArgs{Mode: "XX", TTL: ttl}).Err()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
+
+func (s redisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, redisPrefix+key).Err()
+}
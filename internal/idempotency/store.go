@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage"
+)
+
+// Store хранилище ключей идемпотентности: Postgres или Redis
+type Store interface {
+	// Reserve занимает ключ под первый запрос на lease; если ключ уже занят - возвращает его запись и false.
+	// Незавершенная бронь, у которой истек lease, занимается заново: запрос, видимо, не доработал
+	Reserve(ctx context.Context, key, requestHash string, lease time.Duration) (models.IdempotencyRecord, bool, error)
+	// Complete сохраняет ответ первого запроса и продлевает ключ на ttl
+	Complete(ctx context.Context, record models.IdempotencyRecord, ttl time.Duration) error
+	// Release освобождает ключ, когда ответ сохранять нельзя и повтор должен выполниться заново
+	Release(ctx context.Context, key string) error
+}
+
+type pgStore struct {
+	storage storage.IdempotencyStorage
+}
+
+func NewPgStore(storage storage.IdempotencyStorage) Store {
+	return pgStore{storage: storage}
+}
+
+func (s pgStore) Reserve(ctx context.Context, key, requestHash string, lease time.Duration) (models.IdempotencyRecord, bool, error) {
+	return s.storage.ReserveIdempotencyKey(ctx, key, requestHash, lease)
+}
+
+func (s pgStore) Complete(ctx context.Context, record models.IdempotencyRecord, ttl time.Duration) error {
+	return s.storage.CompleteIdempotencyKey(ctx, record, ttl)
+}
+
+func (s pgStore) Release(ctx context.Context, key string) error {
+	return s.storage.DeleteIdempotencyKey(ctx, key)
+}
@@ -0,0 +1,52 @@
+package importexport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseDecimal переводит "99.99" или "99,99" из таблицы в целое число минимальных единиц без float
+func parseDecimal(s string, scale int) (int64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	s = strings.Replace(s, ",", ".", 1)
+	if s == "" {
+		return 0, fmt.Errorf("empty number")
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(fracPart) > scale {
+		return 0, fmt.Errorf("%q: more than %d digits after the point", s, scale)
+	}
+	fracPart += strings.Repeat("0", scale-len(fracPart))
+
+	if strings.HasPrefix(intPart, "-") || strings.HasPrefix(intPart, "+") {
+		return 0, fmt.Errorf("%q: sign is not allowed", s)
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	v, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q: not a number", s)
+	}
+	return v, nil
+}
+
+// formatDecimal обратное к parseDecimal, всегда со scale знаками после точки
+func formatDecimal(v int64, scale int) string {
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	if scale == 0 {
+		return sign + strconv.FormatInt(v, 10)
+	}
+
+	pow := int64(1)
+	for i := 0; i < scale; i++ {
+		pow *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, v/pow, scale, v%pow)
+}
@@ -0,0 +1,389 @@
+package importexport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+)
+
+// OrderRecord заказ из накладной курьера
+type OrderRecord struct {
+	Line      int // строка CSV/NDJSON или номер записи в JSON-массиве, для сообщений об ошибках
+	OrderID   uint64
+	UserID    uint64
+	ExpiresAt time.Time
+	Package   models.PackageType
+	Weight    models.Grams
+	Price     models.Money
+}
+
+// RecordError строка накладной, которую не удалось разобрать; остальные строки читаются дальше
+type RecordError struct {
+	Line    int
+	OrderID uint64
+	Err     error
+}
+
+func (e RecordError) Error() string {
+	if e.OrderID == 0 {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d: order %d: %v", e.Line, e.OrderID, e.Err)
+}
+
+func (e RecordError) Unwrap() error {
+	return e.Err
+}
+
+// колонки накладной; weight_g и price_minor точнее и при наличии важнее weight_kg и price
+const (
+	colOrderID    = "order_id"
+	colUserID     = "user_id"
+	colExpiresAt  = "expires_at"
+	colPackage    = "package"
+	colWeightKg   = "weight_kg"
+	colWeightG    = "weight_g"
+	colPrice      = "price"
+	colPriceMinor = "price_minor"
+	colCurrency   = "currency"
+)
+
+// форматы дат, в которых сроки хранения приходят из таблиц; без зоны - местное время
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+	"02.01.2006",
+}
+
+func ReadOrdersFile(path string, format Format) ([]OrderRecord, []RecordError, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", domainErrors.ErrOpenFiled, err)
+	}
+	defer f.Close()
+
+	return ReadOrders(f, format)
+}
+
+// ReadOrders читает накладную целиком; error - только если файл нельзя читать дальше
+func ReadOrders(r io.Reader, format Format) ([]OrderRecord, []RecordError, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSON:
+		return readJSON(r)
+	case FormatNDJSON:
+		return readNDJSON(r)
+	default:
+		return nil, nil, fmt.Errorf("%w: unknown format %q", domainErrors.ErrImportFailed, format)
+	}
+}
+
+func readCSV(r io.Reader) ([]OrderRecord, []RecordError, error) {
+	br := bufio.NewReader(r)
+	// Excel пишет BOM в начало UTF-8 файла
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		_, _ = br.Discard(3)
+	}
+
+	cr := csv.NewReader(br)
+	cr.Comma = detectComma(br)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", domainErrors.ErrReadFiled, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{colOrderID, colUserID, colExpiresAt} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("%w: no column %q", domainErrors.ErrImportFailed, required)
+		}
+	}
+
+	var (
+		records []OrderRecord
+		errs    []RecordError
+	)
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return records, errs, fmt.Errorf("%w: %v", domainErrors.ErrReadFiled, err)
+		}
+		line, _ := cr.FieldPos(0)
+		if isEmptyRow(row) {
+			continue
+		}
+
+		rec, err := parseRecord(line, func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		})
+		if err != nil {
+			errs = append(errs, recordError(line, rec.OrderID, err))
+			continue
+		}
+		records = append(records, rec)
+	}
+
+	return records, errs, nil
+}
+
+// detectComma таблицы с русской локалью сохраняют CSV через точку с запятой
+func detectComma(br *bufio.Reader) rune {
+	head, _ := br.Peek(br.Buffered() + 4096)
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		head = head[:i]
+	}
+	if bytes.Count(head, []byte(";")) > bytes.Count(head, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+func isEmptyRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// text поле JSON, которое может прийти и строкой, и числом; хранится как есть, чтобы не терять точность
+type text string
+
+func (t *text) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*t = text(s)
+		return nil
+	}
+	*t = text(data)
+	return nil
+}
+
+type orderJSON struct {
+	OrderID    text `json:"order_id"`
+	UserID     text `json:"user_id"`
+	ExpiresAt  text `json:"expires_at"`
+	Package    text `json:"package"`
+	WeightKg   text `json:"weight_kg"`
+	WeightG    text `json:"weight_g"`
+	Price      text `json:"price"`
+	PriceMinor text `json:"price_minor"`
+	Currency   text `json:"currency"`
+}
+
+func (o orderJSON) field(name string) string {
+	switch name {
+	case colOrderID:
+		return string(o.OrderID)
+	case colUserID:
+		return string(o.UserID)
+	case colExpiresAt:
+		return string(o.ExpiresAt)
+	case colPackage:
+		return string(o.Package)
+	case colWeightKg:
+		return string(o.WeightKg)
+	case colWeightG:
+		return string(o.WeightG)
+	case colPrice:
+		return string(o.Price)
+	case colPriceMinor:
+		return string(o.PriceMinor)
+	case colCurrency:
+		return string(o.Currency)
+	}
+	return ""
+}
+
+func readJSON(r io.Reader) ([]OrderRecord, []RecordError, error) {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, nil, fmt.Errorf("%w: expected array of orders", domainErrors.ErrJsonFiled)
+	}
+
+	var (
+		records []OrderRecord
+		errs    []RecordError
+	)
+	for n := 1; dec.More(); n++ {
+		var o orderJSON
+		if err := dec.Decode(&o); err != nil {
+			// после синтаксической ошибки продолжить разбор массива нельзя
+			return records, errs, fmt.Errorf("%w: record %d: %v", domainErrors.ErrJsonFiled, n, err)
+		}
+		rec, err := parseRecord(n, o.field)
+		if err != nil {
+			errs = append(errs, recordError(n, rec.OrderID, err))
+			continue
+		}
+		records = append(records, rec)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return records, errs, fmt.Errorf("%w: %v", domainErrors.ErrJsonFiled, err)
+	}
+	return records, errs, nil
+}
+
+func readNDJSON(r io.Reader) ([]OrderRecord, []RecordError, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		records []OrderRecord
+		errs    []RecordError
+	)
+	for line := 1; sc.Scan(); line++ {
+		data := bytes.TrimSpace(sc.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var o orderJSON
+		if err := json.Unmarshal(data, &o); err != nil {
+			errs = append(errs, RecordError{Line: line, Err: fmt.Errorf("%w: %v", domainErrors.ErrJsonFiled, err)})
+			continue
+		}
+		rec, err := parseRecord(line, o.field)
+		if err != nil {
+			errs = append(errs, recordError(line, rec.OrderID, err))
+			continue
+		}
+		records = append(records, rec)
+	}
+	if err := sc.Err(); err != nil {
+		return records, errs, fmt.Errorf("%w: %v", domainErrors.ErrReadFiled, err)
+	}
+
+	return records, errs, nil
+}
+
+func recordError(line int, orderID uint64, err error) RecordError {
+	return RecordError{Line: line, OrderID: orderID, Err: fmt.Errorf("%w: %v", domainErrors.ErrValidationFailed, err)}
+}
+
+// parseRecord разбирает одну строку накладной, OrderID заполняется даже при ошибке в других полях
+func parseRecord(line int, field func(name string) string) (OrderRecord, error) {
+	rec := OrderRecord{Line: line}
+
+	var err error
+	if rec.OrderID, err = parseID(field(colOrderID)); err != nil {
+		return rec, fmt.Errorf("%s: %v", colOrderID, err)
+	}
+	if rec.UserID, err = parseID(field(colUserID)); err != nil {
+		return rec, fmt.Errorf("%s: %v", colUserID, err)
+	}
+	if rec.ExpiresAt, err = parseDate(field(colExpiresAt)); err != nil {
+		return rec, fmt.Errorf("%s: %v", colExpiresAt, err)
+	}
+	if rec.Package, err = parsePackage(field(colPackage)); err != nil {
+		return rec, fmt.Errorf("%s: %v", colPackage, err)
+	}
+
+	if g := strings.TrimSpace(field(colWeightG)); g != "" {
+		v, err := parseDecimal(g, 0)
+		if err != nil {
+			return rec, fmt.Errorf("%s: %v", colWeightG, err)
+		}
+		rec.Weight = models.Grams(v)
+	} else {
+		v, err := parseDecimal(field(colWeightKg), 3)
+		if err != nil {
+			return rec, fmt.Errorf("%s: %v", colWeightKg, err)
+		}
+		rec.Weight = models.Grams(v)
+	}
+	if rec.Weight <= 0 {
+		return rec, fmt.Errorf("weight must be positive")
+	}
+
+	currency := models.Currency(strings.ToUpper(strings.TrimSpace(field(colCurrency)))).OrDefault()
+	if m := strings.TrimSpace(field(colPriceMinor)); m != "" {
+		v, err := parseDecimal(m, 0)
+		if err != nil {
+			return rec, fmt.Errorf("%s: %v", colPriceMinor, err)
+		}
+		rec.Price = models.NewMoney(v, currency)
+	} else {
+		v, err := parseDecimal(field(colPrice), 2)
+		if err != nil {
+			return rec, fmt.Errorf("%s: %v", colPrice, err)
+		}
+		rec.Price = models.NewMoney(v, currency)
+	}
+
+	return rec, nil
+}
+
+func parseID(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty")
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || v == 0 {
+		return 0, fmt.Errorf("%q is not a positive integer", s)
+	}
+	return v, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date", s)
+}
+
+func parsePackage(s string) (models.PackageType, error) {
+	pkg := models.PackageType(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", "")))
+	switch pkg {
+	case "", "none":
+		return models.PackageUnspecified, nil
+	case models.PackageBag, models.PackageBox, models.PackageTape, models.PackageBagTape, models.PackageBoxTape, models.PackageUnspecified:
+		return pkg, nil
+	}
+	return "", fmt.Errorf("unknown package %q", s)
+}
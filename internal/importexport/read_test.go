@@ -0,0 +1,145 @@
+package importexport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		scale   int
+		want    int64
+		wantErr bool
+	}{
+		{"99.99", 2, 9999, false},
+		{"99,9", 2, 9990, false},
+		{"1 250", 2, 125000, false},
+		{".5", 3, 500, false},
+		{"12", 3, 12000, false},
+		{"0.1234", 3, 0, true},
+		{"-1", 2, 0, true},
+		{"abc", 2, 0, true},
+		{"", 2, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseDecimal(tt.in, tt.scale)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+		back, _ := parseDecimal(formatDecimal(got, tt.scale), tt.scale)
+		assert.Equal(t, got, back, tt.in)
+	}
+}
+
+func TestReadOrders_CSV(t *testing.T) {
+	t.Parallel()
+
+	input := "\xef\xbb\xbfOrder_ID;user_id;expires_at;package;weight_kg;price;comment\n" +
+		"1;10;2025-08-01;box;1,5;99,99;хрупкое\n" +
+		";;;;;;\n" +
+		"2;10;01.08.2025 12:00;;0.25;10;\n" +
+		"3;10;завтра;box;1;1;\n" +
+		"4;10;2025-08-01;мешок;1;1;\n"
+
+	records, errs, err := ReadOrders(strings.NewReader(input), FormatCSV)
+	require.NoError(t, err)
+
+	require.Len(t, records, 2)
+	assert.Equal(t, OrderRecord{
+		Line:      2,
+		OrderID:   1,
+		UserID:    10,
+		ExpiresAt: time.Date(2025, 8, 1, 0, 0, 0, 0, time.Local),
+		Package:   models.PackageBox,
+		Weight:    1500,
+		Price:     models.NewMoney(9999, models.CurrencyRUB),
+	}, records[0])
+	assert.Equal(t, 4, records[1].Line)
+	assert.Equal(t, models.PackageUnspecified, records[1].Package)
+	assert.Equal(t, models.Grams(250), records[1].Weight)
+
+	require.Len(t, errs, 2)
+	assert.Equal(t, uint64(3), errs[0].OrderID)
+	assert.Equal(t, 5, errs[0].Line)
+	assert.ErrorIs(t, errs[0], domainErrors.ErrValidationFailed)
+	assert.Contains(t, errs[1].Error(), "package")
+}
+
+func TestReadOrders_MissingColumn(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := ReadOrders(strings.NewReader("order_id,weight_kg\n1,1\n"), FormatCSV)
+	assert.ErrorIs(t, err, domainErrors.ErrImportFailed)
+}
+
+func TestReadOrders_JSON(t *testing.T) {
+	t.Parallel()
+
+	input := `[
+		{"order_id": 1, "user_id": "10", "expires_at": "2025-08-01T00:00:00Z", "package": "bag+tape", "weight_kg": 0.5, "price": "100.50"},
+		{"order_id": 2, "user_id": 10, "expires_at": "2025-08-01T00:00:00Z", "weight_g": 700, "price_minor": 12345, "currency": "rub"},
+		{"order_id": 3, "user_id": 10, "expires_at": "2025-08-01T00:00:00Z", "weight_kg": 0, "price": 1}
+	]`
+
+	records, errs, err := ReadOrders(strings.NewReader(input), FormatJSON)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, models.PackageBagTape, records[0].Package)
+	assert.Equal(t, models.Grams(500), records[0].Weight)
+	assert.Equal(t, models.NewMoney(10050, models.CurrencyRUB), records[0].Price)
+	assert.Equal(t, models.Grams(700), records[1].Weight)
+	assert.Equal(t, models.NewMoney(12345, models.CurrencyRUB), records[1].Price)
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, 3, errs[0].Line)
+
+	_, _, err = ReadOrders(strings.NewReader(`[{"order_id": 1,`), FormatJSON)
+	assert.ErrorIs(t, err, domainErrors.ErrJsonFiled)
+}
+
+func TestReadOrders_NDJSON(t *testing.T) {
+	t.Parallel()
+
+	input := `{"order_id": 1, "user_id": 10, "expires_at": "2025-08-01", "weight_kg": 1, "price": 1}
+
+not json
+{"order_id": 2, "user_id": 10, "expires_at": "2025-08-01", "weight_kg": 1, "price": 1}
+`
+	records, errs, err := ReadOrders(strings.NewReader(input), FormatNDJSON)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, 4, records[1].Line)
+	require.Len(t, errs, 1)
+	assert.Equal(t, 3, errs[0].Line)
+	assert.ErrorIs(t, errs[0], domainErrors.ErrJsonFiled)
+}
+
+func TestReadOrdersFile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := ReadOrdersFile("/nonexistent/orders.csv", FormatCSV)
+	assert.ErrorIs(t, err, domainErrors.ErrOpenFiled)
+}
+
+func TestFormatFromPath(t *testing.T) {
+	t.Parallel()
+
+	f, err := FormatFromPath("orders.JSONL")
+	require.NoError(t, err)
+	assert.Equal(t, FormatNDJSON, f)
+
+	_, err = FormatFromPath("orders.xlsx")
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+package importexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"PWZ1.0/internal/models"
+)
+
+// recordWriter пишет записи по одной, чтобы выгрузка не держала весь результат в памяти
+type recordWriter struct {
+	format Format
+	out    io.Writer
+	csv    *csv.Writer
+	header []string
+	count  int
+}
+
+func newRecordWriter(w io.Writer, format Format, header []string) *recordWriter {
+	rw := &recordWriter{format: format, out: w, header: header}
+	if format == FormatCSV {
+		rw.csv = csv.NewWriter(w)
+	}
+	return rw
+}
+
+func (rw *recordWriter) write(row []string, obj any) error {
+	switch rw.format {
+	case FormatCSV:
+		if rw.count == 0 {
+			if err := rw.csv.Write(rw.header); err != nil {
+				return err
+			}
+		}
+		if err := rw.csv.Write(row); err != nil {
+			return err
+		}
+	case FormatJSON:
+		prefix := ",\n  "
+		if rw.count == 0 {
+			prefix = "[\n  "
+		}
+		if _, err := io.WriteString(rw.out, prefix); err != nil {
+			return err
+		}
+		if err := rw.writeJSON(obj, ""); err != nil {
+			return err
+		}
+	case FormatNDJSON:
+		if err := rw.writeJSON(obj, "\n"); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", rw.format)
+	}
+
+	rw.count++
+	return nil
+}
+
+func (rw *recordWriter) writeJSON(obj any, suffix string) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	_, err = rw.out.Write(append(data, suffix...))
+	return err
+}
+
+// close дописывает заголовок CSV или скобки JSON, даже если записей не было
+func (rw *recordWriter) close() error {
+	switch rw.format {
+	case FormatCSV:
+		if rw.count == 0 {
+			if err := rw.csv.Write(rw.header); err != nil {
+				return err
+			}
+		}
+		rw.csv.Flush()
+		return rw.csv.Error()
+	case FormatJSON:
+		tail := "\n]\n"
+		if rw.count == 0 {
+			tail = "[]\n"
+		}
+		_, err := io.WriteString(rw.out, tail)
+		return err
+	}
+	return nil
+}
+
+var orderColumns = []string{"order_id", "user_id", "status", "expires_at", "package", "weight_kg", "total_price", "currency", "tariff_version", "deleted_at"}
+
+type orderExport struct {
+	OrderID         uint64     `json:"order_id"`
+	UserID          uint64     `json:"user_id"`
+	Status          string     `json:"status"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	Package         string     `json:"package"`
+	WeightKg        string     `json:"weight_kg"`
+	WeightG         int64      `json:"weight_g"`
+	TotalPrice      string     `json:"total_price"`
+	TotalPriceMinor int64      `json:"total_price_minor"`
+	Currency        string     `json:"currency"`
+	TariffVersion   string     `json:"tariff_version,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+// OrderWriter выгрузка заказов; цена в выгрузке итоговая, с надбавками тарифа
+type OrderWriter struct {
+	rw *recordWriter
+}
+
+func NewOrderWriter(w io.Writer, format Format) *OrderWriter {
+	return &OrderWriter{rw: newRecordWriter(w, format, orderColumns)}
+}
+
+func (w *OrderWriter) Write(o models.Order) error {
+	e := orderExport{
+		OrderID:         o.ID,
+		UserID:          o.UserID,
+		Status:          string(o.Status),
+		ExpiresAt:       o.ExpiresAt,
+		Package:         string(o.PackageType),
+		WeightKg:        formatDecimal(int64(o.Weight), 3),
+		WeightG:         int64(o.Weight),
+		TotalPrice:      formatDecimal(o.Price.Amount, 2),
+		TotalPriceMinor: o.Price.Amount,
+		Currency:        string(o.Price.Currency.OrDefault()),
+		TariffVersion:   o.TariffVersion,
+		DeletedAt:       o.DeletedAt,
+	}
+
+	deletedAt := ""
+	if o.DeletedAt != nil {
+		deletedAt = o.DeletedAt.Format(time.RFC3339)
+	}
+	row := []string{
+		strconv.FormatUint(e.OrderID, 10),
+		strconv.FormatUint(e.UserID, 10),
+		e.Status,
+		e.ExpiresAt.Format(time.RFC3339),
+		e.Package,
+		e.WeightKg,
+		e.TotalPrice,
+		e.Currency,
+		e.TariffVersion,
+		deletedAt,
+	}
+	return w.rw.write(row, e)
+}
+
+func (w *OrderWriter) Close() error {
+	return w.rw.close()
+}
+
+var historyColumns = []string{"order_id", "status", "created_at", "actor_type", "actor_id", "actor_subject"}
+
+type historyExport struct {
+	OrderID      uint64    `json:"order_id"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+	ActorType    string    `json:"actor_type"`
+	ActorID      uint64    `json:"actor_id"`
+	ActorSubject string    `json:"actor_subject"`
+}
+
+// HistoryWriter выгрузка истории смены статусов
+type HistoryWriter struct {
+	rw *recordWriter
+}
+
+func NewHistoryWriter(w io.Writer, format Format) *HistoryWriter {
+	return &HistoryWriter{rw: newRecordWriter(w, format, historyColumns)}
+}
+
+func (w *HistoryWriter) Write(h models.OrderHistory) error {
+	row := []string{
+		strconv.FormatUint(h.OrderID, 10),
+		string(h.Status),
+		h.CreatedAt.Format(time.RFC3339),
+		string(h.Actor.Type),
+		strconv.FormatUint(h.Actor.ID, 10),
+		h.Actor.Subject,
+	}
+	return w.rw.write(row, historyExport{
+		OrderID:      h.OrderID,
+		Status:       string(h.Status),
+		CreatedAt:    h.CreatedAt,
+		ActorType:    string(h.Actor.Type),
+		ActorID:      h.Actor.ID,
+		ActorSubject: h.Actor.Subject,
+	})
+}
+
+func (w *HistoryWriter) Close() error {
+	return w.rw.close()
+}
@@ -0,0 +1,70 @@
+package importexport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderWriter(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2025, 8, 2, 10, 0, 0, 0, time.UTC)
+	orders := []models.Order{
+		{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			PackageType: models.PackageBox, Weight: 1500, Price: models.NewMoney(12099, models.CurrencyRUB), TariffVersion: "v1"},
+		{ID: 2, UserID: 10, Status: models.StatusDeleted, ExpiresAt: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			PackageType: models.PackageUnspecified, Weight: 5, Price: models.NewMoney(7, ""), DeletedAt: &deletedAt},
+	}
+
+	write := func(format Format, orders []models.Order) string {
+		var buf bytes.Buffer
+		w := NewOrderWriter(&buf, format)
+		for _, o := range orders {
+			require.NoError(t, w.Write(o))
+		}
+		require.NoError(t, w.Close())
+		return buf.String()
+	}
+
+	assert.Equal(t, "order_id,user_id,status,expires_at,package,weight_kg,total_price,currency,tariff_version,deleted_at\n"+
+		"1,10,EXPECTS,2025-08-01T00:00:00Z,box,1.500,120.99,RUB,v1,\n"+
+		"2,10,DELETED,2025-08-01T00:00:00Z,unspecified,0.005,0.07,RUB,,2025-08-02T10:00:00Z\n", write(FormatCSV, orders))
+
+	assert.Equal(t, "[]\n", write(FormatJSON, nil))
+	assert.Equal(t, "order_id,user_id,status,expires_at,package,weight_kg,total_price,currency,tariff_version,deleted_at\n", write(FormatCSV, nil))
+
+	ndjson := write(FormatNDJSON, orders)
+	assert.Equal(t, 2, strings.Count(ndjson, "\n"))
+	assert.Contains(t, ndjson, `"total_price":"120.99","total_price_minor":12099`)
+
+	// выгрузка JSON читается обратно как накладная: total_price попадает в price
+	records, errs, err := ReadOrders(strings.NewReader(strings.ReplaceAll(write(FormatJSON, orders), "total_price", "price")), FormatJSON)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+	require.Len(t, records, 2)
+	assert.Equal(t, models.Grams(1500), records[0].Weight)
+	assert.Equal(t, models.NewMoney(12099, models.CurrencyRUB), records[0].Price)
+}
+
+func TestHistoryWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := NewHistoryWriter(&buf, FormatJSON)
+	require.NoError(t, w.Write(models.OrderHistory{ID: 1, OrderID: 7, Status: models.StatusExpects, CreatedAt: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+		Actor: models.Actor{Type: models.ActorCourier, ID: 42, Subject: "42"}}))
+	require.NoError(t, w.Write(models.OrderHistory{ID: 2, OrderID: 7, Status: models.StatusAccepted, CreatedAt: time.Date(2025, 8, 2, 0, 0, 0, 0, time.UTC),
+		Actor: models.Actor{Type: models.ActorTerminal, ID: 1001, Subject: "pvz-terminal-1"}}))
+	require.NoError(t, w.Close())
+
+	assert.JSONEq(t, `[
+		{"order_id": 7, "status": "EXPECTS", "created_at": "2025-08-01T00:00:00Z", "actor_type": "courier", "actor_id": 42, "actor_subject": "42"},
+		{"order_id": 7, "status": "ACCEPTED", "created_at": "2025-08-02T00:00:00Z", "actor_type": "terminal", "actor_id": 1001, "actor_subject": "pvz-terminal-1"}
+	]`, buf.String())
+}
@@ -0,0 +1,194 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Runner запускает серверы и фоновые задачи бинарника и останавливает их по SIGINT/SIGTERM или при падении
+// любого из них. Остановка идет по шагам: бинарник перестает быть готовым (и вызываются OnDrain), серверы в обратном порядке регистрации
+// дообрабатывают запросы до общего срока drainTimeout, затем в обратном порядке останавливаются фоновые задачи
+// и закрываются ресурсы
+type Runner struct {
+	drainTimeout time.Duration
+	ready        atomic.Bool
+	stopping     atomic.Bool
+	errs         chan error
+
+	drains  []func()
+	servers []server
+	workers []worker
+	closers []closer
+}
+
+type server struct {
+	name string
+	Server
+}
+
+type worker struct {
+	name   string
+	run    func(ctx context.Context) error
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+func New(drainTimeout time.Duration) *Runner {
+	return &Runner{drainTimeout: drainTimeout}
+}
+
+// Serve регистрирует сервер, порт уже должен быть открыт, чтобы ошибка адреса была видна до Run
+func (r *Runner) Serve(name string, s Server) {
+	r.servers = append(r.servers, server{name: name, Server: s})
+}
+
+// Go регистрирует фоновую задачу, она работает до отмены ctx
+func (r *Runner) Go(name string, run func(ctx context.Context) error) {
+	r.workers = append(r.workers, worker{name: name, run: run})
+}
+
+// OnClose регистрирует ресурс, который закрывается после остановки серверов и задач
+func (r *Runner) OnClose(name string, close func() error) {
+	r.closers = append(r.closers, closer{name: name, close: close})
+}
+
+// OnDrain вызывается в начале остановки, до серверов: например, чтобы health-сервис сразу ответил NOT_SERVING
+func (r *Runner) OnDrain(drain func()) {
+	r.drains = append(r.drains, drain)
+}
+
+// Ready true после запуска и до начала остановки
+func (r *Runner) Ready() bool {
+	return r.ready.Load()
+}
+
+// ReadyHandler отвечает 503, пока бинарник запускается или останавливается, балансировщик за это время
+// перестает слать новые запросы
+func (r *Runner) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !r.Ready() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// Run запускает все зарегистрированное и ждет сигнала или отмены ctx. Возвращает ошибку сервера или задачи,
+// из-за которой пришлось остановиться; после остановки по сигналу - nil
+func (r *Runner) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	r.errs = make(chan error, len(r.servers)+len(r.workers))
+	for i := range r.workers {
+		r.startWorker(ctx, &r.workers[i])
+	}
+	for _, s := range r.servers {
+		go r.serve(s)
+	}
+	r.ready.Store(true)
+
+	var err error
+	select {
+	case <-ctx.Done():
+		slog.InfoContext(ctx, "shutdown", "cause", context.Cause(ctx))
+	case err = <-r.errs:
+		slog.ErrorContext(ctx, "shutdown", "err", err)
+	}
+	// повторный сигнал во время остановки завершит процесс сразу
+	stop()
+
+	r.shutdown()
+	return err
+}
+
+func (r *Runner) startWorker(ctx context.Context, w *worker) {
+	// задачи останавливаются по одной в shutdown, а не все сразу по сигналу
+	var wctx context.Context
+	wctx, w.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		err := w.run(wctx)
+		if wctx.Err() == nil {
+			r.fail(w.name, err)
+		}
+	}()
+}
+
+func (r *Runner) serve(s server) {
+	err := s.Serve()
+	if !r.stopping.Load() {
+		r.fail(s.name, err)
+	}
+}
+
+// fail задача или сервер завершились раньше времени, даже без ошибки это повод остановить бинарник
+func (r *Runner) fail(name string, err error) {
+	if err == nil {
+		err = fmt.Errorf("%s stopped unexpectedly", name)
+	} else {
+		err = fmt.Errorf("%s: %w", name, err)
+	}
+	select {
+	case r.errs <- err:
+	default:
+	}
+}
+
+func (r *Runner) shutdown() {
+	r.ready.Store(false)
+	r.stopping.Store(true)
+	start := time.Now()
+	for _, drain := range r.drains {
+		drain()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.drainTimeout)
+	defer cancel()
+	for i := len(r.servers) - 1; i >= 0; i-- {
+		s := r.servers[i]
+		if err := s.Shutdown(ctx); err != nil {
+			slog.ErrorContext(ctx, "shutdown: server", "server", s.name, "err", err)
+		}
+	}
+
+	deadline := time.NewTimer(r.drainTimeout)
+	defer deadline.Stop()
+	for i := len(r.workers) - 1; i >= 0; i-- {
+		w := r.workers[i]
+		w.cancel()
+		select {
+		case <-w.done:
+			continue
+		case <-deadline.C:
+		}
+		// срок общий: остальные задачи только отменяем и не ждем
+		slog.WarnContext(ctx, "shutdown: worker did not stop in time", "worker", w.name, "timeout", r.drainTimeout)
+		for _, w := range r.workers[:i] {
+			w.cancel()
+		}
+		break
+	}
+
+	for i := len(r.closers) - 1; i >= 0; i-- {
+		c := r.closers[i]
+		if err := c.close(); err != nil {
+			slog.ErrorContext(ctx, "shutdown: close", "resource", c.name, "err", err)
+		}
+	}
+	slog.InfoContext(ctx, "shutdown complete", "duration", time.Since(start).Round(time.Millisecond))
+}
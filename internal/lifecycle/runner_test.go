@@ -0,0 +1,194 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// events порядок шагов остановки
+type events struct {
+	mu   sync.Mutex
+	list []string
+}
+
+func (e *events) add(s string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, s)
+}
+
+func (e *events) get() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.list...)
+}
+
+type fakeServer struct {
+	name   string
+	events *events
+	runner *Runner
+	stop   chan struct{}
+}
+
+func (s *fakeServer) Serve() error {
+	<-s.stop
+	return nil
+}
+
+func (s *fakeServer) Shutdown(context.Context) error {
+	s.events.add(fmt.Sprintf("%s shutdown, ready=%t", s.name, s.runner.Ready()))
+	close(s.stop)
+	return nil
+}
+
+func TestRunner_StopsInReverseOrder(t *testing.T) {
+	t.Parallel()
+
+	ev := &events{}
+	r := New(time.Second)
+	for _, name := range []string{"metrics", "grpc"} {
+		r.Serve(name, &fakeServer{name: name, events: ev, runner: r, stop: make(chan struct{})})
+	}
+	for _, name := range []string{"scheduler", "purger"} {
+		r.Go(name, func(ctx context.Context) error {
+			<-ctx.Done()
+			ev.add(name + " stopped")
+			return nil
+		})
+	}
+	for _, name := range []string{"postgres", "redis"} {
+		r.OnClose(name, func() error {
+			ev.add(name + " closed")
+			return nil
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx) }()
+
+	require.Eventually(t, r.Ready, time.Second, time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.False(t, r.Ready())
+	assert.Equal(t, []string{
+		"grpc shutdown, ready=false",
+		"metrics shutdown, ready=false",
+		"purger stopped",
+		"scheduler stopped",
+		"redis closed",
+		"postgres closed",
+	}, ev.get())
+}
+
+func TestRunner_WorkerFailureStopsEverything(t *testing.T) {
+	t.Parallel()
+
+	errBroken := errors.New("broken")
+	closed := make(chan struct{})
+	r := New(time.Second)
+	r.Go("relay", func(context.Context) error { return errBroken })
+	r.Go("reminder", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	r.OnClose("postgres", func() error {
+		close(closed)
+		return nil
+	})
+
+	err := r.Run(context.Background())
+	require.ErrorIs(t, err, errBroken)
+	assert.ErrorContains(t, err, "relay")
+	<-closed
+}
+
+func TestRunner_WorkerDeadline(t *testing.T) {
+	t.Parallel()
+
+	r := New(50 * time.Millisecond)
+	r.Go("stuck", func(context.Context) error {
+		select {}
+	})
+	closed := false
+	r.OnClose("postgres", func() error {
+		closed = true
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	require.NoError(t, r.Run(ctx))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.True(t, closed)
+}
+
+func TestReadyHandler(t *testing.T) {
+	t.Parallel()
+
+	r := New(time.Second)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := HTTP(&http.Server{Handler: r.ReadyHandler()}, lis)
+	go func() { _ = srv.Serve() }()
+	t.Cleanup(func() { _ = srv.Shutdown(context.Background()) })
+
+	get := func() int {
+		resp, err := http.Get("http://" + lis.Addr().String())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusServiceUnavailable, get())
+	r.ready.Store(true)
+	assert.Equal(t, http.StatusOK, get())
+}
+
+func TestHTTP_DrainsInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("done"))
+	})
+	srv, err := ListenHTTP("127.0.0.1:0", mux)
+	require.NoError(t, err)
+	addr := srv.(httpServer).lis.Addr().String()
+
+	served := make(chan error)
+	go func() { served <- srv.Serve() }()
+
+	body := make(chan string)
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+
+	<-started
+	require.NoError(t, srv.Shutdown(context.Background()))
+	assert.Equal(t, "done", <-body)
+	assert.NoError(t, <-served)
+}
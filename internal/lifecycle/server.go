@@ -0,0 +1,82 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+// Server то, что принимает запросы: Serve блокируется до Shutdown, Shutdown дожидается текущих запросов
+// до отмены ctx и затем обрывает оставшиеся
+type Server interface {
+	Serve() error
+	Shutdown(ctx context.Context) error
+}
+
+type grpcServer struct {
+	srv *grpc.Server
+	lis net.Listener
+}
+
+// GRPC останавливается через GracefulStop, по истечении срока - через Stop
+func GRPC(srv *grpc.Server, lis net.Listener) Server {
+	return grpcServer{srv: srv, lis: lis}
+}
+
+func (s grpcServer) Serve() error {
+	return s.srv.Serve(s.lis)
+}
+
+func (s grpcServer) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.srv.Stop()
+		<-done
+		return fmt.Errorf("drain deadline exceeded, remaining calls cancelled: %w", ctx.Err())
+	}
+}
+
+type httpServer struct {
+	srv *http.Server
+	lis net.Listener
+}
+
+func HTTP(srv *http.Server, lis net.Listener) Server {
+	return httpServer{srv: srv, lis: lis}
+}
+
+func (s httpServer) Serve() error {
+	if err := s.srv.Serve(s.lis); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s httpServer) Shutdown(ctx context.Context) error {
+	if err := s.srv.Shutdown(ctx); err != nil {
+		_ = s.srv.Close()
+		return fmt.Errorf("drain deadline exceeded, remaining requests closed: %w", err)
+	}
+	return nil
+}
+
+// ListenHTTP открывает порт сразу, чтобы занятый адрес был ошибкой запуска, а не падением после него
+func ListenHTTP(addr string, handler http.Handler) (Server, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return HTTP(&http.Server{Addr: addr, Handler: handler}, lis), nil
+}
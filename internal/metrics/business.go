@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BusinessStatsSource storage.StatsStorage
+type BusinessStatsSource interface {
+	BusinessStats(ctx context.Context) (models.BusinessStats, error)
+}
+
+// businessStatuses статусы всегда есть в метриках, даже с нулем, чтобы на дашборде не пропадали линии
+var businessStatuses = []models.OrderStatus{
+	models.StatusExpects, models.StatusAccepted, models.StatusReturned, models.StatusDeleted,
+}
+
+// BusinessCollector показатели заказов для менеджеров. Запросы к базе дорогие, поэтому база опрашивается
+// в Run не чаще раза в interval, а Collect отдает последний снимок: сколько бы раз ни собирали метрики,
+// нагрузка на базу та же
+type BusinessCollector struct {
+	source   BusinessStatsSource
+	interval time.Duration
+
+	mu        sync.RWMutex
+	snapshot  models.BusinessStats
+	refreshed time.Time
+	failures  float64
+
+	orders         *prometheus.Desc
+	expiredExpects *prometheus.Desc
+	returns        *prometheus.Desc
+	storedWeight   *prometheus.Desc
+	storedValue    *prometheus.Desc
+	refreshedAt    *prometheus.Desc
+	refreshErrors  *prometheus.Desc
+}
+
+func NewBusinessCollector(source BusinessStatsSource, interval time.Duration) *BusinessCollector {
+	return &BusinessCollector{
+		source:   source,
+		interval: interval,
+
+		orders: prometheus.NewDesc("pvz_orders", "orders by status", []string{"status"}, nil),
+		expiredExpects: prometheus.NewDesc("pvz_orders_expired_expects",
+			"orders still waiting for the client after expires_at, they should go back to the courier", nil, nil),
+		returns: prometheus.NewDesc("pvz_returns_awaiting_courier",
+			"orders returned by clients that the courier has not picked up yet", nil, nil),
+		storedWeight: prometheus.NewDesc("pvz_stored_weight_grams",
+			"total weight of orders kept in the pickup point", nil, nil),
+		storedValue: prometheus.NewDesc("pvz_stored_value_minor_units",
+			"total price of orders kept in the pickup point, in minor currency units", []string{"package_type", "currency"}, nil),
+		refreshedAt: prometheus.NewDesc("pvz_business_stats_refreshed_timestamp_seconds",
+			"when the business stats snapshot was last taken", nil, nil),
+		refreshErrors: prometheus.NewDesc("pvz_business_stats_refresh_errors_total",
+			"number of failed business stats queries, the previous snapshot is served meanwhile", nil, nil),
+	}
+}
+
+// Run снимает показатели сразу и затем раз в interval до отмены ctx
+func (c *BusinessCollector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "business metrics: refresh failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh один запрос к базе не дольше interval; при ошибке остается предыдущий снимок
+func (c *BusinessCollector) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+
+	stats, err := c.source.BusinessStats(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.failures++
+		return err
+	}
+	c.snapshot = stats
+	c.refreshed = time.Now()
+	return nil
+}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.orders
+	ch <- c.expiredExpects
+	ch <- c.returns
+	ch <- c.storedWeight
+	ch <- c.storedValue
+	ch <- c.refreshedAt
+	ch <- c.refreshErrors
+}
+
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(c.refreshErrors, prometheus.CounterValue, c.failures)
+	// до первого снимка нулей не показываем, иначе на дашборде будет ложный провал
+	if c.refreshed.IsZero() {
+		return
+	}
+
+	s := c.snapshot
+	for _, status := range businessStatuses {
+		ch <- prometheus.MustNewConstMetric(c.orders, prometheus.GaugeValue, float64(s.ByStatus[status]), string(status))
+	}
+	ch <- prometheus.MustNewConstMetric(c.expiredExpects, prometheus.GaugeValue, float64(s.ExpiredExpects))
+	ch <- prometheus.MustNewConstMetric(c.returns, prometheus.GaugeValue, float64(s.ReturnsAwaitingCourier))
+	ch <- prometheus.MustNewConstMetric(c.storedWeight, prometheus.GaugeValue, float64(s.StoredWeight))
+	for _, v := range s.StoredValue {
+		ch <- prometheus.MustNewConstMetric(c.storedValue, prometheus.GaugeValue, float64(v.Value.Amount),
+			string(v.Package), string(v.Value.Currency))
+	}
+	ch <- prometheus.MustNewConstMetric(c.refreshedAt, prometheus.GaugeValue, float64(c.refreshed.Unix()))
+}
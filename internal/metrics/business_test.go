@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusinessCollector(t *testing.T) {
+	t.Parallel()
+
+	source := mocks.NewStatsStorageMock(t)
+	collector := NewBusinessCollector(source, time.Minute)
+
+	// до первого снимка только счетчик ошибок
+	assert.Equal(t, 1, testutil.CollectAndCount(collector))
+
+	source.BusinessStatsMock.Return(models.BusinessStats{
+		ByStatus:               map[models.OrderStatus]int64{models.StatusExpects: 3, models.StatusReturned: 1},
+		ExpiredExpects:         2,
+		ReturnsAwaitingCourier: 1,
+		StoredWeight:           4500,
+		StoredValue: []models.PackageValue{
+			{Package: models.PackageBox, Value: models.NewMoney(150000, models.CurrencyRUB)},
+			{Package: models.PackageUnspecified, Value: models.NewMoney(990, models.CurrencyRUB)},
+		},
+	}, nil)
+	require.NoError(t, collector.Refresh(context.Background()))
+
+	expected := `
+# HELP pvz_orders orders by status
+# TYPE pvz_orders gauge
+pvz_orders{status="ACCEPTED"} 0
+pvz_orders{status="DELETED"} 0
+pvz_orders{status="EXPECTS"} 3
+pvz_orders{status="RETURNED"} 1
+# HELP pvz_orders_expired_expects orders still waiting for the client after expires_at, they should go back to the courier
+# TYPE pvz_orders_expired_expects gauge
+pvz_orders_expired_expects 2
+# HELP pvz_returns_awaiting_courier orders returned by clients that the courier has not picked up yet
+# TYPE pvz_returns_awaiting_courier gauge
+pvz_returns_awaiting_courier 1
+# HELP pvz_stored_weight_grams total weight of orders kept in the pickup point
+# TYPE pvz_stored_weight_grams gauge
+pvz_stored_weight_grams 4500
+# HELP pvz_stored_value_minor_units total price of orders kept in the pickup point, in minor currency units
+# TYPE pvz_stored_value_minor_units gauge
+pvz_stored_value_minor_units{currency="RUB",package_type="box"} 150000
+pvz_stored_value_minor_units{currency="RUB",package_type="unspecified"} 990
+`
+	names := []string{"pvz_orders", "pvz_orders_expired_expects", "pvz_returns_awaiting_courier", "pvz_stored_weight_grams", "pvz_stored_value_minor_units"}
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), names...))
+
+	// ошибка базы: остается прошлый снимок, растет счетчик ошибок
+	source.BusinessStatsMock.Return(models.BusinessStats{}, errors.New("db is down"))
+	require.Error(t, collector.Refresh(context.Background()))
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), names...))
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP pvz_business_stats_refresh_errors_total number of failed business stats queries, the previous snapshot is served meanwhile
+# TYPE pvz_business_stats_refresh_errors_total counter
+pvz_business_stats_refresh_errors_total 1
+`), "pvz_business_stats_refresh_errors_total"))
+}
+
+func TestBusinessCollector_ScrapeDoesNotQuery(t *testing.T) {
+	t.Parallel()
+
+	source := mocks.NewStatsStorageMock(t)
+	source.BusinessStatsMock.Return(models.BusinessStats{}, nil)
+	collector := NewBusinessCollector(source, time.Minute)
+	require.NoError(t, collector.Refresh(context.Background()))
+
+	for i := 0; i < 5; i++ {
+		testutil.CollectAndCount(collector)
+	}
+	assert.Equal(t, uint64(1), source.BusinessStatsAfterCounter())
+}
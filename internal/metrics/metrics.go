@@ -1,9 +1,18 @@
 package metrics
 
 import (
+	"net/http"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// Registry метрики бинарника; отдельный от глобального, чтобы в /metrics были только свои метрики,
+// а тесты собирали их без чужих
+var Registry = prometheus.NewRegistry()
+
 var (
 	OrdersIssued = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -11,8 +20,134 @@ var (
 			Help: "number of orders issued",
 		},
 	)
+
+	GRPCRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "number of unary gRPC calls by method and status code",
+		},
+		[]string{"method", "code"},
+	)
+
+	GRPCDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "unary gRPC call latency by method",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	RedisDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "redis_command_duration_seconds",
+			Help:    "redis command latency by command",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		},
+		[]string{"command"},
+	)
+
+	RedisErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "redis_command_errors_total",
+			Help: "number of failed redis commands, a missing key is not an error",
+		},
+		[]string{"command"},
+	)
+
+	CacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "order_cache_requests_total",
+			Help: "order history cache lookups by result: hit, miss or error",
+		},
+		[]string{"result"},
+	)
+
+	OutboxPublished = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "outbox_events_published_total",
+			Help: "number of outbox events published to the broker",
+		},
+	)
+
+	OutboxPublishFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "outbox_publish_failures_total",
+			Help: "number of failed outbox publish attempts",
+		},
+	)
+
+	OutboxDeadEvents = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "outbox_events_dead_total",
+			Help: "number of outbox events that exhausted all publish attempts",
+		},
+	)
+
+	OutboxLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "outbox_lag_seconds",
+			Help: "age of the oldest outbox event waiting to be published",
+		},
+	)
+
+	OutboxBacklog = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "outbox_backlog",
+			Help: "number of outbox events waiting to be published",
+		},
+	)
+)
+
+// значения результата для CacheRequests
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheError = "error"
 )
 
+var initOnce, initOutboxOnce, initRuntimeOnce sync.Once
+
+// Init регистрирует метрики gRPC сервера, повторный вызов ничего не делает
 func Init() {
-	prometheus.MustRegister(OrdersIssued)
+	initOnce.Do(func() {
+		registerRuntime()
+		Registry.MustRegister(
+			OrdersIssued,
+			GRPCRequests,
+			GRPCDuration,
+			RedisDuration,
+			RedisErrors,
+			CacheRequests,
+		)
+	})
+}
+
+// InitOutbox регистрирует метрики воркера outbox
+func InitOutbox() {
+	initOutboxOnce.Do(func() {
+		registerRuntime()
+		Registry.MustRegister(
+			OutboxPublished,
+			OutboxPublishFailures,
+			OutboxDeadEvents,
+			OutboxLag,
+			OutboxBacklog,
+		)
+	})
+}
+
+// registerRuntime метрики Go и процесса, которые раньше приходили из глобального реестра
+func registerRuntime() {
+	initRuntimeOnce.Do(func() {
+		Registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+	})
+}
+
+// Handler /metrics для Registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
 }
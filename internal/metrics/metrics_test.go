@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit_DedicatedRegistry(t *testing.T) {
+	Init()
+	Init()
+	InitOutbox()
+
+	families, err := Registry.Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool, len(families))
+	for _, f := range families {
+		names[f.GetName()] = true
+	}
+	assert.True(t, names["go_goroutines"])
+	assert.True(t, names["orders_issued_total"])
+	assert.True(t, names["outbox_backlog"])
+
+	// глобальный реестр не трогаем
+	global, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range global {
+		assert.NotEqual(t, "orders_issued_total", f.GetName())
+	}
+}
+
+func TestPoolCollector(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := pgxpool.ParseConfig("postgres://user@127.0.0.1:1/db?pool_max_conns=7")
+	require.NoError(t, err)
+	// пул без MinConns не подключается при создании
+	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(NewPoolCollector(pool))
+
+	count, err := testutil.GatherAndCount(reg)
+	require.NoError(t, err)
+	assert.Equal(t, 7, count)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() == "pgxpool_max_conns" {
+			assert.InDelta(t, 7, f.GetMetric()[0].GetGauge().GetValue(), 0)
+		}
+	}
+}
+
+func TestRedisHook(t *testing.T) {
+	t.Parallel()
+
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 50 * time.Millisecond, MaxRetries: -1})
+	defer client.Close()
+	client.AddHook(RedisHook{})
+
+	// команда, которой больше нигде в тестах нет, иначе счетчики общие
+	require.Error(t, client.Echo(context.Background(), "x").Err())
+
+	assert.InDelta(t, 1, testutil.ToFloat64(RedisErrors.WithLabelValues("echo")), 0)
+	assert.Equal(t, 1, testutil.CollectAndCount(RedisDuration, "redis_command_duration_seconds"))
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolStater *pgxpool.Pool
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
+// PoolCollector снимает pool.Stat() при каждом сборе метрик, поэтому отдельный опрос пула не нужен
+type PoolCollector struct {
+	pool PoolStater
+
+	acquired    *prometheus.Desc
+	idle        *prometheus.Desc
+	total       *prometheus.Desc
+	max         *prometheus.Desc
+	acquires    *prometheus.Desc
+	emptyWaits  *prometheus.Desc
+	waitSeconds *prometheus.Desc
+}
+
+func NewPoolCollector(pool PoolStater) *PoolCollector {
+	return &PoolCollector{
+		pool:        pool,
+		acquired:    prometheus.NewDesc("pgxpool_acquired_conns", "connections currently in use", nil, nil),
+		idle:        prometheus.NewDesc("pgxpool_idle_conns", "idle connections in the pool", nil, nil),
+		total:       prometheus.NewDesc("pgxpool_total_conns", "all open connections in the pool", nil, nil),
+		max:         prometheus.NewDesc("pgxpool_max_conns", "maximum size of the pool", nil, nil),
+		acquires:    prometheus.NewDesc("pgxpool_acquires_total", "number of successful connection acquires", nil, nil),
+		emptyWaits:  prometheus.NewDesc("pgxpool_empty_acquires_total", "number of acquires that had to wait for a free connection", nil, nil),
+		waitSeconds: prometheus.NewDesc("pgxpool_acquire_wait_seconds_total", "total time spent waiting for a connection", nil, nil),
+	}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquired
+	ch <- c.idle
+	ch <- c.total
+	ch <- c.max
+	ch <- c.acquires
+	ch <- c.emptyWaits
+	ch <- c.waitSeconds
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquired, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.max, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyWaits, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.waitSeconds, prometheus.CounterValue, s.AcquireDuration().Seconds())
+}
@@ -21,6 +21,20 @@ var (
 	ErrWeightTooHeavy       = errors.New("вес слишком большой")
 	ErrInvalidPackage       = errors.New("неизвестная упаковка или другая ошибка упаковки") //можно просто VALIDATION_FAILED
 	ErrOrderAlreadyReturned = errors.New("заказ уже был возвращен")
+	ErrInvalidTransition    = errors.New("недопустимая смена статуса заказа")
+	ErrOrderOfAnotherUser   = errors.New("заказ принадлежит другому клиенту")
+	ErrCurrencyMismatch     = errors.New("валюта не совпадает с валютой тарифа")
+	ErrImportJobConflict    = errors.New("задание импорта уже выполняется в другом потоке")
+	ErrImportOutOfOrder     = errors.New("нарушен порядок заказов в импорте")
+
+	ErrNotificationNotFound       = errors.New("сообщение не найдено")
+	ErrNotificationNotCancellable = errors.New("сообщение уже отправлено или отменено")
+
+	ErrUnauthenticated  = errors.New("требуется аутентификация")
+	ErrPermissionDenied = errors.New("недостаточно прав")
+
+	ErrIdempotencyKeyReused  = errors.New("ключ идемпотентности уже использован с другим запросом")
+	ErrIdempotencyInProgress = errors.New("запрос с этим ключом идемпотентности еще выполняется")
 )
 
 // Привязка ошибок к кодам
@@ -47,4 +61,35 @@ var ErrorCodes = map[error]string{
 	//todo: новые ошибки
 	ErrWeightTooHeavy: "WEIGHT_TOO_HEAVY",
 	ErrInvalidPackage: "INVALID_PACKAGE", //можно просто VALIDATION_FAILED
+
+	ErrOrderAlreadyReturned: "ORDER_ALREADY_RETURNED",
+	ErrInvalidTransition:    "INVALID_TRANSITION",
+	ErrOrderOfAnotherUser:   "ORDER_OF_ANOTHER_USER",
+	ErrCurrencyMismatch:     "CURRENCY_MISMATCH",
+	ErrImportJobConflict:    "IMPORT_JOB_CONFLICT",
+	ErrImportOutOfOrder:     "IMPORT_OUT_OF_ORDER",
+
+	ErrNotificationNotFound:       "NOTIFICATION_NOT_FOUND",
+	ErrNotificationNotCancellable: "NOTIFICATION_NOT_CANCELLABLE",
+
+	ErrUnauthenticated:  "UNAUTHENTICATED",
+	ErrPermissionDenied: "PERMISSION_DENIED",
+
+	ErrIdempotencyKeyReused:  "IDEMPOTENCY_KEY_REUSED",
+	ErrIdempotencyInProgress: "IDEMPOTENCY_IN_PROGRESS",
+}
+
+// Public возвращает ошибку, которую можно показать клиенту: известную доменную или ErrInternalError
+func Public(err error) error {
+	for known := range ErrorCodes {
+		if errors.Is(err, known) {
+			return known
+		}
+	}
+	return ErrInternalError
+}
+
+// Code код ошибки из ErrorCodes, в том числе для обернутых ошибок
+func Code(err error) string {
+	return ErrorCodes[Public(err)]
 }
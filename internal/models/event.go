@@ -6,6 +6,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// типы событий, которые пишутся в outbox при смене статуса заказа
+const (
+	EventOrderAccepted          = "order_accepted"            // принят от курьера
+	EventOrderIssued            = "order_issued"              // выдан клиенту
+	EventOrderReturnedByClient  = "order_returned_by_client"  // клиент вернул заказ в ПВЗ
+	EventOrderReturnedToCourier = "order_returned_to_courier" // заказ отдан курьеру из ПВЗ
+)
+
 type Event struct {
 	EventID   uuid.UUID  `json:"event_id"`
 	EventType string     `json:"event_type"`
@@ -13,11 +21,25 @@ type Event struct {
 	Actor     Actor      `json:"actor"`
 	Order     EventOrder `json:"order"`
 	Source    string     `json:"source"`
+	// TraceContext traceparent запроса, в котором сменился статус: relay и консьюмеры продолжают его трассировку
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
+type ActorType string
+
+const (
+	ActorUnknown  ActorType = "unknown"  // запрос без аутентификации
+	ActorCourier  ActorType = "courier"  // курьер
+	ActorOperator ActorType = "operator" // сотрудник ПВЗ
+	ActorAdmin    ActorType = "admin"    // администратор
+	ActorTerminal ActorType = "terminal" // терминал ПВЗ по API-ключу
+)
+
+// Actor кто сменил статус заказа: ID сотрудника или терминала, Subject - sub токена, имя API-ключа или задания
 type Actor struct {
-	Type string `json:"type"`
-	ID   int    `json:"id"`
+	Type    ActorType `json:"type"`
+	ID      uint64    `json:"id"`
+	Subject string    `json:"subject,omitempty"`
 }
 
 type EventOrder struct {
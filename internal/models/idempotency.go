@@ -0,0 +1,11 @@
+package models
+
+// IdempotencyRecord первый ответ на запрос с ключом идемпотентности, повторы с тем же ключом получают его же
+type IdempotencyRecord struct {
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"` // sha256 тела запроса, hex
+	Done        bool   `json:"done"`         // false - первый запрос еще выполняется
+	Code        uint32 `json:"code"`         // код gRPC
+	Message     string `json:"message,omitempty"`
+	Response    []byte `json:"response,omitempty"` // ответ в google.protobuf.Any
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// ImportJob состояние потокового импорта, повторный запуск продолжает с заказа Processed+1
+type ImportJob struct {
+	ID        string
+	Processed uint64 // сколько заказов из потока обработано, с ошибкой или без
+	Imported  uint64
+	Failed    uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
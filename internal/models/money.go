@@ -0,0 +1,74 @@
+package models
+
+import (
+	"fmt"
+	"math"
+
+	"PWZ1.0/internal/models/domainErrors"
+)
+
+// Currency код валюты ISO 4217
+type Currency string
+
+const (
+	CurrencyRUB     Currency = "RUB"
+	DefaultCurrency          = CurrencyRUB
+)
+
+// OrDefault валюта по умолчанию, если код не указан
+func (c Currency) OrDefault() Currency {
+	if c == "" {
+		return DefaultCurrency
+	}
+	return c
+}
+
+// Money сумма в минимальных единицах валюты (копейках), без ошибок округления float
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// MoneyFromFloat переводит сумму в рублях из устаревших float-полей, округляя до копейки
+func MoneyFromFloat(v float64, currency Currency) Money {
+	return Money{Amount: int64(math.Round(v * 100)), Currency: currency}
+}
+
+// Float сумма в основных единицах для устаревших float-полей
+func (m Money) Float() float64 {
+	return float64(m.Amount) / 100
+}
+
+// Add складывает суммы в одной валюте
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, domainErrors.ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/100, amount%100, m.Currency)
+}
+
+// Grams вес в граммах
+type Grams int64
+
+// GramsFromKg переводит вес в килограммах из устаревших float-полей, округляя до грамма
+func GramsFromKg(kg float64) Grams {
+	return Grams(math.Round(kg * 1000))
+}
+
+// Kg вес в килограммах для устаревших float-полей
+func (g Grams) Kg() float64 {
+	return float64(g) / 1000
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float32
+		expected int64
+	}{
+		{"Whole", 100, 10000},
+		{"Kopecks", 99.99, 9999},
+		{"Rounding", 0.1 + 0.2, 30},
+		{"Zero", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, NewMoney(tt.expected, CurrencyRUB), MoneyFromFloat(float64(tt.value), CurrencyRUB))
+		})
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	sum, err := NewMoney(1099, CurrencyRUB).Add(NewMoney(1, CurrencyRUB))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(1100, CurrencyRUB), sum)
+	assert.Equal(t, "11.00 RUB", sum.String())
+
+	_, err = NewMoney(1, CurrencyRUB).Add(NewMoney(1, Currency("USD")))
+	assert.ErrorIs(t, err, domainErrors.ErrCurrencyMismatch)
+}
+
+func TestGramsFromKg(t *testing.T) {
+	assert.Equal(t, Grams(1500), GramsFromKg(1.5))
+	assert.Equal(t, Grams(29900), GramsFromKg(float64(float32(29.9))))
+	assert.Equal(t, 0.25, Grams(250).Kg())
+}
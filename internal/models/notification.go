@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+type NotificationStatus string
+type NotificationKind string
+type NotificationPriority int
+
+const (
+	NotificationPending   NotificationStatus = "PENDING"   // ждет отправки
+	NotificationSent      NotificationStatus = "SENT"      // отправлено
+	NotificationCancelled NotificationStatus = "CANCELLED" // отменено
+	NotificationFailed    NotificationStatus = "FAILED"    // не удалось отправить
+
+	NotificationKindManual         NotificationKind = "manual"          // через SendMessage
+	NotificationKindOrderArrived   NotificationKind = "order_arrived"   // заказ прибыл в ПВЗ
+	NotificationKindStorageExpires NotificationKind = "storage_expires" // скоро истекает срок хранения
+
+	PriorityMin     NotificationPriority = 1
+	PriorityLow     NotificationPriority = 2
+	PriorityDefault NotificationPriority = 3
+	PriorityHigh    NotificationPriority = 4
+	PriorityMax     NotificationPriority = 5
+)
+
+type Notification struct {
+	ID          uint32               `json:"id"`
+	UserID      uint64               `json:"user_id"`  // 0 - без получателя
+	OrderID     uint64               `json:"order_id"` // 0 - не связано с заказом
+	Kind        NotificationKind     `json:"kind"`
+	Title       string               `json:"title"`
+	Text        string               `json:"text"`
+	Comment     string               `json:"comment"`
+	Tags        []string             `json:"tags"`
+	Priority    NotificationPriority `json:"priority"`
+	Status      NotificationStatus   `json:"status"`
+	Attempts    int                  `json:"attempts"`
+	Error       string               `json:"error"`
+	ScheduledAt time.Time            `json:"scheduled_at"` // раньше этого времени не отправляется
+	CreatedAt   time.Time            `json:"created_at"`
+	SentAt      *time.Time           `json:"sent_at"`
+}
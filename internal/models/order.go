@@ -2,8 +2,6 @@ package models
 
 import (
 	"time"
-
-	"PWZ1.0/internal/models/domainErrors"
 )
 
 type OrderStatus string
@@ -40,6 +38,7 @@ type OrderHistory struct {
 	OrderID   uint64      `json:"order_id"`
 	Status    OrderStatus `json:"status"`
 	CreatedAt time.Time   `json:"created_at"`
+	Actor     Actor       `json:"actor"`
 }
 
 func (a ActionType) String() string {
@@ -71,56 +70,9 @@ type Order struct {
 	ExpiresAt   time.Time   `json:"expires_at"` //время до которого заказ можно выдать
 	Status      OrderStatus `json:"status"`
 	PackageType PackageType `json:"package_type"`
-	Weight      float32     `json:"weight"`
-	Price       float32     `json:"price"`
-}
-
-// расчёт всей стоимости
-func (o *Order) CalculateTotalPrice() {
-	switch o.PackageType {
-	case PackageBag:
-		o.Price += 5
-	case PackageBox:
-		o.Price += 20
-	case PackageTape:
-		o.Price += 1
-	case PackageBagTape:
-		o.Price += 6
-	case PackageBoxTape:
-		o.Price += 21
-	case PackageUnspecified:
-		o.Price += 0
-	}
-}
-
-// валидация веса
-func (o *Order) ValidationWeight() error {
-	switch o.PackageType {
-	case PackageUnspecified:
-		return nil
-	case PackageBag:
-		if o.Weight >= 10 {
-			return domainErrors.ErrWeightTooHeavy
-		}
-		return nil
-	case PackageBox:
-		if o.Weight >= 30 {
-			return domainErrors.ErrWeightTooHeavy
-		}
-		return nil
-	case PackageTape:
-		return nil
-	case PackageBagTape:
-		if o.Weight >= 10 {
-			return domainErrors.ErrWeightTooHeavy
-		}
-		return nil
-	case PackageBoxTape:
-		if o.Weight >= 30 {
-			return domainErrors.ErrWeightTooHeavy
-		}
-		return nil
-	default:
-		return domainErrors.ErrInvalidPackage
-	}
+	Weight      Grams       `json:"weight_g"`
+	Price       Money       `json:"price"`
+	DeletedAt   *time.Time  `json:"deleted_at,omitempty"` //когда заказ возвращен курьеру, nil для активных
+	//версия тарифа, по которому посчитана цена
+	TariffVersion string `json:"tariff_version,omitempty"`
 }
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type OrderSortKey int
+
+const (
+	SortByID        OrderSortKey = iota // по ID заказа
+	SortByExpiresAt                     // по сроку хранения, при равенстве по ID
+)
+
+// OrderCursor позиция последней полученной строки для keyset-пагинации
+type OrderCursor struct {
+	ID        uint64    `json:"id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// OrderFilter условия выборки заказов, нулевые значения полей не ограничивают выборку
+type OrderFilter struct {
+	UserID         uint64
+	Statuses       []OrderStatus
+	ExpiresFrom    time.Time
+	ExpiresTo      time.Time
+	SortBy         OrderSortKey
+	Desc           bool
+	Cursor         *OrderCursor // строки строго после курсора в порядке сортировки
+	LastN          uint32       // только N последних по ID заказов
+	IncludeDeleted bool         // по умолчанию возвращенные курьеру заказы не выбираются
+	Offset         uint32
+	Limit          uint32
+}
+
+type OrdersPage struct {
+	Orders     []Order
+	Total      uint32       // всего заказов под фильтром, без учета курсора и страницы
+	NextCursor *OrderCursor // nil, если строк больше нет
+}
+
+// HistoryFilter условия выборки истории заказов, нулевые значения полей не ограничивают выборку
+type HistoryFilter struct {
+	UserID      uint64
+	Statuses    []OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
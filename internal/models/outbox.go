@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusCreated    OutboxStatus = "CREATED"    // событие записано, ещё не отправлялось
+	OutboxStatusProcessing OutboxStatus = "PROCESSING" // событие забрал воркер
+	OutboxStatusCompleted  OutboxStatus = "COMPLETED"  // событие отправлено в брокер
+	OutboxStatusFailed     OutboxStatus = "FAILED"     // отправка не удалась
+)
+
+// OutboxMessage строка таблицы outbox, взятая воркером в обработку
+type OutboxMessage struct {
+	ID        uuid.UUID `json:"id"`
+	Payload   []byte    `json:"payload"`
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OutboxStats состояние очереди неотправленных событий
+type OutboxStats struct {
+	Pending         int64     `json:"pending"`
+	OldestCreatedAt time.Time `json:"oldest_created_at"`
+}
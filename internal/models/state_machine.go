@@ -0,0 +1,154 @@
+package models
+
+import (
+	"time"
+
+	"PWZ1.0/internal/models/domainErrors"
+)
+
+// DefaultReturnWindow сколько клиент может вернуть заказ после выдачи
+const DefaultReturnWindow = 48 * time.Hour
+
+// Guard проверяет, можно ли выполнить переход для заказа в момент now
+type Guard func(o Order, now time.Time) error
+
+// Transition разрешенная смена статуса
+type Transition struct {
+	From      OrderStatus
+	To        OrderStatus
+	EventType string
+	Guard     Guard                         // nil, если переход всегда разрешен
+	Apply     func(o *Order, now time.Time) // изменения заказа помимо статуса, может быть nil
+}
+
+// OrderStateMachine таблица всех разрешенных переходов между статусами заказа
+type OrderStateMachine struct {
+	transitions map[OrderStatus]map[OrderStatus]Transition
+}
+
+func NewOrderStateMachine(transitions ...Transition) *OrderStateMachine {
+	m := &OrderStateMachine{transitions: make(map[OrderStatus]map[OrderStatus]Transition)}
+	for _, t := range transitions {
+		if m.transitions[t.From] == nil {
+			m.transitions[t.From] = make(map[OrderStatus]Transition)
+		}
+		m.transitions[t.From][t.To] = t
+	}
+	return m
+}
+
+// NewStateMachine жизненный цикл заказа, returnWindow задается при запуске сервера из orders.return_window
+func NewStateMachine(returnWindow time.Duration) *OrderStateMachine {
+	return NewOrderStateMachine(OrderTransitions(returnWindow)...)
+}
+
+// OrderTransitions жизненный цикл заказа в ПВЗ
+func OrderTransitions(returnWindow time.Duration) []Transition {
+	return []Transition{
+		{
+			// приемка от курьера, у нового заказа статуса еще нет
+			From:      StatusUnspecified,
+			To:        StatusExpects,
+			EventType: EventOrderAccepted,
+			Guard:     storageDeadlineInFuture,
+		},
+		{
+			From:      StatusExpects,
+			To:        StatusAccepted,
+			EventType: EventOrderIssued,
+			Guard:     storageNotExpired,
+			Apply: func(o *Order, now time.Time) {
+				// после выдачи срок хранения становится сроком возврата
+				o.ExpiresAt = now.Add(returnWindow)
+			},
+		},
+		{
+			From:      StatusAccepted,
+			To:        StatusReturned,
+			EventType: EventOrderReturnedByClient,
+			Guard:     returnWindowOpen,
+		},
+		{
+			From:      StatusReturned,
+			To:        StatusDeleted,
+			EventType: EventOrderReturnedToCourier,
+		},
+		{
+			// невостребованный заказ отдается курьеру только после окончания хранения
+			From:      StatusExpects,
+			To:        StatusDeleted,
+			EventType: EventOrderReturnedToCourier,
+			Guard:     storageExpired,
+		},
+	}
+}
+
+// Lookup возвращает переход без проверки guard
+func (m *OrderStateMachine) Lookup(from, to OrderStatus) (Transition, bool) {
+	t, ok := m.transitions[from][to]
+	return t, ok
+}
+
+// Transition переводит заказ в статус to, если переход есть в таблице и guard его разрешает
+func (m *OrderStateMachine) Transition(o *Order, to OrderStatus, now time.Time) (Transition, error) {
+	t, ok := m.Lookup(o.Status, to)
+	if !ok {
+		return Transition{}, notAllowed(o.Status, to)
+	}
+
+	if t.Guard != nil {
+		if err := t.Guard(*o, now); err != nil {
+			return Transition{}, err
+		}
+	}
+
+	o.Status = to
+	if t.Apply != nil {
+		t.Apply(o, now)
+	}
+	return t, nil
+}
+
+// notAllowed подбирает ошибку для перехода, которого нет в таблице
+func notAllowed(from, to OrderStatus) error {
+	switch {
+	case from == StatusDeleted:
+		return domainErrors.ErrOrderAlreadyReturned
+	case from == StatusAccepted && to != StatusReturned:
+		return domainErrors.ErrOrderAlreadyIssued
+	case from == StatusReturned && to == StatusReturned:
+		return domainErrors.ErrOrderAlreadyReturned
+	case from != StatusUnspecified && to == StatusExpects:
+		return domainErrors.ErrOrderAlreadyExists
+	default:
+		return domainErrors.ErrInvalidTransition
+	}
+}
+
+func storageDeadlineInFuture(o Order, now time.Time) error {
+	if !o.ExpiresAt.After(now) {
+		return domainErrors.ErrValidationFailed
+	}
+	return nil
+}
+
+func storageNotExpired(o Order, now time.Time) error {
+	if now.After(o.ExpiresAt) {
+		return domainErrors.ErrStorageExpired
+	}
+	return nil
+}
+
+func storageExpired(o Order, now time.Time) error {
+	if now.Before(o.ExpiresAt) {
+		return domainErrors.ErrStorageNotExpired
+	}
+	return nil
+}
+
+func returnWindowOpen(o Order, now time.Time) error {
+	if now.After(o.ExpiresAt) {
+		return domainErrors.ErrReturnTimeExpired
+	}
+	return nil
+}
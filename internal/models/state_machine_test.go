@@ -0,0 +1,113 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderStateMachine_AllPairs(t *testing.T) {
+	machine := NewStateMachine(DefaultReturnWindow)
+	const (
+		U = StatusUnspecified
+		E = StatusExpects
+		A = StatusAccepted
+		R = StatusReturned
+		D = StatusDeleted
+	)
+
+	tests := []struct {
+		from, to    OrderStatus
+		wantActive  error // срок хранения/возврата еще не истек
+		wantExpired error // срок истек
+		wantEvent   string
+	}{
+		{U, U, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{U, E, nil, domainErrors.ErrValidationFailed, EventOrderAccepted},
+		{U, A, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{U, R, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{U, D, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+
+		{E, U, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{E, E, domainErrors.ErrOrderAlreadyExists, domainErrors.ErrOrderAlreadyExists, ""},
+		{E, A, nil, domainErrors.ErrStorageExpired, EventOrderIssued},
+		{E, R, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{E, D, domainErrors.ErrStorageNotExpired, nil, EventOrderReturnedToCourier},
+
+		{A, U, domainErrors.ErrOrderAlreadyIssued, domainErrors.ErrOrderAlreadyIssued, ""},
+		{A, E, domainErrors.ErrOrderAlreadyIssued, domainErrors.ErrOrderAlreadyIssued, ""},
+		{A, A, domainErrors.ErrOrderAlreadyIssued, domainErrors.ErrOrderAlreadyIssued, ""},
+		{A, R, nil, domainErrors.ErrReturnTimeExpired, EventOrderReturnedByClient},
+		{A, D, domainErrors.ErrOrderAlreadyIssued, domainErrors.ErrOrderAlreadyIssued, ""},
+
+		{R, U, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{R, E, domainErrors.ErrOrderAlreadyExists, domainErrors.ErrOrderAlreadyExists, ""},
+		{R, A, domainErrors.ErrInvalidTransition, domainErrors.ErrInvalidTransition, ""},
+		{R, R, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+		{R, D, nil, nil, EventOrderReturnedToCourier},
+
+		{D, U, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+		{D, E, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+		{D, A, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+		{D, R, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+		{D, D, domainErrors.ErrOrderAlreadyReturned, domainErrors.ErrOrderAlreadyReturned, ""},
+	}
+
+	now := time.Now()
+	cases := []struct {
+		name      string
+		expiresAt time.Time
+		want      func(i int) error
+	}{
+		{"active", now.Add(time.Hour), func(i int) error { return tests[i].wantActive }},
+		{"expired", now.Add(-time.Hour), func(i int) error { return tests[i].wantExpired }},
+	}
+
+	for i, tt := range tests {
+		for _, c := range cases {
+			t.Run(string(tt.from)+"->"+string(tt.to)+"/"+c.name, func(t *testing.T) {
+				t.Parallel()
+				order := Order{ID: 1, Status: tt.from, ExpiresAt: c.expiresAt}
+
+				transition, err := machine.Transition(&order, tt.to, now)
+
+				if want := c.want(i); want != nil {
+					assert.ErrorIs(t, err, want)
+					assert.Equal(t, tt.from, order.Status, "статус не должен меняться при ошибке")
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, order.Status)
+				assert.Equal(t, tt.wantEvent, transition.EventType)
+			})
+		}
+	}
+}
+
+func TestOrderStateMachine_IssueStartsReturnWindow(t *testing.T) {
+	machine := NewStateMachine(DefaultReturnWindow)
+	now := time.Now()
+	order := Order{Status: StatusExpects, ExpiresAt: now.Add(time.Hour)}
+
+	_, err := machine.Transition(&order, StatusAccepted, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(DefaultReturnWindow), order.ExpiresAt)
+
+	// возврат через 47 часов еще возможен, через 49 уже нет
+	returned := order
+	_, err = machine.Transition(&returned, StatusReturned, now.Add(47*time.Hour))
+	assert.NoError(t, err)
+
+	late := order
+	_, err = machine.Transition(&late, StatusReturned, now.Add(49*time.Hour))
+	assert.ErrorIs(t, err, domainErrors.ErrReturnTimeExpired)
+
+	// окно возврата из конфигурации
+	custom := Order{Status: StatusExpects, ExpiresAt: now.Add(time.Hour)}
+	_, err = NewStateMachine(72*time.Hour).Transition(&custom, StatusAccepted, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(72*time.Hour), custom.ExpiresAt)
+}
@@ -0,0 +1,16 @@
+package models
+
+// BusinessStats срез заказов для дашборда менеджеров
+type BusinessStats struct {
+	ByStatus               map[OrderStatus]int64 `json:"by_status"`
+	ExpiredExpects         int64                 `json:"expired_expects"`          // срок хранения истек, заказ пора вернуть курьеру
+	ReturnsAwaitingCourier int64                 `json:"returns_awaiting_courier"` // возвращены клиентом, курьер еще не забрал
+	StoredWeight           Grams                 `json:"stored_weight_g"`          // заказы, которые физически лежат в ПВЗ: EXPECTS и RETURNED
+	StoredValue            []PackageValue        `json:"stored_value"`
+}
+
+// PackageValue стоимость лежащих в ПВЗ заказов одной упаковки в одной валюте
+type PackageValue struct {
+	Package PackageType `json:"package_type"`
+	Value   Money       `json:"value"`
+}
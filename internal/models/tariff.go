@@ -0,0 +1,106 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"PWZ1.0/internal/models/domainErrors"
+)
+
+// PackageTariff надбавка и ограничение веса для одного вида упаковки
+type PackageTariff struct {
+	Surcharge   int64 `json:"surcharge_minor" yaml:"surcharge_minor"`                   // в копейках валюты тарифа
+	WeightLimit Grams `json:"weight_limit_g,omitempty" yaml:"weight_limit_g,omitempty"` // вес должен быть строго меньше, 0 - без ограничения
+}
+
+// WeightTier надбавка за вес, действует начиная с FromWeight включительно
+type WeightTier struct {
+	FromWeight Grams `json:"from_weight_g" yaml:"from_weight_g"`
+	Surcharge  int64 `json:"surcharge_minor" yaml:"surcharge_minor"`
+}
+
+// Tariff версия тарифа, действующая с ValidFrom до начала следующей версии
+type Tariff struct {
+	Version     string                        `json:"version" yaml:"version"`
+	ValidFrom   time.Time                     `json:"valid_from" yaml:"valid_from"`
+	Currency    Currency                      `json:"currency" yaml:"currency"`
+	Packages    map[PackageType]PackageTariff `json:"packages" yaml:"packages"`
+	WeightTiers []WeightTier                  `json:"weight_tiers,omitempty" yaml:"weight_tiers,omitempty"`
+}
+
+// DefaultTariffVersion версия, которой помечены заказы, принятые до появления тарифов
+const DefaultTariffVersion = "v1"
+
+// DefaultTariff надбавки, которые действовали до появления настраиваемых тарифов
+var DefaultTariff = Tariff{
+	Version:  DefaultTariffVersion,
+	Currency: CurrencyRUB,
+	Packages: map[PackageType]PackageTariff{
+		PackageUnspecified: {},
+		PackageBag:         {Surcharge: 500, WeightLimit: 10000},
+		PackageBox:         {Surcharge: 2000, WeightLimit: 30000},
+		PackageTape:        {Surcharge: 100},
+		PackageBagTape:     {Surcharge: 600, WeightLimit: 10000},
+		PackageBoxTape:     {Surcharge: 2100, WeightLimit: 30000},
+	},
+}
+
+// ValidateWeight проверяет, что упаковка есть в тарифе и вес в ее пределах
+func (t Tariff) ValidateWeight(pkg PackageType, weight Grams) error {
+	p, ok := t.Packages[pkg]
+	if !ok {
+		return domainErrors.ErrInvalidPackage
+	}
+	if p.WeightLimit > 0 && weight >= p.WeightLimit {
+		return domainErrors.ErrWeightTooHeavy
+	}
+	return nil
+}
+
+// Surcharge надбавка к цене заказа за упаковку и вес
+func (t Tariff) Surcharge(pkg PackageType, weight Grams) (Money, error) {
+	if err := t.ValidateWeight(pkg, weight); err != nil {
+		return Money{}, err
+	}
+
+	surcharge := t.Packages[pkg].Surcharge
+	if tier, ok := t.weightTier(weight); ok {
+		surcharge += tier.Surcharge
+	}
+	return NewMoney(surcharge, t.Currency.OrDefault()), nil
+}
+
+// Apply считает итоговую цену заказа и запоминает версию тарифа
+func (t Tariff) Apply(o *Order) error {
+	surcharge, err := t.Surcharge(o.PackageType, o.Weight)
+	if err != nil {
+		return err
+	}
+	price, err := o.Price.Add(surcharge)
+	if err != nil {
+		return err
+	}
+	o.Price = price
+	o.TariffVersion = t.Version
+	return nil
+}
+
+func (t Tariff) weightTier(weight Grams) (WeightTier, bool) {
+	var (
+		found WeightTier
+		ok    bool
+	)
+	for _, tier := range t.WeightTiers {
+		if weight >= tier.FromWeight && (!ok || tier.FromWeight > found.FromWeight) {
+			found, ok = tier, true
+		}
+	}
+	return found, ok
+}
+
+// SortTariffs упорядочивает версии по дате начала действия
+func SortTariffs(tariffs []Tariff) {
+	sort.Slice(tariffs, func(i, j int) bool {
+		return tariffs[i].ValidFrom.Before(tariffs[j].ValidFrom)
+	})
+}
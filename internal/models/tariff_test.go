@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTariff_ValidateWeight(t *testing.T) {
+	tests := []struct {
+		name      string
+		pkgType   PackageType
+		weight    Grams
+		wantError error
+	}{
+		{"BagUnderLimit", PackageBag, 5000, nil},
+		{"BagOverLimit", PackageBag, 10000, domainErrors.ErrWeightTooHeavy},
+		{"BoxUnderLimit", PackageBox, 29999, nil},
+		{"BoxOverLimit", PackageBox, 30000, domainErrors.ErrWeightTooHeavy},
+		{"TapeAnyWeight", PackageTape, 1000000, nil},
+		{"Unspecified", PackageUnspecified, 1000000, nil},
+		{"InvalidPackage", PackageType("мешок с дыркой"), 1000, domainErrors.ErrInvalidPackage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := DefaultTariff.ValidateWeight(tt.pkgType, tt.weight)
+			assert.ErrorIs(t, err, tt.wantError)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package mw
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"PWZ1.0/internal/auth"
+	"PWZ1.0/internal/models/domainErrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthInterceptor проверяет JWT (authorization: Bearer) или API-ключ (x-api-key) и роль для метода,
+// principal кладется в контекст; health-пробы проходят без проверки. Клиенту уходит только текст доменной ошибки, по нему gateway находит код
+func AuthInterceptor(a *auth.Authenticator, policy auth.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isHealthCheck(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		p, err := authorize(ctx, a, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(auth.WithPrincipal(ctx, p), req)
+	}
+}
+
+// AuthStreamInterceptor то же для потоковых ручек
+func AuthStreamInterceptor(a *auth.Authenticator, policy auth.Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isHealthCheck(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		p, err := authorize(ss.Context(), a, policy, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, contextStream{ServerStream: ss, ctx: auth.WithPrincipal(ss.Context(), p)})
+	}
+}
+
+// contextStream поток с контекстом, дополненным интерцептором
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func authorize(ctx context.Context, a *auth.Authenticator, policy auth.Policy, method string) (auth.Principal, error) {
+	p, err := authenticate(ctx, a)
+	if err != nil {
+		slog.WarnContext(ctx, "auth: unauthenticated", "method", method, "err", err)
+		return auth.Principal{}, status.Error(codes.Unauthenticated, domainErrors.ErrUnauthenticated.Error())
+	}
+	if !policy.Allowed(method, p.Role) {
+		slog.WarnContext(ctx, "auth: permission denied", "method", method, "role", p.Role, "subject", p.Subject)
+		return auth.Principal{}, status.Error(codes.PermissionDenied, domainErrors.ErrPermissionDenied.Error())
+	}
+	return p, nil
+}
+
+func authenticate(ctx context.Context, a *auth.Authenticator) (auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var authorization, apiKey string
+	if v := md.Get("authorization"); len(v) > 0 {
+		authorization = v[0]
+	}
+	if v := md.Get("x-api-key"); len(v) > 0 {
+		apiKey = v[0]
+	}
+	return authenticateCredentials(a, authorization, apiKey)
+}
+
+func authenticateCredentials(a *auth.Authenticator, authorization, apiKey string) (auth.Principal, error) {
+	if authorization != "" {
+		scheme, token, ok := strings.Cut(authorization, " ")
+		if !ok || !strings.EqualFold(scheme, "bearer") {
+			return auth.Principal{}, auth.ErrInvalidToken
+		}
+		return a.Token(strings.TrimSpace(token))
+	}
+	if apiKey != "" {
+		return a.APIKey(apiKey)
+	}
+	return auth.Principal{}, domainErrors.ErrUnauthenticated
+}
+
+// AdminHTTPHandler служебные ручки на порту метрик: GET без проверки, изменения - только admin
+// по тем же JWT и API-ключам, что и gRPC. a == nil - аутентификация выключена (auth.disabled)
+func AdminHTTPHandler(a *auth.Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a == nil || r.Method == http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+		p, err := authenticateCredentials(a, r.Header.Get("Authorization"), r.Header.Get("X-Api-Key"))
+		if err != nil {
+			slog.WarnContext(r.Context(), "auth: unauthenticated", "path", r.URL.Path, "err", err)
+			http.Error(w, domainErrors.ErrUnauthenticated.Error(), http.StatusUnauthorized)
+			return
+		}
+		if p.Role != auth.RoleAdmin {
+			slog.WarnContext(r.Context(), "auth: permission denied", "path", r.URL.Path, "role", p.Role, "subject", p.Subject)
+			http.Error(w, domainErrors.ErrPermissionDenied.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), p)))
+	})
+}
+
+// ReadOnlyHTTPHandler служебные ручки бинарников без аутентификации: только GET, изменения запрещены
+func ReadOnlyHTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,99 @@
+package mw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"PWZ1.0/internal/auth"
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	sum := sha256.Sum256([]byte("courier-key"))
+	a, err := auth.NewAuthenticator(auth.Config{APIKeys: []auth.APIKey{
+		{Name: "courier-1", ID: 5, Role: auth.RoleCourier, SHA256: hex.EncodeToString(sum[:])},
+	}})
+	require.NoError(t, err)
+	interceptor := AuthInterceptor(a, auth.DefaultPolicy)
+
+	call := func(md metadata.MD, method string) (auth.Principal, error) {
+		var got auth.Principal
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+			got, _ = auth.FromContext(ctx)
+			return nil, nil
+		})
+		return got, err
+	}
+
+	p, err := call(metadata.Pairs("x-api-key", "courier-key"), desc.Notifier_AcceptOrder_FullMethodName)
+	require.NoError(t, err)
+	assert.Equal(t, auth.Principal{Subject: "courier-1", ID: 5, Role: auth.RoleCourier}, p)
+
+	_, err = call(metadata.Pairs("x-api-key", "courier-key"), desc.Notifier_ProcessOrders_FullMethodName)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call(metadata.Pairs("x-api-key", "wrong"), desc.Notifier_AcceptOrder_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(metadata.Pairs("authorization", "Basic abc"), desc.Notifier_AcceptOrder_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(metadata.MD{}, desc.Notifier_AcceptOrder_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// пробы health без ключей
+	_, err = call(metadata.MD{}, healthgrpc.Health_Check_FullMethodName)
+	assert.NoError(t, err)
+}
+
+func TestAdminHTTPHandler(t *testing.T) {
+	t.Parallel()
+
+	keys := map[string]auth.Role{"admin-key": auth.RoleAdmin, "operator-key": auth.RoleOperator}
+	var apiKeys []auth.APIKey
+	for key, role := range keys {
+		sum := sha256.Sum256([]byte(key))
+		apiKeys = append(apiKeys, auth.APIKey{Name: key, ID: 1, Role: role, SHA256: hex.EncodeToString(sum[:])})
+	}
+	a, err := auth.NewAuthenticator(auth.Config{APIKeys: apiKeys})
+	require.NoError(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	call := func(h http.Handler, method, apiKey string) int {
+		req := httptest.NewRequest(method, "/loglevel?level=debug", nil)
+		if apiKey != "" {
+			req.Header.Set("X-Api-Key", apiKey)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	h := AdminHTTPHandler(a, next)
+	assert.Equal(t, http.StatusNoContent, call(h, http.MethodGet, ""))
+	assert.Equal(t, http.StatusUnauthorized, call(h, http.MethodPut, ""))
+	assert.Equal(t, http.StatusUnauthorized, call(h, http.MethodPut, "wrong"))
+	assert.Equal(t, http.StatusForbidden, call(h, http.MethodPut, "operator-key"))
+	assert.Equal(t, http.StatusNoContent, call(h, http.MethodPut, "admin-key"))
+
+	// аутентификация выключена
+	assert.Equal(t, http.StatusNoContent, call(AdminHTTPHandler(nil, next), http.MethodPut, ""))
+
+	ro := ReadOnlyHTTPHandler(next)
+	assert.Equal(t, http.StatusNoContent, call(ro, http.MethodGet, ""))
+	assert.Equal(t, http.StatusMethodNotAllowed, call(ro, http.MethodPut, "admin-key"))
+}
@@ -0,0 +1,13 @@
+package mw
+
+import (
+	"strings"
+
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// isHealthCheck пробы балансировщика и оркестратора приходят каждые несколько секунд и без ключей,
+// поэтому их не аутентифицируем и не ограничиваем rate limit
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/"+healthgrpc.Health_ServiceDesc.ServiceName+"/")
+}
@@ -0,0 +1,130 @@
+package mw
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/idempotency"
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyInterceptor первый ответ на изменяющий запрос с idempotency-key, в том числе ошибка, сохраняется на ttl,
+// повторы с тем же ключом и телом получают его без повторного выполнения.
+// Пока запрос выполняется, ключ занят только на inFlightTTL (или до дедлайна запроса, если он раньше)
+func IdempotencyInterceptor(store idempotency.Store, ttl, inFlightTTL time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !idempotency.Methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(idempotency.Header)
+		msg, ok := req.(proto.Message)
+		if len(keys) == 0 || keys[0] == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(keys[0]) > idempotency.MaxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: длина %s больше %d", domainErrors.ErrValidationFailed, idempotency.Header, idempotency.MaxKeyLength)
+		}
+
+		hash, err := idempotency.RequestHash(msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		key := idempotency.ScopedKey(info.FullMethod, callerKey(ctx), keys[0])
+
+		record, reserved, err := store.Reserve(ctx, key, hash, reservationLease(ctx, inFlightTTL))
+		if err != nil {
+			slog.ErrorContext(ctx, "idempotency: reserve key failed", "err", err)
+			return nil, status.Error(codes.Unavailable, "idempotency store unavailable")
+		}
+		if !reserved {
+			return replay(ctx, record, hash)
+		}
+
+		resp, err := handler(ctx, req)
+		// ответ сохраняем, даже если клиент уже отключился: его повтор придет с тем же ключом
+		saveRecord(context.WithoutCancel(ctx), store, record, ttl, resp, err)
+		return resp, err
+	}
+}
+
+// reservationLease после дедлайна запрос уже не ответит клиенту, держать ключ дольше незачем
+func reservationLease(ctx context.Context, inFlightTTL time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline); left > 0 && left < inFlightTTL {
+			return left
+		}
+	}
+	return inFlightTTL
+}
+
+func replay(ctx context.Context, record models.IdempotencyRecord, hash string) (any, error) {
+	if record.RequestHash != hash {
+		return nil, status.Error(codes.AlreadyExists, domainErrors.ErrIdempotencyKeyReused.Error())
+	}
+	if !record.Done {
+		return nil, status.Error(codes.Aborted, domainErrors.ErrIdempotencyInProgress.Error())
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(idempotency.ReplayedHeader, "true"))
+	if code := codes.Code(record.Code); code != codes.OK {
+		return nil, status.Error(code, record.Message)
+	}
+
+	resp, err := idempotency.DecodeResponse(record.Response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func saveRecord(ctx context.Context, store idempotency.Store, record models.IdempotencyRecord, ttl time.Duration, resp any, handlerErr error) {
+	st := status.New(codes.OK, "")
+	if handlerErr != nil {
+		var ok bool
+		if st, ok = status.FromError(handlerErr); !ok {
+			st, _ = status.FromError(mapErrorToStatus(handlerErr))
+		}
+	}
+
+	if retryable(st.Code()) {
+		if err := store.Release(ctx, record.Key); err != nil {
+			slog.ErrorContext(ctx, "idempotency: release key failed", "err", err)
+		}
+		return
+	}
+
+	record.Done = true
+	record.Code = uint32(st.Code())
+	record.Message = st.Message()
+	if msg, ok := resp.(proto.Message); ok && handlerErr == nil {
+		data, err := idempotency.EncodeResponse(msg)
+		if err != nil {
+			slog.ErrorContext(ctx, "idempotency: encode response failed", "err", err)
+			_ = store.Release(ctx, record.Key)
+			return
+		}
+		record.Response = data
+	}
+
+	if err := store.Complete(ctx, record, ttl); err != nil {
+		slog.ErrorContext(ctx, "idempotency: save response failed", "err", err)
+	}
+}
+
+// retryable временные ошибки не сохраняются: повтор с тем же ключом выполнится заново
+func retryable(code codes.Code) bool {
+	switch code {
+	case codes.Canceled, codes.DeadlineExceeded, codes.Unavailable, codes.ResourceExhausted,
+		codes.Aborted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package mw
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	desc "PWZ1.0/pkg/pwz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoryStore хранилище ключей в памяти, TTL не учитывается
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]models.IdempotencyRecord
+}
+
+func (s *memoryStore) Reserve(_ context.Context, key, requestHash string, _ time.Duration) (models.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rec, ok := s.records[key]; ok {
+		return rec, false, nil
+	}
+	rec := models.IdempotencyRecord{Key: key, RequestHash: requestHash}
+	s.records[key] = rec
+	return rec, true, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, record models.IdempotencyRecord, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[record.Key] = record
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	store := &memoryStore{records: map[string]models.IdempotencyRecord{}}
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	info := &grpc.UnaryServerInfo{FullMethod: desc.Notifier_AcceptOrder_FullMethodName}
+
+	calls := 0
+	var handlerErr error
+	handler := func(_ context.Context, req any) (any, error) {
+		calls++
+		if handlerErr != nil {
+			return nil, handlerErr
+		}
+		return &desc.OrderResponse{OrderId: req.(*desc.AcceptOrderRequest).GetOrderId(), Status: desc.OrderStatus_ORDER_STATUS_EXPECTS}, nil
+	}
+	call := func(key string, req *desc.AcceptOrderRequest) (any, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", key, "sender", "test"))
+		return interceptor(ctx, req, info, handler)
+	}
+
+	first, err := call("k1", &desc.AcceptOrderRequest{OrderId: 1, UserId: 2})
+	require.NoError(t, err)
+
+	// повтор получает тот же ответ без выполнения
+	again, err := call("k1", &desc.AcceptOrderRequest{OrderId: 1, UserId: 2})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(first.(proto.Message), again.(proto.Message)))
+	assert.Equal(t, 1, calls)
+
+	// тот же ключ с другим телом
+	_, err = call("k1", &desc.AcceptOrderRequest{OrderId: 1, UserId: 3})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	// доменная ошибка сохраняется и повторяется
+	handlerErr = domainErrors.ErrOrderAlreadyExists
+	_, err = call("k2", &desc.AcceptOrderRequest{OrderId: 5})
+	assert.ErrorIs(t, err, domainErrors.ErrOrderAlreadyExists)
+	handlerErr = nil
+	_, err = call("k2", &desc.AcceptOrderRequest{OrderId: 5})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Equal(t, domainErrors.ErrOrderAlreadyExists.Error(), status.Convert(err).Message())
+	assert.Equal(t, 2, calls)
+
+	// временная ошибка не сохраняется, повтор выполняется заново
+	handlerErr = status.Error(codes.Unavailable, "db down")
+	_, err = call("k3", &desc.AcceptOrderRequest{OrderId: 6})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	handlerErr = nil
+	_, err = call("k3", &desc.AcceptOrderRequest{OrderId: 6})
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+
+	// без ключа каждый вызов выполняется
+	_, err = interceptor(context.Background(), &desc.AcceptOrderRequest{OrderId: 1, UserId: 2}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls)
+}
+
+func TestIdempotencyInterceptor_InProgress(t *testing.T) {
+	t.Parallel()
+
+	store := &memoryStore{records: map[string]models.IdempotencyRecord{}}
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	info := &grpc.UnaryServerInfo{FullMethod: desc.Notifier_ProcessOrders_FullMethodName}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "k"))
+	req := &desc.ProcessOrdersRequest{UserId: 1, OrderIds: []uint64{1}}
+
+	_, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		// пока первый запрос выполняется, повтор получает Aborted
+		_, err := interceptor(ctx, req, info, func(context.Context, any) (any, error) {
+			t.Fatal("retry must not run")
+			return nil, nil
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		return &desc.ProcessResult{}, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestReservationLease(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Minute, reservationLease(context.Background(), time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	lease := reservationLease(ctx, time.Minute)
+	assert.LessOrEqual(t, lease, 5*time.Second)
+	assert.Greater(t, lease, time.Duration(0))
+
+	// дедлайн позже lease ничего не меняет
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	assert.Equal(t, time.Minute, reservationLease(ctx, time.Minute))
+}
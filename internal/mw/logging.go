@@ -3,7 +3,8 @@ package mw
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
+	"time"
 
 	"PWZ1.0/internal/models/domainErrors"
 	"google.golang.org/grpc"
@@ -11,37 +12,90 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func LoggingInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+// LoggingInterceptor пишет каждый вызов с кодом и длительностью, доменные ошибки переводит в коды gRPC
+func LoggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
 	resp, err = handler(ctx, req)
-	if err != nil {
-		// если gRPC оишбка
-		if s, ok := status.FromError(err); ok {
-			log.Printf("error: code: %v, message: %q", s.Code(), s.Message())
-			return resp, err
-		}
-
-		// другая
-		mappedErr := mapErrorToStatus(err)
-		log.Printf("%v", mappedErr)
-		return resp, mappedErr
+	err = toStatus(err)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// LoggingStreamInterceptor то же для потоковых ручек
+func LoggingStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := toStatus(handler(srv, ss))
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+// toStatus ошибки gRPC как есть, остальные - через mapErrorToStatus
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return mapErrorToStatus(err)
+}
+
+// logCall успешные вызовы - info, ошибки клиента - warn, ошибки сервера - error; успешные health-пробы не пишутся
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	if err == nil && isHealthCheck(method) {
+		return
+	}
+	s := status.Convert(err)
+	level := slog.LevelInfo
+	switch s.Code() {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
 	}
 
-	return resp, nil
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", s.Code().String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("message", s.Message()))
+	}
+	slog.LogAttrs(ctx, level, "grpc call", attrs...)
 }
 
 func mapErrorToStatus(err error) error {
 	switch {
 	case errors.Is(err, domainErrors.ErrInvalidPackage),
 		errors.Is(err, domainErrors.ErrValidationFailed),
-		errors.Is(err, domainErrors.ErrWeightTooHeavy):
+		errors.Is(err, domainErrors.ErrWeightTooHeavy),
+		errors.Is(err, domainErrors.ErrCurrencyMismatch),
+		errors.Is(err, domainErrors.ErrImportOutOfOrder):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainErrors.ErrImportJobConflict),
+		errors.Is(err, domainErrors.ErrIdempotencyInProgress):
+		return status.Error(codes.Aborted, err.Error())
 	case errors.Is(err, domainErrors.ErrOrderAlreadyExists),
-		errors.Is(err, domainErrors.ErrDuplicateOrder):
+		errors.Is(err, domainErrors.ErrDuplicateOrder),
+		errors.Is(err, domainErrors.ErrIdempotencyKeyReused):
 		return status.Error(codes.AlreadyExists, err.Error())
-	case errors.Is(err, domainErrors.ErrOrderNotFound):
+	case errors.Is(err, domainErrors.ErrOrderNotFound),
+		errors.Is(err, domainErrors.ErrNotificationNotFound):
 		return status.Error(codes.NotFound, err.Error())
-	case errors.Is(err, domainErrors.ErrStorageExpired):
+	case errors.Is(err, domainErrors.ErrStorageExpired),
+		errors.Is(err, domainErrors.ErrStorageNotExpired),
+		errors.Is(err, domainErrors.ErrReturnTimeExpired),
+		errors.Is(err, domainErrors.ErrOrderAlreadyIssued),
+		errors.Is(err, domainErrors.ErrOrderAlreadyReturned),
+		errors.Is(err, domainErrors.ErrInvalidTransition),
+		errors.Is(err, domainErrors.ErrNotificationNotCancellable):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domainErrors.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domainErrors.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, domainErrors.ErrInternalError),
 		errors.Is(err, domainErrors.ErrImportFailed),
 		errors.Is(err, domainErrors.ErrOpenFiled),
@@ -0,0 +1,22 @@
+package mw
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsInterceptor число вызовов по методу и коду ответа и задержка по методу. Стоит перед LoggingInterceptor
+// и auth (раньше только RequestIDInterceptor), чтобы учесть и отказы auth и rate limit, и доменные ошибки
+// уже с кодом из LoggingInterceptor
+func MetricsInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	metrics.GRPCDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	metrics.GRPCRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	return resp, err
+}
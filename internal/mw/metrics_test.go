@@ -0,0 +1,42 @@
+package mw
+
+import (
+	"context"
+	"testing"
+
+	"PWZ1.0/internal/metrics"
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/pwz.Notifier/MetricsTest"
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	ok := func(context.Context, any) (any, error) { return "ok", nil }
+	notFound := func(context.Context, any) (any, error) { return nil, domainErrors.ErrOrderNotFound }
+
+	resp, err := MetricsInterceptor(context.Background(), nil, info, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	// доменная ошибка считается с кодом, который выставил LoggingInterceptor
+	_, err = MetricsInterceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return LoggingInterceptor(ctx, req, info, notFound)
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.InDelta(t, 1, testutil.ToFloat64(metrics.GRPCRequests.WithLabelValues(method, codes.OK.String())), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(metrics.GRPCRequests.WithLabelValues(method, codes.NotFound.String())), 0)
+	var latency dto.Metric
+	require.NoError(t, metrics.GRPCDuration.WithLabelValues(method).(prometheus.Histogram).Write(&latency))
+	assert.Equal(t, uint64(2), latency.GetHistogram().GetSampleCount())
+}
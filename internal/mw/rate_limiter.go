@@ -3,6 +3,7 @@ package mw
 import (
 	"context"
 
+	"PWZ1.0/internal/auth"
 	"github.com/ulule/limiter/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,14 +13,10 @@ import (
 
 func RateLimiterInterceptor(limiter *limiter.Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		sender := "unknown"
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if s, ok := md["sender"]; ok {
-				sender = s[0]
-			}
+		if isHealthCheck(info.FullMethod) {
+			return handler(ctx, req)
 		}
-
-		limiterCtx, err := limiter.Get(ctx, sender)
+		limiterCtx, err := limiter.Get(ctx, callerKey(ctx))
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -30,3 +27,18 @@ func RateLimiterInterceptor(limiter *limiter.Limiter) grpc.UnaryServerIntercepto
 		return handler(ctx, req)
 	}
 }
+
+// callerKey кто делает запрос: sender клиент указывает сам, поэтому если известен пользователь - он
+func callerKey(ctx context.Context) string {
+	if p, ok := auth.FromContext(ctx); ok {
+		return string(p.Role) + ":" + p.Subject
+	}
+
+	sender := "unknown"
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if s, ok := md["sender"]; ok {
+			sender = s[0]
+		}
+	}
+	return sender
+}
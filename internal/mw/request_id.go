@@ -0,0 +1,45 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+
+	"PWZ1.0/internal/tools/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDInterceptor берет ID запроса из метаданных x-request-id или создает новый, кладет его в контекст
+// для логов и возвращает клиенту в заголовке ответа; стоит первым, чтобы ID был во всех строках лога запроса
+func RequestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(requestIDContext(ctx), req)
+}
+
+// RequestIDStreamInterceptor то же для потоковых ручек
+func RequestIDStreamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, contextStream{ServerStream: ss, ctx: requestIDContext(ss.Context())})
+}
+
+func requestIDContext(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(logger.RequestIDHeader); len(v) > 0 {
+			id = v[0]
+		}
+	}
+	id = logger.EnsureRequestID(id)
+	// ошибка только если заголовки уже отправлены, в начале вызова такого не бывает
+	_ = grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIDHeader, id))
+	return logger.WithRequestID(ctx, id)
+}
+
+// RequestIDHandler то же для gateway: X-Request-Id из запроса или новый уходит в ответ и дальше в gRPC
+// через incomingHeader, поэтому у строк лога gateway и сервера один ID
+func RequestIDHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := logger.EnsureRequestID(r.Header.Get(logger.RequestIDHeader))
+		r.Header.Set(logger.RequestIDHeader, id)
+		w.Header().Set(logger.RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logger.WithRequestID(r.Context(), id)))
+	})
+}
@@ -0,0 +1,58 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"PWZ1.0/internal/tools/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	handler := func(ctx context.Context, _ any) (any, error) {
+		got = logger.RequestID(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(logger.RequestIDHeader, "from-client"))
+	_, err := RequestIDInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "from-client", got)
+
+	_, err = RequestIDInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Len(t, got, 36)
+}
+
+func TestRequestIDHandler(t *testing.T) {
+	t.Parallel()
+
+	var fromCtx, fromHeader string
+	handler := RequestIDHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		fromCtx = logger.RequestID(r.Context())
+		fromHeader = r.Header.Get(logger.RequestIDHeader)
+	}))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders", nil)
+	req.Header.Set("X-Request-Id", "from-client")
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "from-client", fromCtx)
+	assert.Equal(t, "from-client", fromHeader)
+	assert.Equal(t, "from-client", rec.Header().Get("X-Request-Id"))
+
+	// новый ID уходит и в ответ, и в gRPC
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/orders", nil))
+	assert.Len(t, fromCtx, 36)
+	assert.Equal(t, fromCtx, fromHeader)
+	assert.Equal(t, fromCtx, rec.Header().Get("X-Request-Id"))
+}
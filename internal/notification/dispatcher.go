@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	"PWZ1.0/internal/models"
+)
+
+// Dispatcher доставляет сообщение получателю
+type Dispatcher interface {
+	Dispatch(ctx context.Context, n models.Notification) error
+}
+
+// LogDispatcher пишет сообщения в лог вместо реальной доставки
+type LogDispatcher struct{}
+
+func NewLogDispatcher() *LogDispatcher {
+	return &LogDispatcher{}
+}
+
+func (d *LogDispatcher) Dispatch(ctx context.Context, n models.Notification) error {
+	slog.InfoContext(ctx, "notification", "id", n.ID, "priority", n.Priority, "user_id", n.UserID, "title", n.Title, "text", n.Text, "tags", n.Tags)
+	return nil
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"fmt"
+
+	"PWZ1.0/internal/models"
+)
+
+// OrderArrived сообщение клиенту о поступлении заказа в ПВЗ
+func OrderArrived(order models.Order) models.Notification {
+	return models.Notification{
+		UserID:   order.UserID,
+		OrderID:  order.ID,
+		Kind:     models.NotificationKindOrderArrived,
+		Title:    "Заказ прибыл",
+		Text:     fmt.Sprintf("Ваш заказ %d прибыл в пункт выдачи и ждет вас до %s", order.ID, order.ExpiresAt.Format("02.01.2006")),
+		Tags:     []string{"order"},
+		Priority: models.PriorityDefault,
+	}
+}
+
+// StorageExpires напоминание о том, что срок хранения заказа скоро истечет
+func StorageExpires(order models.Order) models.Notification {
+	return models.Notification{
+		UserID:   order.UserID,
+		OrderID:  order.ID,
+		Kind:     models.NotificationKindStorageExpires,
+		Title:    "Срок хранения истекает",
+		Text:     fmt.Sprintf("Срок хранения заказа %d истекает %s, заберите его", order.ID, order.ExpiresAt.Format("02.01.2006 15:04")),
+		Tags:     []string{"order", "reminder"},
+		Priority: models.PriorityHigh,
+	}
+}
@@ -0,0 +1,450 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/notification.Enqueuer -o enqueuer_mock.go -n EnqueuerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"PWZ1.0/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+)
+
+// EnqueuerMock implements mm_notification.Enqueuer
+type EnqueuerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcEnqueueTx          func(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error)
+	funcEnqueueTxOrigin    string
+	inspectFuncEnqueueTx   func(ctx context.Context, tx pgx.Tx, n models.Notification)
+	afterEnqueueTxCounter  uint64
+	beforeEnqueueTxCounter uint64
+	EnqueueTxMock          mEnqueuerMockEnqueueTx
+}
+
+// NewEnqueuerMock returns a mock for mm_notification.Enqueuer
+func NewEnqueuerMock(t minimock.Tester) *EnqueuerMock {
+	m := &EnqueuerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.EnqueueTxMock = mEnqueuerMockEnqueueTx{mock: m}
+	m.EnqueueTxMock.callArgs = []*EnqueuerMockEnqueueTxParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEnqueuerMockEnqueueTx struct {
+	optional           bool
+	mock               *EnqueuerMock
+	defaultExpectation *EnqueuerMockEnqueueTxExpectation
+	expectations       []*EnqueuerMockEnqueueTxExpectation
+
+	callArgs []*EnqueuerMockEnqueueTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EnqueuerMockEnqueueTxExpectation specifies expectation struct of the Enqueuer.EnqueueTx
+type EnqueuerMockEnqueueTxExpectation struct {
+	mock               *EnqueuerMock
+	params             *EnqueuerMockEnqueueTxParams
+	paramPtrs          *EnqueuerMockEnqueueTxParamPtrs
+	expectationOrigins EnqueuerMockEnqueueTxExpectationOrigins
+	results            *EnqueuerMockEnqueueTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EnqueuerMockEnqueueTxParams contains parameters of the Enqueuer.EnqueueTx
+type EnqueuerMockEnqueueTxParams struct {
+	ctx context.Context
+	tx  pgx.Tx
+	n   models.Notification
+}
+
+// EnqueuerMockEnqueueTxParamPtrs contains pointers to parameters of the Enqueuer.EnqueueTx
+type EnqueuerMockEnqueueTxParamPtrs struct {
+	ctx *context.Context
+	tx  *pgx.Tx
+	n   *models.Notification
+}
+
+// EnqueuerMockEnqueueTxResults contains results of the Enqueuer.EnqueueTx
+type EnqueuerMockEnqueueTxResults struct {
+	u1  uint32
+	err error
+}
+
+// EnqueuerMockEnqueueTxOrigins contains origins of expectations of the Enqueuer.EnqueueTx
+type EnqueuerMockEnqueueTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originTx  string
+	originN   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Optional() *mEnqueuerMockEnqueueTx {
+	mmEnqueueTx.optional = true
+	return mmEnqueueTx
+}
+
+// Expect sets up expected params for Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Expect(ctx context.Context, tx pgx.Tx, n models.Notification) *mEnqueuerMockEnqueueTx {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	if mmEnqueueTx.defaultExpectation == nil {
+		mmEnqueueTx.defaultExpectation = &EnqueuerMockEnqueueTxExpectation{}
+	}
+
+	if mmEnqueueTx.defaultExpectation.paramPtrs != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by ExpectParams functions")
+	}
+
+	mmEnqueueTx.defaultExpectation.params = &EnqueuerMockEnqueueTxParams{ctx, tx, n}
+	mmEnqueueTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEnqueueTx.expectations {
+		if minimock.Equal(e.params, mmEnqueueTx.defaultExpectation.params) {
+			mmEnqueueTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEnqueueTx.defaultExpectation.params)
+		}
+	}
+
+	return mmEnqueueTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) ExpectCtxParam1(ctx context.Context) *mEnqueuerMockEnqueueTx {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	if mmEnqueueTx.defaultExpectation == nil {
+		mmEnqueueTx.defaultExpectation = &EnqueuerMockEnqueueTxExpectation{}
+	}
+
+	if mmEnqueueTx.defaultExpectation.params != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Expect")
+	}
+
+	if mmEnqueueTx.defaultExpectation.paramPtrs == nil {
+		mmEnqueueTx.defaultExpectation.paramPtrs = &EnqueuerMockEnqueueTxParamPtrs{}
+	}
+	mmEnqueueTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEnqueueTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEnqueueTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) ExpectTxParam2(tx pgx.Tx) *mEnqueuerMockEnqueueTx {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	if mmEnqueueTx.defaultExpectation == nil {
+		mmEnqueueTx.defaultExpectation = &EnqueuerMockEnqueueTxExpectation{}
+	}
+
+	if mmEnqueueTx.defaultExpectation.params != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Expect")
+	}
+
+	if mmEnqueueTx.defaultExpectation.paramPtrs == nil {
+		mmEnqueueTx.defaultExpectation.paramPtrs = &EnqueuerMockEnqueueTxParamPtrs{}
+	}
+	mmEnqueueTx.defaultExpectation.paramPtrs.tx = &tx
+	mmEnqueueTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmEnqueueTx
+}
+
+// ExpectNParam3 sets up expected param n for Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) ExpectNParam3(n models.Notification) *mEnqueuerMockEnqueueTx {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	if mmEnqueueTx.defaultExpectation == nil {
+		mmEnqueueTx.defaultExpectation = &EnqueuerMockEnqueueTxExpectation{}
+	}
+
+	if mmEnqueueTx.defaultExpectation.params != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Expect")
+	}
+
+	if mmEnqueueTx.defaultExpectation.paramPtrs == nil {
+		mmEnqueueTx.defaultExpectation.paramPtrs = &EnqueuerMockEnqueueTxParamPtrs{}
+	}
+	mmEnqueueTx.defaultExpectation.paramPtrs.n = &n
+	mmEnqueueTx.defaultExpectation.expectationOrigins.originN = minimock.CallerInfo(1)
+
+	return mmEnqueueTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Inspect(f func(ctx context.Context, tx pgx.Tx, n models.Notification)) *mEnqueuerMockEnqueueTx {
+	if mmEnqueueTx.mock.inspectFuncEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("Inspect function is already set for EnqueuerMock.EnqueueTx")
+	}
+
+	mmEnqueueTx.mock.inspectFuncEnqueueTx = f
+
+	return mmEnqueueTx
+}
+
+// Return sets up results that will be returned by Enqueuer.EnqueueTx
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Return(u1 uint32, err error) *EnqueuerMock {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	if mmEnqueueTx.defaultExpectation == nil {
+		mmEnqueueTx.defaultExpectation = &EnqueuerMockEnqueueTxExpectation{mock: mmEnqueueTx.mock}
+	}
+	mmEnqueueTx.defaultExpectation.results = &EnqueuerMockEnqueueTxResults{u1, err}
+	mmEnqueueTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEnqueueTx.mock
+}
+
+// Set uses given function f to mock the Enqueuer.EnqueueTx method
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Set(f func(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error)) *EnqueuerMock {
+	if mmEnqueueTx.defaultExpectation != nil {
+		mmEnqueueTx.mock.t.Fatalf("Default expectation is already set for the Enqueuer.EnqueueTx method")
+	}
+
+	if len(mmEnqueueTx.expectations) > 0 {
+		mmEnqueueTx.mock.t.Fatalf("Some expectations are already set for the Enqueuer.EnqueueTx method")
+	}
+
+	mmEnqueueTx.mock.funcEnqueueTx = f
+	mmEnqueueTx.mock.funcEnqueueTxOrigin = minimock.CallerInfo(1)
+	return mmEnqueueTx.mock
+}
+
+// When sets expectation for the Enqueuer.EnqueueTx which will trigger the result defined by the following
+// Then helper
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) When(ctx context.Context, tx pgx.Tx, n models.Notification) *EnqueuerMockEnqueueTxExpectation {
+	if mmEnqueueTx.mock.funcEnqueueTx != nil {
+		mmEnqueueTx.mock.t.Fatalf("EnqueuerMock.EnqueueTx mock is already set by Set")
+	}
+
+	expectation := &EnqueuerMockEnqueueTxExpectation{
+		mock:               mmEnqueueTx.mock,
+		params:             &EnqueuerMockEnqueueTxParams{ctx, tx, n},
+		expectationOrigins: EnqueuerMockEnqueueTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEnqueueTx.expectations = append(mmEnqueueTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Enqueuer.EnqueueTx return parameters for the expectation previously defined by the When method
+func (e *EnqueuerMockEnqueueTxExpectation) Then(u1 uint32, err error) *EnqueuerMock {
+	e.results = &EnqueuerMockEnqueueTxResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Enqueuer.EnqueueTx should be invoked
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Times(n uint64) *mEnqueuerMockEnqueueTx {
+	if n == 0 {
+		mmEnqueueTx.mock.t.Fatalf("Times of EnqueuerMock.EnqueueTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEnqueueTx.expectedInvocations, n)
+	mmEnqueueTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEnqueueTx
+}
+
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) invocationsDone() bool {
+	if len(mmEnqueueTx.expectations) == 0 && mmEnqueueTx.defaultExpectation == nil && mmEnqueueTx.mock.funcEnqueueTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEnqueueTx.mock.afterEnqueueTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEnqueueTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EnqueueTx implements mm_notification.Enqueuer
+func (mmEnqueueTx *EnqueuerMock) EnqueueTx(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmEnqueueTx.beforeEnqueueTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmEnqueueTx.afterEnqueueTxCounter, 1)
+
+	mmEnqueueTx.t.Helper()
+
+	if mmEnqueueTx.inspectFuncEnqueueTx != nil {
+		mmEnqueueTx.inspectFuncEnqueueTx(ctx, tx, n)
+	}
+
+	mm_params := EnqueuerMockEnqueueTxParams{ctx, tx, n}
+
+	// Record call args
+	mmEnqueueTx.EnqueueTxMock.mutex.Lock()
+	mmEnqueueTx.EnqueueTxMock.callArgs = append(mmEnqueueTx.EnqueueTxMock.callArgs, &mm_params)
+	mmEnqueueTx.EnqueueTxMock.mutex.Unlock()
+
+	for _, e := range mmEnqueueTx.EnqueueTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmEnqueueTx.EnqueueTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEnqueueTx.EnqueueTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmEnqueueTx.EnqueueTxMock.defaultExpectation.params
+		mm_want_ptrs := mmEnqueueTx.EnqueueTxMock.defaultExpectation.paramPtrs
+
+		mm_got := EnqueuerMockEnqueueTxParams{ctx, tx, n}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEnqueueTx.t.Errorf("EnqueuerMock.EnqueueTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEnqueueTx.EnqueueTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmEnqueueTx.t.Errorf("EnqueuerMock.EnqueueTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEnqueueTx.EnqueueTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.n != nil && !minimock.Equal(*mm_want_ptrs.n, mm_got.n) {
+				mmEnqueueTx.t.Errorf("EnqueuerMock.EnqueueTx got unexpected parameter n, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEnqueueTx.EnqueueTxMock.defaultExpectation.expectationOrigins.originN, *mm_want_ptrs.n, mm_got.n, minimock.Diff(*mm_want_ptrs.n, mm_got.n))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEnqueueTx.t.Errorf("EnqueuerMock.EnqueueTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEnqueueTx.EnqueueTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEnqueueTx.EnqueueTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEnqueueTx.t.Fatal("No results are set for the EnqueuerMock.EnqueueTx")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmEnqueueTx.funcEnqueueTx != nil {
+		return mmEnqueueTx.funcEnqueueTx(ctx, tx, n)
+	}
+	mmEnqueueTx.t.Fatalf("Unexpected call to EnqueuerMock.EnqueueTx. %v %v %v", ctx, tx, n)
+	return
+}
+
+// EnqueueTxAfterCounter returns a count of finished EnqueuerMock.EnqueueTx invocations
+func (mmEnqueueTx *EnqueuerMock) EnqueueTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnqueueTx.afterEnqueueTxCounter)
+}
+
+// EnqueueTxBeforeCounter returns a count of EnqueuerMock.EnqueueTx invocations
+func (mmEnqueueTx *EnqueuerMock) EnqueueTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnqueueTx.beforeEnqueueTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to EnqueuerMock.EnqueueTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEnqueueTx *mEnqueuerMockEnqueueTx) Calls() []*EnqueuerMockEnqueueTxParams {
+	mmEnqueueTx.mutex.RLock()
+
+	argCopy := make([]*EnqueuerMockEnqueueTxParams, len(mmEnqueueTx.callArgs))
+	copy(argCopy, mmEnqueueTx.callArgs)
+
+	mmEnqueueTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEnqueueTxDone returns true if the count of the EnqueueTx invocations corresponds
+// the number of defined expectations
+func (m *EnqueuerMock) MinimockEnqueueTxDone() bool {
+	if m.EnqueueTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EnqueueTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EnqueueTxMock.invocationsDone()
+}
+
+// MinimockEnqueueTxInspect logs each unmet expectation
+func (m *EnqueuerMock) MinimockEnqueueTxInspect() {
+	for _, e := range m.EnqueueTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EnqueuerMock.EnqueueTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEnqueueTxCounter := mm_atomic.LoadUint64(&m.afterEnqueueTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EnqueueTxMock.defaultExpectation != nil && afterEnqueueTxCounter < 1 {
+		if m.EnqueueTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EnqueuerMock.EnqueueTx at\n%s", m.EnqueueTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EnqueuerMock.EnqueueTx at\n%s with params: %#v", m.EnqueueTxMock.defaultExpectation.expectationOrigins.origin, *m.EnqueueTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEnqueueTx != nil && afterEnqueueTxCounter < 1 {
+		m.t.Errorf("Expected call to EnqueuerMock.EnqueueTx at\n%s", m.funcEnqueueTxOrigin)
+	}
+
+	if !m.EnqueueTxMock.invocationsDone() && afterEnqueueTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to EnqueuerMock.EnqueueTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EnqueueTxMock.expectedInvocations), m.EnqueueTxMock.expectedInvocationsOrigin, afterEnqueueTxCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EnqueuerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockEnqueueTxInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EnqueuerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EnqueuerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEnqueueTxDone()
+}
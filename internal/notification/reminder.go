@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/storage"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExpiryReminder периодически ставит в очередь напоминания о заказах, срок хранения которых скоро истечет
+type ExpiryReminder struct {
+	storage  storage.NotificationStorage
+	service  Service
+	interval time.Duration
+	ahead    time.Duration
+}
+
+func NewExpiryReminder(storage storage.NotificationStorage, service Service, interval, ahead time.Duration) *ExpiryReminder {
+	return &ExpiryReminder{
+		storage:  storage,
+		service:  service,
+		interval: interval,
+		ahead:    ahead,
+	}
+}
+
+func (r *ExpiryReminder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Remind(ctx); err != nil {
+			slog.ErrorContext(ctx, "expiry reminder failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remind возвращает количество новых напоминаний, уже поставленные повторно не создаются
+func (r *ExpiryReminder) Remind(ctx context.Context) (int, error) {
+	orders, err := r.storage.ListExpiringOrders(ctx, time.Now().Add(r.ahead))
+	if err != nil {
+		return 0, err
+	}
+
+	var queued int
+	for _, o := range orders {
+		err := r.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			id, err := r.service.EnqueueTx(ctx, tx, StorageExpires(o))
+			if id != 0 {
+				queued++
+			}
+			return err
+		})
+		if err != nil {
+			return queued, err
+		}
+	}
+
+	if queued > 0 {
+		slog.InfoContext(ctx, "expiry reminder: reminders queued", "count", queued)
+	}
+	return queued, nil
+}
@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/outbox"
+	"PWZ1.0/internal/storage"
+)
+
+type SchedulerConfig struct {
+	BatchSize    int
+	PollInterval time.Duration
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	// Lease на сколько пачка закрепляется за экземпляром, должна покрывать отправку всей пачки
+	Lease time.Duration
+}
+
+func DefaultSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		BatchSize:    50,
+		PollInterval: time.Second,
+		MaxAttempts:  3,
+		RetryBackoff: 10 * time.Second,
+		Lease:        5 * time.Minute,
+	}
+}
+
+// Scheduler отправляет сообщения, у которых прошла задержка, начиная с самого высокого приоритета
+type Scheduler struct {
+	storage    storage.NotificationStorage
+	dispatcher Dispatcher
+	cfg        SchedulerConfig
+}
+
+func NewScheduler(storage storage.NotificationStorage, dispatcher Dispatcher, cfg SchedulerConfig) *Scheduler {
+	return &Scheduler{
+		storage:    storage,
+		dispatcher: dispatcher,
+		cfg:        cfg,
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) error {
+	slog.InfoContext(ctx, "notification scheduler started", "batch", s.cfg.BatchSize, "interval", s.cfg.PollInterval)
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.DispatchDue(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "notification scheduler: dispatch failed", "err", err)
+		}
+		if err == nil && n == s.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "notification scheduler stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue отправляет одну пачку готовых сообщений, возвращает её размер.
+// Пачка берется в аренду без долгой транзакции, каждое сообщение отмечается отдельно после отправки
+func (s *Scheduler) DispatchDue(ctx context.Context) (int, error) {
+	batch, err := s.storage.ClaimDueNotifications(ctx, s.cfg.BatchSize, s.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range batch {
+		dispatchErr := s.dispatcher.Dispatch(ctx, n)
+		if dispatchErr == nil {
+			// не отмеченное сообщение после аренды отправится повторно
+			if err := s.storage.MarkNotificationSent(ctx, n.ID); err != nil {
+				return len(batch), err
+			}
+			continue
+		}
+
+		var retryAt *time.Time
+		if attempt := n.Attempts + 1; attempt < s.cfg.MaxAttempts {
+			t := time.Now().Add(outbox.Backoff(attempt, s.cfg.RetryBackoff, time.Hour))
+			retryAt = &t
+		}
+		slog.WarnContext(ctx, "notification scheduler: dispatch attempt failed", "id", n.ID, "attempt", n.Attempts+1, "err", dispatchErr)
+
+		if err := s.storage.MarkNotificationFailed(ctx, n.ID, dispatchErr.Error(), retryAt); err != nil {
+			return len(batch), err
+		}
+	}
+	return len(batch), nil
+}
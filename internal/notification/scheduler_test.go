@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDispatcher struct {
+	sent []uint32
+	err  error
+}
+
+func (d *recordingDispatcher) Dispatch(_ context.Context, n models.Notification) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.sent = append(d.sent, n.ID)
+	return nil
+}
+
+func newSchedulerStorage(t *testing.T, batch []models.Notification) *mocks.NotificationStorageMock {
+	m := mocks.NewNotificationStorageMock(t)
+	m.ClaimDueNotificationsMock.Expect(minimock.AnyContext, DefaultSchedulerConfig().BatchSize, DefaultSchedulerConfig().Lease).Return(batch, nil)
+	return m
+}
+
+func TestScheduler_DispatchDue_Sent(t *testing.T) {
+	t.Parallel()
+
+	batch := []models.Notification{
+		{ID: 2, Priority: models.PriorityMax},
+		{ID: 1, Priority: models.PriorityLow},
+	}
+	storage := newSchedulerStorage(t, batch)
+
+	var marked []uint32
+	storage.MarkNotificationSentMock.Set(func(ctx context.Context, id uint32) error {
+		marked = append(marked, id)
+		return nil
+	})
+
+	dispatcher := &recordingDispatcher{}
+	s := NewScheduler(storage, dispatcher, DefaultSchedulerConfig())
+
+	n, err := s.DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	// порядок задаёт выборка из хранилища: сначала высокий приоритет
+	assert.Equal(t, []uint32{2, 1}, dispatcher.sent)
+	assert.Equal(t, []uint32{2, 1}, marked)
+}
+
+func TestScheduler_DispatchDue_Failed(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  int
+		wantRetry bool
+	}{
+		{"first failure is retried", 0, true},
+		{"last attempt marks failed", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := newSchedulerStorage(t, []models.Notification{{ID: 7, Attempts: tt.attempts}})
+			storage.MarkNotificationFailedMock.Set(func(ctx context.Context, id uint32, errText string, retryAt *time.Time) error {
+				assert.Equal(t, uint32(7), id)
+				assert.Equal(t, "smtp is down", errText)
+				if tt.wantRetry {
+					require.NotNil(t, retryAt)
+					assert.True(t, retryAt.After(time.Now()))
+				} else {
+					assert.Nil(t, retryAt)
+				}
+				return nil
+			})
+
+			s := NewScheduler(storage, &recordingDispatcher{err: errors.New("smtp is down")}, DefaultSchedulerConfig())
+
+			n, err := s.DispatchDue(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
+
+func TestService_Send(t *testing.T) {
+	t.Parallel()
+
+	storage := mocks.NewNotificationStorageMock(t)
+	storage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	storage.SaveNotificationTxMock.Set(func(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error) {
+		assert.Equal(t, models.NotificationKindManual, n.Kind)
+		assert.Equal(t, models.NotificationPending, n.Status)
+		assert.Equal(t, models.PriorityDefault, n.Priority)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), n.ScheduledAt, time.Second)
+		return 5, nil
+	})
+
+	id, err := NewService(storage).Send(context.Background(), models.Notification{Text: "hello"}, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), id)
+}
@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage"
+	"github.com/jackc/pgx/v5"
+)
+
+// Enqueuer ставит сообщение в очередь в рамках чужой транзакции, например при приёмке заказа
+type Enqueuer interface {
+	EnqueueTx(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error)
+}
+
+type Service interface {
+	Enqueuer
+	Send(ctx context.Context, n models.Notification, delay time.Duration) (uint32, error)
+	Status(ctx context.Context, id uint32) (models.Notification, error)
+	Cancel(ctx context.Context, id uint32) (models.Notification, error)
+}
+
+type notificationService struct {
+	storage storage.NotificationStorage
+}
+
+func NewService(storage storage.NotificationStorage) Service {
+	return &notificationService{storage: storage}
+}
+
+func (s *notificationService) Send(ctx context.Context, n models.Notification, delay time.Duration) (uint32, error) {
+	if delay < 0 {
+		delay = 0
+	}
+	if n.Kind == "" {
+		n.Kind = models.NotificationKindManual
+	}
+	n.ScheduledAt = time.Now().Add(delay)
+
+	var id uint32
+	err := s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var err error
+		id, err = s.EnqueueTx(ctx, tx, n)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "notification queued", "id", id, "priority", n.Priority, "scheduled_at", n.ScheduledAt)
+	return id, nil
+}
+
+func (s *notificationService) EnqueueTx(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error) {
+	if n.Priority < models.PriorityMin || n.Priority > models.PriorityMax {
+		n.Priority = models.PriorityDefault
+	}
+	if n.ScheduledAt.IsZero() {
+		n.ScheduledAt = time.Now()
+	}
+	n.Status = models.NotificationPending
+
+	return s.storage.SaveNotificationTx(ctx, tx, n)
+}
+
+func (s *notificationService) Status(ctx context.Context, id uint32) (models.Notification, error) {
+	return s.storage.GetNotification(ctx, id)
+}
+
+func (s *notificationService) Cancel(ctx context.Context, id uint32) (models.Notification, error) {
+	n, err := s.storage.CancelNotification(ctx, id)
+	if err != nil {
+		return n, err
+	}
+
+	slog.InfoContext(ctx, "notification cancelled", "id", id)
+	return n, nil
+}
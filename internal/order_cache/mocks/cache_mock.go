@@ -0,0 +1,1158 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/order_cache.Cache -o cache_mock.go -n CacheMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CacheMock implements mm_order_cache.Cache
+type CacheMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, key string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, key string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mCacheMockDelete
+
+	funcGet          func(ctx context.Context, key string) (s1 string, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, key string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mCacheMockGet
+
+	funcSet          func(ctx context.Context, key string, value string) (err error)
+	funcSetOrigin    string
+	inspectFuncSet   func(ctx context.Context, key string, value string)
+	afterSetCounter  uint64
+	beforeSetCounter uint64
+	SetMock          mCacheMockSet
+}
+
+// NewCacheMock returns a mock for mm_order_cache.Cache
+func NewCacheMock(t minimock.Tester) *CacheMock {
+	m := &CacheMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mCacheMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*CacheMockDeleteParams{}
+
+	m.GetMock = mCacheMockGet{mock: m}
+	m.GetMock.callArgs = []*CacheMockGetParams{}
+
+	m.SetMock = mCacheMockSet{mock: m}
+	m.SetMock.callArgs = []*CacheMockSetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCacheMockDelete struct {
+	optional           bool
+	mock               *CacheMock
+	defaultExpectation *CacheMockDeleteExpectation
+	expectations       []*CacheMockDeleteExpectation
+
+	callArgs []*CacheMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheMockDeleteExpectation specifies expectation struct of the Cache.Delete
+type CacheMockDeleteExpectation struct {
+	mock               *CacheMock
+	params             *CacheMockDeleteParams
+	paramPtrs          *CacheMockDeleteParamPtrs
+	expectationOrigins CacheMockDeleteExpectationOrigins
+	results            *CacheMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheMockDeleteParams contains parameters of the Cache.Delete
+type CacheMockDeleteParams struct {
+	ctx context.Context
+	key string
+}
+
+// CacheMockDeleteParamPtrs contains pointers to parameters of the Cache.Delete
+type CacheMockDeleteParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// CacheMockDeleteResults contains results of the Cache.Delete
+type CacheMockDeleteResults struct {
+	err error
+}
+
+// CacheMockDeleteOrigins contains origins of expectations of the Cache.Delete
+type CacheMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mCacheMockDelete) Optional() *mCacheMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for Cache.Delete
+func (mmDelete *mCacheMockDelete) Expect(ctx context.Context, key string) *mCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &CacheMockDeleteParams{ctx, key}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Cache.Delete
+func (mmDelete *mCacheMockDelete) ExpectCtxParam1(ctx context.Context) *mCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &CacheMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectKeyParam2 sets up expected param key for Cache.Delete
+func (mmDelete *mCacheMockDelete) ExpectKeyParam2(key string) *mCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &CacheMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.key = &key
+	mmDelete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.Delete
+func (mmDelete *mCacheMockDelete) Inspect(f func(ctx context.Context, key string)) *mCacheMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for CacheMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by Cache.Delete
+func (mmDelete *mCacheMockDelete) Return(err error) *CacheMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &CacheMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &CacheMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the Cache.Delete method
+func (mmDelete *mCacheMockDelete) Set(f func(ctx context.Context, key string) (err error)) *CacheMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the Cache.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the Cache.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the Cache.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mCacheMockDelete) When(ctx context.Context, key string) *CacheMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("CacheMock.Delete mock is already set by Set")
+	}
+
+	expectation := &CacheMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &CacheMockDeleteParams{ctx, key},
+		expectationOrigins: CacheMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.Delete return parameters for the expectation previously defined by the When method
+func (e *CacheMockDeleteExpectation) Then(err error) *CacheMock {
+	e.results = &CacheMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times Cache.Delete should be invoked
+func (mmDelete *mCacheMockDelete) Times(n uint64) *mCacheMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of CacheMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mCacheMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_order_cache.Cache
+func (mmDelete *CacheMock) Delete(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, key)
+	}
+
+	mm_params := CacheMockDeleteParams{ctx, key}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheMockDeleteParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("CacheMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDelete.t.Errorf("CacheMock.Delete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("CacheMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the CacheMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, key)
+	}
+	mmDelete.t.Fatalf("Unexpected call to CacheMock.Delete. %v %v", ctx, key)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished CacheMock.Delete invocations
+func (mmDelete *CacheMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of CacheMock.Delete invocations
+func (mmDelete *CacheMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mCacheMockDelete) Calls() []*CacheMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*CacheMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *CacheMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to CacheMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mCacheMockGet struct {
+	optional           bool
+	mock               *CacheMock
+	defaultExpectation *CacheMockGetExpectation
+	expectations       []*CacheMockGetExpectation
+
+	callArgs []*CacheMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheMockGetExpectation specifies expectation struct of the Cache.Get
+type CacheMockGetExpectation struct {
+	mock               *CacheMock
+	params             *CacheMockGetParams
+	paramPtrs          *CacheMockGetParamPtrs
+	expectationOrigins CacheMockGetExpectationOrigins
+	results            *CacheMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheMockGetParams contains parameters of the Cache.Get
+type CacheMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// CacheMockGetParamPtrs contains pointers to parameters of the Cache.Get
+type CacheMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// CacheMockGetResults contains results of the Cache.Get
+type CacheMockGetResults struct {
+	s1  string
+	err error
+}
+
+// CacheMockGetOrigins contains origins of expectations of the Cache.Get
+type CacheMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mCacheMockGet) Optional() *mCacheMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Cache.Get
+func (mmGet *mCacheMockGet) Expect(ctx context.Context, key string) *mCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &CacheMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Cache.Get
+func (mmGet *mCacheMockGet) ExpectCtxParam1(ctx context.Context) *mCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for Cache.Get
+func (mmGet *mCacheMockGet) ExpectKeyParam2(key string) *mCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.Get
+func (mmGet *mCacheMockGet) Inspect(f func(ctx context.Context, key string)) *mCacheMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for CacheMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Cache.Get
+func (mmGet *mCacheMockGet) Return(s1 string, err error) *CacheMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CacheMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &CacheMockGetResults{s1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Cache.Get method
+func (mmGet *mCacheMockGet) Set(f func(ctx context.Context, key string) (s1 string, err error)) *CacheMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Cache.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Cache.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Cache.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mCacheMockGet) When(ctx context.Context, key string) *CacheMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CacheMock.Get mock is already set by Set")
+	}
+
+	expectation := &CacheMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &CacheMockGetParams{ctx, key},
+		expectationOrigins: CacheMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.Get return parameters for the expectation previously defined by the When method
+func (e *CacheMockGetExpectation) Then(s1 string, err error) *CacheMock {
+	e.results = &CacheMockGetResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Cache.Get should be invoked
+func (mmGet *mCacheMockGet) Times(n uint64) *mCacheMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of CacheMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mCacheMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_order_cache.Cache
+func (mmGet *CacheMock) Get(ctx context.Context, key string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := CacheMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("CacheMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("CacheMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("CacheMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the CacheMock.Get")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to CacheMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished CacheMock.Get invocations
+func (mmGet *CacheMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of CacheMock.Get invocations
+func (mmGet *CacheMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mCacheMockGet) Calls() []*CacheMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*CacheMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *CacheMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to CacheMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mCacheMockSet struct {
+	optional           bool
+	mock               *CacheMock
+	defaultExpectation *CacheMockSetExpectation
+	expectations       []*CacheMockSetExpectation
+
+	callArgs []*CacheMockSetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CacheMockSetExpectation specifies expectation struct of the Cache.Set
+type CacheMockSetExpectation struct {
+	mock               *CacheMock
+	params             *CacheMockSetParams
+	paramPtrs          *CacheMockSetParamPtrs
+	expectationOrigins CacheMockSetExpectationOrigins
+	results            *CacheMockSetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CacheMockSetParams contains parameters of the Cache.Set
+type CacheMockSetParams struct {
+	ctx   context.Context
+	key   string
+	value string
+}
+
+// CacheMockSetParamPtrs contains pointers to parameters of the Cache.Set
+type CacheMockSetParamPtrs struct {
+	ctx   *context.Context
+	key   *string
+	value *string
+}
+
+// CacheMockSetResults contains results of the Cache.Set
+type CacheMockSetResults struct {
+	err error
+}
+
+// CacheMockSetOrigins contains origins of expectations of the Cache.Set
+type CacheMockSetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originValue string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSet *mCacheMockSet) Optional() *mCacheMockSet {
+	mmSet.optional = true
+	return mmSet
+}
+
+// Expect sets up expected params for Cache.Set
+func (mmSet *mCacheMockSet) Expect(ctx context.Context, key string, value string) *mCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &CacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.paramPtrs != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by ExpectParams functions")
+	}
+
+	mmSet.defaultExpectation.params = &CacheMockSetParams{ctx, key, value}
+	mmSet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSet.expectations {
+		if minimock.Equal(e.params, mmSet.defaultExpectation.params) {
+			mmSet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSet.defaultExpectation.params)
+		}
+	}
+
+	return mmSet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Cache.Set
+func (mmSet *mCacheMockSet) ExpectCtxParam1(ctx context.Context) *mCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &CacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &CacheMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// ExpectKeyParam2 sets up expected param key for Cache.Set
+func (mmSet *mCacheMockSet) ExpectKeyParam2(key string) *mCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &CacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &CacheMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.key = &key
+	mmSet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// ExpectValueParam3 sets up expected param value for Cache.Set
+func (mmSet *mCacheMockSet) ExpectValueParam3(value string) *mCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &CacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &CacheMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.value = &value
+	mmSet.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmSet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Cache.Set
+func (mmSet *mCacheMockSet) Inspect(f func(ctx context.Context, key string, value string)) *mCacheMockSet {
+	if mmSet.mock.inspectFuncSet != nil {
+		mmSet.mock.t.Fatalf("Inspect function is already set for CacheMock.Set")
+	}
+
+	mmSet.mock.inspectFuncSet = f
+
+	return mmSet
+}
+
+// Return sets up results that will be returned by Cache.Set
+func (mmSet *mCacheMockSet) Return(err error) *CacheMock {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &CacheMockSetExpectation{mock: mmSet.mock}
+	}
+	mmSet.defaultExpectation.results = &CacheMockSetResults{err}
+	mmSet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSet.mock
+}
+
+// Set uses given function f to mock the Cache.Set method
+func (mmSet *mCacheMockSet) Set(f func(ctx context.Context, key string, value string) (err error)) *CacheMock {
+	if mmSet.defaultExpectation != nil {
+		mmSet.mock.t.Fatalf("Default expectation is already set for the Cache.Set method")
+	}
+
+	if len(mmSet.expectations) > 0 {
+		mmSet.mock.t.Fatalf("Some expectations are already set for the Cache.Set method")
+	}
+
+	mmSet.mock.funcSet = f
+	mmSet.mock.funcSetOrigin = minimock.CallerInfo(1)
+	return mmSet.mock
+}
+
+// When sets expectation for the Cache.Set which will trigger the result defined by the following
+// Then helper
+func (mmSet *mCacheMockSet) When(ctx context.Context, key string, value string) *CacheMockSetExpectation {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("CacheMock.Set mock is already set by Set")
+	}
+
+	expectation := &CacheMockSetExpectation{
+		mock:               mmSet.mock,
+		params:             &CacheMockSetParams{ctx, key, value},
+		expectationOrigins: CacheMockSetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSet.expectations = append(mmSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Cache.Set return parameters for the expectation previously defined by the When method
+func (e *CacheMockSetExpectation) Then(err error) *CacheMock {
+	e.results = &CacheMockSetResults{err}
+	return e.mock
+}
+
+// Times sets number of times Cache.Set should be invoked
+func (mmSet *mCacheMockSet) Times(n uint64) *mCacheMockSet {
+	if n == 0 {
+		mmSet.mock.t.Fatalf("Times of CacheMock.Set mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSet.expectedInvocations, n)
+	mmSet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSet
+}
+
+func (mmSet *mCacheMockSet) invocationsDone() bool {
+	if len(mmSet.expectations) == 0 && mmSet.defaultExpectation == nil && mmSet.mock.funcSet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSet.mock.afterSetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Set implements mm_order_cache.Cache
+func (mmSet *CacheMock) Set(ctx context.Context, key string, value string) (err error) {
+	mm_atomic.AddUint64(&mmSet.beforeSetCounter, 1)
+	defer mm_atomic.AddUint64(&mmSet.afterSetCounter, 1)
+
+	mmSet.t.Helper()
+
+	if mmSet.inspectFuncSet != nil {
+		mmSet.inspectFuncSet(ctx, key, value)
+	}
+
+	mm_params := CacheMockSetParams{ctx, key, value}
+
+	// Record call args
+	mmSet.SetMock.mutex.Lock()
+	mmSet.SetMock.callArgs = append(mmSet.SetMock.callArgs, &mm_params)
+	mmSet.SetMock.mutex.Unlock()
+
+	for _, e := range mmSet.SetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSet.SetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSet.SetMock.defaultExpectation.Counter, 1)
+		mm_want := mmSet.SetMock.defaultExpectation.params
+		mm_want_ptrs := mmSet.SetMock.defaultExpectation.paramPtrs
+
+		mm_got := CacheMockSetParams{ctx, key, value}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSet.t.Errorf("CacheMock.Set got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSet.t.Errorf("CacheMock.Set got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmSet.t.Errorf("CacheMock.Set got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSet.SetMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSet.t.Errorf("CacheMock.Set got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSet.SetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSet.SetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSet.t.Fatal("No results are set for the CacheMock.Set")
+		}
+		return (*mm_results).err
+	}
+	if mmSet.funcSet != nil {
+		return mmSet.funcSet(ctx, key, value)
+	}
+	mmSet.t.Fatalf("Unexpected call to CacheMock.Set. %v %v %v", ctx, key, value)
+	return
+}
+
+// SetAfterCounter returns a count of finished CacheMock.Set invocations
+func (mmSet *CacheMock) SetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.afterSetCounter)
+}
+
+// SetBeforeCounter returns a count of CacheMock.Set invocations
+func (mmSet *CacheMock) SetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.beforeSetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CacheMock.Set.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSet *mCacheMockSet) Calls() []*CacheMockSetParams {
+	mmSet.mutex.RLock()
+
+	argCopy := make([]*CacheMockSetParams, len(mmSet.callArgs))
+	copy(argCopy, mmSet.callArgs)
+
+	mmSet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetDone returns true if the count of the Set invocations corresponds
+// the number of defined expectations
+func (m *CacheMock) MinimockSetDone() bool {
+	if m.SetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMock.invocationsDone()
+}
+
+// MinimockSetInspect logs each unmet expectation
+func (m *CacheMock) MinimockSetInspect() {
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CacheMock.Set at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetCounter := mm_atomic.LoadUint64(&m.afterSetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMock.defaultExpectation != nil && afterSetCounter < 1 {
+		if m.SetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CacheMock.Set at\n%s", m.SetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CacheMock.Set at\n%s with params: %#v", m.SetMock.defaultExpectation.expectationOrigins.origin, *m.SetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSet != nil && afterSetCounter < 1 {
+		m.t.Errorf("Expected call to CacheMock.Set at\n%s", m.funcSetOrigin)
+	}
+
+	if !m.SetMock.invocationsDone() && afterSetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CacheMock.Set at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMock.expectedInvocations), m.SetMock.expectedInvocationsOrigin, afterSetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CacheMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockSetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CacheMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockSetDone()
+}
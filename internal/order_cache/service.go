@@ -4,8 +4,10 @@ package order_cache
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"PWZ1.0/internal/metrics"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -26,7 +28,16 @@ func (s *OrderCacheService) Set(ctx context.Context, key string, value string) e
 }
 
 func (s *OrderCacheService) Get(ctx context.Context, key string) (string, error) {
-	return s.client.Get(ctx, key).Result()
+	value, err := s.client.Get(ctx, key).Result()
+	switch {
+	case err == nil:
+		metrics.CacheRequests.WithLabelValues(metrics.CacheHit).Inc()
+	case errors.Is(err, redis.Nil):
+		metrics.CacheRequests.WithLabelValues(metrics.CacheMiss).Inc()
+	default:
+		metrics.CacheRequests.WithLabelValues(metrics.CacheError).Inc()
+	}
+	return value, err
 }
 
 func (s *OrderCacheService) Delete(ctx context.Context, key string) error {
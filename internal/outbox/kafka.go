@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+type KafkaPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func NewKafkaPublisher(brokers []string, topic string) (*KafkaPublisher, error) {
+	cfg := sarama.NewConfig()
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.Retry.Max = 3
+	// ключ сообщения - ID заказа, события одного заказа попадают в одну партицию
+	cfg.Producer.Partitioner = sarama.NewHashPartitioner
+
+	producer, err := sarama.NewSyncProducer(brokers, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaPublisher{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
+
+func (p *KafkaPublisher) Publish(_ context.Context, msg Message) error {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   p.topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	})
+	return err
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.producer.Close()
+}
+
+// PingKafka проверяет, что брокеры отвечают и знают топик; каждый вызов открывает отдельное соединение,
+// поэтому это для редких проверок здоровья, а не для каждого сообщения
+func PingKafka(ctx context.Context, brokers []string, topic string) error {
+	cfg := sarama.NewConfig()
+	if deadline, ok := ctx.Deadline(); ok {
+		cfg.Net.DialTimeout = time.Until(deadline)
+		cfg.Metadata.Retry.Max = 0
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		client, err := sarama.NewClient(brokers, cfg)
+		if err != nil {
+			done <- err
+			return
+		}
+		defer client.Close()
+		_, err = client.Partitions(topic)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher складывает сообщения в память, используется в тестах
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+	// Err если задан, возвращается из Publish вместо сохранения сообщения
+	Err error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.Err != nil {
+		return p.Err
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]Message, len(p.messages))
+	copy(out, p.messages)
+	return out
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
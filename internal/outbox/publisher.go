@@ -0,0 +1,15 @@
+package outbox
+
+import "context"
+
+// Message сообщение для брокера, собранное из строки outbox
+type Message struct {
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
@@ -0,0 +1,187 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"PWZ1.0/internal/metrics"
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Config struct {
+	BatchSize    int
+	PollInterval time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// через сколько строка в PROCESSING считается брошенной упавшим воркером
+	StaleAfter time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		MaxAttempts:  10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   5 * time.Minute,
+		StaleAfter:   time.Minute,
+	}
+}
+
+// Relay перекладывает события из таблицы outbox в брокер
+type Relay struct {
+	storage   storage.OutboxStorage
+	publisher Publisher
+	cfg       Config
+}
+
+func NewRelay(storage storage.OutboxStorage, publisher Publisher, cfg Config) *Relay {
+	return &Relay{
+		storage:   storage,
+		publisher: publisher,
+		cfg:       cfg,
+	}
+}
+
+// Run обрабатывает outbox до отмены контекста
+func (r *Relay) Run(ctx context.Context) error {
+	slog.InfoContext(ctx, "outbox relay started", "batch", r.cfg.BatchSize, "interval", r.cfg.PollInterval)
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.ProcessBatch(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "outbox relay: batch failed", "err", err)
+		}
+		r.reportStats(ctx)
+
+		// полная пачка - скорее всего есть ещё, не ждём тикер
+		if err == nil && n == r.cfg.BatchSize {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "outbox relay stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch забирает одну пачку событий и отправляет их, возвращает размер пачки
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	batch, err := r.storage.ClaimOutboxBatch(ctx, r.cfg.BatchSize, r.cfg.MaxAttempts, r.cfg.StaleAfter)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range batch {
+		r.publish(ctx, m)
+	}
+
+	return len(batch), nil
+}
+
+// publish спан отправки продолжает трассировку запроса, записавшего событие, и передается консьюмерам в заголовках
+func (r *Relay) publish(ctx context.Context, m models.OutboxMessage) {
+	msg, parent := toMessage(m)
+	publishCtx, span := tracing.Start(tracing.Extract(ctx, parent), "outbox publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.message.id", m.ID.String()),
+			attribute.Int("outbox.attempt", m.Attempts),
+		),
+	)
+	for k, v := range tracing.Inject(publishCtx) {
+		msg.Headers[k] = v
+	}
+	err := r.publisher.Publish(publishCtx, msg)
+	tracing.End(span, err)
+	if err == nil {
+		metrics.OutboxPublished.Inc()
+		if err := r.storage.MarkOutboxCompleted(ctx, m.ID); err != nil {
+			// событие уже в брокере, после StaleAfter оно уйдёт повторно - консьюмеры дедуплицируют по event_id
+			slog.ErrorContext(publishCtx, "outbox relay: failed to mark event completed", "event_id", m.ID, "err", err)
+		}
+		return
+	}
+
+	metrics.OutboxPublishFailures.Inc()
+	if m.Attempts >= r.cfg.MaxAttempts {
+		metrics.OutboxDeadEvents.Inc()
+		slog.ErrorContext(publishCtx, "outbox relay: event gave up", "event_id", m.ID, "attempts", m.Attempts, "err", err)
+	} else {
+		slog.WarnContext(publishCtx, "outbox relay: event attempt failed", "event_id", m.ID, "attempt", m.Attempts, "err", err)
+	}
+
+	nextAttemptAt := time.Now().Add(Backoff(m.Attempts, r.cfg.BaseBackoff, r.cfg.MaxBackoff))
+	if err := r.storage.MarkOutboxFailed(ctx, m.ID, err.Error(), nextAttemptAt); err != nil {
+		slog.ErrorContext(publishCtx, "outbox relay: failed to mark event failed", "event_id", m.ID, "err", err)
+	}
+}
+
+func (r *Relay) reportStats(ctx context.Context) {
+	stats, err := r.storage.OutboxStats(ctx, r.cfg.MaxAttempts)
+	if err != nil {
+		return
+	}
+
+	lag := time.Duration(0)
+	if stats.Pending > 0 {
+		lag = time.Since(stats.OldestCreatedAt)
+	}
+	metrics.OutboxLag.Set(lag.Seconds())
+	metrics.OutboxBacklog.Set(float64(stats.Pending))
+}
+
+// Backoff экспоненциальная задержка перед попыткой attempt (с единицы), не больше max
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// toMessage сообщение и контекст трассировки из события
+func toMessage(m models.OutboxMessage) (Message, map[string]string) {
+	msg := Message{
+		Value: m.Payload,
+		Headers: map[string]string{
+			"event_id": m.ID.String(),
+		},
+	}
+
+	var event models.Event
+	if err := json.Unmarshal(m.Payload, &event); err == nil {
+		msg.Key = []byte(strconv.FormatUint(event.Order.ID, 10))
+		msg.Headers["event_type"] = event.EventType
+	}
+
+	return msg, event.TraceContext
+}
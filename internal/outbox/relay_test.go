@@ -0,0 +1,178 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/metrics"
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage/mocks"
+	"PWZ1.0/internal/tracing"
+	"PWZ1.0/internal/tracing/tracingtest"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func testConfig() Config {
+	cfg := DefaultConfig()
+	cfg.BatchSize = 10
+	cfg.MaxAttempts = 3
+	return cfg
+}
+
+func newOutboxMessage(t *testing.T, orderID uint64, attempts int) models.OutboxMessage {
+	t.Helper()
+
+	event := models.Event{
+		EventID:   uuid.New(),
+		EventType: "order_accepted",
+		Order:     models.EventOrder{ID: orderID, UserID: 1, Status: models.StatusExpects},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	return models.OutboxMessage{
+		ID:        event.EventID,
+		Payload:   payload,
+		Attempts:  attempts,
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestRelay_ProcessBatch_Published(t *testing.T) {
+	t.Parallel()
+
+	msg := newOutboxMessage(t, 42, 1)
+
+	storage := mocks.NewOutboxStorageMock(t)
+	storage.ClaimOutboxBatchMock.Return([]models.OutboxMessage{msg}, nil)
+	storage.MarkOutboxCompletedMock.Expect(context.Background(), msg.ID).Return(nil)
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(storage, publisher, testConfig())
+
+	n, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	published := publisher.Messages()
+	require.Len(t, published, 1)
+	assert.Equal(t, []byte("42"), published[0].Key)
+	assert.Equal(t, msg.Payload, published[0].Value)
+	assert.Equal(t, msg.ID.String(), published[0].Headers["event_id"])
+	assert.Equal(t, "order_accepted", published[0].Headers["event_type"])
+}
+
+func TestRelay_ProcessBatch_PublishFailed(t *testing.T) {
+	t.Parallel()
+
+	msg := newOutboxMessage(t, 42, 2)
+	cfg := testConfig()
+
+	storage := mocks.NewOutboxStorageMock(t)
+	storage.ClaimOutboxBatchMock.Return([]models.OutboxMessage{msg}, nil)
+	storage.MarkOutboxFailedMock.Set(func(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) error {
+		assert.Equal(t, msg.ID, id)
+		assert.Equal(t, "broker is down", errText)
+		assert.WithinDuration(t, time.Now().Add(Backoff(2, cfg.BaseBackoff, cfg.MaxBackoff)), nextAttemptAt, time.Second)
+		return nil
+	})
+
+	publisher := NewMemoryPublisher()
+	publisher.Err = errors.New("broker is down")
+	relay := NewRelay(storage, publisher, cfg)
+
+	n, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Empty(t, publisher.Messages())
+}
+
+func TestRelay_ProcessBatch_ClaimError(t *testing.T) {
+	t.Parallel()
+
+	storage := mocks.NewOutboxStorageMock(t)
+	storage.ClaimOutboxBatchMock.Return(nil, errors.New("db error"))
+
+	relay := NewRelay(storage, NewMemoryPublisher(), testConfig())
+
+	n, err := relay.ProcessBatch(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, n)
+}
+
+// TestRelay_Publish_ContinuesTrace спан отправки - дочерний к запросу, записавшему событие, и уходит в заголовках
+func TestRelay_Publish_ContinuesTrace(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+
+	requestCtx, request := tracing.Start(context.Background(), "request")
+	event := models.Event{
+		EventID:      uuid.New(),
+		EventType:    "order_accepted",
+		Order:        models.EventOrder{ID: 42, UserID: 1, Status: models.StatusExpects},
+		TraceContext: tracing.Inject(requestCtx),
+	}
+	request.End()
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	msg := models.OutboxMessage{ID: event.EventID, Payload: payload, Attempts: 1, CreatedAt: time.Now()}
+
+	storage := mocks.NewOutboxStorageMock(t)
+	storage.ClaimOutboxBatchMock.Return([]models.OutboxMessage{msg}, nil)
+	storage.MarkOutboxCompletedMock.Expect(context.Background(), msg.ID).Return(nil)
+
+	publisher := NewMemoryPublisher()
+	_, err = NewRelay(storage, publisher, testConfig()).ProcessBatch(context.Background())
+	require.NoError(t, err)
+
+	publish := tracingtest.Find(recorder, "outbox publish")
+	require.NotNil(t, publish)
+	assert.Equal(t, request.SpanContext().TraceID(), publish.SpanContext().TraceID())
+	assert.Equal(t, request.SpanContext().SpanID(), publish.Parent().SpanID())
+	assert.Equal(t, trace.SpanKindProducer, publish.SpanKind())
+
+	published := publisher.Messages()
+	require.Len(t, published, 1)
+	remote := trace.SpanContextFromContext(tracing.Extract(context.Background(), published[0].Headers))
+	assert.Equal(t, publish.SpanContext().SpanID(), remote.SpanID())
+	assert.Equal(t, msg.ID.String(), published[0].Headers["event_id"])
+}
+
+func TestRelay_ReportStats(t *testing.T) {
+	t.Parallel()
+
+	storage := mocks.NewOutboxStorageMock(t)
+	storage.OutboxStatsMock.Return(models.OutboxStats{Pending: 5, OldestCreatedAt: time.Now().Add(-time.Minute)}, nil)
+
+	relay := NewRelay(storage, NewMemoryPublisher(), testConfig())
+	relay.reportStats(context.Background())
+
+	assert.InDelta(t, 5, testutil.ToFloat64(metrics.OutboxBacklog), 0)
+	assert.InDelta(t, 60, testutil.ToFloat64(metrics.OutboxLag), 5)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{10, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Backoff(tt.attempt, time.Second, 30*time.Second))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package retention
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/storage"
+)
+
+const DefaultBatchSize = 1000
+
+// Purger периодически удаляет заказы, возвращенные курьеру дольше retention назад, и истекшие ключи идемпотентности
+type Purger struct {
+	storage   storage.RetentionStorage
+	interval  time.Duration
+	retention time.Duration
+	batchSize int
+}
+
+func NewPurger(storage storage.RetentionStorage, interval, retention time.Duration) *Purger {
+	return &Purger{
+		storage:   storage,
+		interval:  interval,
+		retention: retention,
+		batchSize: DefaultBatchSize,
+	}
+}
+
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Purge(ctx); err != nil {
+			slog.ErrorContext(ctx, "retention purge failed", "err", err)
+		}
+		if _, err := p.storage.PurgeExpiredIdempotencyKeys(ctx); err != nil {
+			slog.ErrorContext(ctx, "idempotency keys purge failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge удаляет пачками, пока есть что удалять, и возвращает общее количество удаленных заказов
+func (p *Purger) Purge(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-p.retention)
+
+	var total int64
+	for {
+		n, err := p.storage.PurgeDeletedOrders(ctx, before, p.batchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < int64(p.batchSize) || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, "retention purge: orders removed", "count", total, "deleted_before", before)
+	}
+	return total, nil
+}
@@ -0,0 +1,45 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurger_Purge_Batches(t *testing.T) {
+	t.Parallel()
+
+	batches := []int64{2, 2, 1}
+	m := mocks.NewRetentionStorageMock(t)
+	m.PurgeDeletedOrdersMock.Set(func(_ context.Context, before time.Time, limit int) (int64, error) {
+		assert.Equal(t, 2, limit)
+		assert.WithinDuration(t, time.Now().Add(-48*time.Hour), before, time.Minute)
+		n := batches[0]
+		batches = batches[1:]
+		return n, nil
+	})
+
+	p := NewPurger(m, time.Hour, 48*time.Hour)
+	p.batchSize = 2
+
+	total, err := p.Purge(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.Empty(t, batches)
+}
+
+func TestPurger_Purge_Error(t *testing.T) {
+	t.Parallel()
+
+	m := mocks.NewRetentionStorageMock(t)
+	m.PurgeDeletedOrdersMock.Return(0, errors.New("db down"))
+
+	total, err := NewPurger(m, time.Hour, time.Hour).Purge(context.Background())
+	assert.EqualError(t, err, "db down")
+	assert.Zero(t, total)
+}
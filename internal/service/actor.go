@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"PWZ1.0/internal/auth"
+	"PWZ1.0/internal/models"
+)
+
+// actorFromContext автор смены статуса: терминал ПВЗ или аутентифицированный пользователь;
+// без аутентификации (AUTH_DISABLED) автор неизвестен
+func actorFromContext(ctx context.Context) models.Actor {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return models.Actor{Type: models.ActorUnknown}
+	}
+
+	actor := models.Actor{ID: p.ID, Subject: p.Subject}
+	switch {
+	case p.Terminal:
+		actor.Type = models.ActorTerminal
+	case p.Role == auth.RoleCourier:
+		actor.Type = models.ActorCourier
+	case p.Role == auth.RoleOperator:
+		actor.Type = models.ActorOperator
+	case p.Role == auth.RoleAdmin:
+		actor.Type = models.ActorAdmin
+	default:
+		actor.Type = models.ActorUnknown
+	}
+	return actor
+}
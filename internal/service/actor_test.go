@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"PWZ1.0/internal/auth"
+	"PWZ1.0/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_actorFromContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want models.Actor
+	}{
+		{
+			name: "no auth",
+			ctx:  context.Background(),
+			want: models.Actor{Type: models.ActorUnknown},
+		},
+		{
+			name: "courier",
+			ctx:  auth.WithPrincipal(context.Background(), auth.Principal{Subject: "42", ID: 42, Role: auth.RoleCourier}),
+			want: models.Actor{Type: models.ActorCourier, ID: 42, Subject: "42"},
+		},
+		{
+			name: "terminal",
+			ctx:  auth.WithPrincipal(context.Background(), auth.Principal{Subject: "pvz-1", ID: 1001, Role: auth.RoleOperator, Terminal: true}),
+			want: models.Actor{Type: models.ActorTerminal, ID: 1001, Subject: "pvz-1"},
+		},
+		{
+			name: "admin",
+			ctx:  auth.WithPrincipal(context.Background(), auth.Principal{Subject: "1", ID: 1, Role: auth.RoleAdmin}),
+			want: models.Actor{Type: models.ActorAdmin, ID: 1, Subject: "1"},
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, actorFromContext(tt.ctx), tt.name)
+	}
+}
@@ -4,25 +4,39 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
-	"sort"
+	"log/slog"
+	"slices"
 	"time"
 
 	"PWZ1.0/internal/metrics"
 	"PWZ1.0/internal/models"
 	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/notification"
 	"PWZ1.0/internal/order_cache"
 	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tariff"
 	"PWZ1.0/internal/tools/logger"
+	"PWZ1.0/internal/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
 const (
-	ExpiredTime    = 48 * time.Hour
 	DateTimeFormat = "2006-01-02 15:04:05"
 )
 
+// ListOrdersRequest условия списка заказов, Statuses вместе с InPvzOnly дают пересечение
+type ListOrdersRequest struct {
+	UserID         uint64
+	InPvzOnly      bool
+	IncludeDeleted bool
+	Statuses       []models.OrderStatus
+	ExpiresFrom    time.Time
+	ExpiresTo      time.Time
+	LastN          uint32
+	Pagination     Pagination
+}
+
 type ListReturnsRequest struct {
 	Pagination Pagination
 }
@@ -37,24 +51,39 @@ type ReturnsList struct {
 }
 
 type OrderService interface {
-	AcceptOrder(ctx context.Context, orderID, userID uint64, weight, price float32, expiresAt time.Time, packageType models.PackageType) (models.Order, error)
+	AcceptOrder(ctx context.Context, orderID, userID uint64, weight models.Grams, price models.Money, expiresAt time.Time, packageType models.PackageType) (models.Order, error)
 	ReturnOrder(ctx context.Context, orderID uint64) (*OrderResponse, error)
 	ProcessOrders(ctx context.Context, userID uint64, action models.ActionType, orderIDs []uint64) ProcessResult
-	ListOrders(ctx context.Context, userID uint64, inPvzOnly bool, lastId, page, limit uint32) ([]models.Order, uint32)
+	ListOrders(ctx context.Context, req ListOrdersRequest) ([]models.Order, uint32)
 	ListReturns(ctx context.Context, req ListReturnsRequest) ReturnsList
 	ScrollOrders(ctx context.Context, userID, lastID uint64, limit int) ([]models.Order, uint64)
-	GetHistory(ctx context.Context, page uint32, count uint32) ([]models.OrderHistory, error)
+	GetHistory(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) ([]models.OrderHistory, error)
 	GetOrderHistory(ctx context.Context, orderID uint64) ([]models.OrderHistory, error)
+	// StartImport находит задание импорта или создает новое, пустой jobID - новое задание
+	StartImport(ctx context.Context, jobID string) (models.ImportJob, error)
+	// ImportChunk сохраняет пачку заказов, идущих в потоке сразу после job.Processed
+	ImportChunk(ctx context.Context, job models.ImportJob, orders []ImportOrder) (models.ImportJob, []ItemError, error)
+	// CheckImportChunk те же проверки, что в ImportChunk, без сохранения
+	CheckImportChunk(ctx context.Context, orders []ImportOrder) ([]ItemError, error)
 }
 
 type ProcessResult struct {
 	Processed []uint64
-	Errors    []uint64
+	Errors    []ItemError
+}
+
+// ItemError причина, по которой не удалось обработать конкретный заказ
+type ItemError struct {
+	OrderID uint64
+	Err     error
 }
 
 type orderService struct {
-	storage storage.Storage
-	cache   order_cache.Cache
+	storage  storage.Storage
+	cache    order_cache.Cache
+	notifier notification.Enqueuer
+	tariffs  tariff.Provider
+	machine  *models.OrderStateMachine
 }
 
 type OrderResponse struct {
@@ -62,21 +91,24 @@ type OrderResponse struct {
 	Status  models.OrderStatus
 }
 
-func NewOrderService(storage storage.Storage, cache order_cache.Cache) OrderService {
-	return &orderService{
-		storage: storage,
-		cache:   cache,
-	}
+func NewOrderService(storage storage.Storage, cache order_cache.Cache, notifier notification.Enqueuer, tariffs tariff.Provider, machine *models.OrderStateMachine) OrderService {
+	return tracedOrderService{next: &orderService{
+		storage:  storage,
+		cache:    cache,
+		notifier: notifier,
+		tariffs:  tariffs,
+		machine:  machine,
+	}}
 }
 
-func (s *orderService) AcceptOrder(ctx context.Context, orderID, userID uint64, weight, price float32, expiresAt time.Time, packageType models.PackageType) (models.Order, error) {
-	log.Printf("AcceptOrder called: orderID=%d, userID=%d", orderID, userID)
+func (s *orderService) AcceptOrder(ctx context.Context, orderID, userID uint64, weight models.Grams, price models.Money, expiresAt time.Time, packageType models.PackageType) (models.Order, error) {
+	slog.InfoContext(ctx, "accept order", "order_id", orderID, "user_id", userID)
 
 	newOrder := models.Order{
 		ID:          orderID,
 		UserID:      userID,
 		ExpiresAt:   expiresAt,
-		Status:      models.StatusExpects,
+		Status:      models.StatusUnspecified,
 		Weight:      weight,
 		Price:       price,
 		PackageType: packageType,
@@ -87,60 +119,84 @@ func (s *orderService) AcceptOrder(ctx context.Context, orderID, userID uint64,
 		return newOrder, domainErrors.ErrInvalidPackage
 	}
 
-	if expiresAt.Before(time.Now()) {
-		logger.LogErrorWithCode(ctx, domainErrors.ErrValidationFailed, "Expiration date in past")
-		return newOrder, domainErrors.ErrValidationFailed
+	transition, err := s.machine.Transition(&newOrder, models.StatusExpects, time.Now())
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Expiration date in past")
+		return newOrder, err
 	}
 
-	_, err := s.storage.GetOrder(ctx, orderID)
+	_, err = s.storage.GetOrder(ctx, orderID)
 	if err == nil {
 		logger.LogErrorWithCode(ctx, domainErrors.ErrOrderAlreadyExists, "Order already exists")
 		return newOrder, domainErrors.ErrOrderAlreadyExists
 	}
 
-	if err := newOrder.ValidationWeight(); err != nil {
-		logger.LogErrorWithCode(ctx, err, "Weight validation failed")
+	active, err := s.tariffs.Active(time.Now())
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "No active tariff")
 		return newOrder, err
 	}
 
-	newOrder.CalculateTotalPrice()
+	if err := active.Apply(&newOrder); err != nil {
+		logger.LogErrorWithCode(ctx, err, "Tariff apply failed")
+		return newOrder, err
+	}
 
+	actor := actorFromContext(ctx)
 	err = s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		if err := s.storage.SaveOrderTx(ctx, tx, newOrder); err != nil {
+		if err := s.storage.SaveOrderTx(ctx, tx, newOrder, actor); err != nil {
 			return err
 		}
-
-		event := models.Event{
-			EventID:   uuid.New(),
-			EventType: "order_accepted",
-			Timestamp: time.Now().UTC(),
-			Actor: models.Actor{
-				Type: "courier",
-				ID:   int(userID),
-			},
-			Order: models.EventOrder{
-				ID:     newOrder.ID,
-				UserID: newOrder.UserID,
-				Status: newOrder.Status,
-			},
-			Source: "pvz-api",
-		}
-
-		if err := s.storage.SaveEventTx(ctx, tx, event); err != nil {
-			return err
-		}
-
-		return nil
+		return s.publishAcceptedTx(ctx, tx, newOrder, transition.EventType, actor)
 	})
 	if err != nil {
 		logger.LogErrorWithCode(ctx, err, "Failed to save order")
 		return newOrder, err
 	}
 
-	log.Printf("Order accepted successfully: orderID=%d", orderID)
+	slog.InfoContext(ctx, "order accepted", "order_id", orderID)
 	return newOrder, nil
 }
 
+// publishAcceptedTx пишет событие о приемке заказа и уведомление клиенту в той же транзакции, что и заказ
+func (s *orderService) publishAcceptedTx(ctx context.Context, tx pgx.Tx, order models.Order, eventType string, actor models.Actor) error {
+	event := models.Event{
+		EventID:   uuid.New(),
+		EventType: eventType,
+		Timestamp: time.Now().UTC(),
+		Actor:     actor,
+		Order: models.EventOrder{
+			ID:     order.ID,
+			UserID: order.UserID,
+			Status: order.Status,
+		},
+		Source:       "pvz-api",
+		TraceContext: tracing.Inject(ctx),
+	}
+
+	if err := s.storage.SaveEventTx(ctx, tx, event); err != nil {
+		return err
+	}
+
+	_, err := s.notifier.EnqueueTx(ctx, tx, notification.OrderArrived(order))
+	return err
+}
+
+// intersectStatuses статусы allowed, которые есть в requested; пустой requested не ограничивает
+func intersectStatuses(requested, allowed []models.OrderStatus) []models.OrderStatus {
+	if len(requested) == 0 {
+		return allowed
+	}
+
+	var result []models.OrderStatus
+	for _, s := range requested {
+		if slices.Contains(allowed, s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 func IsValidPackage(pkg models.PackageType) bool {
 	switch pkg {
 	case models.PackageBag, models.PackageBox, models.PackageTape, models.PackageBagTape, models.PackageBoxTape, models.PackageUnspecified:
@@ -150,48 +206,38 @@ func IsValidPackage(pkg models.PackageType) bool {
 }
 
 func (s *orderService) ReturnOrder(ctx context.Context, orderID uint64) (*OrderResponse, error) {
-	log.Printf("ReturnOrder called: orderID=%d", orderID)
+	slog.InfoContext(ctx, "return order", "order_id", orderID)
 
-	order, err := s.storage.GetOrder(ctx, orderID)
-	if err != nil {
-		logger.LogErrorWithCode(ctx, err, "Failed to get order for return")
-		return nil, err
-	}
-
-	if order.Status == models.StatusAccepted {
-		logger.LogErrorWithCode(ctx, domainErrors.ErrOrderAlreadyIssued, "Order already issued")
-		return nil, domainErrors.ErrOrderAlreadyIssued
-	}
-
-	if order.Status != models.StatusReturned && time.Now().Before(order.ExpiresAt) {
-		logger.LogErrorWithCode(ctx, domainErrors.ErrStorageNotExpired, "Storage not expired yet")
-		return nil, domainErrors.ErrStorageNotExpired
-	}
-
-	var deleted bool
+	actor := actorFromContext(ctx)
+	err := s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		order, err := s.storage.GetOrderForUpdateTx(ctx, tx, orderID)
+		if err != nil {
+			logger.LogErrorWithCode(ctx, err, "Failed to get order for return")
+			return err
+		}
 
-	err = s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		err := s.storage.DeleteOrder(ctx, orderID)
+		transition, err := s.machine.Transition(&order, models.StatusDeleted, time.Now())
 		if err != nil {
+			logger.LogErrorWithCode(ctx, err, "Order cannot be returned to courier")
 			return err
 		}
 
-		deleted = true
+		if err := s.storage.DeleteOrderTx(ctx, tx, orderID, actor); err != nil {
+			return err
+		}
 
 		event := models.Event{
 			EventID:   uuid.New(),
-			EventType: "order_returned_by_client",
+			EventType: transition.EventType,
 			Timestamp: time.Now().UTC(),
-			Actor: models.Actor{
-				Type: "courier",
-				ID:   int(order.UserID),
-			},
+			Actor:     actor,
 			Order: models.EventOrder{
 				ID:     order.ID,
 				UserID: order.UserID,
-				Status: models.StatusDeleted,
+				Status: order.Status,
 			},
-			Source: "pvz-api",
+			Source:       "pvz-api",
+			TraceContext: tracing.Inject(ctx),
 		}
 
 		return s.storage.SaveEventTx(ctx, tx, event)
@@ -202,97 +248,81 @@ func (s *orderService) ReturnOrder(ctx context.Context, orderID uint64) (*OrderR
 		return nil, err
 	}
 
-	if deleted {
-		log.Printf("Order returned by client and deleted: orderID=%d", orderID)
-		_ = s.cache.Delete(ctx, fmt.Sprintf("%d", orderID))
-		return &OrderResponse{
-			OrderID: orderID,
-			Status:  models.StatusDeleted,
-		}, nil
-	}
-
-	logger.LogErrorWithCode(ctx, domainErrors.ErrOrderAlreadyReturned, "Unexpected state after ReturnOrder")
-	return nil, domainErrors.ErrOrderAlreadyReturned
+	slog.InfoContext(ctx, "order returned to courier and deleted", "order_id", orderID)
+	_ = s.cache.Delete(ctx, historyCacheKey(orderID))
+	return &OrderResponse{
+		OrderID: orderID,
+		Status:  models.StatusDeleted,
+	}, nil
 }
 
 func (s *orderService) ProcessOrders(ctx context.Context, userID uint64, actionType models.ActionType, orderIDs []uint64) ProcessResult {
-	log.Printf("ProcessOrders called: userID=%d, action=%v, orderIDs=%v", userID, actionType, orderIDs)
+	slog.InfoContext(ctx, "process orders", "user_id", userID, "action", actionType, "order_ids", orderIDs)
 
 	result := ProcessResult{
 		Processed: make([]uint64, 0),
-		Errors:    make([]uint64, 0),
+		Errors:    make([]ItemError, 0),
 	}
 
+	actor := actorFromContext(ctx)
 	err := s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		for _, id := range orderIDs {
-			order, err := s.storage.GetOrder(ctx, id)
-			if err != nil || order.UserID != userID {
-				result.Errors = append(result.Errors, id)
+			order, err := s.storage.GetOrderForUpdateTx(ctx, tx, id)
+			if err != nil {
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: err})
 				continue
 			}
-
-			if time.Now().After(order.ExpiresAt) {
-				result.Errors = append(result.Errors, id)
+			if order.UserID != userID {
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: domainErrors.ErrOrderOfAnotherUser})
 				continue
 			}
 
-			var eventType string
-
+			var to models.OrderStatus
 			switch actionType {
 			case models.ActionTypeIssue:
-				if order.Status != models.StatusExpects {
-					result.Errors = append(result.Errors, id)
-					continue
-				}
-				order.Status = models.StatusAccepted
-				order.ExpiresAt = time.Now().Add(ExpiredTime)
-				eventType = "order_issued"
-
-				metrics.OrdersIssued.Inc()
-				log.Println("метрика")
-
+				to = models.StatusAccepted
 			case models.ActionTypeReturn:
-				if order.Status != models.StatusAccepted {
-					result.Errors = append(result.Errors, id)
-					continue
-				}
-				order.Status = models.StatusReturned
-				eventType = "order_returned_to_courier"
-
+				to = models.StatusReturned
 			default:
-				result.Errors = append(result.Errors, id)
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: domainErrors.ErrInvalidAction})
+				continue
+			}
+
+			transition, err := s.machine.Transition(&order, to, time.Now())
+			if err != nil {
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: err})
 				continue
 			}
 
-			if err = s.storage.UpdateOrderTx(ctx, tx, order); err != nil {
-				result.Errors = append(result.Errors, id)
+			if err = s.storage.UpdateOrderTx(ctx, tx, order, actor); err != nil {
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: err})
 				continue
 			}
 
 			event := models.Event{
 				EventID:   uuid.New(),
-				EventType: eventType,
+				EventType: transition.EventType,
 				Timestamp: time.Now().UTC(),
-				Actor: models.Actor{
-					Type: "courier",
-					ID:   int(userID),
-				},
+				Actor:     actor,
 				Order: models.EventOrder{
 					ID:     order.ID,
 					UserID: order.UserID,
 					Status: order.Status,
 				},
-				Source: "pvz-api",
+				Source:       "pvz-api",
+				TraceContext: tracing.Inject(ctx),
 			}
 
 			if err := s.storage.SaveEventTx(ctx, tx, event); err != nil {
-				result.Errors = append(result.Errors, id)
+				result.Errors = append(result.Errors, ItemError{OrderID: id, Err: err})
 				continue
 			}
 
-			result.Processed = append(result.Processed, id)
+			if to == models.StatusAccepted {
+				metrics.OrdersIssued.Inc()
+			}
 
-			_ = s.cache.Delete(ctx, fmt.Sprintf("%d", id))
+			result.Processed = append(result.Processed, id)
 		}
 
 		return nil
@@ -300,153 +330,118 @@ func (s *orderService) ProcessOrders(ctx context.Context, userID uint64, actionT
 
 	if err != nil {
 		logger.LogErrorWithCode(ctx, err, "Transaction error in ProcessOrders")
+		// транзакция не зафиксирована, ни один заказ не обработан
+		for _, id := range result.Processed {
+			result.Errors = append(result.Errors, ItemError{OrderID: id, Err: err})
+		}
+		result.Processed = result.Processed[:0]
 	}
 
-	log.Printf("ProcessOrders result: processed=%d, errors=%d", len(result.Processed), len(result.Errors))
+	// кэш истории сбрасываем только после коммита, иначе его успеют заполнить старыми данными
+	for _, id := range result.Processed {
+		_ = s.cache.Delete(ctx, historyCacheKey(id))
+	}
+
+	slog.InfoContext(ctx, "orders processed", "processed", len(result.Processed), "errors", len(result.Errors))
 	return result
 }
 
-func (s *orderService) ListOrders(ctx context.Context, userID uint64, inPvzOnly bool, lastId uint32, page uint32, limit uint32) ([]models.Order, uint32) {
-	log.Printf("ListOrders called: userID=%d, inPvzOnly=%v, lastId=%d, page=%d, limit=%d",
-		userID, inPvzOnly, lastId, page, limit)
+func (s *orderService) ListOrders(ctx context.Context, req ListOrdersRequest) ([]models.Order, uint32) {
+	page, limit := req.Pagination.Page, req.Pagination.CountOnPage
+	slog.DebugContext(ctx, "list orders", "user_id", req.UserID, "in_pvz_only", req.InPvzOnly, "include_deleted", req.IncludeDeleted,
+		"statuses", req.Statuses, "last_n", req.LastN, "page", page, "limit", limit)
 
 	if limit == 0 {
 		logger.LogErrorWithCode(ctx, domainErrors.ErrValidationFailed, "Limit must be greater than zero")
 		return nil, 0
 	}
 
-	allOrders, err := s.storage.ListOrders(ctx)
-	if err != nil {
-		logger.LogErrorWithCode(ctx, err, "Failed to list orders")
-		return []models.Order{}, 0
-	}
-
-	filtered := make([]models.Order, 0)
-	for _, o := range allOrders {
-		if o.UserID != userID {
-			continue
-		}
-		if inPvzOnly {
-			if o.Status != models.StatusExpects && o.Status != models.StatusReturned {
-				continue
-			}
+	filter := models.OrderFilter{
+		UserID:         req.UserID,
+		Statuses:       req.Statuses,
+		ExpiresFrom:    req.ExpiresFrom,
+		ExpiresTo:      req.ExpiresTo,
+		LastN:          req.LastN,
+		IncludeDeleted: req.IncludeDeleted,
+		Offset:         page * limit,
+		Limit:          limit,
+	}
+	if req.InPvzOnly {
+		filter.Statuses = intersectStatuses(req.Statuses, []models.OrderStatus{models.StatusExpects, models.StatusReturned})
+		if len(filter.Statuses) == 0 {
+			return []models.Order{}, 0
 		}
-		filtered = append(filtered, o)
 	}
 
-	total := uint32(len(filtered))
-
-	if lastId > 0 {
-		if lastId > total {
-			lastId = total
-		}
-		filtered = filtered[total-lastId:]
-	}
-
-	start := page * limit
-	end := start + limit
-	if start >= uint32(len(filtered)) {
-		return []models.Order{}, total
-	}
-	if end > uint32(len(filtered)) {
-		end = uint32(len(filtered))
+	result, err := s.storage.QueryOrders(ctx, filter)
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Failed to list orders")
+		return []models.Order{}, 0
 	}
-	filtered = filtered[start:end]
 
-	log.Printf("ListOrders result: count=%d", len(filtered))
-	return filtered, total
+	slog.DebugContext(ctx, "orders listed", "count", len(result.Orders))
+	return result.Orders, result.Total
 }
 
 func (s *orderService) ListReturns(ctx context.Context, req ListReturnsRequest) ReturnsList {
-	log.Printf("ListReturns called: page=%d, count=%d", req.Pagination.Page, req.Pagination.CountOnPage)
+	slog.DebugContext(ctx, "list returns", "page", req.Pagination.Page, "count", req.Pagination.CountOnPage)
+
+	filter := models.OrderFilter{
+		Statuses: []models.OrderStatus{models.StatusReturned},
+		Offset:   req.Pagination.Page * req.Pagination.CountOnPage,
+		Limit:    req.Pagination.CountOnPage,
+	}
 
-	allOrders, err := s.storage.ListOrders(ctx)
+	result, err := s.storage.QueryOrders(ctx, filter)
 	if err != nil {
 		logger.LogErrorWithCode(ctx, err, "Failed to list returns")
 		return ReturnsList{}
 	}
 
-	var returned []models.Order
-	for _, o := range allOrders {
-		if o.Status == models.StatusReturned {
-			returned = append(returned, o)
-		}
-	}
-
-	page := int(req.Pagination.Page)
-	limit := int(req.Pagination.CountOnPage)
-
-	if limit > 0 {
-		start := page * limit
-		end := start + limit
-		if start >= len(returned) {
-			return ReturnsList{Returns: []models.Order{}}
-		}
-		if end > len(returned) {
-			end = len(returned)
-		}
-		return ReturnsList{Returns: returned[start:end]}
-	}
-
-	log.Printf("ListReturns result: count=%d", len(returned))
-	return ReturnsList{Returns: returned}
+	slog.DebugContext(ctx, "returns listed", "count", len(result.Orders))
+	return ReturnsList{Returns: result.Orders}
 }
 
 func (s *orderService) ScrollOrders(ctx context.Context, userID uint64, lastID uint64, limit int) ([]models.Order, uint64) {
-	log.Printf("ScrollOrders called: userID=%d, lastID=%d, limit=%d", userID, lastID, limit)
+	slog.DebugContext(ctx, "scroll orders", "user_id", userID, "last_id", lastID, "limit", limit)
 
-	allOrders, err := s.storage.ListOrders(ctx)
-	if err != nil {
-		logger.LogErrorWithCode(ctx, err, "Failed to list orders for scrolling")
+	if limit <= 0 {
 		return []models.Order{}, 0
 	}
 
-	userOrders := make([]models.Order, 0)
-	for _, o := range allOrders {
-		if o.UserID == userID {
-			userOrders = append(userOrders, o)
-		}
+	filter := models.OrderFilter{
+		UserID: userID,
+		SortBy: models.SortByID,
+		Limit:  uint32(limit),
 	}
-
-	sort.Slice(userOrders, func(i, j int) bool {
-		return userOrders[i].ID < userOrders[j].ID
-	})
-
-	startIdx := 0
 	if lastID != 0 {
-		for i, o := range userOrders {
-			if o.ID == lastID {
-				startIdx = i + 1
-				break
-			}
-		}
+		filter.Cursor = &models.OrderCursor{ID: lastID}
 	}
 
-	endIdx := startIdx + limit
-	if endIdx > len(userOrders) {
-		endIdx = len(userOrders)
+	result, err := s.storage.QueryOrders(ctx, filter)
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Failed to list orders for scrolling")
+		return []models.Order{}, 0
 	}
 
-	result := userOrders[startIdx:endIdx]
-
 	var nextLastID uint64
-	if len(result) > 0 {
-		nextLastID = result[len(result)-1].ID
+	if len(result.Orders) > 0 {
+		nextLastID = result.Orders[len(result.Orders)-1].ID
 	}
 
-	log.Printf("ScrollOrders result: count=%d, nextLastID=%d", len(result), nextLastID)
-	return result, nextLastID
+	slog.DebugContext(ctx, "orders scrolled", "count", len(result.Orders), "next_last_id", nextLastID)
+	return result.Orders, nextLastID
 }
 
-func (s *orderService) GetHistory(ctx context.Context, page, count uint32) ([]models.OrderHistory, error) {
-	log.Printf("GetHistory called: page=%d, count=%d", page, count)
-	return s.storage.GetHistory(ctx, page, count)
+func (s *orderService) GetHistory(ctx context.Context, filter models.HistoryFilter, page, count uint32) ([]models.OrderHistory, error) {
+	slog.DebugContext(ctx, "get history", "filter", filter, "page", page, "count", count)
+	return s.storage.GetHistory(ctx, filter, page, count)
 }
 
 func (s *orderService) GetOrderHistory(ctx context.Context, orderID uint64) ([]models.OrderHistory, error) {
-	log.Printf("GetOrderHistory called: orderID=%d", orderID)
+	slog.DebugContext(ctx, "get order history", "order_id", orderID)
 
-	cacheKey := fmt.Sprintf("%d", orderID)
+	cacheKey := historyCacheKey(orderID)
 
 	cachedData, err := s.cache.Get(ctx, cacheKey)
 	if err == nil && cachedData != "" {
@@ -456,29 +451,27 @@ func (s *orderService) GetOrderHistory(ctx context.Context, orderID uint64) ([]m
 		}
 	}
 
-	history, err := s.storage.GetHistory(ctx, 0, 0)
+	history, err := s.storage.GetOrderHistory(ctx, orderID)
 	if err != nil {
 		logger.LogErrorWithCode(ctx, err, "Failed to get order history")
 		return nil, err
 	}
 
-	var filtered []models.OrderHistory
-	for _, h := range history {
-		if h.OrderID == orderID {
-			filtered = append(filtered, h)
-		}
-	}
-
-	if len(filtered) == 0 {
+	if len(history) == 0 {
 		logger.LogErrorWithCode(ctx, domainErrors.ErrOrderNotFound, "Order history not found")
 		return nil, domainErrors.ErrOrderNotFound
 	}
 
-	log.Printf("GetOrderHistory result: count=%d", len(filtered))
+	slog.DebugContext(ctx, "order history loaded", "count", len(history))
 
-	dataBytes, err := json.Marshal(filtered)
+	dataBytes, err := json.Marshal(history)
 	if err == nil {
 		_ = s.cache.Set(ctx, cacheKey, string(dataBytes))
 	}
-	return filtered, nil
+	return history, nil
+}
+
+// historyCacheKey ключ истории заказа в кэше, сбрасывается при каждой смене статуса
+func historyCacheKey(orderID uint64) string {
+	return fmt.Sprintf("order_history:%d", orderID)
 }
@@ -9,11 +9,15 @@ import (
 
 	"PWZ1.0/internal/models"
 	"PWZ1.0/internal/models/domainErrors"
+	notificationMocks "PWZ1.0/internal/notification/mocks"
+	cacheMocks "PWZ1.0/internal/order_cache/mocks"
 	"PWZ1.0/internal/storage/mocks"
+	"PWZ1.0/internal/tariff"
 	"PWZ1.0/internal/tools/logger"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMain(m *testing.M) {
@@ -21,12 +25,18 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+func defaultTariffs(t *testing.T) *tariff.Book {
+	book, err := tariff.NewBook(models.DefaultTariff)
+	require.NoError(t, err)
+	return book
+}
+
 func Test_orderService_AcceptOrder(t *testing.T) {
 	type args struct {
 		orderID     uint64
 		userID      uint64
-		weight      float32
-		price       float32
+		weight      models.Grams
+		price       models.Money
 		expiresAt   time.Time
 		packageType models.PackageType
 	}
@@ -35,6 +45,7 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 		name         string
 		args         args
 		mockSetup    func(m *mocks.StorageMock)
+		notifySetup  func(m *notificationMocks.EnqueuerMock)
 		expectedErr  error
 		expectedStat models.OrderStatus
 	}{
@@ -43,8 +54,8 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 			args: args{
 				orderID:     1,
 				userID:      10,
-				weight:      10,
-				price:       100,
+				weight:      10000,
+				price:       models.NewMoney(10000, models.CurrencyRUB),
 				expiresAt:   time.Now().Add(48 * time.Hour),
 				packageType: models.PackageBox,
 			},
@@ -60,14 +71,31 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 					return fn(context.Background(), nil)
 				})
 
-				m.SaveOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order) error {
+				m.SaveOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order, _ models.Actor) error {
 					if order.ID == 1 &&
 						order.UserID == 10 &&
-						order.Status == models.StatusExpects {
+						order.Status == models.StatusExpects &&
+						order.Price == models.NewMoney(12000, models.CurrencyRUB) &&
+						order.TariffVersion == models.DefaultTariffVersion {
 						return nil
 					}
 					return errors.New("unexpected order")
 				})
+
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+					if event.EventType == "order_accepted" && event.Order.ID == 1 {
+						return nil
+					}
+					return errors.New("unexpected event")
+				})
+			},
+			notifySetup: func(m *notificationMocks.EnqueuerMock) {
+				m.EnqueueTxMock.Set(func(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error) {
+					if n.Kind == models.NotificationKindOrderArrived && n.OrderID == 1 && n.UserID == 10 {
+						return 1, nil
+					}
+					return 0, errors.New("unexpected notification")
+				})
 			},
 			expectedErr:  nil,
 			expectedStat: models.StatusExpects,
@@ -77,8 +105,8 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 			args: args{
 				orderID:     1,
 				userID:      10,
-				weight:      10,
-				price:       100,
+				weight:      10000,
+				price:       models.NewMoney(10000, models.CurrencyRUB),
 				expiresAt:   time.Now().Add(-2 * time.Hour),
 				packageType: models.PackageBox,
 			},
@@ -91,8 +119,8 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 			args: args{
 				orderID:     1,
 				userID:      10,
-				weight:      10,
-				price:       100,
+				weight:      10000,
+				price:       models.NewMoney(10000, models.CurrencyRUB),
 				expiresAt:   time.Now().Add(48 * time.Hour),
 				packageType: models.PackageBox,
 			},
@@ -106,13 +134,28 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 			},
 			expectedErr: domainErrors.ErrOrderAlreadyExists,
 		},
+		{
+			name: "price in another currency",
+			args: args{
+				orderID:     1,
+				userID:      10,
+				weight:      10000,
+				price:       models.NewMoney(10000, models.Currency("USD")),
+				expiresAt:   time.Now().Add(48 * time.Hour),
+				packageType: models.PackageBox,
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderMock.Return(models.Order{}, domainErrors.ErrOrderNotFound)
+			},
+			expectedErr: domainErrors.ErrCurrencyMismatch,
+		},
 		{
 			name: "error saving order",
 			args: args{
 				orderID:     1,
 				userID:      10,
-				weight:      10,
-				price:       100,
+				weight:      10000,
+				price:       models.NewMoney(10000, models.CurrencyRUB),
 				expiresAt:   time.Now().Add(48 * time.Hour),
 				packageType: models.PackageBox,
 			},
@@ -128,7 +171,7 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 					return fn(context.Background(), nil)
 				})
 
-				m.SaveOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order) error {
+				m.SaveOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order, _ models.Actor) error {
 					return errors.New("db error")
 				})
 			},
@@ -143,8 +186,12 @@ func Test_orderService_AcceptOrder(t *testing.T) {
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockStorage)
 			}
+			mockNotifier := notificationMocks.NewEnqueuerMock(t)
+			if tt.notifySetup != nil {
+				tt.notifySetup(mockNotifier)
+			}
 
-			svc := NewOrderService(mockStorage)
+			svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), mockNotifier, defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
 
 			order, err := svc.AcceptOrder(
 				context.Background(),
@@ -197,7 +244,7 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 			want:    nil,
 			wantErr: domainErrors.ErrOrderNotFound,
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{}, domainErrors.ErrOrderNotFound
 				})
 			},
@@ -214,7 +261,7 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 			want:    nil,
 			wantErr: domainErrors.ErrOrderAlreadyIssued,
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:     1,
 						Status: models.StatusAccepted,
@@ -222,6 +269,27 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "order already returned to courier",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:     context.Background(),
+				orderID: 1,
+			},
+			want:    nil,
+			wantErr: domainErrors.ErrOrderAlreadyReturned,
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+					return models.Order{
+						ID:        1,
+						Status:    models.StatusDeleted,
+						ExpiresAt: time.Now().Add(-time.Hour),
+					}, nil
+				})
+			},
+		},
 		{
 			name: "order returned and deleted successfully",
 			fields: fields{
@@ -237,13 +305,18 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 			},
 			wantErr: nil,
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:     1,
 						Status: models.StatusReturned,
 					}, nil
 				})
-				m.DeleteOrderMock.Set(func(ctx context.Context, id uint64) error {
+				m.DeleteOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64, _ models.Actor) error {
+					return nil
+				})
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+					assert.Equal(t, models.EventOrderReturnedToCourier, event.EventType)
+					assert.Equal(t, models.StatusDeleted, event.Order.Status)
 					return nil
 				})
 			},
@@ -263,16 +336,45 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 			},
 			wantErr: nil,
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:        1,
 						Status:    models.StatusExpects,
 						ExpiresAt: time.Now().Add(-time.Hour),
 					}, nil
 				})
-				m.DeleteOrderMock.Set(func(ctx context.Context, id uint64) error {
+				m.DeleteOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64, _ models.Actor) error {
+					return nil
+				})
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+					return nil
+				})
+			},
+		},
+		{
+			name: "event save failure is returned",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:     context.Background(),
+				orderID: 1,
+			},
+			want:    nil,
+			wantErr: domainErrors.ErrInternalError,
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+					return models.Order{
+						ID:     1,
+						Status: models.StatusReturned,
+					}, nil
+				})
+				m.DeleteOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64, _ models.Actor) error {
 					return nil
 				})
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+					return domainErrors.ErrInternalError
+				})
 			},
 		},
 		{
@@ -287,7 +389,7 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 			want:    nil,
 			wantErr: domainErrors.ErrStorageNotExpired,
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:        1,
 						Status:    models.StatusExpects,
@@ -301,9 +403,16 @@ func Test_orderService_ReturnOrder(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
+			tt.fields.storage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+				return fn(ctx, nil)
+			})
 			tt.mockSetup(tt.fields.storage)
+			cache := cacheMocks.NewCacheMock(t)
+			cache.DeleteMock.Optional().Return(nil)
 			s := &orderService{
 				storage: tt.fields.storage,
+				cache:   cache,
+				machine: models.NewStateMachine(models.DefaultReturnWindow),
 			}
 			got, err := s.ReturnOrder(tt.args.ctx, tt.args.orderID)
 			if tt.wantErr != nil {
@@ -346,7 +455,7 @@ func Test_orderService_ProcessOrders(t *testing.T) {
 				orderIDs:   []uint64{1, 2},
 			},
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:        id,
 						UserID:    10,
@@ -359,13 +468,132 @@ func Test_orderService_ProcessOrders(t *testing.T) {
 					return fn(ctx, nil)
 				})
 
-				m.UpdateOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order) error {
+				m.UpdateOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order, _ models.Actor) error {
+					return nil
+				})
+
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
 					return nil
 				})
 			},
 			want: ProcessResult{
 				Processed: []uint64{1, 2},
-				Errors:    []uint64{},
+				Errors:    []ItemError{},
+			},
+		},
+		{
+			name: "client return after return window",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:        context.Background(),
+				userID:     10,
+				actionType: models.ActionTypeReturn,
+				orderIDs:   []uint64{1, 2},
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+					expiresAt := time.Now().Add(time.Hour)
+					if id == 2 {
+						expiresAt = time.Now().Add(-time.Hour)
+					}
+					return models.Order{
+						ID:        id,
+						UserID:    10,
+						Status:    models.StatusAccepted,
+						ExpiresAt: expiresAt,
+					}, nil
+				})
+
+				m.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+					return fn(ctx, nil)
+				})
+
+				m.UpdateOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order, _ models.Actor) error {
+					return nil
+				})
+
+				m.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+					assert.Equal(t, models.EventOrderReturnedByClient, event.EventType)
+					return nil
+				})
+			},
+			want: ProcessResult{
+				Processed: []uint64{1},
+				Errors:    []ItemError{{OrderID: 2, Err: domainErrors.ErrReturnTimeExpired}},
+			},
+		},
+		{
+			name: "not found and another user's orders",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:        context.Background(),
+				userID:     10,
+				actionType: models.ActionTypeIssue,
+				orderIDs:   []uint64{1, 2},
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+					if id == 1 {
+						return models.Order{}, domainErrors.ErrOrderNotFound
+					}
+					return models.Order{
+						ID:        id,
+						UserID:    20,
+						Status:    models.StatusExpects,
+						ExpiresAt: time.Now().Add(time.Hour),
+					}, nil
+				})
+
+				m.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+					return fn(ctx, nil)
+				})
+			},
+			want: ProcessResult{
+				Processed: []uint64{},
+				Errors: []ItemError{
+					{OrderID: 1, Err: domainErrors.ErrOrderNotFound},
+					{OrderID: 2, Err: domainErrors.ErrOrderOfAnotherUser},
+				},
+			},
+		},
+		{
+			name: "commit failure fails every order",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:        context.Background(),
+				userID:     10,
+				actionType: models.ActionTypeIssue,
+				orderIDs:   []uint64{1},
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+					return models.Order{
+						ID:        id,
+						UserID:    10,
+						Status:    models.StatusExpects,
+						ExpiresAt: time.Now().Add(time.Hour),
+					}, nil
+				})
+
+				m.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+					if err := fn(ctx, nil); err != nil {
+						return err
+					}
+					return errors.New("commit failed")
+				})
+
+				m.UpdateOrderTxMock.Return(nil)
+				m.SaveEventTxMock.Return(nil)
+			},
+			want: ProcessResult{
+				Processed: []uint64{},
+				Errors:    []ItemError{{OrderID: 1, Err: errors.New("commit failed")}},
 			},
 		},
 		{
@@ -380,7 +608,7 @@ func Test_orderService_ProcessOrders(t *testing.T) {
 				orderIDs:   []uint64{1},
 			},
 			mockSetup: func(m *mocks.StorageMock) {
-				m.GetOrderMock.Set(func(ctx context.Context, id uint64) (models.Order, error) {
+				m.GetOrderForUpdateTxMock.Set(func(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
 					return models.Order{
 						ID:        id,
 						UserID:    10,
@@ -393,13 +621,13 @@ func Test_orderService_ProcessOrders(t *testing.T) {
 					return fn(ctx, nil)
 				})
 
-				m.UpdateOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order) error {
+				m.UpdateOrderTxMock.Set(func(ctx context.Context, tx pgx.Tx, order models.Order, _ models.Actor) error {
 					return errors.New("update failed")
 				})
 			},
 			want: ProcessResult{
 				Processed: []uint64{},
-				Errors:    []uint64{1},
+				Errors:    []ItemError{{OrderID: 1, Err: errors.New("update failed")}},
 			},
 		},
 	}
@@ -408,8 +636,12 @@ func Test_orderService_ProcessOrders(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			tt.mockSetup(tt.fields.storage)
+			cache := cacheMocks.NewCacheMock(t)
+			cache.DeleteMock.Optional().Return(nil)
 			s := &orderService{
 				storage: tt.fields.storage,
+				cache:   cache,
+				machine: models.NewStateMachine(models.DefaultReturnWindow),
 			}
 			got := s.ProcessOrders(tt.args.ctx, tt.args.userID, tt.args.actionType, tt.args.orderIDs)
 			assert.ElementsMatch(t, tt.want.Processed, got.Processed)
@@ -423,12 +655,15 @@ func Test_orderService_ListOrders(t *testing.T) {
 		storage *mocks.StorageMock
 	}
 	type args struct {
-		ctx       context.Context
-		userID    uint64
-		inPvzOnly bool
-		lastId    uint32
-		page      uint32
-		limit     uint32
+		ctx            context.Context
+		userID         uint64
+		inPvzOnly      bool
+		includeDeleted bool
+		statuses       []models.OrderStatus
+		expiresFrom    time.Time
+		lastId         uint32
+		page           uint32
+		limit          uint32
 	}
 
 	tests := []struct {
@@ -454,14 +689,18 @@ func Test_orderService_ListOrders(t *testing.T) {
 				limit:     2,
 			},
 			mockSetup: func(m *mocks.StorageMock) {
-				m.ListOrdersMock.Set(func(ctx context.Context) ([]models.Order, error) {
-					return []models.Order{
+				m.QueryOrdersMock.Expect(context.Background(), models.OrderFilter{
+					UserID:   10,
+					Statuses: []models.OrderStatus{models.StatusExpects, models.StatusReturned},
+					Offset:   0,
+					Limit:    2,
+				}).Return(models.OrdersPage{
+					Orders: []models.Order{
 						{ID: 1, UserID: 10, Status: models.StatusExpects},
 						{ID: 2, UserID: 10, Status: models.StatusReturned},
-						{ID: 3, UserID: 10, Status: models.StatusAccepted},
-						{ID: 4, UserID: 20, Status: models.StatusExpects},
-					}, nil
-				})
+					},
+					Total: 2,
+				}, nil)
 			},
 			wantOrders: []models.Order{
 				{ID: 1, UserID: 10, Status: models.StatusExpects},
@@ -470,6 +709,89 @@ func Test_orderService_ListOrders(t *testing.T) {
 			wantTotal: 2,
 			wantErr:   false,
 		},
+		{
+			name: "include deleted is passed to storage",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:            context.Background(),
+				userID:         10,
+				includeDeleted: true,
+				limit:          10,
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.QueryOrdersMock.Expect(context.Background(), models.OrderFilter{
+					UserID:         10,
+					IncludeDeleted: true,
+					Limit:          10,
+				}).Return(models.OrdersPage{
+					Orders: []models.Order{{ID: 3, UserID: 10, Status: models.StatusDeleted}},
+					Total:  1,
+				}, nil)
+			},
+			wantOrders: []models.Order{{ID: 3, UserID: 10, Status: models.StatusDeleted}},
+			wantTotal:  1,
+		},
+		{
+			name: "statuses and date range are passed to storage",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:         context.Background(),
+				statuses:    []models.OrderStatus{models.StatusAccepted},
+				expiresFrom: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+				limit:       10,
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.QueryOrdersMock.Expect(context.Background(), models.OrderFilter{
+					Statuses:    []models.OrderStatus{models.StatusAccepted},
+					ExpiresFrom: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+					Limit:       10,
+				}).Return(models.OrdersPage{}, nil)
+			},
+			wantOrders: nil,
+			wantTotal:  0,
+		},
+		{
+			name: "in pvz with statuses keeps only intersection",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:       context.Background(),
+				userID:    10,
+				inPvzOnly: true,
+				statuses:  []models.OrderStatus{models.StatusAccepted, models.StatusReturned},
+				limit:     10,
+			},
+			mockSetup: func(m *mocks.StorageMock) {
+				m.QueryOrdersMock.Expect(context.Background(), models.OrderFilter{
+					UserID:   10,
+					Statuses: []models.OrderStatus{models.StatusReturned},
+					Limit:    10,
+				}).Return(models.OrdersPage{}, nil)
+			},
+			wantOrders: nil,
+			wantTotal:  0,
+		},
+		{
+			name: "in pvz with issued status only is empty without query",
+			fields: fields{
+				storage: mocks.NewStorageMock(t),
+			},
+			args: args{
+				ctx:       context.Background(),
+				userID:    10,
+				inPvzOnly: true,
+				statuses:  []models.OrderStatus{models.StatusAccepted},
+				limit:     10,
+			},
+			mockSetup:  func(m *mocks.StorageMock) {},
+			wantOrders: []models.Order{},
+			wantTotal:  0,
+		},
 		{
 			name: "limit zero returns empty result and zero total",
 			fields: fields{
@@ -503,9 +825,7 @@ func Test_orderService_ListOrders(t *testing.T) {
 				limit:     10,
 			},
 			mockSetup: func(m *mocks.StorageMock) {
-				m.ListOrdersMock.Set(func(ctx context.Context) ([]models.Order, error) {
-					return nil, errors.New("storage error")
-				})
+				m.QueryOrdersMock.Return(models.OrdersPage{}, errors.New("storage error"))
 			},
 			wantOrders: []models.Order{},
 			wantTotal:  0,
@@ -519,10 +839,130 @@ func Test_orderService_ListOrders(t *testing.T) {
 			tt.mockSetup(tt.fields.storage)
 			s := &orderService{storage: tt.fields.storage}
 
-			got, got1 := s.ListOrders(tt.args.ctx, tt.args.userID, tt.args.inPvzOnly, tt.args.lastId, tt.args.page, tt.args.limit)
+			got, got1 := s.ListOrders(tt.args.ctx, ListOrdersRequest{
+				UserID:         tt.args.userID,
+				InPvzOnly:      tt.args.inPvzOnly,
+				IncludeDeleted: tt.args.includeDeleted,
+				Statuses:       tt.args.statuses,
+				ExpiresFrom:    tt.args.expiresFrom,
+				LastN:          tt.args.lastId,
+				Pagination:     Pagination{Page: tt.args.page, CountOnPage: tt.args.limit},
+			})
 
 			assert.Equal(t, tt.wantOrders, got)
 			assert.Equal(t, tt.wantTotal, got1)
 		})
 	}
 }
+
+func Test_orderService_ScrollOrders(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastID     uint64
+		wantCursor *models.OrderCursor
+		page       models.OrdersPage
+		wantNext   uint64
+	}{
+		{
+			name:       "first page has no cursor",
+			lastID:     0,
+			wantCursor: nil,
+			page:       models.OrdersPage{Orders: []models.Order{{ID: 3}, {ID: 5}}},
+			wantNext:   5,
+		},
+		{
+			name:       "next page continues after last id",
+			lastID:     5,
+			wantCursor: &models.OrderCursor{ID: 5},
+			page:       models.OrdersPage{Orders: []models.Order{}},
+			wantNext:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			storage := mocks.NewStorageMock(t)
+			storage.QueryOrdersMock.Expect(context.Background(), models.OrderFilter{
+				UserID: 10,
+				SortBy: models.SortByID,
+				Cursor: tt.wantCursor,
+				Limit:  2,
+			}).Return(tt.page, nil)
+
+			s := &orderService{storage: storage}
+			got, next := s.ScrollOrders(context.Background(), 10, tt.lastID, 2)
+
+			assert.Equal(t, tt.page.Orders, got)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func Test_orderService_GetOrderHistory(t *testing.T) {
+	history := []models.OrderHistory{
+		{ID: 1, OrderID: 7, Status: models.StatusExpects, Actor: models.Actor{Type: models.ActorCourier, ID: 3, Subject: "3"}},
+		{ID: 2, OrderID: 7, Status: models.StatusAccepted, Actor: models.Actor{Type: models.ActorTerminal, ID: 1001, Subject: "pvz-1"}},
+	}
+	cached := `[{"id":1,"order_id":7,"status":"EXPECTS","created_at":"0001-01-01T00:00:00Z","actor":{"type":"courier","id":3,"subject":"3"}},` +
+		`{"id":2,"order_id":7,"status":"ACCEPTED","created_at":"0001-01-01T00:00:00Z","actor":{"type":"terminal","id":1001,"subject":"pvz-1"}}]`
+
+	tests := []struct {
+		name    string
+		prepare func(storage *mocks.StorageMock, cache *cacheMocks.CacheMock)
+		want    []models.OrderHistory
+		wantErr error
+	}{
+		{
+			name: "served from cache",
+			prepare: func(storage *mocks.StorageMock, cache *cacheMocks.CacheMock) {
+				cache.GetMock.Expect(context.Background(), "order_history:7").Return(cached, nil)
+			},
+			want: history,
+		},
+		{
+			name: "cache miss reads storage and fills cache",
+			prepare: func(storage *mocks.StorageMock, cache *cacheMocks.CacheMock) {
+				cache.GetMock.Return("", errors.New("miss"))
+				storage.GetOrderHistoryMock.Expect(context.Background(), 7).Return(history, nil)
+				cache.SetMock.Expect(context.Background(), "order_history:7", cached).Return(nil)
+			},
+			want: history,
+		},
+		{
+			name: "empty history is not found",
+			prepare: func(storage *mocks.StorageMock, cache *cacheMocks.CacheMock) {
+				cache.GetMock.Return("", errors.New("miss"))
+				storage.GetOrderHistoryMock.Return(nil, nil)
+			},
+			wantErr: domainErrors.ErrOrderNotFound,
+		},
+		{
+			name: "storage error",
+			prepare: func(storage *mocks.StorageMock, cache *cacheMocks.CacheMock) {
+				cache.GetMock.Return("", errors.New("miss"))
+				storage.GetOrderHistoryMock.Return(nil, errors.New("db down"))
+			},
+			wantErr: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			storage := mocks.NewStorageMock(t)
+			cache := cacheMocks.NewCacheMock(t)
+			tt.prepare(storage, cache)
+
+			s := &orderService{storage: storage, cache: cache}
+			got, err := s.GetOrderHistory(context.Background(), 7)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
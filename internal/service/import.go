@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/tools/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// DefaultImportChunkSize сколько заказов из потока сохраняется одной транзакцией
+const DefaultImportChunkSize = 500
+
+// ImportOrder заказ из потока импорта, Err - ошибка разбора запроса, такой заказ сразу попадает в ошибки
+type ImportOrder struct {
+	OrderID     uint64
+	UserID      uint64
+	Weight      models.Grams
+	Price       models.Money
+	ExpiresAt   time.Time
+	PackageType models.PackageType
+	Err         error
+}
+
+// acceptedOrder заказ, прошедший проверки, и событие его приемки
+type acceptedOrder struct {
+	order     models.Order
+	eventType string
+}
+
+func (s *orderService) StartImport(ctx context.Context, jobID string) (models.ImportJob, error) {
+	if jobID == "" {
+		jobID = uuid.NewString()
+	}
+
+	job, err := s.storage.StartImportJob(ctx, jobID)
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Failed to start import job")
+		return models.ImportJob{}, err
+	}
+
+	slog.InfoContext(ctx, "import job started", "job_id", job.ID, "processed", job.Processed)
+	return job, nil
+}
+
+func (s *orderService) ImportChunk(ctx context.Context, job models.ImportJob, orders []ImportOrder) (models.ImportJob, []ItemError, error) {
+	now := time.Now()
+	active, err := s.tariffs.Active(now)
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "No active tariff")
+		return job, nil, err
+	}
+	accepted, invalid := s.prepareImport(active, now, orders)
+
+	var (
+		saved      models.ImportJob
+		itemErrors []ItemError
+	)
+	actor := actorFromContext(ctx)
+	save := func(ctx context.Context, tx pgx.Tx) error {
+		locked, err := s.storage.GetImportJobForUpdateTx(ctx, tx, job.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Processed != job.Processed {
+			return domainErrors.ErrImportJobConflict
+		}
+
+		var toSave []acceptedOrder
+		toSave, itemErrors, err = s.excludeExistingTx(ctx, tx, accepted, invalid)
+		if err != nil {
+			return err
+		}
+
+		newOrders := make([]models.Order, 0, len(toSave))
+		for _, a := range toSave {
+			newOrders = append(newOrders, a.order)
+		}
+		if err := s.storage.SaveOrdersTx(ctx, tx, newOrders, actor); err != nil {
+			return err
+		}
+		for _, a := range toSave {
+			if err := s.publishAcceptedTx(ctx, tx, a.order, a.eventType, actor); err != nil {
+				return err
+			}
+		}
+
+		saved = locked
+		saved.Processed += uint64(len(orders))
+		saved.Imported += uint64(len(toSave))
+		saved.Failed += uint64(len(itemErrors))
+		return s.storage.UpdateImportJobTx(ctx, tx, saved)
+	}
+
+	err = s.storage.WithTransaction(ctx, save)
+	if errors.Is(err, domainErrors.ErrDuplicateOrder) {
+		// заказ с тем же ID успели принять между проверкой и COPY, повторная проверка его отсеет
+		err = s.storage.WithTransaction(ctx, save)
+	}
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Failed to import chunk")
+		return job, nil, err
+	}
+
+	slog.InfoContext(ctx, "import chunk saved", "job_id", saved.ID, "processed", saved.Processed, "imported", saved.Imported, "failed", saved.Failed)
+	return saved, itemErrors, nil
+}
+
+func (s *orderService) CheckImportChunk(ctx context.Context, orders []ImportOrder) ([]ItemError, error) {
+	now := time.Now()
+	active, err := s.tariffs.Active(now)
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "No active tariff")
+		return nil, err
+	}
+	accepted, invalid := s.prepareImport(active, now, orders)
+
+	var itemErrors []ItemError
+	err = s.storage.WithTransaction(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, itemErrors, err = s.excludeExistingTx(ctx, tx, accepted, invalid)
+		return err
+	})
+	if err != nil {
+		logger.LogErrorWithCode(ctx, err, "Failed to check import chunk")
+		return nil, err
+	}
+	return itemErrors, nil
+}
+
+// prepareImport проверяет заказы пачки без похода в базу
+func (s *orderService) prepareImport(active models.Tariff, now time.Time, orders []ImportOrder) ([]acceptedOrder, []ItemError) {
+	var (
+		invalid  []ItemError
+		accepted = make([]acceptedOrder, 0, len(orders))
+		seen     = make(map[uint64]struct{}, len(orders))
+	)
+	for _, item := range orders {
+		if _, ok := seen[item.OrderID]; ok && item.Err == nil {
+			invalid = append(invalid, ItemError{OrderID: item.OrderID, Err: domainErrors.ErrDuplicateOrder})
+			continue
+		}
+
+		a, err := s.newImportedOrder(active, now, item)
+		if err != nil {
+			invalid = append(invalid, ItemError{OrderID: item.OrderID, Err: err})
+			continue
+		}
+		seen[item.OrderID] = struct{}{}
+		accepted = append(accepted, a)
+	}
+	return accepted, invalid
+}
+
+// excludeExistingTx убирает из пачки заказы, которые уже есть в базе, и дописывает их к ошибкам
+func (s *orderService) excludeExistingTx(ctx context.Context, tx pgx.Tx, accepted []acceptedOrder, invalid []ItemError) ([]acceptedOrder, []ItemError, error) {
+	ids := make([]uint64, 0, len(accepted))
+	for _, a := range accepted {
+		ids = append(ids, a.order.ID)
+	}
+	existing, err := s.storage.ExistingOrderIDsTx(ctx, tx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itemErrors := append([]ItemError(nil), invalid...)
+	toSave := make([]acceptedOrder, 0, len(accepted))
+	for _, a := range accepted {
+		if _, ok := existing[a.order.ID]; ok {
+			itemErrors = append(itemErrors, ItemError{OrderID: a.order.ID, Err: domainErrors.ErrOrderAlreadyExists})
+			continue
+		}
+		toSave = append(toSave, a)
+	}
+	return toSave, itemErrors, nil
+}
+
+// newImportedOrder те же проверки, что при приемке одного заказа, кроме похода в базу
+func (s *orderService) newImportedOrder(active models.Tariff, now time.Time, item ImportOrder) (acceptedOrder, error) {
+	if item.Err != nil {
+		return acceptedOrder{}, item.Err
+	}
+	if !IsValidPackage(item.PackageType) {
+		return acceptedOrder{}, domainErrors.ErrInvalidPackage
+	}
+
+	order := models.Order{
+		ID:          item.OrderID,
+		UserID:      item.UserID,
+		ExpiresAt:   item.ExpiresAt,
+		Status:      models.StatusUnspecified,
+		Weight:      item.Weight,
+		Price:       item.Price,
+		PackageType: item.PackageType,
+	}
+
+	transition, err := s.machine.Transition(&order, models.StatusExpects, now)
+	if err != nil {
+		return acceptedOrder{}, err
+	}
+	if err := active.Apply(&order); err != nil {
+		return acceptedOrder{}, err
+	}
+	return acceptedOrder{order: order, eventType: transition.EventType}, nil
+}
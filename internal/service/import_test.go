@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	notificationMocks "PWZ1.0/internal/notification/mocks"
+	cacheMocks "PWZ1.0/internal/order_cache/mocks"
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func importOrder(id uint64) ImportOrder {
+	return ImportOrder{
+		OrderID:     id,
+		UserID:      10,
+		Weight:      1000,
+		Price:       models.NewMoney(10000, models.CurrencyRUB),
+		ExpiresAt:   time.Now().Add(48 * time.Hour),
+		PackageType: models.PackageBox,
+	}
+}
+
+func Test_orderService_ImportChunk(t *testing.T) {
+	t.Parallel()
+
+	job := models.ImportJob{ID: "job", Processed: 3, Imported: 3}
+	broken := importOrder(5)
+	broken.Err = domainErrors.ErrValidationFailed
+	heavy := importOrder(6)
+	heavy.Weight = 30000
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	mockStorage.GetImportJobForUpdateTxMock.Return(job, nil)
+	mockStorage.ExistingOrderIDsTxMock.Return(map[uint64]struct{}{2: {}}, nil)
+
+	var saved []models.Order
+	mockStorage.SaveOrdersTxMock.Set(func(_ context.Context, _ pgx.Tx, orders []models.Order, _ models.Actor) error {
+		saved = orders
+		return nil
+	})
+	mockStorage.SaveEventTxMock.Return(nil)
+
+	var stored models.ImportJob
+	mockStorage.UpdateImportJobTxMock.Set(func(_ context.Context, _ pgx.Tx, job models.ImportJob) error {
+		stored = job
+		return nil
+	})
+
+	mockNotifier := notificationMocks.NewEnqueuerMock(t)
+	mockNotifier.EnqueueTxMock.Return(1, nil)
+
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), mockNotifier, defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+
+	got, itemErrors, err := svc.ImportChunk(context.Background(), job,
+		[]ImportOrder{importOrder(1), importOrder(2), importOrder(1), importOrder(4), broken, heavy})
+	require.NoError(t, err)
+
+	require.Len(t, saved, 2)
+	assert.Equal(t, uint64(1), saved[0].ID)
+	assert.Equal(t, uint64(4), saved[1].ID)
+	assert.Equal(t, models.StatusExpects, saved[0].Status)
+	assert.Equal(t, models.NewMoney(12000, models.CurrencyRUB), saved[0].Price)
+
+	want := map[uint64]error{
+		1: domainErrors.ErrDuplicateOrder,
+		5: domainErrors.ErrValidationFailed,
+		6: domainErrors.ErrWeightTooHeavy,
+		2: domainErrors.ErrOrderAlreadyExists,
+	}
+	require.Len(t, itemErrors, len(want))
+	for _, e := range itemErrors {
+		assert.ErrorIs(t, e.Err, want[e.OrderID], "order %d", e.OrderID)
+	}
+
+	assert.Equal(t, models.ImportJob{ID: "job", Processed: 9, Imported: 5, Failed: 4}, got)
+	assert.Equal(t, got, stored)
+	assert.Equal(t, uint64(2), mockNotifier.EnqueueTxAfterCounter())
+}
+
+func Test_orderService_ImportChunk_Conflict(t *testing.T) {
+	t.Parallel()
+
+	job := models.ImportJob{ID: "job", Processed: 3}
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	// другой поток уже сохранил эту пачку
+	mockStorage.GetImportJobForUpdateTxMock.Return(models.ImportJob{ID: "job", Processed: 4}, nil)
+
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), notificationMocks.NewEnqueuerMock(t), defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+
+	got, itemErrors, err := svc.ImportChunk(context.Background(), job, []ImportOrder{importOrder(4)})
+	assert.ErrorIs(t, err, domainErrors.ErrImportJobConflict)
+	assert.Nil(t, itemErrors)
+	assert.Equal(t, job, got)
+}
+
+func Test_orderService_ImportChunk_RetriesDuplicateRace(t *testing.T) {
+	t.Parallel()
+
+	job := models.ImportJob{ID: "job"}
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	mockStorage.GetImportJobForUpdateTxMock.Return(job, nil)
+
+	// между проверкой и COPY заказ 1 успели принять отдельно
+	attempts := 0
+	mockStorage.ExistingOrderIDsTxMock.Set(func(context.Context, pgx.Tx, []uint64) (map[uint64]struct{}, error) {
+		attempts++
+		if attempts == 1 {
+			return map[uint64]struct{}{}, nil
+		}
+		return map[uint64]struct{}{1: {}}, nil
+	})
+	mockStorage.SaveOrdersTxMock.Set(func(_ context.Context, _ pgx.Tx, orders []models.Order, _ models.Actor) error {
+		if len(orders) == 1 {
+			return domainErrors.ErrDuplicateOrder
+		}
+		return nil
+	})
+	mockStorage.UpdateImportJobTxMock.Return(nil)
+
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), notificationMocks.NewEnqueuerMock(t), defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+
+	got, itemErrors, err := svc.ImportChunk(context.Background(), job, []ImportOrder{importOrder(1)})
+	require.NoError(t, err)
+	require.Len(t, itemErrors, 1)
+	assert.ErrorIs(t, itemErrors[0].Err, domainErrors.ErrOrderAlreadyExists)
+	assert.Equal(t, models.ImportJob{ID: "job", Processed: 1, Failed: 1}, got)
+}
+
+func Test_orderService_CheckImportChunk(t *testing.T) {
+	t.Parallel()
+
+	heavy := importOrder(3)
+	heavy.Weight = 30000
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	mockStorage.ExistingOrderIDsTxMock.Return(map[uint64]struct{}{2: {}}, nil)
+
+	// ничего не сохраняется: вызов SaveOrdersTx или UpdateImportJobTx уронит тест
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), notificationMocks.NewEnqueuerMock(t), defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+
+	itemErrors, err := svc.CheckImportChunk(context.Background(), []ImportOrder{importOrder(1), importOrder(2), heavy})
+	require.NoError(t, err)
+	require.Len(t, itemErrors, 2)
+	assert.Equal(t, uint64(3), itemErrors[0].OrderID)
+	assert.ErrorIs(t, itemErrors[0].Err, domainErrors.ErrWeightTooHeavy)
+	assert.Equal(t, uint64(2), itemErrors[1].OrderID)
+	assert.ErrorIs(t, itemErrors[1].Err, domainErrors.ErrOrderAlreadyExists)
+}
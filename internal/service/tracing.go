@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracedOrderService спан на каждый вызов OrderService; запросы к базе и Redis внутри вызова
+// становятся его дочерними спанами через ctx
+type tracedOrderService struct {
+	next OrderService
+}
+
+func (s tracedOrderService) AcceptOrder(ctx context.Context, orderID, userID uint64, weight models.Grams, price models.Money, expiresAt time.Time, packageType models.PackageType) (models.Order, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.AcceptOrder")
+	span.SetAttributes(attribute.Int64("order.id", int64(orderID)), attribute.Int64("user.id", int64(userID)))
+	order, err := s.next.AcceptOrder(ctx, orderID, userID, weight, price, expiresAt, packageType)
+	tracing.End(span, err)
+	return order, err
+}
+
+func (s tracedOrderService) ReturnOrder(ctx context.Context, orderID uint64) (*OrderResponse, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.ReturnOrder")
+	span.SetAttributes(attribute.Int64("order.id", int64(orderID)))
+	resp, err := s.next.ReturnOrder(ctx, orderID)
+	tracing.End(span, err)
+	return resp, err
+}
+
+// ProcessOrders ошибки по отдельным заказам - обычный результат, спан помечается только их количеством
+func (s tracedOrderService) ProcessOrders(ctx context.Context, userID uint64, action models.ActionType, orderIDs []uint64) ProcessResult {
+	ctx, span := tracing.Start(ctx, "OrderService.ProcessOrders")
+	span.SetAttributes(attribute.Int64("user.id", int64(userID)), attribute.Int("orders.requested", len(orderIDs)))
+	result := s.next.ProcessOrders(ctx, userID, action, orderIDs)
+	span.SetAttributes(attribute.Int("orders.processed", len(result.Processed)), attribute.Int("orders.failed", len(result.Errors)))
+	span.End()
+	return result
+}
+
+func (s tracedOrderService) ListOrders(ctx context.Context, req ListOrdersRequest) ([]models.Order, uint32) {
+	ctx, span := tracing.Start(ctx, "OrderService.ListOrders")
+	span.SetAttributes(attribute.Int64("user.id", int64(req.UserID)))
+	orders, total := s.next.ListOrders(ctx, req)
+	span.SetAttributes(attribute.Int("orders.count", len(orders)))
+	span.End()
+	return orders, total
+}
+
+func (s tracedOrderService) ListReturns(ctx context.Context, req ListReturnsRequest) ReturnsList {
+	ctx, span := tracing.Start(ctx, "OrderService.ListReturns")
+	list := s.next.ListReturns(ctx, req)
+	span.SetAttributes(attribute.Int("orders.count", len(list.Returns)))
+	span.End()
+	return list
+}
+
+func (s tracedOrderService) ScrollOrders(ctx context.Context, userID, lastID uint64, limit int) ([]models.Order, uint64) {
+	ctx, span := tracing.Start(ctx, "OrderService.ScrollOrders")
+	span.SetAttributes(attribute.Int64("user.id", int64(userID)))
+	orders, next := s.next.ScrollOrders(ctx, userID, lastID, limit)
+	span.SetAttributes(attribute.Int("orders.count", len(orders)))
+	span.End()
+	return orders, next
+}
+
+func (s tracedOrderService) GetHistory(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) ([]models.OrderHistory, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.GetHistory")
+	history, err := s.next.GetHistory(ctx, filter, page, count)
+	tracing.End(span, err)
+	return history, err
+}
+
+func (s tracedOrderService) GetOrderHistory(ctx context.Context, orderID uint64) ([]models.OrderHistory, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.GetOrderHistory")
+	span.SetAttributes(attribute.Int64("order.id", int64(orderID)))
+	history, err := s.next.GetOrderHistory(ctx, orderID)
+	tracing.End(span, err)
+	return history, err
+}
+
+func (s tracedOrderService) StartImport(ctx context.Context, jobID string) (models.ImportJob, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.StartImport")
+	span.SetAttributes(attribute.String("import.job_id", jobID))
+	job, err := s.next.StartImport(ctx, jobID)
+	tracing.End(span, err)
+	return job, err
+}
+
+func (s tracedOrderService) ImportChunk(ctx context.Context, job models.ImportJob, orders []ImportOrder) (models.ImportJob, []ItemError, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.ImportChunk")
+	span.SetAttributes(attribute.String("import.job_id", job.ID), attribute.Int("orders.requested", len(orders)))
+	saved, itemErrors, err := s.next.ImportChunk(ctx, job, orders)
+	span.SetAttributes(attribute.Int("orders.failed", len(itemErrors)))
+	tracing.End(span, err)
+	return saved, itemErrors, err
+}
+
+func (s tracedOrderService) CheckImportChunk(ctx context.Context, orders []ImportOrder) ([]ItemError, error) {
+	ctx, span := tracing.Start(ctx, "OrderService.CheckImportChunk")
+	span.SetAttributes(attribute.Int("orders.requested", len(orders)))
+	itemErrors, err := s.next.CheckImportChunk(ctx, orders)
+	tracing.End(span, err)
+	return itemErrors, err
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	notificationMocks "PWZ1.0/internal/notification/mocks"
+	cacheMocks "PWZ1.0/internal/order_cache/mocks"
+	"PWZ1.0/internal/storage/mocks"
+	"PWZ1.0/internal/tracing"
+	"PWZ1.0/internal/tracing/tracingtest"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Test_tracedOrderService_AcceptOrder событие outbox несет контекст спана вызова сервиса, который продолжает трассировку запроса
+func Test_tracedOrderService_AcceptOrder(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+
+	var saved models.Event
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetOrderMock.Return(models.Order{}, domainErrors.ErrOrderNotFound)
+	mockStorage.WithTransactionMock.Set(func(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
+		return fn(ctx, nil)
+	})
+	mockStorage.SaveOrderTxMock.Return(nil)
+	mockStorage.SaveEventTxMock.Set(func(ctx context.Context, tx pgx.Tx, event models.Event) error {
+		saved = event
+		return nil
+	})
+	mockNotifier := notificationMocks.NewEnqueuerMock(t)
+	mockNotifier.EnqueueTxMock.Return(1, nil)
+
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), mockNotifier, defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+
+	ctx, request := tracing.Start(context.Background(), "request")
+	_, err := svc.AcceptOrder(ctx, 1, 10, 1000, models.NewMoney(10000, models.CurrencyRUB), time.Now().Add(48*time.Hour), models.PackageBox)
+	request.End()
+	require.NoError(t, err)
+
+	span := tracingtest.Find(recorder, "OrderService.AcceptOrder")
+	require.NotNil(t, span)
+	assert.Equal(t, request.SpanContext().SpanID(), span.Parent().SpanID())
+
+	remote := trace.SpanContextFromContext(tracing.Extract(context.Background(), saved.TraceContext))
+	assert.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+}
+
+func Test_tracedOrderService_Error(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetHistoryMock.Return(nil, errors.New("db error"))
+
+	svc := NewOrderService(mockStorage, cacheMocks.NewCacheMock(t), notificationMocks.NewEnqueuerMock(t), defaultTariffs(t), models.NewStateMachine(models.DefaultReturnWindow))
+	_, err := svc.GetHistory(context.Background(), models.HistoryFilter{}, 0, 10)
+	require.Error(t, err)
+
+	span := tracingtest.Find(recorder, "OrderService.GetHistory")
+	require.NotNil(t, span)
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, "db error", span.Status().Description)
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyStorage interface {
+	// ReserveIdempotencyKey занимает ключ на lease; если ключ уже занят и не истек - возвращает его запись и false
+	ReserveIdempotencyKey(ctx context.Context, key, requestHash string, lease time.Duration) (models.IdempotencyRecord, bool, error)
+	// CompleteIdempotencyKey сохраняет ответ и продлевает ключ на ttl
+	CompleteIdempotencyKey(ctx context.Context, record models.IdempotencyRecord, ttl time.Duration) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+}
+
+func (ps *PgStorage) ReserveIdempotencyKey(ctx context.Context, key, requestHash string, lease time.Duration) (models.IdempotencyRecord, bool, error) {
+	// истекший ключ занимается заново, как будто его не было: и сохраненный ответ после ttl,
+	// и незавершенная бронь после lease, если запрос не доработал
+	const reserveQuery = `
+		INSERT INTO idempotency_keys (key, request_hash, expires_at)
+		VALUES ($1, $2, now() + $3 * interval '1 second')
+		ON CONFLICT (key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, done = false, code = 0, message = '', response = NULL,
+			created_at = now(), expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < now()
+		RETURNING key
+	`
+	const selectQuery = `
+		SELECT key, request_hash, done, code, message, response
+		FROM idempotency_keys
+		WHERE key = $1
+	`
+
+	// между INSERT и SELECT чужой ключ может истечь и быть очищен, тогда пробуем еще раз
+	for attempt := 0; attempt < 2; attempt++ {
+		err := ps.db.QueryRow(ctx, reserveQuery, key, requestHash, lease.Seconds()).Scan(new(string))
+		if err == nil {
+			return models.IdempotencyRecord{Key: key, RequestHash: requestHash}, true, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to reserve idempotency key", "err", err)
+			return models.IdempotencyRecord{}, false, err
+		}
+
+		var rec models.IdempotencyRecord
+		err = ps.db.QueryRow(ctx, selectQuery, key).Scan(&rec.Key, &rec.RequestHash, &rec.Done, &rec.Code, &rec.Message, &rec.Response)
+		if err == nil {
+			return rec, false, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.ErrorContext(ctx, "failed to get idempotency key", "err", err)
+			return models.IdempotencyRecord{}, false, err
+		}
+	}
+	return models.IdempotencyRecord{}, false, errors.New("idempotency key changed concurrently")
+}
+
+func (ps *PgStorage) CompleteIdempotencyKey(ctx context.Context, record models.IdempotencyRecord, ttl time.Duration) error {
+	// бронь могла истечь и перейти к повтору с другим телом, его ключ не трогаем
+	const query = `
+		UPDATE idempotency_keys
+		SET done = true, code = $3, message = $4, response = $5, expires_at = now() + $6 * interval '1 second'
+		WHERE key = $1 AND request_hash = $2 AND NOT done
+	`
+
+	_, err := ps.db.Exec(ctx, query, record.Key, record.RequestHash, record.Code, record.Message, record.Response, ttl.Seconds())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to complete idempotency key", "err", err)
+	}
+	return err
+}
+
+func (ps *PgStorage) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	const query = `DELETE FROM idempotency_keys WHERE key = $1`
+
+	_, err := ps.db.Exec(ctx, query, key)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete idempotency key", "err", err)
+	}
+	return err
+}
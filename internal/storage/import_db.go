@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"github.com/jackc/pgx/v5"
+)
+
+// StartImportJob возвращает задание импорта, создавая его при первом запуске
+func (ps *PgStorage) StartImportJob(ctx context.Context, id string) (models.ImportJob, error) {
+	const query = `
+		INSERT INTO import_jobs (id)
+		VALUES ($1)
+		ON CONFLICT (id) DO UPDATE SET updated_at = now()
+		RETURNING id, processed, imported, failed, created_at, updated_at
+	`
+
+	job, err := scanImportJob(ps.db.QueryRow(ctx, query, id))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to start import job", "err", err)
+	}
+	return job, err
+}
+
+func (ps *PgStorage) GetImportJobForUpdateTx(ctx context.Context, tx pgx.Tx, id string) (models.ImportJob, error) {
+	const query = `
+		SELECT id, processed, imported, failed, created_at, updated_at
+		FROM import_jobs WHERE id = $1
+		FOR UPDATE
+	`
+
+	job, err := scanImportJob(tx.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		slog.InfoContext(ctx, "import job not found", "job_id", id)
+		return job, domainErrors.ErrImportJobConflict
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get import job", "err", err)
+	}
+	return job, err
+}
+
+func (ps *PgStorage) UpdateImportJobTx(ctx context.Context, tx pgx.Tx, job models.ImportJob) error {
+	const query = `
+		UPDATE import_jobs
+		SET processed = $2, imported = $3, failed = $4, updated_at = now()
+		WHERE id = $1
+	`
+
+	_, err := tx.Exec(ctx, query, job.ID, job.Processed, job.Imported, job.Failed)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update import job", "err", err)
+	}
+	return err
+}
+
+// ExistingOrderIDsTx какие из ids уже есть в таблице заказов
+func (ps *PgStorage) ExistingOrderIDsTx(ctx context.Context, tx pgx.Tx, ids []uint64) (map[uint64]struct{}, error) {
+	const query = `SELECT id FROM orders WHERE id = ANY($1)`
+
+	rows, err := tx.Query(ctx, query, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query existing orders", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	existing := make(map[uint64]struct{})
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			slog.ErrorContext(ctx, "failed to scan order id", "err", err)
+			return nil, err
+		}
+		existing[id] = struct{}{}
+	}
+	return existing, rows.Err()
+}
+
+// SaveOrdersTx пачкой сохраняет заказы и первую запись их истории через COPY
+func (ps *PgStorage) SaveOrdersTx(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	slog.DebugContext(ctx, "copy orders", "count", len(orders))
+
+	tariffVersion := func(v string) any {
+		if v == "" {
+			return nil
+		}
+		return v
+	}
+
+	_, err := tx.CopyFrom(ctx,
+		pgx.Identifier{"orders"},
+		[]string{"id", "user_id", "status", "expires_at", "weight_grams", "price_minor", "currency", "package_type", "tariff_version"},
+		pgx.CopyFromSlice(len(orders), func(i int) ([]any, error) {
+			o := orders[i]
+			return []any{
+				int64(o.ID),
+				int64(o.UserID),
+				string(o.Status),
+				o.ExpiresAt,
+				int64(o.Weight),
+				o.Price.Amount,
+				string(o.Price.Currency.OrDefault()),
+				string(o.PackageType),
+				tariffVersion(o.TariffVersion),
+			}, nil
+		}),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			slog.InfoContext(ctx, "duplicate order in batch", "err", err)
+			return domainErrors.ErrDuplicateOrder
+		}
+		slog.ErrorContext(ctx, "failed to copy orders", "err", err)
+		return err
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"order_history"},
+		[]string{"order_id", "status", "actor_type", "actor_id", "actor_subject"},
+		pgx.CopyFromSlice(len(orders), func(i int) ([]any, error) {
+			return []any{int64(orders[i].ID), string(orders[i].Status), string(actor.Type), int64(actor.ID), actor.Subject}, nil
+		}),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to copy order history", "err", err)
+	}
+	return err
+}
+
+func scanImportJob(row pgx.Row) (models.ImportJob, error) {
+	var job models.ImportJob
+	err := row.Scan(&job.ID, &job.Processed, &job.Imported, &job.Failed, &job.CreatedAt, &job.UpdatedAt)
+	return job, err
+}
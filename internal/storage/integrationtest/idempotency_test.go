@@ -0,0 +1,76 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"time"
+
+	"PWZ1.0/internal/models"
+)
+
+func (s *PgStorageSuite) Test_IdempotencyKey() {
+	rec, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "k", "hash", time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+
+	// первый запрос еще выполняется
+	got, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "k", "other", time.Hour)
+	s.Require().NoError(err)
+	s.Require().False(reserved)
+	s.Require().Equal(models.IdempotencyRecord{Key: "k", RequestHash: "hash"}, got)
+
+	rec.Done = true
+	rec.Code = 6
+	rec.Message = "заказ уже есть"
+	s.Require().NoError(s.storage.CompleteIdempotencyKey(s.ctx, rec, time.Hour))
+
+	got, reserved, err = s.storage.ReserveIdempotencyKey(s.ctx, "k", "hash", time.Hour)
+	s.Require().NoError(err)
+	s.Require().False(reserved)
+	s.Require().Equal(rec, got)
+
+	s.Require().NoError(s.storage.DeleteIdempotencyKey(s.ctx, "k"))
+	_, reserved, err = s.storage.ReserveIdempotencyKey(s.ctx, "k", "hash", time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+}
+
+func (s *PgStorageSuite) Test_IdempotencyKey_StaleReservation() {
+	_, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "stale", "hash", 10*time.Millisecond)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+	time.Sleep(20 * time.Millisecond)
+
+	// первый запрос не завершился за lease, повтор занимает ключ заново
+	rec, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "stale", "hash", 10*time.Millisecond)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+
+	// после ответа ключ живет ttl, а не lease
+	rec.Done = true
+	s.Require().NoError(s.storage.CompleteIdempotencyKey(s.ctx, rec, time.Hour))
+	time.Sleep(20 * time.Millisecond)
+	got, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "stale", "hash", time.Minute)
+	s.Require().NoError(err)
+	s.Require().False(reserved)
+	s.Require().True(got.Done)
+}
+
+func (s *PgStorageSuite) Test_IdempotencyKey_Expired() {
+	_, reserved, err := s.storage.ReserveIdempotencyKey(s.ctx, "k", "hash", time.Millisecond)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+	time.Sleep(10 * time.Millisecond)
+
+	// истекший ключ занимается заново
+	_, reserved, err = s.storage.ReserveIdempotencyKey(s.ctx, "k", "other", time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(reserved)
+
+	_, _, err = s.storage.ReserveIdempotencyKey(s.ctx, "old", "hash", time.Millisecond)
+	s.Require().NoError(err)
+	time.Sleep(10 * time.Millisecond)
+	n, err := s.storage.PurgeExpiredIdempotencyKeys(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), n)
+}
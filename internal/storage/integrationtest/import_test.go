@@ -0,0 +1,138 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	notificationMocks "PWZ1.0/internal/notification/mocks"
+	cacheMocks "PWZ1.0/internal/order_cache/mocks"
+	"PWZ1.0/internal/service"
+	"PWZ1.0/internal/storage"
+	"PWZ1.0/internal/tariff"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *PgStorageSuite) Test_ImportChunk_Resume() {
+	notifier := notificationMocks.NewEnqueuerMock(s.T())
+	notifier.EnqueueTxMock.Return(1, nil)
+	tariffs, err := tariff.NewBook(models.DefaultTariff)
+	s.Require().NoError(err)
+	_, err = s.storage.SaveTariff(s.ctx, models.DefaultTariff)
+	s.Require().NoError(err)
+	svc := service.NewOrderService(s.storage, cacheMocks.NewCacheMock(s.T()), notifier, tariffs, models.NewStateMachine(models.DefaultReturnWindow))
+
+	s.saveOrder(models.Order{ID: 2, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+
+	item := func(id uint64) service.ImportOrder {
+		return service.ImportOrder{
+			OrderID:     id,
+			UserID:      10,
+			Weight:      1000,
+			Price:       models.NewMoney(9999, models.CurrencyRUB),
+			ExpiresAt:   time.Now().Add(48 * time.Hour),
+			PackageType: models.PackageBox,
+		}
+	}
+
+	job, err := svc.StartImport(s.ctx, "job-1")
+	s.Require().NoError(err)
+	s.Require().Zero(job.Processed)
+
+	job, itemErrors, err := svc.ImportChunk(s.ctx, job, []service.ImportOrder{item(1), item(2), item(3)})
+	s.Require().NoError(err)
+	s.Require().Len(itemErrors, 1)
+	s.Require().ErrorIs(itemErrors[0].Err, domainErrors.ErrOrderAlreadyExists)
+
+	// повторный запуск видит, сколько уже обработано
+	resumed, err := svc.StartImport(s.ctx, "job-1")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), resumed.Processed)
+	s.Require().Equal(uint64(2), resumed.Imported)
+	s.Require().Equal(uint64(1), resumed.Failed)
+
+	// устаревшее состояние задания не дает сохранить пачку второй раз
+	_, _, err = svc.ImportChunk(s.ctx, models.ImportJob{ID: "job-1"}, []service.ImportOrder{item(4)})
+	s.Require().ErrorIs(err, domainErrors.ErrImportJobConflict)
+
+	order, err := s.storage.GetOrder(s.ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusExpects, order.Status)
+	s.Require().Equal(models.NewMoney(11999, models.CurrencyRUB), order.Price)
+	s.Require().Equal(models.DefaultTariffVersion, order.TariffVersion)
+	s.Require().Equal(1, s.countHistory(3, models.StatusExpects))
+
+	_, err = s.storage.GetOrder(s.ctx, 4)
+	s.Require().ErrorIs(err, domainErrors.ErrOrderNotFound)
+}
+
+// racingStorage принимает заказ в отдельной транзакции перед первым COPY пачки, как параллельный AcceptOrder
+type racingStorage struct {
+	*storage.PgStorage
+	race  func()
+	raced bool
+}
+
+func (r *racingStorage) SaveOrdersTx(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) error {
+	if !r.raced {
+		r.raced = true
+		r.race()
+	}
+	return r.PgStorage.SaveOrdersTx(ctx, tx, orders, actor)
+}
+
+func (s *PgStorageSuite) Test_ImportChunk_DuplicateRace() {
+	notifier := notificationMocks.NewEnqueuerMock(s.T())
+	notifier.EnqueueTxMock.Return(1, nil)
+	tariffs, err := tariff.NewBook(models.DefaultTariff)
+	s.Require().NoError(err)
+	_, err = s.storage.SaveTariff(s.ctx, models.DefaultTariff)
+	s.Require().NoError(err)
+
+	racing := &racingStorage{PgStorage: s.storage, race: func() {
+		s.saveOrder(models.Order{ID: 2, UserID: 20, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+	}}
+	svc := service.NewOrderService(racing, cacheMocks.NewCacheMock(s.T()), notifier, tariffs, models.NewStateMachine(models.DefaultReturnWindow))
+
+	item := func(id uint64) service.ImportOrder {
+		return service.ImportOrder{
+			OrderID:     id,
+			UserID:      10,
+			Weight:      1000,
+			Price:       models.NewMoney(9999, models.CurrencyRUB),
+			ExpiresAt:   time.Now().Add(48 * time.Hour),
+			PackageType: models.PackageBox,
+		}
+	}
+
+	// COPY падает на unique violation, повторная попытка отсеивает заказ 2 и сохраняет остальные
+	job, err := svc.StartImport(s.ctx, "job-race")
+	s.Require().NoError(err)
+	job, itemErrors, err := svc.ImportChunk(s.ctx, job, []service.ImportOrder{item(1), item(2), item(3)})
+	s.Require().NoError(err)
+	s.Require().True(racing.raced)
+	s.Require().Len(itemErrors, 1)
+	s.Require().Equal(uint64(2), itemErrors[0].OrderID)
+	s.Require().ErrorIs(itemErrors[0].Err, domainErrors.ErrOrderAlreadyExists)
+	s.Require().Equal(uint64(2), job.Imported)
+
+	order, err := s.storage.GetOrder(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(20), order.UserID)
+	_, err = s.storage.GetOrder(s.ctx, 3)
+	s.Require().NoError(err)
+}
+
+func (s *PgStorageSuite) Test_SaveOrdersTx_Duplicate() {
+	s.saveOrder(models.Order{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+
+	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.SaveOrdersTx(ctx, tx, []models.Order{
+			{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"},
+		}, testActor)
+	})
+	s.Require().ErrorIs(err, domainErrors.ErrDuplicateOrder)
+}
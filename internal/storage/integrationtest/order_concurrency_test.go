@@ -0,0 +1,129 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	cacheMocks "PWZ1.0/internal/order_cache/mocks"
+	"PWZ1.0/internal/service"
+	"PWZ1.0/internal/tariff"
+	"github.com/jackc/pgx/v5"
+)
+
+const parallelCalls = 8
+
+func (s *PgStorageSuite) newOrderService() service.OrderService {
+	cache := cacheMocks.NewCacheMock(s.T())
+	cache.DeleteMock.Optional().Return(nil)
+	tariffs, err := tariff.NewBook(models.DefaultTariff)
+	s.Require().NoError(err)
+	return service.NewOrderService(s.storage, cache, nil, tariffs, models.NewStateMachine(models.DefaultReturnWindow))
+}
+
+func (s *PgStorageSuite) saveOrder(order models.Order) {
+	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PgStorageSuite) countHistory(orderID uint64, status models.OrderStatus) int {
+	history, err := s.storage.GetOrderHistory(s.ctx, orderID)
+	s.Require().NoError(err)
+
+	var n int
+	for _, h := range history {
+		if h.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *PgStorageSuite) Test_ProcessOrders_ParallelIssue() {
+	s.saveOrder(models.Order{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+	svc := s.newOrderService()
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		processed int
+	)
+	for i := 0; i < parallelCalls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := svc.ProcessOrders(s.ctx, 10, models.ActionTypeIssue, []uint64{1})
+			mu.Lock()
+			processed += len(res.Processed)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	s.Require().Equal(1, processed)
+	s.Require().Equal(1, s.countHistory(1, models.StatusAccepted))
+}
+
+func (s *PgStorageSuite) Test_ReturnOrder_Parallel() {
+	s.saveOrder(models.Order{ID: 1, UserID: 10, Status: models.StatusReturned, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+	svc := s.newOrderService()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		returned int
+		errs     []error
+	)
+	for i := 0; i < parallelCalls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := svc.ReturnOrder(s.ctx, 1)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			returned++
+		}()
+	}
+	wg.Wait()
+
+	s.Require().Equal(1, returned)
+	for _, err := range errs {
+		s.Require().ErrorIs(err, domainErrors.ErrOrderAlreadyReturned)
+	}
+	s.Require().Equal(1, s.countHistory(1, models.StatusDeleted))
+
+	var events int
+	err := s.db.QueryRow(s.ctx, `SELECT count(*) FROM outbox`).Scan(&events)
+	s.Require().NoError(err)
+	s.Require().Equal(1, events)
+}
+
+func (s *PgStorageSuite) Test_DeleteOrderTx_Rollback() {
+	s.saveOrder(models.Order{ID: 1, UserID: 10, Status: models.StatusReturned, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+
+	errEvent := errors.New("event write failed")
+	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.storage.DeleteOrderTx(ctx, tx, 1, testActor); err != nil {
+			return err
+		}
+		return errEvent
+	})
+	s.Require().ErrorIs(err, errEvent)
+
+	order, err := s.storage.GetOrder(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusReturned, order.Status)
+	s.Require().Nil(order.DeletedAt)
+	s.Require().Zero(s.countHistory(1, models.StatusDeleted))
+}
@@ -0,0 +1,98 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	benchOrders = 100_000
+	benchUsers  = 1_000
+)
+
+// Сравнение старого пути (вся таблица в память и фильтр в Go) с QueryOrders.
+// Запуск: go test ./internal/storage/integrationtest -run '^$' -bench ListOrders -benchmem
+func BenchmarkListOrders(b *testing.B) {
+	ctx := context.Background()
+
+	pool, container, err := startPostgres(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() { _ = container.Terminate(ctx) }()
+	defer pool.Close()
+
+	if err := seedOrders(ctx, pool); err != nil {
+		b.Fatal(err)
+	}
+	st := storage.NewPgStorage(pool)
+
+	const userID, limit = 42, 10
+	inPvz := []models.OrderStatus{models.StatusExpects, models.StatusReturned}
+
+	b.Run("InMemory", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			all, err := st.ListOrders(ctx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			filtered := make([]models.Order, 0)
+			for _, o := range all {
+				if o.UserID == userID && (o.Status == models.StatusExpects || o.Status == models.StatusReturned) {
+					filtered = append(filtered, o)
+				}
+			}
+			if len(filtered) > limit {
+				filtered = filtered[:limit]
+			}
+		}
+	})
+
+	b.Run("QueryOrders", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := st.QueryOrders(ctx, models.OrderFilter{UserID: userID, Statuses: inPvz, Limit: limit})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func seedOrders(ctx context.Context, pool *pgxpool.Pool) error {
+	statuses := []models.OrderStatus{models.StatusExpects, models.StatusAccepted, models.StatusReturned}
+	expires := time.Now().Add(24 * time.Hour)
+
+	rows := make([][]any, 0, benchOrders)
+	for i := 1; i <= benchOrders; i++ {
+		rows = append(rows, []any{
+			int64(i),
+			int64(i % benchUsers),
+			string(statuses[i%len(statuses)]),
+			expires,
+			int64(1000),
+			int64(10000),
+			string(models.CurrencyRUB),
+			string(models.PackageBox),
+		})
+	}
+
+	_, err := pool.CopyFrom(ctx,
+		pgx.Identifier{"orders"},
+		[]string{"id", "user_id", "status", "expires_at", "weight_grams", "price_minor", "currency", "package_type"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = pool.Exec(ctx, "ANALYZE orders")
+	return err
+}
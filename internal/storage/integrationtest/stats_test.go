@@ -0,0 +1,42 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *PgStorageSuite) Test_BusinessStats() {
+	now := time.Now().UTC()
+	orders := []models.Order{
+		{ID: 1, UserID: 1, Status: models.StatusExpects, ExpiresAt: now.Add(time.Hour), Weight: 1000, Price: models.NewMoney(5000, models.CurrencyRUB), PackageType: models.PackageBox},
+		{ID: 2, UserID: 1, Status: models.StatusExpects, ExpiresAt: now.Add(-time.Hour), Weight: 2000, Price: models.NewMoney(7000, models.CurrencyRUB), PackageType: models.PackageBox},
+		{ID: 3, UserID: 2, Status: models.StatusReturned, ExpiresAt: now.Add(time.Hour), Weight: 500, Price: models.NewMoney(300, models.CurrencyRUB), PackageType: models.PackageBag},
+		{ID: 4, UserID: 2, Status: models.StatusAccepted, ExpiresAt: now.Add(time.Hour), Weight: 9000, Price: models.NewMoney(100000, models.CurrencyRUB), PackageType: models.PackageBox},
+	}
+	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.SaveOrdersTx(ctx, tx, orders, testActor)
+	})
+	s.Require().NoError(err)
+
+	stats, err := s.storage.BusinessStats(s.ctx)
+	s.Require().NoError(err)
+
+	s.Equal(map[models.OrderStatus]int64{
+		models.StatusExpects:  2,
+		models.StatusReturned: 1,
+		models.StatusAccepted: 1,
+	}, stats.ByStatus)
+	s.Equal(int64(1), stats.ExpiredExpects)
+	s.Equal(int64(1), stats.ReturnsAwaitingCourier)
+	// выданный клиенту заказ в ПВЗ не лежит
+	s.Equal(models.Grams(3500), stats.StoredWeight)
+	s.Equal([]models.PackageValue{
+		{Package: models.PackageBag, Value: models.NewMoney(300, models.CurrencyRUB)},
+		{Package: models.PackageBox, Value: models.NewMoney(12000, models.CurrencyRUB)},
+	}, stats.StoredValue)
+}
@@ -1,3 +1,5 @@
+//go:build integration
+
 package integrationtest
 
 import (
@@ -11,6 +13,7 @@ import (
 	"PWZ1.0/internal/models"
 	"PWZ1.0/internal/models/domainErrors"
 	"PWZ1.0/internal/storage"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
@@ -19,6 +22,9 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
+// testActor автор смен статусов в тестах хранилища
+var testActor = models.Actor{Type: models.ActorOperator, ID: 7, Subject: "7"}
+
 type PgStorageSuite struct {
 	suite.Suite
 	db        *pgxpool.Pool
@@ -31,36 +37,10 @@ type PgStorageSuite struct {
 func (s *PgStorageSuite) SetupSuite() {
 	s.ctx, s.cancel = context.WithTimeout(context.Background(), 5*time.Minute)
 
-	req := testcontainers.ContainerRequest{
-		Image:        "postgres:16",
-		ExposedPorts: []string{"5432/tcp"},
-		Env: map[string]string{
-			"POSTGRES_PASSWORD": "test",
-			"POSTGRES_USER":     "test",
-			"POSTGRES_DB":       "testdb",
-		},
-		WaitingFor: wait.ForListeningPort("5432/tcp"),
-	}
-	container, err := testcontainers.GenericContainer(s.ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          true,
-	})
+	pool, container, err := startPostgres(s.ctx)
 	require.NoError(s.T(), err)
 	s.container = container
 
-	host, err := container.Host(s.ctx)
-	require.NoError(s.T(), err)
-
-	port, err := container.MappedPort(s.ctx, "5432")
-	require.NoError(s.T(), err)
-
-	dsn := "postgres://test:test@" + host + ":" + port.Port() + "/testdb?sslmode=disable"
-	pool, err := pgxpool.New(s.ctx, dsn)
-	require.NoError(s.T(), err)
-
-	err = migrateTestDB(pool)
-	require.NoError(s.T(), err)
-
 	s.db = pool
 	s.storage = storage.NewPgStorage(pool)
 }
@@ -82,6 +62,10 @@ func (s *PgStorageSuite) TearDownTest() {
 	_, err := s.db.Exec(s.ctx, `
 		TRUNCATE TABLE orders CASCADE;
 		TRUNCATE TABLE order_history CASCADE;
+		TRUNCATE TABLE outbox;
+		TRUNCATE TABLE tariffs CASCADE;
+		TRUNCATE TABLE import_jobs;
+		TRUNCATE TABLE idempotency_keys;
 	`)
 	require.NoError(s.T(), err)
 }
@@ -92,13 +76,13 @@ func (s *PgStorageSuite) Test_SaveAndGetOrder() {
 		UserID:      10,
 		Status:      "ACCEPTED",
 		ExpiresAt:   time.Now().UTC().Add(24 * time.Hour),
-		Weight:      10,
-		Price:       1000,
+		Weight:      10000,
+		Price:       models.NewMoney(100000, models.CurrencyRUB),
 		PackageType: "box",
 	}
 
 	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.SaveOrderTx(ctx, tx, order)
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
 	})
 	s.Require().NoError(err)
 
@@ -120,21 +104,74 @@ func (s *PgStorageSuite) Test_DeleteOrder() {
 		UserID:      10,
 		Status:      "RETURNED",
 		ExpiresAt:   time.Now().UTC().Add(24 * time.Hour),
-		Weight:      10,
-		Price:       100,
+		Weight:      10000,
+		Price:       models.NewMoney(10000, models.CurrencyRUB),
 		PackageType: "box",
 	}
 
 	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.SaveOrderTx(ctx, tx, order)
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
 	})
 	s.Require().NoError(err)
 
-	err = s.storage.DeleteOrder(s.ctx, order.ID)
+	err = s.deleteOrder(order.ID)
+	s.Require().NoError(err)
+
+	got, err := s.storage.GetOrder(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusDeleted, got.Status)
+	s.Require().NotNil(got.DeletedAt)
+
+	history, err := s.storage.GetOrderHistory(s.ctx, order.ID)
 	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(models.StatusDeleted, history[1].Status)
+	s.Require().Equal(testActor, history[1].Actor)
 
-	_, err = s.storage.GetOrder(s.ctx, order.ID)
+	// повторное удаление не пишет историю
+	err = s.deleteOrder(order.ID)
 	s.Require().ErrorIs(err, domainErrors.ErrOrderNotFound)
+
+	page, err := s.storage.QueryOrders(s.ctx, models.OrderFilter{UserID: order.UserID})
+	s.Require().NoError(err)
+	s.Require().Empty(page.Orders)
+
+	page, err = s.storage.QueryOrders(s.ctx, models.OrderFilter{UserID: order.UserID, IncludeDeleted: true})
+	s.Require().NoError(err)
+	s.Require().Len(page.Orders, 1)
+	s.Require().Equal(uint32(1), page.Total)
+}
+
+func (s *PgStorageSuite) deleteOrder(id uint64) error {
+	return s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.DeleteOrderTx(ctx, tx, id, testActor)
+	})
+}
+
+func (s *PgStorageSuite) Test_PurgeDeletedOrders() {
+	for _, id := range []uint64{1, 2, 3} {
+		order := models.Order{ID: id, UserID: 10, Status: "RETURNED", ExpiresAt: time.Now().UTC(), Weight: 1000, PackageType: "box"}
+		err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+			return s.storage.SaveOrderTx(ctx, tx, order, testActor)
+		})
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(s.deleteOrder(1))
+	s.Require().NoError(s.deleteOrder(2))
+
+	_, err := s.db.Exec(s.ctx, `UPDATE orders SET deleted_at = now() - interval '40 days' WHERE id = 1`)
+	s.Require().NoError(err)
+
+	n, err := s.storage.PurgeDeletedOrders(s.ctx, time.Now().Add(-30*24*time.Hour), 100)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), n)
+
+	_, err = s.storage.GetOrder(s.ctx, 1)
+	s.Require().ErrorIs(err, domainErrors.ErrOrderNotFound)
+	_, err = s.storage.GetOrder(s.ctx, 2)
+	s.Require().NoError(err)
+	_, err = s.storage.GetOrder(s.ctx, 3)
+	s.Require().NoError(err)
 }
 
 func (s *PgStorageSuite) Test_UpdateOrderTx() {
@@ -143,22 +180,22 @@ func (s *PgStorageSuite) Test_UpdateOrderTx() {
 		UserID:      10,
 		Status:      "ACCEPTED",
 		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		Weight:      10,
-		Price:       100,
+		Weight:      10000,
+		Price:       models.NewMoney(10000, models.CurrencyRUB),
 		PackageType: "box",
 	}
 	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.SaveOrderTx(ctx, tx, order)
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
 	})
 	s.Require().NoError(err)
 
 	updatedOrder := order
 	updatedOrder.Status = "RETURNED"
-	updatedOrder.Weight = 11
-	updatedOrder.Price = 150
+	updatedOrder.Weight = 11000
+	updatedOrder.Price = models.NewMoney(15000, models.CurrencyRUB)
 
 	err = s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.UpdateOrderTx(ctx, tx, updatedOrder)
+		return s.storage.UpdateOrderTx(ctx, tx, updatedOrder, testActor)
 	})
 	s.Require().NoError(err)
 
@@ -169,7 +206,7 @@ func (s *PgStorageSuite) Test_UpdateOrderTx() {
 	s.Require().Equal(updatedOrder.Weight, got.Weight)
 	s.Require().Equal(updatedOrder.Price, got.Price)
 
-	history, err := s.storage.GetHistory(s.ctx, 0, 10)
+	history, err := s.storage.GetHistory(s.ctx, models.HistoryFilter{}, 0, 10)
 	s.Require().NoError(err)
 
 	found := false
@@ -189,8 +226,8 @@ func (s *PgStorageSuite) Test_ListOrders() {
 			UserID:      10,
 			Status:      "EXPECTS",
 			ExpiresAt:   time.Now().Add(24 * time.Hour).UTC(),
-			Weight:      10,
-			Price:       100,
+			Weight:      10000,
+			Price:       models.NewMoney(10000, models.CurrencyRUB),
 			PackageType: "box",
 		},
 		{
@@ -198,15 +235,15 @@ func (s *PgStorageSuite) Test_ListOrders() {
 			UserID:      20,
 			Status:      "ACCEPTED",
 			ExpiresAt:   time.Now().Add(48 * time.Hour).UTC(),
-			Weight:      20,
-			Price:       200,
+			Weight:      20000,
+			Price:       models.NewMoney(20000, models.CurrencyRUB),
 			PackageType: "tape",
 		},
 	}
 
 	for _, o := range orders {
 		err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-			return s.storage.SaveOrderTx(ctx, tx, o)
+			return s.storage.SaveOrderTx(ctx, tx, o, testActor)
 		})
 		s.Require().NoError(err)
 	}
@@ -240,23 +277,23 @@ func (s *PgStorageSuite) Test_GetHistory() {
 		UserID:      10,
 		Status:      "EXPECTS",
 		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		Weight:      10,
-		Price:       100,
+		Weight:      10000,
+		Price:       models.NewMoney(10000, models.CurrencyRUB),
 		PackageType: "tape",
 	}
 
 	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.SaveOrderTx(ctx, tx, order)
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
 	})
 	s.Require().NoError(err)
 
 	order.Status = "ACCEPTED"
 	err = s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return s.storage.UpdateOrderTx(ctx, tx, order)
+		return s.storage.UpdateOrderTx(ctx, tx, order, testActor)
 	})
 	s.Require().NoError(err)
 
-	history, err := s.storage.GetHistory(s.ctx, 0, 10)
+	history, err := s.storage.GetHistory(s.ctx, models.HistoryFilter{}, 0, 10)
 	s.Require().NoError(err)
 
 	var foundExpects, foundAccepted bool
@@ -272,10 +309,245 @@ func (s *PgStorageSuite) Test_GetHistory() {
 	s.Require().True(foundAccepted, "не хватает статуса accepted")
 }
 
+func (s *PgStorageSuite) Test_GetHistory_Filter() {
+	s.saveOrder(models.Order{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+	s.saveOrder(models.Order{ID: 2, UserID: 20, Status: models.StatusExpects, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"})
+
+	issued := models.Order{ID: 1, UserID: 10, Status: models.StatusAccepted, ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box"}
+	err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.UpdateOrderTx(ctx, tx, issued, testActor)
+	})
+	s.Require().NoError(err)
+
+	history, err := s.storage.GetHistory(s.ctx, models.HistoryFilter{UserID: 10}, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	for _, h := range history {
+		s.Require().Equal(uint64(1), h.OrderID)
+	}
+
+	history, err = s.storage.GetHistory(s.ctx, models.HistoryFilter{Statuses: []models.OrderStatus{models.StatusAccepted}}, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(models.StatusAccepted, history[0].Status)
+
+	history, err = s.storage.GetHistory(s.ctx, models.HistoryFilter{CreatedFrom: time.Now().Add(time.Hour)}, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+}
+
+func (s *PgStorageSuite) Test_GetOrderHistory() {
+	target := models.Order{ID: 1, UserID: 10, Status: "EXPECTS", ExpiresAt: time.Now().Add(24 * time.Hour), Weight: 1000, PackageType: "tape"}
+	other := models.Order{ID: 2, UserID: 20, Status: "EXPECTS", ExpiresAt: time.Now().Add(24 * time.Hour), Weight: 1000, PackageType: "box"}
+
+	for _, o := range []models.Order{target, other} {
+		err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+			return s.storage.SaveOrderTx(ctx, tx, o, testActor)
+		})
+		s.Require().NoError(err)
+	}
+
+	// больше одной страницы глобальной истории, вперемешку с чужим заказом
+	statuses := []models.OrderStatus{"ACCEPTED", "RETURNED"}
+	for i := 0; i < 60; i++ {
+		for _, o := range []models.Order{target, other} {
+			o.Status = statuses[i%len(statuses)]
+			err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+				return s.storage.UpdateOrderTx(ctx, tx, o, testActor)
+			})
+			s.Require().NoError(err)
+		}
+	}
+
+	history, err := s.storage.GetOrderHistory(s.ctx, target.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 61)
+	s.Require().Equal(models.OrderStatus("EXPECTS"), history[0].Status)
+	for i, h := range history {
+		s.Require().Equal(target.ID, h.OrderID)
+		if i > 0 {
+			s.Require().False(h.CreatedAt.Before(history[i-1].CreatedAt), "история не по порядку")
+			s.Require().Greater(h.ID, history[i-1].ID)
+		}
+	}
+
+	empty, err := s.storage.GetOrderHistory(s.ctx, 999)
+	s.Require().NoError(err)
+	s.Require().Empty(empty)
+}
+
+func (s *PgStorageSuite) Test_Tariffs() {
+	saved, err := s.storage.SaveTariff(s.ctx, models.DefaultTariff)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	// сохраненная версия не перезаписывается
+	changed := models.DefaultTariff
+	changed.Packages = map[models.PackageType]models.PackageTariff{models.PackageBox: {Surcharge: 100}}
+	saved, err = s.storage.SaveTariff(s.ctx, changed)
+	s.Require().NoError(err)
+	s.Require().False(saved)
+
+	tariffs, err := s.storage.ListTariffs(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(tariffs, 1)
+	s.Require().Equal(models.DefaultTariff.Packages, tariffs[0].Packages)
+
+	order := models.Order{ID: 1, UserID: 10, Status: "EXPECTS", ExpiresAt: time.Now().Add(time.Hour), Weight: 1000, PackageType: "box", TariffVersion: models.DefaultTariffVersion}
+	err = s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.storage.SaveOrderTx(ctx, tx, order, testActor)
+	})
+	s.Require().NoError(err)
+
+	got, err := s.storage.GetOrder(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(models.DefaultTariffVersion, got.TariffVersion)
+}
+
+func (s *PgStorageSuite) Test_ClaimOutboxBatch() {
+	events := []models.Event{
+		{EventID: uuid.New(), EventType: "order_accepted", Order: models.EventOrder{ID: 1}},
+		{EventID: uuid.New(), EventType: "order_issued", Order: models.EventOrder{ID: 1}},
+	}
+	for _, e := range events {
+		err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+			return s.storage.SaveEventTx(ctx, tx, e)
+		})
+		s.Require().NoError(err)
+	}
+
+	batch, err := s.storage.ClaimOutboxBatch(s.ctx, 10, 3, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(batch, 2)
+	s.Require().Equal(1, batch[0].Attempts)
+
+	// взятые в работу события повторно не выдаются
+	again, err := s.storage.ClaimOutboxBatch(s.ctx, 10, 3, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Empty(again)
+
+	s.Require().NoError(s.storage.MarkOutboxCompleted(s.ctx, batch[0].ID))
+	s.Require().NoError(s.storage.MarkOutboxFailed(s.ctx, batch[1].ID, "broker is down", time.Now().Add(-time.Second)))
+
+	retry, err := s.storage.ClaimOutboxBatch(s.ctx, 10, 3, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(retry, 1)
+	s.Require().Equal(batch[1].ID, retry[0].ID)
+	s.Require().Equal(2, retry[0].Attempts)
+
+	stats, err := s.storage.OutboxStats(s.ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), stats.Pending)
+}
+
+func (s *PgStorageSuite) Test_QueryOrders() {
+	expires := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	orders := []models.Order{
+		{ID: 1, UserID: 10, Status: models.StatusExpects, ExpiresAt: expires.Add(3 * time.Hour)},
+		{ID: 2, UserID: 10, Status: models.StatusAccepted, ExpiresAt: expires.Add(2 * time.Hour)},
+		{ID: 3, UserID: 10, Status: models.StatusReturned, ExpiresAt: expires.Add(1 * time.Hour)},
+		{ID: 4, UserID: 20, Status: models.StatusExpects, ExpiresAt: expires},
+		{ID: 5, UserID: 10, Status: models.StatusExpects, ExpiresAt: expires},
+	}
+	for _, o := range orders {
+		o.Weight = 1000
+		o.PackageType = models.PackageBox
+		err := s.storage.WithTransaction(s.ctx, func(ctx context.Context, tx pgx.Tx) error {
+			return s.storage.SaveOrderTx(ctx, tx, o, testActor)
+		})
+		s.Require().NoError(err)
+	}
+
+	ids := func(page models.OrdersPage) []uint64 {
+		var out []uint64
+		for _, o := range page.Orders {
+			out = append(out, o.ID)
+		}
+		return out
+	}
+
+	page, err := s.storage.QueryOrders(s.ctx, models.OrderFilter{
+		UserID:   10,
+		Statuses: []models.OrderStatus{models.StatusExpects, models.StatusReturned},
+		Limit:    2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(3), page.Total)
+	s.Require().Equal([]uint64{1, 3}, ids(page))
+	s.Require().NotNil(page.NextCursor)
+
+	page, err = s.storage.QueryOrders(s.ctx, models.OrderFilter{
+		UserID:   10,
+		Statuses: []models.OrderStatus{models.StatusExpects, models.StatusReturned},
+		Cursor:   page.NextCursor,
+		Limit:    2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{5}, ids(page))
+	s.Require().Nil(page.NextCursor)
+
+	page, err = s.storage.QueryOrders(s.ctx, models.OrderFilter{UserID: 10, LastN: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(4), page.Total)
+	s.Require().Equal([]uint64{3, 5}, ids(page))
+
+	page, err = s.storage.QueryOrders(s.ctx, models.OrderFilter{
+		ExpiresFrom: expires.Add(time.Hour),
+		SortBy:      models.SortByExpiresAt,
+		Desc:        true,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2, 3}, ids(page))
+}
+
 func TestPgStorageSuite(t *testing.T) {
 	suite.Run(t, new(PgStorageSuite))
 }
 
+// startPostgres поднимает контейнер с Postgres и применяет тестовые миграции
+func startPostgres(ctx context.Context) (*pgxpool.Pool, testcontainers.Container, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:16",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": "test",
+			"POSTGRES_USER":     "test",
+			"POSTGRES_DB":       "testdb",
+		},
+		WaitingFor: wait.ForListeningPort("5432/tcp"),
+	}
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, container, err
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		return nil, container, err
+	}
+
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		return nil, container, err
+	}
+
+	dsn := "postgres://test:test@" + host + ":" + port.Port() + "/testdb?sslmode=disable"
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, container, err
+	}
+
+	if err := migrateTestDB(pool); err != nil {
+		pool.Close()
+		return nil, container, err
+	}
+
+	return pool, container, nil
+}
+
 func migrateTestDB(pool *pgxpool.Pool) error {
 	_, filename, _, _ := runtime.Caller(0)
 	migrationPath := filepath.Join(filepath.Dir(filename), "test_migrations.sql")
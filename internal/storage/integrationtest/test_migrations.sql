@@ -1,18 +1,76 @@
+CREATE TABLE IF NOT EXISTS tariffs
+(
+    version     TEXT PRIMARY KEY,
+    valid_from  TIMESTAMPTZ NOT NULL,
+    body        JSONB NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT now()
+);
+
 CREATE TABLE IF NOT EXISTS orders
 (
     id              BIGSERIAL PRIMARY KEY,
     user_id         BIGINT NOT NULL,
     status          VARCHAR(20) NOT NULL,
     expires_at      TIMESTAMP NOT NULL,
-    weight          REAL NOT NULL CHECK (weight > 0),
-    total_price     REAL NOT NULL CHECK (total_price >= 0),
-    package_type    VARCHAR(20)
+    weight_grams    BIGINT NOT NULL CHECK (weight_grams > 0),
+    price_minor     BIGINT NOT NULL CHECK (price_minor >= 0),
+    currency        CHAR(3) NOT NULL DEFAULT 'RUB',
+    package_type    VARCHAR(20),
+    deleted_at      TIMESTAMP,
+    tariff_version  TEXT REFERENCES tariffs (version)
     );
+CREATE INDEX IF NOT EXISTS orders_deleted_at_idx ON orders (deleted_at) WHERE deleted_at IS NOT NULL;
 
 CREATE TABLE IF NOT EXISTS order_history
 (
     id          BIGSERIAL PRIMARY KEY,
     order_id    BIGINT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
     status      VARCHAR(20) NOT NULL,
-    created_at  TIMESTAMP DEFAULT now()
-    );
\ No newline at end of file
+    created_at  TIMESTAMP DEFAULT now(),
+    actor_type    VARCHAR(20) NOT NULL DEFAULT 'unknown',
+    actor_id      BIGINT NOT NULL DEFAULT 0,
+    actor_subject TEXT NOT NULL DEFAULT ''
+    );
+CREATE INDEX IF NOT EXISTS order_history_order_id_created_at_idx ON order_history (order_id, created_at);
+CREATE TYPE outbox_status AS ENUM ('CREATED', 'PROCESSING', 'COMPLETED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              UUID PRIMARY KEY,
+    payload         JSONB NOT NULL,
+    status          outbox_status NOT NULL,
+    error           TEXT,
+    created_at      TIMESTAMP NOT NULL DEFAULT now(),
+    sent_at         TIMESTAMP,
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMP NOT NULL DEFAULT now()
+    );
+
+CREATE INDEX IF NOT EXISTS orders_user_id_id_idx ON orders (user_id, id);
+CREATE INDEX IF NOT EXISTS orders_user_id_status_id_idx ON orders (user_id, status, id);
+CREATE INDEX IF NOT EXISTS orders_status_id_idx ON orders (status, id);
+CREATE INDEX IF NOT EXISTS orders_expires_at_id_idx ON orders (expires_at, id);
+
+CREATE TABLE IF NOT EXISTS import_jobs
+(
+    id          TEXT PRIMARY KEY,
+    processed   BIGINT NOT NULL DEFAULT 0,
+    imported    BIGINT NOT NULL DEFAULT 0,
+    failed      BIGINT NOT NULL DEFAULT 0,
+    created_at  TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    key          TEXT PRIMARY KEY,
+    request_hash TEXT NOT NULL,
+    done         BOOLEAN NOT NULL DEFAULT false,
+    code         INT NOT NULL DEFAULT 0,
+    message      TEXT NOT NULL DEFAULT '',
+    response     BYTEA,
+    created_at   TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
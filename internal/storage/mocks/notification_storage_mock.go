@@ -0,0 +1,3033 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.NotificationStorage -o notification_storage_mock.go -n NotificationStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"PWZ1.0/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v5"
+)
+
+// NotificationStorageMock implements mm_storage.NotificationStorage
+type NotificationStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCancelNotification          func(ctx context.Context, id uint32) (n1 models.Notification, err error)
+	funcCancelNotificationOrigin    string
+	inspectFuncCancelNotification   func(ctx context.Context, id uint32)
+	afterCancelNotificationCounter  uint64
+	beforeCancelNotificationCounter uint64
+	CancelNotificationMock          mNotificationStorageMockCancelNotification
+
+	funcClaimDueNotifications          func(ctx context.Context, limit int, lease time.Duration) (na1 []models.Notification, err error)
+	funcClaimDueNotificationsOrigin    string
+	inspectFuncClaimDueNotifications   func(ctx context.Context, limit int, lease time.Duration)
+	afterClaimDueNotificationsCounter  uint64
+	beforeClaimDueNotificationsCounter uint64
+	ClaimDueNotificationsMock          mNotificationStorageMockClaimDueNotifications
+
+	funcGetNotification          func(ctx context.Context, id uint32) (n1 models.Notification, err error)
+	funcGetNotificationOrigin    string
+	inspectFuncGetNotification   func(ctx context.Context, id uint32)
+	afterGetNotificationCounter  uint64
+	beforeGetNotificationCounter uint64
+	GetNotificationMock          mNotificationStorageMockGetNotification
+
+	funcListExpiringOrders          func(ctx context.Context, before time.Time) (oa1 []models.Order, err error)
+	funcListExpiringOrdersOrigin    string
+	inspectFuncListExpiringOrders   func(ctx context.Context, before time.Time)
+	afterListExpiringOrdersCounter  uint64
+	beforeListExpiringOrdersCounter uint64
+	ListExpiringOrdersMock          mNotificationStorageMockListExpiringOrders
+
+	funcMarkNotificationFailed          func(ctx context.Context, id uint32, errText string, retryAt *time.Time) (err error)
+	funcMarkNotificationFailedOrigin    string
+	inspectFuncMarkNotificationFailed   func(ctx context.Context, id uint32, errText string, retryAt *time.Time)
+	afterMarkNotificationFailedCounter  uint64
+	beforeMarkNotificationFailedCounter uint64
+	MarkNotificationFailedMock          mNotificationStorageMockMarkNotificationFailed
+
+	funcMarkNotificationSent          func(ctx context.Context, id uint32) (err error)
+	funcMarkNotificationSentOrigin    string
+	inspectFuncMarkNotificationSent   func(ctx context.Context, id uint32)
+	afterMarkNotificationSentCounter  uint64
+	beforeMarkNotificationSentCounter uint64
+	MarkNotificationSentMock          mNotificationStorageMockMarkNotificationSent
+
+	funcSaveNotificationTx          func(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error)
+	funcSaveNotificationTxOrigin    string
+	inspectFuncSaveNotificationTx   func(ctx context.Context, tx pgx.Tx, n models.Notification)
+	afterSaveNotificationTxCounter  uint64
+	beforeSaveNotificationTxCounter uint64
+	SaveNotificationTxMock          mNotificationStorageMockSaveNotificationTx
+
+	funcWithTransaction          func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error)
+	funcWithTransactionOrigin    string
+	inspectFuncWithTransaction   func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error)
+	afterWithTransactionCounter  uint64
+	beforeWithTransactionCounter uint64
+	WithTransactionMock          mNotificationStorageMockWithTransaction
+}
+
+// NewNotificationStorageMock returns a mock for mm_storage.NotificationStorage
+func NewNotificationStorageMock(t minimock.Tester) *NotificationStorageMock {
+	m := &NotificationStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CancelNotificationMock = mNotificationStorageMockCancelNotification{mock: m}
+	m.CancelNotificationMock.callArgs = []*NotificationStorageMockCancelNotificationParams{}
+
+	m.ClaimDueNotificationsMock = mNotificationStorageMockClaimDueNotifications{mock: m}
+	m.ClaimDueNotificationsMock.callArgs = []*NotificationStorageMockClaimDueNotificationsParams{}
+
+	m.GetNotificationMock = mNotificationStorageMockGetNotification{mock: m}
+	m.GetNotificationMock.callArgs = []*NotificationStorageMockGetNotificationParams{}
+
+	m.ListExpiringOrdersMock = mNotificationStorageMockListExpiringOrders{mock: m}
+	m.ListExpiringOrdersMock.callArgs = []*NotificationStorageMockListExpiringOrdersParams{}
+
+	m.MarkNotificationFailedMock = mNotificationStorageMockMarkNotificationFailed{mock: m}
+	m.MarkNotificationFailedMock.callArgs = []*NotificationStorageMockMarkNotificationFailedParams{}
+
+	m.MarkNotificationSentMock = mNotificationStorageMockMarkNotificationSent{mock: m}
+	m.MarkNotificationSentMock.callArgs = []*NotificationStorageMockMarkNotificationSentParams{}
+
+	m.SaveNotificationTxMock = mNotificationStorageMockSaveNotificationTx{mock: m}
+	m.SaveNotificationTxMock.callArgs = []*NotificationStorageMockSaveNotificationTxParams{}
+
+	m.WithTransactionMock = mNotificationStorageMockWithTransaction{mock: m}
+	m.WithTransactionMock.callArgs = []*NotificationStorageMockWithTransactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotificationStorageMockCancelNotification struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockCancelNotificationExpectation
+	expectations       []*NotificationStorageMockCancelNotificationExpectation
+
+	callArgs []*NotificationStorageMockCancelNotificationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockCancelNotificationExpectation specifies expectation struct of the NotificationStorage.CancelNotification
+type NotificationStorageMockCancelNotificationExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockCancelNotificationParams
+	paramPtrs          *NotificationStorageMockCancelNotificationParamPtrs
+	expectationOrigins NotificationStorageMockCancelNotificationExpectationOrigins
+	results            *NotificationStorageMockCancelNotificationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockCancelNotificationParams contains parameters of the NotificationStorage.CancelNotification
+type NotificationStorageMockCancelNotificationParams struct {
+	ctx context.Context
+	id  uint32
+}
+
+// NotificationStorageMockCancelNotificationParamPtrs contains pointers to parameters of the NotificationStorage.CancelNotification
+type NotificationStorageMockCancelNotificationParamPtrs struct {
+	ctx *context.Context
+	id  *uint32
+}
+
+// NotificationStorageMockCancelNotificationResults contains results of the NotificationStorage.CancelNotification
+type NotificationStorageMockCancelNotificationResults struct {
+	n1  models.Notification
+	err error
+}
+
+// NotificationStorageMockCancelNotificationOrigins contains origins of expectations of the NotificationStorage.CancelNotification
+type NotificationStorageMockCancelNotificationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Optional() *mNotificationStorageMockCancelNotification {
+	mmCancelNotification.optional = true
+	return mmCancelNotification
+}
+
+// Expect sets up expected params for NotificationStorage.CancelNotification
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Expect(ctx context.Context, id uint32) *mNotificationStorageMockCancelNotification {
+	if mmCancelNotification.mock.funcCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Set")
+	}
+
+	if mmCancelNotification.defaultExpectation == nil {
+		mmCancelNotification.defaultExpectation = &NotificationStorageMockCancelNotificationExpectation{}
+	}
+
+	if mmCancelNotification.defaultExpectation.paramPtrs != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by ExpectParams functions")
+	}
+
+	mmCancelNotification.defaultExpectation.params = &NotificationStorageMockCancelNotificationParams{ctx, id}
+	mmCancelNotification.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelNotification.expectations {
+		if minimock.Equal(e.params, mmCancelNotification.defaultExpectation.params) {
+			mmCancelNotification.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelNotification.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelNotification
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.CancelNotification
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockCancelNotification {
+	if mmCancelNotification.mock.funcCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Set")
+	}
+
+	if mmCancelNotification.defaultExpectation == nil {
+		mmCancelNotification.defaultExpectation = &NotificationStorageMockCancelNotificationExpectation{}
+	}
+
+	if mmCancelNotification.defaultExpectation.params != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Expect")
+	}
+
+	if mmCancelNotification.defaultExpectation.paramPtrs == nil {
+		mmCancelNotification.defaultExpectation.paramPtrs = &NotificationStorageMockCancelNotificationParamPtrs{}
+	}
+	mmCancelNotification.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelNotification.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelNotification
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationStorage.CancelNotification
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) ExpectIdParam2(id uint32) *mNotificationStorageMockCancelNotification {
+	if mmCancelNotification.mock.funcCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Set")
+	}
+
+	if mmCancelNotification.defaultExpectation == nil {
+		mmCancelNotification.defaultExpectation = &NotificationStorageMockCancelNotificationExpectation{}
+	}
+
+	if mmCancelNotification.defaultExpectation.params != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Expect")
+	}
+
+	if mmCancelNotification.defaultExpectation.paramPtrs == nil {
+		mmCancelNotification.defaultExpectation.paramPtrs = &NotificationStorageMockCancelNotificationParamPtrs{}
+	}
+	mmCancelNotification.defaultExpectation.paramPtrs.id = &id
+	mmCancelNotification.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCancelNotification
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.CancelNotification
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Inspect(f func(ctx context.Context, id uint32)) *mNotificationStorageMockCancelNotification {
+	if mmCancelNotification.mock.inspectFuncCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.CancelNotification")
+	}
+
+	mmCancelNotification.mock.inspectFuncCancelNotification = f
+
+	return mmCancelNotification
+}
+
+// Return sets up results that will be returned by NotificationStorage.CancelNotification
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Return(n1 models.Notification, err error) *NotificationStorageMock {
+	if mmCancelNotification.mock.funcCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Set")
+	}
+
+	if mmCancelNotification.defaultExpectation == nil {
+		mmCancelNotification.defaultExpectation = &NotificationStorageMockCancelNotificationExpectation{mock: mmCancelNotification.mock}
+	}
+	mmCancelNotification.defaultExpectation.results = &NotificationStorageMockCancelNotificationResults{n1, err}
+	mmCancelNotification.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelNotification.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.CancelNotification method
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Set(f func(ctx context.Context, id uint32) (n1 models.Notification, err error)) *NotificationStorageMock {
+	if mmCancelNotification.defaultExpectation != nil {
+		mmCancelNotification.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.CancelNotification method")
+	}
+
+	if len(mmCancelNotification.expectations) > 0 {
+		mmCancelNotification.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.CancelNotification method")
+	}
+
+	mmCancelNotification.mock.funcCancelNotification = f
+	mmCancelNotification.mock.funcCancelNotificationOrigin = minimock.CallerInfo(1)
+	return mmCancelNotification.mock
+}
+
+// When sets expectation for the NotificationStorage.CancelNotification which will trigger the result defined by the following
+// Then helper
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) When(ctx context.Context, id uint32) *NotificationStorageMockCancelNotificationExpectation {
+	if mmCancelNotification.mock.funcCancelNotification != nil {
+		mmCancelNotification.mock.t.Fatalf("NotificationStorageMock.CancelNotification mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockCancelNotificationExpectation{
+		mock:               mmCancelNotification.mock,
+		params:             &NotificationStorageMockCancelNotificationParams{ctx, id},
+		expectationOrigins: NotificationStorageMockCancelNotificationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelNotification.expectations = append(mmCancelNotification.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.CancelNotification return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockCancelNotificationExpectation) Then(n1 models.Notification, err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockCancelNotificationResults{n1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.CancelNotification should be invoked
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Times(n uint64) *mNotificationStorageMockCancelNotification {
+	if n == 0 {
+		mmCancelNotification.mock.t.Fatalf("Times of NotificationStorageMock.CancelNotification mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelNotification.expectedInvocations, n)
+	mmCancelNotification.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelNotification
+}
+
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) invocationsDone() bool {
+	if len(mmCancelNotification.expectations) == 0 && mmCancelNotification.defaultExpectation == nil && mmCancelNotification.mock.funcCancelNotification == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelNotification.mock.afterCancelNotificationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelNotification.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelNotification implements mm_storage.NotificationStorage
+func (mmCancelNotification *NotificationStorageMock) CancelNotification(ctx context.Context, id uint32) (n1 models.Notification, err error) {
+	mm_atomic.AddUint64(&mmCancelNotification.beforeCancelNotificationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelNotification.afterCancelNotificationCounter, 1)
+
+	mmCancelNotification.t.Helper()
+
+	if mmCancelNotification.inspectFuncCancelNotification != nil {
+		mmCancelNotification.inspectFuncCancelNotification(ctx, id)
+	}
+
+	mm_params := NotificationStorageMockCancelNotificationParams{ctx, id}
+
+	// Record call args
+	mmCancelNotification.CancelNotificationMock.mutex.Lock()
+	mmCancelNotification.CancelNotificationMock.callArgs = append(mmCancelNotification.CancelNotificationMock.callArgs, &mm_params)
+	mmCancelNotification.CancelNotificationMock.mutex.Unlock()
+
+	for _, e := range mmCancelNotification.CancelNotificationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.n1, e.results.err
+		}
+	}
+
+	if mmCancelNotification.CancelNotificationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelNotification.CancelNotificationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelNotification.CancelNotificationMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelNotification.CancelNotificationMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockCancelNotificationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelNotification.t.Errorf("NotificationStorageMock.CancelNotification got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelNotification.CancelNotificationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCancelNotification.t.Errorf("NotificationStorageMock.CancelNotification got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelNotification.CancelNotificationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelNotification.t.Errorf("NotificationStorageMock.CancelNotification got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelNotification.CancelNotificationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelNotification.CancelNotificationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelNotification.t.Fatal("No results are set for the NotificationStorageMock.CancelNotification")
+		}
+		return (*mm_results).n1, (*mm_results).err
+	}
+	if mmCancelNotification.funcCancelNotification != nil {
+		return mmCancelNotification.funcCancelNotification(ctx, id)
+	}
+	mmCancelNotification.t.Fatalf("Unexpected call to NotificationStorageMock.CancelNotification. %v %v", ctx, id)
+	return
+}
+
+// CancelNotificationAfterCounter returns a count of finished NotificationStorageMock.CancelNotification invocations
+func (mmCancelNotification *NotificationStorageMock) CancelNotificationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelNotification.afterCancelNotificationCounter)
+}
+
+// CancelNotificationBeforeCounter returns a count of NotificationStorageMock.CancelNotification invocations
+func (mmCancelNotification *NotificationStorageMock) CancelNotificationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelNotification.beforeCancelNotificationCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.CancelNotification.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelNotification *mNotificationStorageMockCancelNotification) Calls() []*NotificationStorageMockCancelNotificationParams {
+	mmCancelNotification.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockCancelNotificationParams, len(mmCancelNotification.callArgs))
+	copy(argCopy, mmCancelNotification.callArgs)
+
+	mmCancelNotification.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelNotificationDone returns true if the count of the CancelNotification invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockCancelNotificationDone() bool {
+	if m.CancelNotificationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelNotificationMock.invocationsDone()
+}
+
+// MinimockCancelNotificationInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockCancelNotificationInspect() {
+	for _, e := range m.CancelNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.CancelNotification at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelNotificationCounter := mm_atomic.LoadUint64(&m.afterCancelNotificationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelNotificationMock.defaultExpectation != nil && afterCancelNotificationCounter < 1 {
+		if m.CancelNotificationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.CancelNotification at\n%s", m.CancelNotificationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.CancelNotification at\n%s with params: %#v", m.CancelNotificationMock.defaultExpectation.expectationOrigins.origin, *m.CancelNotificationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelNotification != nil && afterCancelNotificationCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.CancelNotification at\n%s", m.funcCancelNotificationOrigin)
+	}
+
+	if !m.CancelNotificationMock.invocationsDone() && afterCancelNotificationCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.CancelNotification at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelNotificationMock.expectedInvocations), m.CancelNotificationMock.expectedInvocationsOrigin, afterCancelNotificationCounter)
+	}
+}
+
+type mNotificationStorageMockClaimDueNotifications struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockClaimDueNotificationsExpectation
+	expectations       []*NotificationStorageMockClaimDueNotificationsExpectation
+
+	callArgs []*NotificationStorageMockClaimDueNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockClaimDueNotificationsExpectation specifies expectation struct of the NotificationStorage.ClaimDueNotifications
+type NotificationStorageMockClaimDueNotificationsExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockClaimDueNotificationsParams
+	paramPtrs          *NotificationStorageMockClaimDueNotificationsParamPtrs
+	expectationOrigins NotificationStorageMockClaimDueNotificationsExpectationOrigins
+	results            *NotificationStorageMockClaimDueNotificationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockClaimDueNotificationsParams contains parameters of the NotificationStorage.ClaimDueNotifications
+type NotificationStorageMockClaimDueNotificationsParams struct {
+	ctx   context.Context
+	limit int
+	lease time.Duration
+}
+
+// NotificationStorageMockClaimDueNotificationsParamPtrs contains pointers to parameters of the NotificationStorage.ClaimDueNotifications
+type NotificationStorageMockClaimDueNotificationsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+	lease *time.Duration
+}
+
+// NotificationStorageMockClaimDueNotificationsResults contains results of the NotificationStorage.ClaimDueNotifications
+type NotificationStorageMockClaimDueNotificationsResults struct {
+	na1 []models.Notification
+	err error
+}
+
+// NotificationStorageMockClaimDueNotificationsOrigins contains origins of expectations of the NotificationStorage.ClaimDueNotifications
+type NotificationStorageMockClaimDueNotificationsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+	originLease string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Optional() *mNotificationStorageMockClaimDueNotifications {
+	mmClaimDueNotifications.optional = true
+	return mmClaimDueNotifications
+}
+
+// Expect sets up expected params for NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Expect(ctx context.Context, limit int, lease time.Duration) *mNotificationStorageMockClaimDueNotifications {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation == nil {
+		mmClaimDueNotifications.defaultExpectation = &NotificationStorageMockClaimDueNotificationsExpectation{}
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.paramPtrs != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmClaimDueNotifications.defaultExpectation.params = &NotificationStorageMockClaimDueNotificationsParams{ctx, limit, lease}
+	mmClaimDueNotifications.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimDueNotifications.expectations {
+		if minimock.Equal(e.params, mmClaimDueNotifications.defaultExpectation.params) {
+			mmClaimDueNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimDueNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimDueNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockClaimDueNotifications {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation == nil {
+		mmClaimDueNotifications.defaultExpectation = &NotificationStorageMockClaimDueNotificationsExpectation{}
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.params != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Expect")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.paramPtrs == nil {
+		mmClaimDueNotifications.defaultExpectation.paramPtrs = &NotificationStorageMockClaimDueNotificationsParamPtrs{}
+	}
+	mmClaimDueNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimDueNotifications.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimDueNotifications
+}
+
+// ExpectLimitParam2 sets up expected param limit for NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) ExpectLimitParam2(limit int) *mNotificationStorageMockClaimDueNotifications {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation == nil {
+		mmClaimDueNotifications.defaultExpectation = &NotificationStorageMockClaimDueNotificationsExpectation{}
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.params != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Expect")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.paramPtrs == nil {
+		mmClaimDueNotifications.defaultExpectation.paramPtrs = &NotificationStorageMockClaimDueNotificationsParamPtrs{}
+	}
+	mmClaimDueNotifications.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimDueNotifications.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimDueNotifications
+}
+
+// ExpectLeaseParam3 sets up expected param lease for NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) ExpectLeaseParam3(lease time.Duration) *mNotificationStorageMockClaimDueNotifications {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation == nil {
+		mmClaimDueNotifications.defaultExpectation = &NotificationStorageMockClaimDueNotificationsExpectation{}
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.params != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Expect")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation.paramPtrs == nil {
+		mmClaimDueNotifications.defaultExpectation.paramPtrs = &NotificationStorageMockClaimDueNotificationsParamPtrs{}
+	}
+	mmClaimDueNotifications.defaultExpectation.paramPtrs.lease = &lease
+	mmClaimDueNotifications.defaultExpectation.expectationOrigins.originLease = minimock.CallerInfo(1)
+
+	return mmClaimDueNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Inspect(f func(ctx context.Context, limit int, lease time.Duration)) *mNotificationStorageMockClaimDueNotifications {
+	if mmClaimDueNotifications.mock.inspectFuncClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.ClaimDueNotifications")
+	}
+
+	mmClaimDueNotifications.mock.inspectFuncClaimDueNotifications = f
+
+	return mmClaimDueNotifications
+}
+
+// Return sets up results that will be returned by NotificationStorage.ClaimDueNotifications
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Return(na1 []models.Notification, err error) *NotificationStorageMock {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	if mmClaimDueNotifications.defaultExpectation == nil {
+		mmClaimDueNotifications.defaultExpectation = &NotificationStorageMockClaimDueNotificationsExpectation{mock: mmClaimDueNotifications.mock}
+	}
+	mmClaimDueNotifications.defaultExpectation.results = &NotificationStorageMockClaimDueNotificationsResults{na1, err}
+	mmClaimDueNotifications.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimDueNotifications.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.ClaimDueNotifications method
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Set(f func(ctx context.Context, limit int, lease time.Duration) (na1 []models.Notification, err error)) *NotificationStorageMock {
+	if mmClaimDueNotifications.defaultExpectation != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.ClaimDueNotifications method")
+	}
+
+	if len(mmClaimDueNotifications.expectations) > 0 {
+		mmClaimDueNotifications.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.ClaimDueNotifications method")
+	}
+
+	mmClaimDueNotifications.mock.funcClaimDueNotifications = f
+	mmClaimDueNotifications.mock.funcClaimDueNotificationsOrigin = minimock.CallerInfo(1)
+	return mmClaimDueNotifications.mock
+}
+
+// When sets expectation for the NotificationStorage.ClaimDueNotifications which will trigger the result defined by the following
+// Then helper
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) When(ctx context.Context, limit int, lease time.Duration) *NotificationStorageMockClaimDueNotificationsExpectation {
+	if mmClaimDueNotifications.mock.funcClaimDueNotifications != nil {
+		mmClaimDueNotifications.mock.t.Fatalf("NotificationStorageMock.ClaimDueNotifications mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockClaimDueNotificationsExpectation{
+		mock:               mmClaimDueNotifications.mock,
+		params:             &NotificationStorageMockClaimDueNotificationsParams{ctx, limit, lease},
+		expectationOrigins: NotificationStorageMockClaimDueNotificationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimDueNotifications.expectations = append(mmClaimDueNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.ClaimDueNotifications return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockClaimDueNotificationsExpectation) Then(na1 []models.Notification, err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockClaimDueNotificationsResults{na1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.ClaimDueNotifications should be invoked
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Times(n uint64) *mNotificationStorageMockClaimDueNotifications {
+	if n == 0 {
+		mmClaimDueNotifications.mock.t.Fatalf("Times of NotificationStorageMock.ClaimDueNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimDueNotifications.expectedInvocations, n)
+	mmClaimDueNotifications.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimDueNotifications
+}
+
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) invocationsDone() bool {
+	if len(mmClaimDueNotifications.expectations) == 0 && mmClaimDueNotifications.defaultExpectation == nil && mmClaimDueNotifications.mock.funcClaimDueNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimDueNotifications.mock.afterClaimDueNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimDueNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimDueNotifications implements mm_storage.NotificationStorage
+func (mmClaimDueNotifications *NotificationStorageMock) ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) (na1 []models.Notification, err error) {
+	mm_atomic.AddUint64(&mmClaimDueNotifications.beforeClaimDueNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimDueNotifications.afterClaimDueNotificationsCounter, 1)
+
+	mmClaimDueNotifications.t.Helper()
+
+	if mmClaimDueNotifications.inspectFuncClaimDueNotifications != nil {
+		mmClaimDueNotifications.inspectFuncClaimDueNotifications(ctx, limit, lease)
+	}
+
+	mm_params := NotificationStorageMockClaimDueNotificationsParams{ctx, limit, lease}
+
+	// Record call args
+	mmClaimDueNotifications.ClaimDueNotificationsMock.mutex.Lock()
+	mmClaimDueNotifications.ClaimDueNotificationsMock.callArgs = append(mmClaimDueNotifications.ClaimDueNotificationsMock.callArgs, &mm_params)
+	mmClaimDueNotifications.ClaimDueNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmClaimDueNotifications.ClaimDueNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.na1, e.results.err
+		}
+	}
+
+	if mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockClaimDueNotificationsParams{ctx, limit, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimDueNotifications.t.Errorf("NotificationStorageMock.ClaimDueNotifications got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimDueNotifications.t.Errorf("NotificationStorageMock.ClaimDueNotifications got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmClaimDueNotifications.t.Errorf("NotificationStorageMock.ClaimDueNotifications got unexpected parameter lease, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.expectationOrigins.originLease, *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimDueNotifications.t.Errorf("NotificationStorageMock.ClaimDueNotifications got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimDueNotifications.ClaimDueNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimDueNotifications.t.Fatal("No results are set for the NotificationStorageMock.ClaimDueNotifications")
+		}
+		return (*mm_results).na1, (*mm_results).err
+	}
+	if mmClaimDueNotifications.funcClaimDueNotifications != nil {
+		return mmClaimDueNotifications.funcClaimDueNotifications(ctx, limit, lease)
+	}
+	mmClaimDueNotifications.t.Fatalf("Unexpected call to NotificationStorageMock.ClaimDueNotifications. %v %v %v", ctx, limit, lease)
+	return
+}
+
+// ClaimDueNotificationsAfterCounter returns a count of finished NotificationStorageMock.ClaimDueNotifications invocations
+func (mmClaimDueNotifications *NotificationStorageMock) ClaimDueNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueNotifications.afterClaimDueNotificationsCounter)
+}
+
+// ClaimDueNotificationsBeforeCounter returns a count of NotificationStorageMock.ClaimDueNotifications invocations
+func (mmClaimDueNotifications *NotificationStorageMock) ClaimDueNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueNotifications.beforeClaimDueNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.ClaimDueNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimDueNotifications *mNotificationStorageMockClaimDueNotifications) Calls() []*NotificationStorageMockClaimDueNotificationsParams {
+	mmClaimDueNotifications.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockClaimDueNotificationsParams, len(mmClaimDueNotifications.callArgs))
+	copy(argCopy, mmClaimDueNotifications.callArgs)
+
+	mmClaimDueNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDueNotificationsDone returns true if the count of the ClaimDueNotifications invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockClaimDueNotificationsDone() bool {
+	if m.ClaimDueNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimDueNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimDueNotificationsMock.invocationsDone()
+}
+
+// MinimockClaimDueNotificationsInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockClaimDueNotificationsInspect() {
+	for _, e := range m.ClaimDueNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.ClaimDueNotifications at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimDueNotificationsCounter := mm_atomic.LoadUint64(&m.afterClaimDueNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimDueNotificationsMock.defaultExpectation != nil && afterClaimDueNotificationsCounter < 1 {
+		if m.ClaimDueNotificationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.ClaimDueNotifications at\n%s", m.ClaimDueNotificationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.ClaimDueNotifications at\n%s with params: %#v", m.ClaimDueNotificationsMock.defaultExpectation.expectationOrigins.origin, *m.ClaimDueNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimDueNotifications != nil && afterClaimDueNotificationsCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.ClaimDueNotifications at\n%s", m.funcClaimDueNotificationsOrigin)
+	}
+
+	if !m.ClaimDueNotificationsMock.invocationsDone() && afterClaimDueNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.ClaimDueNotifications at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimDueNotificationsMock.expectedInvocations), m.ClaimDueNotificationsMock.expectedInvocationsOrigin, afterClaimDueNotificationsCounter)
+	}
+}
+
+type mNotificationStorageMockGetNotification struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockGetNotificationExpectation
+	expectations       []*NotificationStorageMockGetNotificationExpectation
+
+	callArgs []*NotificationStorageMockGetNotificationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockGetNotificationExpectation specifies expectation struct of the NotificationStorage.GetNotification
+type NotificationStorageMockGetNotificationExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockGetNotificationParams
+	paramPtrs          *NotificationStorageMockGetNotificationParamPtrs
+	expectationOrigins NotificationStorageMockGetNotificationExpectationOrigins
+	results            *NotificationStorageMockGetNotificationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockGetNotificationParams contains parameters of the NotificationStorage.GetNotification
+type NotificationStorageMockGetNotificationParams struct {
+	ctx context.Context
+	id  uint32
+}
+
+// NotificationStorageMockGetNotificationParamPtrs contains pointers to parameters of the NotificationStorage.GetNotification
+type NotificationStorageMockGetNotificationParamPtrs struct {
+	ctx *context.Context
+	id  *uint32
+}
+
+// NotificationStorageMockGetNotificationResults contains results of the NotificationStorage.GetNotification
+type NotificationStorageMockGetNotificationResults struct {
+	n1  models.Notification
+	err error
+}
+
+// NotificationStorageMockGetNotificationOrigins contains origins of expectations of the NotificationStorage.GetNotification
+type NotificationStorageMockGetNotificationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetNotification *mNotificationStorageMockGetNotification) Optional() *mNotificationStorageMockGetNotification {
+	mmGetNotification.optional = true
+	return mmGetNotification
+}
+
+// Expect sets up expected params for NotificationStorage.GetNotification
+func (mmGetNotification *mNotificationStorageMockGetNotification) Expect(ctx context.Context, id uint32) *mNotificationStorageMockGetNotification {
+	if mmGetNotification.mock.funcGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Set")
+	}
+
+	if mmGetNotification.defaultExpectation == nil {
+		mmGetNotification.defaultExpectation = &NotificationStorageMockGetNotificationExpectation{}
+	}
+
+	if mmGetNotification.defaultExpectation.paramPtrs != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by ExpectParams functions")
+	}
+
+	mmGetNotification.defaultExpectation.params = &NotificationStorageMockGetNotificationParams{ctx, id}
+	mmGetNotification.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetNotification.expectations {
+		if minimock.Equal(e.params, mmGetNotification.defaultExpectation.params) {
+			mmGetNotification.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetNotification.defaultExpectation.params)
+		}
+	}
+
+	return mmGetNotification
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.GetNotification
+func (mmGetNotification *mNotificationStorageMockGetNotification) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockGetNotification {
+	if mmGetNotification.mock.funcGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Set")
+	}
+
+	if mmGetNotification.defaultExpectation == nil {
+		mmGetNotification.defaultExpectation = &NotificationStorageMockGetNotificationExpectation{}
+	}
+
+	if mmGetNotification.defaultExpectation.params != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Expect")
+	}
+
+	if mmGetNotification.defaultExpectation.paramPtrs == nil {
+		mmGetNotification.defaultExpectation.paramPtrs = &NotificationStorageMockGetNotificationParamPtrs{}
+	}
+	mmGetNotification.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetNotification.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetNotification
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationStorage.GetNotification
+func (mmGetNotification *mNotificationStorageMockGetNotification) ExpectIdParam2(id uint32) *mNotificationStorageMockGetNotification {
+	if mmGetNotification.mock.funcGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Set")
+	}
+
+	if mmGetNotification.defaultExpectation == nil {
+		mmGetNotification.defaultExpectation = &NotificationStorageMockGetNotificationExpectation{}
+	}
+
+	if mmGetNotification.defaultExpectation.params != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Expect")
+	}
+
+	if mmGetNotification.defaultExpectation.paramPtrs == nil {
+		mmGetNotification.defaultExpectation.paramPtrs = &NotificationStorageMockGetNotificationParamPtrs{}
+	}
+	mmGetNotification.defaultExpectation.paramPtrs.id = &id
+	mmGetNotification.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetNotification
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.GetNotification
+func (mmGetNotification *mNotificationStorageMockGetNotification) Inspect(f func(ctx context.Context, id uint32)) *mNotificationStorageMockGetNotification {
+	if mmGetNotification.mock.inspectFuncGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.GetNotification")
+	}
+
+	mmGetNotification.mock.inspectFuncGetNotification = f
+
+	return mmGetNotification
+}
+
+// Return sets up results that will be returned by NotificationStorage.GetNotification
+func (mmGetNotification *mNotificationStorageMockGetNotification) Return(n1 models.Notification, err error) *NotificationStorageMock {
+	if mmGetNotification.mock.funcGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Set")
+	}
+
+	if mmGetNotification.defaultExpectation == nil {
+		mmGetNotification.defaultExpectation = &NotificationStorageMockGetNotificationExpectation{mock: mmGetNotification.mock}
+	}
+	mmGetNotification.defaultExpectation.results = &NotificationStorageMockGetNotificationResults{n1, err}
+	mmGetNotification.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetNotification.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.GetNotification method
+func (mmGetNotification *mNotificationStorageMockGetNotification) Set(f func(ctx context.Context, id uint32) (n1 models.Notification, err error)) *NotificationStorageMock {
+	if mmGetNotification.defaultExpectation != nil {
+		mmGetNotification.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.GetNotification method")
+	}
+
+	if len(mmGetNotification.expectations) > 0 {
+		mmGetNotification.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.GetNotification method")
+	}
+
+	mmGetNotification.mock.funcGetNotification = f
+	mmGetNotification.mock.funcGetNotificationOrigin = minimock.CallerInfo(1)
+	return mmGetNotification.mock
+}
+
+// When sets expectation for the NotificationStorage.GetNotification which will trigger the result defined by the following
+// Then helper
+func (mmGetNotification *mNotificationStorageMockGetNotification) When(ctx context.Context, id uint32) *NotificationStorageMockGetNotificationExpectation {
+	if mmGetNotification.mock.funcGetNotification != nil {
+		mmGetNotification.mock.t.Fatalf("NotificationStorageMock.GetNotification mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockGetNotificationExpectation{
+		mock:               mmGetNotification.mock,
+		params:             &NotificationStorageMockGetNotificationParams{ctx, id},
+		expectationOrigins: NotificationStorageMockGetNotificationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetNotification.expectations = append(mmGetNotification.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.GetNotification return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockGetNotificationExpectation) Then(n1 models.Notification, err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockGetNotificationResults{n1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.GetNotification should be invoked
+func (mmGetNotification *mNotificationStorageMockGetNotification) Times(n uint64) *mNotificationStorageMockGetNotification {
+	if n == 0 {
+		mmGetNotification.mock.t.Fatalf("Times of NotificationStorageMock.GetNotification mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetNotification.expectedInvocations, n)
+	mmGetNotification.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetNotification
+}
+
+func (mmGetNotification *mNotificationStorageMockGetNotification) invocationsDone() bool {
+	if len(mmGetNotification.expectations) == 0 && mmGetNotification.defaultExpectation == nil && mmGetNotification.mock.funcGetNotification == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetNotification.mock.afterGetNotificationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetNotification.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetNotification implements mm_storage.NotificationStorage
+func (mmGetNotification *NotificationStorageMock) GetNotification(ctx context.Context, id uint32) (n1 models.Notification, err error) {
+	mm_atomic.AddUint64(&mmGetNotification.beforeGetNotificationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetNotification.afterGetNotificationCounter, 1)
+
+	mmGetNotification.t.Helper()
+
+	if mmGetNotification.inspectFuncGetNotification != nil {
+		mmGetNotification.inspectFuncGetNotification(ctx, id)
+	}
+
+	mm_params := NotificationStorageMockGetNotificationParams{ctx, id}
+
+	// Record call args
+	mmGetNotification.GetNotificationMock.mutex.Lock()
+	mmGetNotification.GetNotificationMock.callArgs = append(mmGetNotification.GetNotificationMock.callArgs, &mm_params)
+	mmGetNotification.GetNotificationMock.mutex.Unlock()
+
+	for _, e := range mmGetNotification.GetNotificationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.n1, e.results.err
+		}
+	}
+
+	if mmGetNotification.GetNotificationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetNotification.GetNotificationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetNotification.GetNotificationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetNotification.GetNotificationMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockGetNotificationParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetNotification.t.Errorf("NotificationStorageMock.GetNotification got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetNotification.GetNotificationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetNotification.t.Errorf("NotificationStorageMock.GetNotification got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetNotification.GetNotificationMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetNotification.t.Errorf("NotificationStorageMock.GetNotification got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetNotification.GetNotificationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetNotification.GetNotificationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetNotification.t.Fatal("No results are set for the NotificationStorageMock.GetNotification")
+		}
+		return (*mm_results).n1, (*mm_results).err
+	}
+	if mmGetNotification.funcGetNotification != nil {
+		return mmGetNotification.funcGetNotification(ctx, id)
+	}
+	mmGetNotification.t.Fatalf("Unexpected call to NotificationStorageMock.GetNotification. %v %v", ctx, id)
+	return
+}
+
+// GetNotificationAfterCounter returns a count of finished NotificationStorageMock.GetNotification invocations
+func (mmGetNotification *NotificationStorageMock) GetNotificationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNotification.afterGetNotificationCounter)
+}
+
+// GetNotificationBeforeCounter returns a count of NotificationStorageMock.GetNotification invocations
+func (mmGetNotification *NotificationStorageMock) GetNotificationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetNotification.beforeGetNotificationCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.GetNotification.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetNotification *mNotificationStorageMockGetNotification) Calls() []*NotificationStorageMockGetNotificationParams {
+	mmGetNotification.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockGetNotificationParams, len(mmGetNotification.callArgs))
+	copy(argCopy, mmGetNotification.callArgs)
+
+	mmGetNotification.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetNotificationDone returns true if the count of the GetNotification invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockGetNotificationDone() bool {
+	if m.GetNotificationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetNotificationMock.invocationsDone()
+}
+
+// MinimockGetNotificationInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockGetNotificationInspect() {
+	for _, e := range m.GetNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.GetNotification at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetNotificationCounter := mm_atomic.LoadUint64(&m.afterGetNotificationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetNotificationMock.defaultExpectation != nil && afterGetNotificationCounter < 1 {
+		if m.GetNotificationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.GetNotification at\n%s", m.GetNotificationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.GetNotification at\n%s with params: %#v", m.GetNotificationMock.defaultExpectation.expectationOrigins.origin, *m.GetNotificationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetNotification != nil && afterGetNotificationCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.GetNotification at\n%s", m.funcGetNotificationOrigin)
+	}
+
+	if !m.GetNotificationMock.invocationsDone() && afterGetNotificationCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.GetNotification at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetNotificationMock.expectedInvocations), m.GetNotificationMock.expectedInvocationsOrigin, afterGetNotificationCounter)
+	}
+}
+
+type mNotificationStorageMockListExpiringOrders struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockListExpiringOrdersExpectation
+	expectations       []*NotificationStorageMockListExpiringOrdersExpectation
+
+	callArgs []*NotificationStorageMockListExpiringOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockListExpiringOrdersExpectation specifies expectation struct of the NotificationStorage.ListExpiringOrders
+type NotificationStorageMockListExpiringOrdersExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockListExpiringOrdersParams
+	paramPtrs          *NotificationStorageMockListExpiringOrdersParamPtrs
+	expectationOrigins NotificationStorageMockListExpiringOrdersExpectationOrigins
+	results            *NotificationStorageMockListExpiringOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockListExpiringOrdersParams contains parameters of the NotificationStorage.ListExpiringOrders
+type NotificationStorageMockListExpiringOrdersParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// NotificationStorageMockListExpiringOrdersParamPtrs contains pointers to parameters of the NotificationStorage.ListExpiringOrders
+type NotificationStorageMockListExpiringOrdersParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// NotificationStorageMockListExpiringOrdersResults contains results of the NotificationStorage.ListExpiringOrders
+type NotificationStorageMockListExpiringOrdersResults struct {
+	oa1 []models.Order
+	err error
+}
+
+// NotificationStorageMockListExpiringOrdersOrigins contains origins of expectations of the NotificationStorage.ListExpiringOrders
+type NotificationStorageMockListExpiringOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Optional() *mNotificationStorageMockListExpiringOrders {
+	mmListExpiringOrders.optional = true
+	return mmListExpiringOrders
+}
+
+// Expect sets up expected params for NotificationStorage.ListExpiringOrders
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Expect(ctx context.Context, before time.Time) *mNotificationStorageMockListExpiringOrders {
+	if mmListExpiringOrders.mock.funcListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Set")
+	}
+
+	if mmListExpiringOrders.defaultExpectation == nil {
+		mmListExpiringOrders.defaultExpectation = &NotificationStorageMockListExpiringOrdersExpectation{}
+	}
+
+	if mmListExpiringOrders.defaultExpectation.paramPtrs != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by ExpectParams functions")
+	}
+
+	mmListExpiringOrders.defaultExpectation.params = &NotificationStorageMockListExpiringOrdersParams{ctx, before}
+	mmListExpiringOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListExpiringOrders.expectations {
+		if minimock.Equal(e.params, mmListExpiringOrders.defaultExpectation.params) {
+			mmListExpiringOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListExpiringOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmListExpiringOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.ListExpiringOrders
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockListExpiringOrders {
+	if mmListExpiringOrders.mock.funcListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Set")
+	}
+
+	if mmListExpiringOrders.defaultExpectation == nil {
+		mmListExpiringOrders.defaultExpectation = &NotificationStorageMockListExpiringOrdersExpectation{}
+	}
+
+	if mmListExpiringOrders.defaultExpectation.params != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Expect")
+	}
+
+	if mmListExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmListExpiringOrders.defaultExpectation.paramPtrs = &NotificationStorageMockListExpiringOrdersParamPtrs{}
+	}
+	mmListExpiringOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListExpiringOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListExpiringOrders
+}
+
+// ExpectBeforeParam2 sets up expected param before for NotificationStorage.ListExpiringOrders
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) ExpectBeforeParam2(before time.Time) *mNotificationStorageMockListExpiringOrders {
+	if mmListExpiringOrders.mock.funcListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Set")
+	}
+
+	if mmListExpiringOrders.defaultExpectation == nil {
+		mmListExpiringOrders.defaultExpectation = &NotificationStorageMockListExpiringOrdersExpectation{}
+	}
+
+	if mmListExpiringOrders.defaultExpectation.params != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Expect")
+	}
+
+	if mmListExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmListExpiringOrders.defaultExpectation.paramPtrs = &NotificationStorageMockListExpiringOrdersParamPtrs{}
+	}
+	mmListExpiringOrders.defaultExpectation.paramPtrs.before = &before
+	mmListExpiringOrders.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmListExpiringOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.ListExpiringOrders
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Inspect(f func(ctx context.Context, before time.Time)) *mNotificationStorageMockListExpiringOrders {
+	if mmListExpiringOrders.mock.inspectFuncListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.ListExpiringOrders")
+	}
+
+	mmListExpiringOrders.mock.inspectFuncListExpiringOrders = f
+
+	return mmListExpiringOrders
+}
+
+// Return sets up results that will be returned by NotificationStorage.ListExpiringOrders
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Return(oa1 []models.Order, err error) *NotificationStorageMock {
+	if mmListExpiringOrders.mock.funcListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Set")
+	}
+
+	if mmListExpiringOrders.defaultExpectation == nil {
+		mmListExpiringOrders.defaultExpectation = &NotificationStorageMockListExpiringOrdersExpectation{mock: mmListExpiringOrders.mock}
+	}
+	mmListExpiringOrders.defaultExpectation.results = &NotificationStorageMockListExpiringOrdersResults{oa1, err}
+	mmListExpiringOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListExpiringOrders.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.ListExpiringOrders method
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Set(f func(ctx context.Context, before time.Time) (oa1 []models.Order, err error)) *NotificationStorageMock {
+	if mmListExpiringOrders.defaultExpectation != nil {
+		mmListExpiringOrders.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.ListExpiringOrders method")
+	}
+
+	if len(mmListExpiringOrders.expectations) > 0 {
+		mmListExpiringOrders.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.ListExpiringOrders method")
+	}
+
+	mmListExpiringOrders.mock.funcListExpiringOrders = f
+	mmListExpiringOrders.mock.funcListExpiringOrdersOrigin = minimock.CallerInfo(1)
+	return mmListExpiringOrders.mock
+}
+
+// When sets expectation for the NotificationStorage.ListExpiringOrders which will trigger the result defined by the following
+// Then helper
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) When(ctx context.Context, before time.Time) *NotificationStorageMockListExpiringOrdersExpectation {
+	if mmListExpiringOrders.mock.funcListExpiringOrders != nil {
+		mmListExpiringOrders.mock.t.Fatalf("NotificationStorageMock.ListExpiringOrders mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockListExpiringOrdersExpectation{
+		mock:               mmListExpiringOrders.mock,
+		params:             &NotificationStorageMockListExpiringOrdersParams{ctx, before},
+		expectationOrigins: NotificationStorageMockListExpiringOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListExpiringOrders.expectations = append(mmListExpiringOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.ListExpiringOrders return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockListExpiringOrdersExpectation) Then(oa1 []models.Order, err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockListExpiringOrdersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.ListExpiringOrders should be invoked
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Times(n uint64) *mNotificationStorageMockListExpiringOrders {
+	if n == 0 {
+		mmListExpiringOrders.mock.t.Fatalf("Times of NotificationStorageMock.ListExpiringOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListExpiringOrders.expectedInvocations, n)
+	mmListExpiringOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListExpiringOrders
+}
+
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) invocationsDone() bool {
+	if len(mmListExpiringOrders.expectations) == 0 && mmListExpiringOrders.defaultExpectation == nil && mmListExpiringOrders.mock.funcListExpiringOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListExpiringOrders.mock.afterListExpiringOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListExpiringOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListExpiringOrders implements mm_storage.NotificationStorage
+func (mmListExpiringOrders *NotificationStorageMock) ListExpiringOrders(ctx context.Context, before time.Time) (oa1 []models.Order, err error) {
+	mm_atomic.AddUint64(&mmListExpiringOrders.beforeListExpiringOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListExpiringOrders.afterListExpiringOrdersCounter, 1)
+
+	mmListExpiringOrders.t.Helper()
+
+	if mmListExpiringOrders.inspectFuncListExpiringOrders != nil {
+		mmListExpiringOrders.inspectFuncListExpiringOrders(ctx, before)
+	}
+
+	mm_params := NotificationStorageMockListExpiringOrdersParams{ctx, before}
+
+	// Record call args
+	mmListExpiringOrders.ListExpiringOrdersMock.mutex.Lock()
+	mmListExpiringOrders.ListExpiringOrdersMock.callArgs = append(mmListExpiringOrders.ListExpiringOrdersMock.callArgs, &mm_params)
+	mmListExpiringOrders.ListExpiringOrdersMock.mutex.Unlock()
+
+	for _, e := range mmListExpiringOrders.ListExpiringOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockListExpiringOrdersParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListExpiringOrders.t.Errorf("NotificationStorageMock.ListExpiringOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmListExpiringOrders.t.Errorf("NotificationStorageMock.ListExpiringOrders got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListExpiringOrders.t.Errorf("NotificationStorageMock.ListExpiringOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListExpiringOrders.ListExpiringOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListExpiringOrders.t.Fatal("No results are set for the NotificationStorageMock.ListExpiringOrders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListExpiringOrders.funcListExpiringOrders != nil {
+		return mmListExpiringOrders.funcListExpiringOrders(ctx, before)
+	}
+	mmListExpiringOrders.t.Fatalf("Unexpected call to NotificationStorageMock.ListExpiringOrders. %v %v", ctx, before)
+	return
+}
+
+// ListExpiringOrdersAfterCounter returns a count of finished NotificationStorageMock.ListExpiringOrders invocations
+func (mmListExpiringOrders *NotificationStorageMock) ListExpiringOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpiringOrders.afterListExpiringOrdersCounter)
+}
+
+// ListExpiringOrdersBeforeCounter returns a count of NotificationStorageMock.ListExpiringOrders invocations
+func (mmListExpiringOrders *NotificationStorageMock) ListExpiringOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpiringOrders.beforeListExpiringOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.ListExpiringOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListExpiringOrders *mNotificationStorageMockListExpiringOrders) Calls() []*NotificationStorageMockListExpiringOrdersParams {
+	mmListExpiringOrders.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockListExpiringOrdersParams, len(mmListExpiringOrders.callArgs))
+	copy(argCopy, mmListExpiringOrders.callArgs)
+
+	mmListExpiringOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListExpiringOrdersDone returns true if the count of the ListExpiringOrders invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockListExpiringOrdersDone() bool {
+	if m.ListExpiringOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListExpiringOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListExpiringOrdersMock.invocationsDone()
+}
+
+// MinimockListExpiringOrdersInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockListExpiringOrdersInspect() {
+	for _, e := range m.ListExpiringOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.ListExpiringOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListExpiringOrdersCounter := mm_atomic.LoadUint64(&m.afterListExpiringOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListExpiringOrdersMock.defaultExpectation != nil && afterListExpiringOrdersCounter < 1 {
+		if m.ListExpiringOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.ListExpiringOrders at\n%s", m.ListExpiringOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.ListExpiringOrders at\n%s with params: %#v", m.ListExpiringOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ListExpiringOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListExpiringOrders != nil && afterListExpiringOrdersCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.ListExpiringOrders at\n%s", m.funcListExpiringOrdersOrigin)
+	}
+
+	if !m.ListExpiringOrdersMock.invocationsDone() && afterListExpiringOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.ListExpiringOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListExpiringOrdersMock.expectedInvocations), m.ListExpiringOrdersMock.expectedInvocationsOrigin, afterListExpiringOrdersCounter)
+	}
+}
+
+type mNotificationStorageMockMarkNotificationFailed struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockMarkNotificationFailedExpectation
+	expectations       []*NotificationStorageMockMarkNotificationFailedExpectation
+
+	callArgs []*NotificationStorageMockMarkNotificationFailedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockMarkNotificationFailedExpectation specifies expectation struct of the NotificationStorage.MarkNotificationFailed
+type NotificationStorageMockMarkNotificationFailedExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockMarkNotificationFailedParams
+	paramPtrs          *NotificationStorageMockMarkNotificationFailedParamPtrs
+	expectationOrigins NotificationStorageMockMarkNotificationFailedExpectationOrigins
+	results            *NotificationStorageMockMarkNotificationFailedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockMarkNotificationFailedParams contains parameters of the NotificationStorage.MarkNotificationFailed
+type NotificationStorageMockMarkNotificationFailedParams struct {
+	ctx     context.Context
+	id      uint32
+	errText string
+	retryAt *time.Time
+}
+
+// NotificationStorageMockMarkNotificationFailedParamPtrs contains pointers to parameters of the NotificationStorage.MarkNotificationFailed
+type NotificationStorageMockMarkNotificationFailedParamPtrs struct {
+	ctx     *context.Context
+	id      *uint32
+	errText *string
+	retryAt **time.Time
+}
+
+// NotificationStorageMockMarkNotificationFailedResults contains results of the NotificationStorage.MarkNotificationFailed
+type NotificationStorageMockMarkNotificationFailedResults struct {
+	err error
+}
+
+// NotificationStorageMockMarkNotificationFailedOrigins contains origins of expectations of the NotificationStorage.MarkNotificationFailed
+type NotificationStorageMockMarkNotificationFailedExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originId      string
+	originErrText string
+	originRetryAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Optional() *mNotificationStorageMockMarkNotificationFailed {
+	mmMarkNotificationFailed.optional = true
+	return mmMarkNotificationFailed
+}
+
+// Expect sets up expected params for NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Expect(ctx context.Context, id uint32, errText string, retryAt *time.Time) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{}
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.paramPtrs != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkNotificationFailed.defaultExpectation.params = &NotificationStorageMockMarkNotificationFailedParams{ctx, id, errText, retryAt}
+	mmMarkNotificationFailed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkNotificationFailed.expectations {
+		if minimock.Equal(e.params, mmMarkNotificationFailed.defaultExpectation.params) {
+			mmMarkNotificationFailed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkNotificationFailed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkNotificationFailed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{}
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.params != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Expect")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationFailed.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationFailedParamPtrs{}
+	}
+	mmMarkNotificationFailed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkNotificationFailed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkNotificationFailed
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) ExpectIdParam2(id uint32) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{}
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.params != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Expect")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationFailed.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationFailedParamPtrs{}
+	}
+	mmMarkNotificationFailed.defaultExpectation.paramPtrs.id = &id
+	mmMarkNotificationFailed.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkNotificationFailed
+}
+
+// ExpectErrTextParam3 sets up expected param errText for NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) ExpectErrTextParam3(errText string) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{}
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.params != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Expect")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationFailed.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationFailedParamPtrs{}
+	}
+	mmMarkNotificationFailed.defaultExpectation.paramPtrs.errText = &errText
+	mmMarkNotificationFailed.defaultExpectation.expectationOrigins.originErrText = minimock.CallerInfo(1)
+
+	return mmMarkNotificationFailed
+}
+
+// ExpectRetryAtParam4 sets up expected param retryAt for NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) ExpectRetryAtParam4(retryAt *time.Time) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{}
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.params != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Expect")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationFailed.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationFailedParamPtrs{}
+	}
+	mmMarkNotificationFailed.defaultExpectation.paramPtrs.retryAt = &retryAt
+	mmMarkNotificationFailed.defaultExpectation.expectationOrigins.originRetryAt = minimock.CallerInfo(1)
+
+	return mmMarkNotificationFailed
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Inspect(f func(ctx context.Context, id uint32, errText string, retryAt *time.Time)) *mNotificationStorageMockMarkNotificationFailed {
+	if mmMarkNotificationFailed.mock.inspectFuncMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.MarkNotificationFailed")
+	}
+
+	mmMarkNotificationFailed.mock.inspectFuncMarkNotificationFailed = f
+
+	return mmMarkNotificationFailed
+}
+
+// Return sets up results that will be returned by NotificationStorage.MarkNotificationFailed
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Return(err error) *NotificationStorageMock {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	if mmMarkNotificationFailed.defaultExpectation == nil {
+		mmMarkNotificationFailed.defaultExpectation = &NotificationStorageMockMarkNotificationFailedExpectation{mock: mmMarkNotificationFailed.mock}
+	}
+	mmMarkNotificationFailed.defaultExpectation.results = &NotificationStorageMockMarkNotificationFailedResults{err}
+	mmMarkNotificationFailed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationFailed.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.MarkNotificationFailed method
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Set(f func(ctx context.Context, id uint32, errText string, retryAt *time.Time) (err error)) *NotificationStorageMock {
+	if mmMarkNotificationFailed.defaultExpectation != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.MarkNotificationFailed method")
+	}
+
+	if len(mmMarkNotificationFailed.expectations) > 0 {
+		mmMarkNotificationFailed.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.MarkNotificationFailed method")
+	}
+
+	mmMarkNotificationFailed.mock.funcMarkNotificationFailed = f
+	mmMarkNotificationFailed.mock.funcMarkNotificationFailedOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationFailed.mock
+}
+
+// When sets expectation for the NotificationStorage.MarkNotificationFailed which will trigger the result defined by the following
+// Then helper
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) When(ctx context.Context, id uint32, errText string, retryAt *time.Time) *NotificationStorageMockMarkNotificationFailedExpectation {
+	if mmMarkNotificationFailed.mock.funcMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.mock.t.Fatalf("NotificationStorageMock.MarkNotificationFailed mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockMarkNotificationFailedExpectation{
+		mock:               mmMarkNotificationFailed.mock,
+		params:             &NotificationStorageMockMarkNotificationFailedParams{ctx, id, errText, retryAt},
+		expectationOrigins: NotificationStorageMockMarkNotificationFailedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkNotificationFailed.expectations = append(mmMarkNotificationFailed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.MarkNotificationFailed return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockMarkNotificationFailedExpectation) Then(err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockMarkNotificationFailedResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.MarkNotificationFailed should be invoked
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Times(n uint64) *mNotificationStorageMockMarkNotificationFailed {
+	if n == 0 {
+		mmMarkNotificationFailed.mock.t.Fatalf("Times of NotificationStorageMock.MarkNotificationFailed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkNotificationFailed.expectedInvocations, n)
+	mmMarkNotificationFailed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationFailed
+}
+
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) invocationsDone() bool {
+	if len(mmMarkNotificationFailed.expectations) == 0 && mmMarkNotificationFailed.defaultExpectation == nil && mmMarkNotificationFailed.mock.funcMarkNotificationFailed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkNotificationFailed.mock.afterMarkNotificationFailedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkNotificationFailed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkNotificationFailed implements mm_storage.NotificationStorage
+func (mmMarkNotificationFailed *NotificationStorageMock) MarkNotificationFailed(ctx context.Context, id uint32, errText string, retryAt *time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkNotificationFailed.beforeMarkNotificationFailedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkNotificationFailed.afterMarkNotificationFailedCounter, 1)
+
+	mmMarkNotificationFailed.t.Helper()
+
+	if mmMarkNotificationFailed.inspectFuncMarkNotificationFailed != nil {
+		mmMarkNotificationFailed.inspectFuncMarkNotificationFailed(ctx, id, errText, retryAt)
+	}
+
+	mm_params := NotificationStorageMockMarkNotificationFailedParams{ctx, id, errText, retryAt}
+
+	// Record call args
+	mmMarkNotificationFailed.MarkNotificationFailedMock.mutex.Lock()
+	mmMarkNotificationFailed.MarkNotificationFailedMock.callArgs = append(mmMarkNotificationFailed.MarkNotificationFailedMock.callArgs, &mm_params)
+	mmMarkNotificationFailed.MarkNotificationFailedMock.mutex.Unlock()
+
+	for _, e := range mmMarkNotificationFailed.MarkNotificationFailedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockMarkNotificationFailedParams{ctx, id, errText, retryAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkNotificationFailed.t.Errorf("NotificationStorageMock.MarkNotificationFailed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkNotificationFailed.t.Errorf("NotificationStorageMock.MarkNotificationFailed got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.errText != nil && !minimock.Equal(*mm_want_ptrs.errText, mm_got.errText) {
+				mmMarkNotificationFailed.t.Errorf("NotificationStorageMock.MarkNotificationFailed got unexpected parameter errText, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.originErrText, *mm_want_ptrs.errText, mm_got.errText, minimock.Diff(*mm_want_ptrs.errText, mm_got.errText))
+			}
+
+			if mm_want_ptrs.retryAt != nil && !minimock.Equal(*mm_want_ptrs.retryAt, mm_got.retryAt) {
+				mmMarkNotificationFailed.t.Errorf("NotificationStorageMock.MarkNotificationFailed got unexpected parameter retryAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.originRetryAt, *mm_want_ptrs.retryAt, mm_got.retryAt, minimock.Diff(*mm_want_ptrs.retryAt, mm_got.retryAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkNotificationFailed.t.Errorf("NotificationStorageMock.MarkNotificationFailed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkNotificationFailed.MarkNotificationFailedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkNotificationFailed.t.Fatal("No results are set for the NotificationStorageMock.MarkNotificationFailed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkNotificationFailed.funcMarkNotificationFailed != nil {
+		return mmMarkNotificationFailed.funcMarkNotificationFailed(ctx, id, errText, retryAt)
+	}
+	mmMarkNotificationFailed.t.Fatalf("Unexpected call to NotificationStorageMock.MarkNotificationFailed. %v %v %v %v", ctx, id, errText, retryAt)
+	return
+}
+
+// MarkNotificationFailedAfterCounter returns a count of finished NotificationStorageMock.MarkNotificationFailed invocations
+func (mmMarkNotificationFailed *NotificationStorageMock) MarkNotificationFailedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkNotificationFailed.afterMarkNotificationFailedCounter)
+}
+
+// MarkNotificationFailedBeforeCounter returns a count of NotificationStorageMock.MarkNotificationFailed invocations
+func (mmMarkNotificationFailed *NotificationStorageMock) MarkNotificationFailedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkNotificationFailed.beforeMarkNotificationFailedCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.MarkNotificationFailed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkNotificationFailed *mNotificationStorageMockMarkNotificationFailed) Calls() []*NotificationStorageMockMarkNotificationFailedParams {
+	mmMarkNotificationFailed.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockMarkNotificationFailedParams, len(mmMarkNotificationFailed.callArgs))
+	copy(argCopy, mmMarkNotificationFailed.callArgs)
+
+	mmMarkNotificationFailed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkNotificationFailedDone returns true if the count of the MarkNotificationFailed invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockMarkNotificationFailedDone() bool {
+	if m.MarkNotificationFailedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkNotificationFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkNotificationFailedMock.invocationsDone()
+}
+
+// MinimockMarkNotificationFailedInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockMarkNotificationFailedInspect() {
+	for _, e := range m.MarkNotificationFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationFailed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkNotificationFailedCounter := mm_atomic.LoadUint64(&m.afterMarkNotificationFailedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkNotificationFailedMock.defaultExpectation != nil && afterMarkNotificationFailedCounter < 1 {
+		if m.MarkNotificationFailedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationFailed at\n%s", m.MarkNotificationFailedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationFailed at\n%s with params: %#v", m.MarkNotificationFailedMock.defaultExpectation.expectationOrigins.origin, *m.MarkNotificationFailedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkNotificationFailed != nil && afterMarkNotificationFailedCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationFailed at\n%s", m.funcMarkNotificationFailedOrigin)
+	}
+
+	if !m.MarkNotificationFailedMock.invocationsDone() && afterMarkNotificationFailedCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.MarkNotificationFailed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkNotificationFailedMock.expectedInvocations), m.MarkNotificationFailedMock.expectedInvocationsOrigin, afterMarkNotificationFailedCounter)
+	}
+}
+
+type mNotificationStorageMockMarkNotificationSent struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockMarkNotificationSentExpectation
+	expectations       []*NotificationStorageMockMarkNotificationSentExpectation
+
+	callArgs []*NotificationStorageMockMarkNotificationSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockMarkNotificationSentExpectation specifies expectation struct of the NotificationStorage.MarkNotificationSent
+type NotificationStorageMockMarkNotificationSentExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockMarkNotificationSentParams
+	paramPtrs          *NotificationStorageMockMarkNotificationSentParamPtrs
+	expectationOrigins NotificationStorageMockMarkNotificationSentExpectationOrigins
+	results            *NotificationStorageMockMarkNotificationSentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockMarkNotificationSentParams contains parameters of the NotificationStorage.MarkNotificationSent
+type NotificationStorageMockMarkNotificationSentParams struct {
+	ctx context.Context
+	id  uint32
+}
+
+// NotificationStorageMockMarkNotificationSentParamPtrs contains pointers to parameters of the NotificationStorage.MarkNotificationSent
+type NotificationStorageMockMarkNotificationSentParamPtrs struct {
+	ctx *context.Context
+	id  *uint32
+}
+
+// NotificationStorageMockMarkNotificationSentResults contains results of the NotificationStorage.MarkNotificationSent
+type NotificationStorageMockMarkNotificationSentResults struct {
+	err error
+}
+
+// NotificationStorageMockMarkNotificationSentOrigins contains origins of expectations of the NotificationStorage.MarkNotificationSent
+type NotificationStorageMockMarkNotificationSentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Optional() *mNotificationStorageMockMarkNotificationSent {
+	mmMarkNotificationSent.optional = true
+	return mmMarkNotificationSent
+}
+
+// Expect sets up expected params for NotificationStorage.MarkNotificationSent
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Expect(ctx context.Context, id uint32) *mNotificationStorageMockMarkNotificationSent {
+	if mmMarkNotificationSent.mock.funcMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Set")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation == nil {
+		mmMarkNotificationSent.defaultExpectation = &NotificationStorageMockMarkNotificationSentExpectation{}
+	}
+
+	if mmMarkNotificationSent.defaultExpectation.paramPtrs != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkNotificationSent.defaultExpectation.params = &NotificationStorageMockMarkNotificationSentParams{ctx, id}
+	mmMarkNotificationSent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkNotificationSent.expectations {
+		if minimock.Equal(e.params, mmMarkNotificationSent.defaultExpectation.params) {
+			mmMarkNotificationSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkNotificationSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkNotificationSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.MarkNotificationSent
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockMarkNotificationSent {
+	if mmMarkNotificationSent.mock.funcMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Set")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation == nil {
+		mmMarkNotificationSent.defaultExpectation = &NotificationStorageMockMarkNotificationSentExpectation{}
+	}
+
+	if mmMarkNotificationSent.defaultExpectation.params != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Expect")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationSent.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationSentParamPtrs{}
+	}
+	mmMarkNotificationSent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkNotificationSent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkNotificationSent
+}
+
+// ExpectIdParam2 sets up expected param id for NotificationStorage.MarkNotificationSent
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) ExpectIdParam2(id uint32) *mNotificationStorageMockMarkNotificationSent {
+	if mmMarkNotificationSent.mock.funcMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Set")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation == nil {
+		mmMarkNotificationSent.defaultExpectation = &NotificationStorageMockMarkNotificationSentExpectation{}
+	}
+
+	if mmMarkNotificationSent.defaultExpectation.params != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Expect")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation.paramPtrs == nil {
+		mmMarkNotificationSent.defaultExpectation.paramPtrs = &NotificationStorageMockMarkNotificationSentParamPtrs{}
+	}
+	mmMarkNotificationSent.defaultExpectation.paramPtrs.id = &id
+	mmMarkNotificationSent.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkNotificationSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.MarkNotificationSent
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Inspect(f func(ctx context.Context, id uint32)) *mNotificationStorageMockMarkNotificationSent {
+	if mmMarkNotificationSent.mock.inspectFuncMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.MarkNotificationSent")
+	}
+
+	mmMarkNotificationSent.mock.inspectFuncMarkNotificationSent = f
+
+	return mmMarkNotificationSent
+}
+
+// Return sets up results that will be returned by NotificationStorage.MarkNotificationSent
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Return(err error) *NotificationStorageMock {
+	if mmMarkNotificationSent.mock.funcMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Set")
+	}
+
+	if mmMarkNotificationSent.defaultExpectation == nil {
+		mmMarkNotificationSent.defaultExpectation = &NotificationStorageMockMarkNotificationSentExpectation{mock: mmMarkNotificationSent.mock}
+	}
+	mmMarkNotificationSent.defaultExpectation.results = &NotificationStorageMockMarkNotificationSentResults{err}
+	mmMarkNotificationSent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationSent.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.MarkNotificationSent method
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Set(f func(ctx context.Context, id uint32) (err error)) *NotificationStorageMock {
+	if mmMarkNotificationSent.defaultExpectation != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.MarkNotificationSent method")
+	}
+
+	if len(mmMarkNotificationSent.expectations) > 0 {
+		mmMarkNotificationSent.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.MarkNotificationSent method")
+	}
+
+	mmMarkNotificationSent.mock.funcMarkNotificationSent = f
+	mmMarkNotificationSent.mock.funcMarkNotificationSentOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationSent.mock
+}
+
+// When sets expectation for the NotificationStorage.MarkNotificationSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) When(ctx context.Context, id uint32) *NotificationStorageMockMarkNotificationSentExpectation {
+	if mmMarkNotificationSent.mock.funcMarkNotificationSent != nil {
+		mmMarkNotificationSent.mock.t.Fatalf("NotificationStorageMock.MarkNotificationSent mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockMarkNotificationSentExpectation{
+		mock:               mmMarkNotificationSent.mock,
+		params:             &NotificationStorageMockMarkNotificationSentParams{ctx, id},
+		expectationOrigins: NotificationStorageMockMarkNotificationSentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkNotificationSent.expectations = append(mmMarkNotificationSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.MarkNotificationSent return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockMarkNotificationSentExpectation) Then(err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockMarkNotificationSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.MarkNotificationSent should be invoked
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Times(n uint64) *mNotificationStorageMockMarkNotificationSent {
+	if n == 0 {
+		mmMarkNotificationSent.mock.t.Fatalf("Times of NotificationStorageMock.MarkNotificationSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkNotificationSent.expectedInvocations, n)
+	mmMarkNotificationSent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkNotificationSent
+}
+
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) invocationsDone() bool {
+	if len(mmMarkNotificationSent.expectations) == 0 && mmMarkNotificationSent.defaultExpectation == nil && mmMarkNotificationSent.mock.funcMarkNotificationSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkNotificationSent.mock.afterMarkNotificationSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkNotificationSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkNotificationSent implements mm_storage.NotificationStorage
+func (mmMarkNotificationSent *NotificationStorageMock) MarkNotificationSent(ctx context.Context, id uint32) (err error) {
+	mm_atomic.AddUint64(&mmMarkNotificationSent.beforeMarkNotificationSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkNotificationSent.afterMarkNotificationSentCounter, 1)
+
+	mmMarkNotificationSent.t.Helper()
+
+	if mmMarkNotificationSent.inspectFuncMarkNotificationSent != nil {
+		mmMarkNotificationSent.inspectFuncMarkNotificationSent(ctx, id)
+	}
+
+	mm_params := NotificationStorageMockMarkNotificationSentParams{ctx, id}
+
+	// Record call args
+	mmMarkNotificationSent.MarkNotificationSentMock.mutex.Lock()
+	mmMarkNotificationSent.MarkNotificationSentMock.callArgs = append(mmMarkNotificationSent.MarkNotificationSentMock.callArgs, &mm_params)
+	mmMarkNotificationSent.MarkNotificationSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkNotificationSent.MarkNotificationSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockMarkNotificationSentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkNotificationSent.t.Errorf("NotificationStorageMock.MarkNotificationSent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkNotificationSent.t.Errorf("NotificationStorageMock.MarkNotificationSent got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkNotificationSent.t.Errorf("NotificationStorageMock.MarkNotificationSent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkNotificationSent.MarkNotificationSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkNotificationSent.t.Fatal("No results are set for the NotificationStorageMock.MarkNotificationSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkNotificationSent.funcMarkNotificationSent != nil {
+		return mmMarkNotificationSent.funcMarkNotificationSent(ctx, id)
+	}
+	mmMarkNotificationSent.t.Fatalf("Unexpected call to NotificationStorageMock.MarkNotificationSent. %v %v", ctx, id)
+	return
+}
+
+// MarkNotificationSentAfterCounter returns a count of finished NotificationStorageMock.MarkNotificationSent invocations
+func (mmMarkNotificationSent *NotificationStorageMock) MarkNotificationSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkNotificationSent.afterMarkNotificationSentCounter)
+}
+
+// MarkNotificationSentBeforeCounter returns a count of NotificationStorageMock.MarkNotificationSent invocations
+func (mmMarkNotificationSent *NotificationStorageMock) MarkNotificationSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkNotificationSent.beforeMarkNotificationSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.MarkNotificationSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkNotificationSent *mNotificationStorageMockMarkNotificationSent) Calls() []*NotificationStorageMockMarkNotificationSentParams {
+	mmMarkNotificationSent.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockMarkNotificationSentParams, len(mmMarkNotificationSent.callArgs))
+	copy(argCopy, mmMarkNotificationSent.callArgs)
+
+	mmMarkNotificationSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkNotificationSentDone returns true if the count of the MarkNotificationSent invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockMarkNotificationSentDone() bool {
+	if m.MarkNotificationSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkNotificationSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkNotificationSentMock.invocationsDone()
+}
+
+// MinimockMarkNotificationSentInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockMarkNotificationSentInspect() {
+	for _, e := range m.MarkNotificationSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationSent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkNotificationSentCounter := mm_atomic.LoadUint64(&m.afterMarkNotificationSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkNotificationSentMock.defaultExpectation != nil && afterMarkNotificationSentCounter < 1 {
+		if m.MarkNotificationSentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationSent at\n%s", m.MarkNotificationSentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationSent at\n%s with params: %#v", m.MarkNotificationSentMock.defaultExpectation.expectationOrigins.origin, *m.MarkNotificationSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkNotificationSent != nil && afterMarkNotificationSentCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.MarkNotificationSent at\n%s", m.funcMarkNotificationSentOrigin)
+	}
+
+	if !m.MarkNotificationSentMock.invocationsDone() && afterMarkNotificationSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.MarkNotificationSent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkNotificationSentMock.expectedInvocations), m.MarkNotificationSentMock.expectedInvocationsOrigin, afterMarkNotificationSentCounter)
+	}
+}
+
+type mNotificationStorageMockSaveNotificationTx struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockSaveNotificationTxExpectation
+	expectations       []*NotificationStorageMockSaveNotificationTxExpectation
+
+	callArgs []*NotificationStorageMockSaveNotificationTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockSaveNotificationTxExpectation specifies expectation struct of the NotificationStorage.SaveNotificationTx
+type NotificationStorageMockSaveNotificationTxExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockSaveNotificationTxParams
+	paramPtrs          *NotificationStorageMockSaveNotificationTxParamPtrs
+	expectationOrigins NotificationStorageMockSaveNotificationTxExpectationOrigins
+	results            *NotificationStorageMockSaveNotificationTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockSaveNotificationTxParams contains parameters of the NotificationStorage.SaveNotificationTx
+type NotificationStorageMockSaveNotificationTxParams struct {
+	ctx context.Context
+	tx  pgx.Tx
+	n   models.Notification
+}
+
+// NotificationStorageMockSaveNotificationTxParamPtrs contains pointers to parameters of the NotificationStorage.SaveNotificationTx
+type NotificationStorageMockSaveNotificationTxParamPtrs struct {
+	ctx *context.Context
+	tx  *pgx.Tx
+	n   *models.Notification
+}
+
+// NotificationStorageMockSaveNotificationTxResults contains results of the NotificationStorage.SaveNotificationTx
+type NotificationStorageMockSaveNotificationTxResults struct {
+	u1  uint32
+	err error
+}
+
+// NotificationStorageMockSaveNotificationTxOrigins contains origins of expectations of the NotificationStorage.SaveNotificationTx
+type NotificationStorageMockSaveNotificationTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originTx  string
+	originN   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Optional() *mNotificationStorageMockSaveNotificationTx {
+	mmSaveNotificationTx.optional = true
+	return mmSaveNotificationTx
+}
+
+// Expect sets up expected params for NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Expect(ctx context.Context, tx pgx.Tx, n models.Notification) *mNotificationStorageMockSaveNotificationTx {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation == nil {
+		mmSaveNotificationTx.defaultExpectation = &NotificationStorageMockSaveNotificationTxExpectation{}
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.paramPtrs != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by ExpectParams functions")
+	}
+
+	mmSaveNotificationTx.defaultExpectation.params = &NotificationStorageMockSaveNotificationTxParams{ctx, tx, n}
+	mmSaveNotificationTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveNotificationTx.expectations {
+		if minimock.Equal(e.params, mmSaveNotificationTx.defaultExpectation.params) {
+			mmSaveNotificationTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveNotificationTx.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveNotificationTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockSaveNotificationTx {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation == nil {
+		mmSaveNotificationTx.defaultExpectation = &NotificationStorageMockSaveNotificationTxExpectation{}
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.params != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Expect")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.paramPtrs == nil {
+		mmSaveNotificationTx.defaultExpectation.paramPtrs = &NotificationStorageMockSaveNotificationTxParamPtrs{}
+	}
+	mmSaveNotificationTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveNotificationTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveNotificationTx
+}
+
+// ExpectTxParam2 sets up expected param tx for NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) ExpectTxParam2(tx pgx.Tx) *mNotificationStorageMockSaveNotificationTx {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation == nil {
+		mmSaveNotificationTx.defaultExpectation = &NotificationStorageMockSaveNotificationTxExpectation{}
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.params != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Expect")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.paramPtrs == nil {
+		mmSaveNotificationTx.defaultExpectation.paramPtrs = &NotificationStorageMockSaveNotificationTxParamPtrs{}
+	}
+	mmSaveNotificationTx.defaultExpectation.paramPtrs.tx = &tx
+	mmSaveNotificationTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmSaveNotificationTx
+}
+
+// ExpectNParam3 sets up expected param n for NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) ExpectNParam3(n models.Notification) *mNotificationStorageMockSaveNotificationTx {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation == nil {
+		mmSaveNotificationTx.defaultExpectation = &NotificationStorageMockSaveNotificationTxExpectation{}
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.params != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Expect")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation.paramPtrs == nil {
+		mmSaveNotificationTx.defaultExpectation.paramPtrs = &NotificationStorageMockSaveNotificationTxParamPtrs{}
+	}
+	mmSaveNotificationTx.defaultExpectation.paramPtrs.n = &n
+	mmSaveNotificationTx.defaultExpectation.expectationOrigins.originN = minimock.CallerInfo(1)
+
+	return mmSaveNotificationTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Inspect(f func(ctx context.Context, tx pgx.Tx, n models.Notification)) *mNotificationStorageMockSaveNotificationTx {
+	if mmSaveNotificationTx.mock.inspectFuncSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.SaveNotificationTx")
+	}
+
+	mmSaveNotificationTx.mock.inspectFuncSaveNotificationTx = f
+
+	return mmSaveNotificationTx
+}
+
+// Return sets up results that will be returned by NotificationStorage.SaveNotificationTx
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Return(u1 uint32, err error) *NotificationStorageMock {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	if mmSaveNotificationTx.defaultExpectation == nil {
+		mmSaveNotificationTx.defaultExpectation = &NotificationStorageMockSaveNotificationTxExpectation{mock: mmSaveNotificationTx.mock}
+	}
+	mmSaveNotificationTx.defaultExpectation.results = &NotificationStorageMockSaveNotificationTxResults{u1, err}
+	mmSaveNotificationTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveNotificationTx.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.SaveNotificationTx method
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Set(f func(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error)) *NotificationStorageMock {
+	if mmSaveNotificationTx.defaultExpectation != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.SaveNotificationTx method")
+	}
+
+	if len(mmSaveNotificationTx.expectations) > 0 {
+		mmSaveNotificationTx.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.SaveNotificationTx method")
+	}
+
+	mmSaveNotificationTx.mock.funcSaveNotificationTx = f
+	mmSaveNotificationTx.mock.funcSaveNotificationTxOrigin = minimock.CallerInfo(1)
+	return mmSaveNotificationTx.mock
+}
+
+// When sets expectation for the NotificationStorage.SaveNotificationTx which will trigger the result defined by the following
+// Then helper
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) When(ctx context.Context, tx pgx.Tx, n models.Notification) *NotificationStorageMockSaveNotificationTxExpectation {
+	if mmSaveNotificationTx.mock.funcSaveNotificationTx != nil {
+		mmSaveNotificationTx.mock.t.Fatalf("NotificationStorageMock.SaveNotificationTx mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockSaveNotificationTxExpectation{
+		mock:               mmSaveNotificationTx.mock,
+		params:             &NotificationStorageMockSaveNotificationTxParams{ctx, tx, n},
+		expectationOrigins: NotificationStorageMockSaveNotificationTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveNotificationTx.expectations = append(mmSaveNotificationTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.SaveNotificationTx return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockSaveNotificationTxExpectation) Then(u1 uint32, err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockSaveNotificationTxResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.SaveNotificationTx should be invoked
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Times(n uint64) *mNotificationStorageMockSaveNotificationTx {
+	if n == 0 {
+		mmSaveNotificationTx.mock.t.Fatalf("Times of NotificationStorageMock.SaveNotificationTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveNotificationTx.expectedInvocations, n)
+	mmSaveNotificationTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveNotificationTx
+}
+
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) invocationsDone() bool {
+	if len(mmSaveNotificationTx.expectations) == 0 && mmSaveNotificationTx.defaultExpectation == nil && mmSaveNotificationTx.mock.funcSaveNotificationTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveNotificationTx.mock.afterSaveNotificationTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveNotificationTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveNotificationTx implements mm_storage.NotificationStorage
+func (mmSaveNotificationTx *NotificationStorageMock) SaveNotificationTx(ctx context.Context, tx pgx.Tx, n models.Notification) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmSaveNotificationTx.beforeSaveNotificationTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveNotificationTx.afterSaveNotificationTxCounter, 1)
+
+	mmSaveNotificationTx.t.Helper()
+
+	if mmSaveNotificationTx.inspectFuncSaveNotificationTx != nil {
+		mmSaveNotificationTx.inspectFuncSaveNotificationTx(ctx, tx, n)
+	}
+
+	mm_params := NotificationStorageMockSaveNotificationTxParams{ctx, tx, n}
+
+	// Record call args
+	mmSaveNotificationTx.SaveNotificationTxMock.mutex.Lock()
+	mmSaveNotificationTx.SaveNotificationTxMock.callArgs = append(mmSaveNotificationTx.SaveNotificationTxMock.callArgs, &mm_params)
+	mmSaveNotificationTx.SaveNotificationTxMock.mutex.Unlock()
+
+	for _, e := range mmSaveNotificationTx.SaveNotificationTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockSaveNotificationTxParams{ctx, tx, n}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveNotificationTx.t.Errorf("NotificationStorageMock.SaveNotificationTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmSaveNotificationTx.t.Errorf("NotificationStorageMock.SaveNotificationTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.n != nil && !minimock.Equal(*mm_want_ptrs.n, mm_got.n) {
+				mmSaveNotificationTx.t.Errorf("NotificationStorageMock.SaveNotificationTx got unexpected parameter n, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.expectationOrigins.originN, *mm_want_ptrs.n, mm_got.n, minimock.Diff(*mm_want_ptrs.n, mm_got.n))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveNotificationTx.t.Errorf("NotificationStorageMock.SaveNotificationTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveNotificationTx.SaveNotificationTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveNotificationTx.t.Fatal("No results are set for the NotificationStorageMock.SaveNotificationTx")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmSaveNotificationTx.funcSaveNotificationTx != nil {
+		return mmSaveNotificationTx.funcSaveNotificationTx(ctx, tx, n)
+	}
+	mmSaveNotificationTx.t.Fatalf("Unexpected call to NotificationStorageMock.SaveNotificationTx. %v %v %v", ctx, tx, n)
+	return
+}
+
+// SaveNotificationTxAfterCounter returns a count of finished NotificationStorageMock.SaveNotificationTx invocations
+func (mmSaveNotificationTx *NotificationStorageMock) SaveNotificationTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveNotificationTx.afterSaveNotificationTxCounter)
+}
+
+// SaveNotificationTxBeforeCounter returns a count of NotificationStorageMock.SaveNotificationTx invocations
+func (mmSaveNotificationTx *NotificationStorageMock) SaveNotificationTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveNotificationTx.beforeSaveNotificationTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.SaveNotificationTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveNotificationTx *mNotificationStorageMockSaveNotificationTx) Calls() []*NotificationStorageMockSaveNotificationTxParams {
+	mmSaveNotificationTx.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockSaveNotificationTxParams, len(mmSaveNotificationTx.callArgs))
+	copy(argCopy, mmSaveNotificationTx.callArgs)
+
+	mmSaveNotificationTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveNotificationTxDone returns true if the count of the SaveNotificationTx invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockSaveNotificationTxDone() bool {
+	if m.SaveNotificationTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveNotificationTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveNotificationTxMock.invocationsDone()
+}
+
+// MinimockSaveNotificationTxInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockSaveNotificationTxInspect() {
+	for _, e := range m.SaveNotificationTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.SaveNotificationTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveNotificationTxCounter := mm_atomic.LoadUint64(&m.afterSaveNotificationTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveNotificationTxMock.defaultExpectation != nil && afterSaveNotificationTxCounter < 1 {
+		if m.SaveNotificationTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.SaveNotificationTx at\n%s", m.SaveNotificationTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.SaveNotificationTx at\n%s with params: %#v", m.SaveNotificationTxMock.defaultExpectation.expectationOrigins.origin, *m.SaveNotificationTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveNotificationTx != nil && afterSaveNotificationTxCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.SaveNotificationTx at\n%s", m.funcSaveNotificationTxOrigin)
+	}
+
+	if !m.SaveNotificationTxMock.invocationsDone() && afterSaveNotificationTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.SaveNotificationTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveNotificationTxMock.expectedInvocations), m.SaveNotificationTxMock.expectedInvocationsOrigin, afterSaveNotificationTxCounter)
+	}
+}
+
+type mNotificationStorageMockWithTransaction struct {
+	optional           bool
+	mock               *NotificationStorageMock
+	defaultExpectation *NotificationStorageMockWithTransactionExpectation
+	expectations       []*NotificationStorageMockWithTransactionExpectation
+
+	callArgs []*NotificationStorageMockWithTransactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// NotificationStorageMockWithTransactionExpectation specifies expectation struct of the NotificationStorage.WithTransaction
+type NotificationStorageMockWithTransactionExpectation struct {
+	mock               *NotificationStorageMock
+	params             *NotificationStorageMockWithTransactionParams
+	paramPtrs          *NotificationStorageMockWithTransactionParamPtrs
+	expectationOrigins NotificationStorageMockWithTransactionExpectationOrigins
+	results            *NotificationStorageMockWithTransactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// NotificationStorageMockWithTransactionParams contains parameters of the NotificationStorage.WithTransaction
+type NotificationStorageMockWithTransactionParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context, tx pgx.Tx) error
+}
+
+// NotificationStorageMockWithTransactionParamPtrs contains pointers to parameters of the NotificationStorage.WithTransaction
+type NotificationStorageMockWithTransactionParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context, tx pgx.Tx) error
+}
+
+// NotificationStorageMockWithTransactionResults contains results of the NotificationStorage.WithTransaction
+type NotificationStorageMockWithTransactionResults struct {
+	err error
+}
+
+// NotificationStorageMockWithTransactionOrigins contains origins of expectations of the NotificationStorage.WithTransaction
+type NotificationStorageMockWithTransactionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Optional() *mNotificationStorageMockWithTransaction {
+	mmWithTransaction.optional = true
+	return mmWithTransaction
+}
+
+// Expect sets up expected params for NotificationStorage.WithTransaction
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Expect(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) *mNotificationStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &NotificationStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by ExpectParams functions")
+	}
+
+	mmWithTransaction.defaultExpectation.params = &NotificationStorageMockWithTransactionParams{ctx, fn}
+	mmWithTransaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWithTransaction.expectations {
+		if minimock.Equal(e.params, mmWithTransaction.defaultExpectation.params) {
+			mmWithTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWithTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmWithTransaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationStorage.WithTransaction
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) ExpectCtxParam1(ctx context.Context) *mNotificationStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &NotificationStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.params != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Expect")
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithTransaction.defaultExpectation.paramPtrs = &NotificationStorageMockWithTransactionParamPtrs{}
+	}
+	mmWithTransaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWithTransaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWithTransaction
+}
+
+// ExpectFnParam2 sets up expected param fn for NotificationStorage.WithTransaction
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) ExpectFnParam2(fn func(ctx context.Context, tx pgx.Tx) error) *mNotificationStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &NotificationStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.params != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Expect")
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithTransaction.defaultExpectation.paramPtrs = &NotificationStorageMockWithTransactionParamPtrs{}
+	}
+	mmWithTransaction.defaultExpectation.paramPtrs.fn = &fn
+	mmWithTransaction.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWithTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationStorage.WithTransaction
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Inspect(f func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error)) *mNotificationStorageMockWithTransaction {
+	if mmWithTransaction.mock.inspectFuncWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("Inspect function is already set for NotificationStorageMock.WithTransaction")
+	}
+
+	mmWithTransaction.mock.inspectFuncWithTransaction = f
+
+	return mmWithTransaction
+}
+
+// Return sets up results that will be returned by NotificationStorage.WithTransaction
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Return(err error) *NotificationStorageMock {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &NotificationStorageMockWithTransactionExpectation{mock: mmWithTransaction.mock}
+	}
+	mmWithTransaction.defaultExpectation.results = &NotificationStorageMockWithTransactionResults{err}
+	mmWithTransaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction.mock
+}
+
+// Set uses given function f to mock the NotificationStorage.WithTransaction method
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Set(f func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error)) *NotificationStorageMock {
+	if mmWithTransaction.defaultExpectation != nil {
+		mmWithTransaction.mock.t.Fatalf("Default expectation is already set for the NotificationStorage.WithTransaction method")
+	}
+
+	if len(mmWithTransaction.expectations) > 0 {
+		mmWithTransaction.mock.t.Fatalf("Some expectations are already set for the NotificationStorage.WithTransaction method")
+	}
+
+	mmWithTransaction.mock.funcWithTransaction = f
+	mmWithTransaction.mock.funcWithTransactionOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction.mock
+}
+
+// When sets expectation for the NotificationStorage.WithTransaction which will trigger the result defined by the following
+// Then helper
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) When(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) *NotificationStorageMockWithTransactionExpectation {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("NotificationStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	expectation := &NotificationStorageMockWithTransactionExpectation{
+		mock:               mmWithTransaction.mock,
+		params:             &NotificationStorageMockWithTransactionParams{ctx, fn},
+		expectationOrigins: NotificationStorageMockWithTransactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWithTransaction.expectations = append(mmWithTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationStorage.WithTransaction return parameters for the expectation previously defined by the When method
+func (e *NotificationStorageMockWithTransactionExpectation) Then(err error) *NotificationStorageMock {
+	e.results = &NotificationStorageMockWithTransactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationStorage.WithTransaction should be invoked
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Times(n uint64) *mNotificationStorageMockWithTransaction {
+	if n == 0 {
+		mmWithTransaction.mock.t.Fatalf("Times of NotificationStorageMock.WithTransaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWithTransaction.expectedInvocations, n)
+	mmWithTransaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction
+}
+
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) invocationsDone() bool {
+	if len(mmWithTransaction.expectations) == 0 && mmWithTransaction.defaultExpectation == nil && mmWithTransaction.mock.funcWithTransaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWithTransaction.mock.afterWithTransactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWithTransaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WithTransaction implements mm_storage.NotificationStorage
+func (mmWithTransaction *NotificationStorageMock) WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error) {
+	mm_atomic.AddUint64(&mmWithTransaction.beforeWithTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmWithTransaction.afterWithTransactionCounter, 1)
+
+	mmWithTransaction.t.Helper()
+
+	if mmWithTransaction.inspectFuncWithTransaction != nil {
+		mmWithTransaction.inspectFuncWithTransaction(ctx, fn)
+	}
+
+	mm_params := NotificationStorageMockWithTransactionParams{ctx, fn}
+
+	// Record call args
+	mmWithTransaction.WithTransactionMock.mutex.Lock()
+	mmWithTransaction.WithTransactionMock.callArgs = append(mmWithTransaction.WithTransactionMock.callArgs, &mm_params)
+	mmWithTransaction.WithTransactionMock.mutex.Unlock()
+
+	for _, e := range mmWithTransaction.WithTransactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWithTransaction.WithTransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWithTransaction.WithTransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmWithTransaction.WithTransactionMock.defaultExpectation.params
+		mm_want_ptrs := mmWithTransaction.WithTransactionMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationStorageMockWithTransactionParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWithTransaction.t.Errorf("NotificationStorageMock.WithTransaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWithTransaction.t.Errorf("NotificationStorageMock.WithTransaction got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWithTransaction.t.Errorf("NotificationStorageMock.WithTransaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWithTransaction.WithTransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWithTransaction.t.Fatal("No results are set for the NotificationStorageMock.WithTransaction")
+		}
+		return (*mm_results).err
+	}
+	if mmWithTransaction.funcWithTransaction != nil {
+		return mmWithTransaction.funcWithTransaction(ctx, fn)
+	}
+	mmWithTransaction.t.Fatalf("Unexpected call to NotificationStorageMock.WithTransaction. %v %v", ctx, fn)
+	return
+}
+
+// WithTransactionAfterCounter returns a count of finished NotificationStorageMock.WithTransaction invocations
+func (mmWithTransaction *NotificationStorageMock) WithTransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithTransaction.afterWithTransactionCounter)
+}
+
+// WithTransactionBeforeCounter returns a count of NotificationStorageMock.WithTransaction invocations
+func (mmWithTransaction *NotificationStorageMock) WithTransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithTransaction.beforeWithTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationStorageMock.WithTransaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWithTransaction *mNotificationStorageMockWithTransaction) Calls() []*NotificationStorageMockWithTransactionParams {
+	mmWithTransaction.mutex.RLock()
+
+	argCopy := make([]*NotificationStorageMockWithTransactionParams, len(mmWithTransaction.callArgs))
+	copy(argCopy, mmWithTransaction.callArgs)
+
+	mmWithTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWithTransactionDone returns true if the count of the WithTransaction invocations corresponds
+// the number of defined expectations
+func (m *NotificationStorageMock) MinimockWithTransactionDone() bool {
+	if m.WithTransactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WithTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WithTransactionMock.invocationsDone()
+}
+
+// MinimockWithTransactionInspect logs each unmet expectation
+func (m *NotificationStorageMock) MinimockWithTransactionInspect() {
+	for _, e := range m.WithTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationStorageMock.WithTransaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWithTransactionCounter := mm_atomic.LoadUint64(&m.afterWithTransactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WithTransactionMock.defaultExpectation != nil && afterWithTransactionCounter < 1 {
+		if m.WithTransactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to NotificationStorageMock.WithTransaction at\n%s", m.WithTransactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to NotificationStorageMock.WithTransaction at\n%s with params: %#v", m.WithTransactionMock.defaultExpectation.expectationOrigins.origin, *m.WithTransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWithTransaction != nil && afterWithTransactionCounter < 1 {
+		m.t.Errorf("Expected call to NotificationStorageMock.WithTransaction at\n%s", m.funcWithTransactionOrigin)
+	}
+
+	if !m.WithTransactionMock.invocationsDone() && afterWithTransactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationStorageMock.WithTransaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WithTransactionMock.expectedInvocations), m.WithTransactionMock.expectedInvocationsOrigin, afterWithTransactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotificationStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCancelNotificationInspect()
+
+			m.MinimockClaimDueNotificationsInspect()
+
+			m.MinimockGetNotificationInspect()
+
+			m.MinimockListExpiringOrdersInspect()
+
+			m.MinimockMarkNotificationFailedInspect()
+
+			m.MinimockMarkNotificationSentInspect()
+
+			m.MinimockSaveNotificationTxInspect()
+
+			m.MinimockWithTransactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotificationStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotificationStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCancelNotificationDone() &&
+		m.MinimockClaimDueNotificationsDone() &&
+		m.MinimockGetNotificationDone() &&
+		m.MinimockListExpiringOrdersDone() &&
+		m.MinimockMarkNotificationFailedDone() &&
+		m.MinimockMarkNotificationSentDone() &&
+		m.MinimockSaveNotificationTxDone() &&
+		m.MinimockWithTransactionDone()
+}
@@ -0,0 +1,1610 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.OutboxStorage -o outbox_storage_mock.go -n OutboxStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"PWZ1.0/internal/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// OutboxStorageMock implements mm_storage.OutboxStorage
+type OutboxStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimOutboxBatch          func(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration) (oa1 []models.OutboxMessage, err error)
+	funcClaimOutboxBatchOrigin    string
+	inspectFuncClaimOutboxBatch   func(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration)
+	afterClaimOutboxBatchCounter  uint64
+	beforeClaimOutboxBatchCounter uint64
+	ClaimOutboxBatchMock          mOutboxStorageMockClaimOutboxBatch
+
+	funcMarkOutboxCompleted          func(ctx context.Context, id uuid.UUID) (err error)
+	funcMarkOutboxCompletedOrigin    string
+	inspectFuncMarkOutboxCompleted   func(ctx context.Context, id uuid.UUID)
+	afterMarkOutboxCompletedCounter  uint64
+	beforeMarkOutboxCompletedCounter uint64
+	MarkOutboxCompletedMock          mOutboxStorageMockMarkOutboxCompleted
+
+	funcMarkOutboxFailed          func(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) (err error)
+	funcMarkOutboxFailedOrigin    string
+	inspectFuncMarkOutboxFailed   func(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time)
+	afterMarkOutboxFailedCounter  uint64
+	beforeMarkOutboxFailedCounter uint64
+	MarkOutboxFailedMock          mOutboxStorageMockMarkOutboxFailed
+
+	funcOutboxStats          func(ctx context.Context, maxAttempts int) (o1 models.OutboxStats, err error)
+	funcOutboxStatsOrigin    string
+	inspectFuncOutboxStats   func(ctx context.Context, maxAttempts int)
+	afterOutboxStatsCounter  uint64
+	beforeOutboxStatsCounter uint64
+	OutboxStatsMock          mOutboxStorageMockOutboxStats
+}
+
+// NewOutboxStorageMock returns a mock for mm_storage.OutboxStorage
+func NewOutboxStorageMock(t minimock.Tester) *OutboxStorageMock {
+	m := &OutboxStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimOutboxBatchMock = mOutboxStorageMockClaimOutboxBatch{mock: m}
+	m.ClaimOutboxBatchMock.callArgs = []*OutboxStorageMockClaimOutboxBatchParams{}
+
+	m.MarkOutboxCompletedMock = mOutboxStorageMockMarkOutboxCompleted{mock: m}
+	m.MarkOutboxCompletedMock.callArgs = []*OutboxStorageMockMarkOutboxCompletedParams{}
+
+	m.MarkOutboxFailedMock = mOutboxStorageMockMarkOutboxFailed{mock: m}
+	m.MarkOutboxFailedMock.callArgs = []*OutboxStorageMockMarkOutboxFailedParams{}
+
+	m.OutboxStatsMock = mOutboxStorageMockOutboxStats{mock: m}
+	m.OutboxStatsMock.callArgs = []*OutboxStorageMockOutboxStatsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxStorageMockClaimOutboxBatch struct {
+	optional           bool
+	mock               *OutboxStorageMock
+	defaultExpectation *OutboxStorageMockClaimOutboxBatchExpectation
+	expectations       []*OutboxStorageMockClaimOutboxBatchExpectation
+
+	callArgs []*OutboxStorageMockClaimOutboxBatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxStorageMockClaimOutboxBatchExpectation specifies expectation struct of the OutboxStorage.ClaimOutboxBatch
+type OutboxStorageMockClaimOutboxBatchExpectation struct {
+	mock               *OutboxStorageMock
+	params             *OutboxStorageMockClaimOutboxBatchParams
+	paramPtrs          *OutboxStorageMockClaimOutboxBatchParamPtrs
+	expectationOrigins OutboxStorageMockClaimOutboxBatchExpectationOrigins
+	results            *OutboxStorageMockClaimOutboxBatchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxStorageMockClaimOutboxBatchParams contains parameters of the OutboxStorage.ClaimOutboxBatch
+type OutboxStorageMockClaimOutboxBatchParams struct {
+	ctx         context.Context
+	limit       int
+	maxAttempts int
+	staleAfter  time.Duration
+}
+
+// OutboxStorageMockClaimOutboxBatchParamPtrs contains pointers to parameters of the OutboxStorage.ClaimOutboxBatch
+type OutboxStorageMockClaimOutboxBatchParamPtrs struct {
+	ctx         *context.Context
+	limit       *int
+	maxAttempts *int
+	staleAfter  *time.Duration
+}
+
+// OutboxStorageMockClaimOutboxBatchResults contains results of the OutboxStorage.ClaimOutboxBatch
+type OutboxStorageMockClaimOutboxBatchResults struct {
+	oa1 []models.OutboxMessage
+	err error
+}
+
+// OutboxStorageMockClaimOutboxBatchOrigins contains origins of expectations of the OutboxStorage.ClaimOutboxBatch
+type OutboxStorageMockClaimOutboxBatchExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originLimit       string
+	originMaxAttempts string
+	originStaleAfter  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Optional() *mOutboxStorageMockClaimOutboxBatch {
+	mmClaimOutboxBatch.optional = true
+	return mmClaimOutboxBatch
+}
+
+// Expect sets up expected params for OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Expect(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{}
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.paramPtrs != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by ExpectParams functions")
+	}
+
+	mmClaimOutboxBatch.defaultExpectation.params = &OutboxStorageMockClaimOutboxBatchParams{ctx, limit, maxAttempts, staleAfter}
+	mmClaimOutboxBatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimOutboxBatch.expectations {
+		if minimock.Equal(e.params, mmClaimOutboxBatch.defaultExpectation.params) {
+			mmClaimOutboxBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimOutboxBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimOutboxBatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) ExpectCtxParam1(ctx context.Context) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{}
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.params != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Expect")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxBatch.defaultExpectation.paramPtrs = &OutboxStorageMockClaimOutboxBatchParamPtrs{}
+	}
+	mmClaimOutboxBatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimOutboxBatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimOutboxBatch
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) ExpectLimitParam2(limit int) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{}
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.params != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Expect")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxBatch.defaultExpectation.paramPtrs = &OutboxStorageMockClaimOutboxBatchParamPtrs{}
+	}
+	mmClaimOutboxBatch.defaultExpectation.paramPtrs.limit = &limit
+	mmClaimOutboxBatch.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmClaimOutboxBatch
+}
+
+// ExpectMaxAttemptsParam3 sets up expected param maxAttempts for OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) ExpectMaxAttemptsParam3(maxAttempts int) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{}
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.params != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Expect")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxBatch.defaultExpectation.paramPtrs = &OutboxStorageMockClaimOutboxBatchParamPtrs{}
+	}
+	mmClaimOutboxBatch.defaultExpectation.paramPtrs.maxAttempts = &maxAttempts
+	mmClaimOutboxBatch.defaultExpectation.expectationOrigins.originMaxAttempts = minimock.CallerInfo(1)
+
+	return mmClaimOutboxBatch
+}
+
+// ExpectStaleAfterParam4 sets up expected param staleAfter for OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) ExpectStaleAfterParam4(staleAfter time.Duration) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{}
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.params != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Expect")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation.paramPtrs == nil {
+		mmClaimOutboxBatch.defaultExpectation.paramPtrs = &OutboxStorageMockClaimOutboxBatchParamPtrs{}
+	}
+	mmClaimOutboxBatch.defaultExpectation.paramPtrs.staleAfter = &staleAfter
+	mmClaimOutboxBatch.defaultExpectation.expectationOrigins.originStaleAfter = minimock.CallerInfo(1)
+
+	return mmClaimOutboxBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Inspect(f func(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration)) *mOutboxStorageMockClaimOutboxBatch {
+	if mmClaimOutboxBatch.mock.inspectFuncClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("Inspect function is already set for OutboxStorageMock.ClaimOutboxBatch")
+	}
+
+	mmClaimOutboxBatch.mock.inspectFuncClaimOutboxBatch = f
+
+	return mmClaimOutboxBatch
+}
+
+// Return sets up results that will be returned by OutboxStorage.ClaimOutboxBatch
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Return(oa1 []models.OutboxMessage, err error) *OutboxStorageMock {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	if mmClaimOutboxBatch.defaultExpectation == nil {
+		mmClaimOutboxBatch.defaultExpectation = &OutboxStorageMockClaimOutboxBatchExpectation{mock: mmClaimOutboxBatch.mock}
+	}
+	mmClaimOutboxBatch.defaultExpectation.results = &OutboxStorageMockClaimOutboxBatchResults{oa1, err}
+	mmClaimOutboxBatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxBatch.mock
+}
+
+// Set uses given function f to mock the OutboxStorage.ClaimOutboxBatch method
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Set(f func(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration) (oa1 []models.OutboxMessage, err error)) *OutboxStorageMock {
+	if mmClaimOutboxBatch.defaultExpectation != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("Default expectation is already set for the OutboxStorage.ClaimOutboxBatch method")
+	}
+
+	if len(mmClaimOutboxBatch.expectations) > 0 {
+		mmClaimOutboxBatch.mock.t.Fatalf("Some expectations are already set for the OutboxStorage.ClaimOutboxBatch method")
+	}
+
+	mmClaimOutboxBatch.mock.funcClaimOutboxBatch = f
+	mmClaimOutboxBatch.mock.funcClaimOutboxBatchOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxBatch.mock
+}
+
+// When sets expectation for the OutboxStorage.ClaimOutboxBatch which will trigger the result defined by the following
+// Then helper
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) When(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration) *OutboxStorageMockClaimOutboxBatchExpectation {
+	if mmClaimOutboxBatch.mock.funcClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.mock.t.Fatalf("OutboxStorageMock.ClaimOutboxBatch mock is already set by Set")
+	}
+
+	expectation := &OutboxStorageMockClaimOutboxBatchExpectation{
+		mock:               mmClaimOutboxBatch.mock,
+		params:             &OutboxStorageMockClaimOutboxBatchParams{ctx, limit, maxAttempts, staleAfter},
+		expectationOrigins: OutboxStorageMockClaimOutboxBatchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimOutboxBatch.expectations = append(mmClaimOutboxBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxStorage.ClaimOutboxBatch return parameters for the expectation previously defined by the When method
+func (e *OutboxStorageMockClaimOutboxBatchExpectation) Then(oa1 []models.OutboxMessage, err error) *OutboxStorageMock {
+	e.results = &OutboxStorageMockClaimOutboxBatchResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxStorage.ClaimOutboxBatch should be invoked
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Times(n uint64) *mOutboxStorageMockClaimOutboxBatch {
+	if n == 0 {
+		mmClaimOutboxBatch.mock.t.Fatalf("Times of OutboxStorageMock.ClaimOutboxBatch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimOutboxBatch.expectedInvocations, n)
+	mmClaimOutboxBatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimOutboxBatch
+}
+
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) invocationsDone() bool {
+	if len(mmClaimOutboxBatch.expectations) == 0 && mmClaimOutboxBatch.defaultExpectation == nil && mmClaimOutboxBatch.mock.funcClaimOutboxBatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimOutboxBatch.mock.afterClaimOutboxBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimOutboxBatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimOutboxBatch implements mm_storage.OutboxStorage
+func (mmClaimOutboxBatch *OutboxStorageMock) ClaimOutboxBatch(ctx context.Context, limit int, maxAttempts int, staleAfter time.Duration) (oa1 []models.OutboxMessage, err error) {
+	mm_atomic.AddUint64(&mmClaimOutboxBatch.beforeClaimOutboxBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimOutboxBatch.afterClaimOutboxBatchCounter, 1)
+
+	mmClaimOutboxBatch.t.Helper()
+
+	if mmClaimOutboxBatch.inspectFuncClaimOutboxBatch != nil {
+		mmClaimOutboxBatch.inspectFuncClaimOutboxBatch(ctx, limit, maxAttempts, staleAfter)
+	}
+
+	mm_params := OutboxStorageMockClaimOutboxBatchParams{ctx, limit, maxAttempts, staleAfter}
+
+	// Record call args
+	mmClaimOutboxBatch.ClaimOutboxBatchMock.mutex.Lock()
+	mmClaimOutboxBatch.ClaimOutboxBatchMock.callArgs = append(mmClaimOutboxBatch.ClaimOutboxBatchMock.callArgs, &mm_params)
+	mmClaimOutboxBatch.ClaimOutboxBatchMock.mutex.Unlock()
+
+	for _, e := range mmClaimOutboxBatch.ClaimOutboxBatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxStorageMockClaimOutboxBatchParams{ctx, limit, maxAttempts, staleAfter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimOutboxBatch.t.Errorf("OutboxStorageMock.ClaimOutboxBatch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimOutboxBatch.t.Errorf("OutboxStorageMock.ClaimOutboxBatch got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.maxAttempts != nil && !minimock.Equal(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts) {
+				mmClaimOutboxBatch.t.Errorf("OutboxStorageMock.ClaimOutboxBatch got unexpected parameter maxAttempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.originMaxAttempts, *mm_want_ptrs.maxAttempts, mm_got.maxAttempts, minimock.Diff(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts))
+			}
+
+			if mm_want_ptrs.staleAfter != nil && !minimock.Equal(*mm_want_ptrs.staleAfter, mm_got.staleAfter) {
+				mmClaimOutboxBatch.t.Errorf("OutboxStorageMock.ClaimOutboxBatch got unexpected parameter staleAfter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.originStaleAfter, *mm_want_ptrs.staleAfter, mm_got.staleAfter, minimock.Diff(*mm_want_ptrs.staleAfter, mm_got.staleAfter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimOutboxBatch.t.Errorf("OutboxStorageMock.ClaimOutboxBatch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimOutboxBatch.ClaimOutboxBatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimOutboxBatch.t.Fatal("No results are set for the OutboxStorageMock.ClaimOutboxBatch")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmClaimOutboxBatch.funcClaimOutboxBatch != nil {
+		return mmClaimOutboxBatch.funcClaimOutboxBatch(ctx, limit, maxAttempts, staleAfter)
+	}
+	mmClaimOutboxBatch.t.Fatalf("Unexpected call to OutboxStorageMock.ClaimOutboxBatch. %v %v %v %v", ctx, limit, maxAttempts, staleAfter)
+	return
+}
+
+// ClaimOutboxBatchAfterCounter returns a count of finished OutboxStorageMock.ClaimOutboxBatch invocations
+func (mmClaimOutboxBatch *OutboxStorageMock) ClaimOutboxBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimOutboxBatch.afterClaimOutboxBatchCounter)
+}
+
+// ClaimOutboxBatchBeforeCounter returns a count of OutboxStorageMock.ClaimOutboxBatch invocations
+func (mmClaimOutboxBatch *OutboxStorageMock) ClaimOutboxBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimOutboxBatch.beforeClaimOutboxBatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxStorageMock.ClaimOutboxBatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimOutboxBatch *mOutboxStorageMockClaimOutboxBatch) Calls() []*OutboxStorageMockClaimOutboxBatchParams {
+	mmClaimOutboxBatch.mutex.RLock()
+
+	argCopy := make([]*OutboxStorageMockClaimOutboxBatchParams, len(mmClaimOutboxBatch.callArgs))
+	copy(argCopy, mmClaimOutboxBatch.callArgs)
+
+	mmClaimOutboxBatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimOutboxBatchDone returns true if the count of the ClaimOutboxBatch invocations corresponds
+// the number of defined expectations
+func (m *OutboxStorageMock) MinimockClaimOutboxBatchDone() bool {
+	if m.ClaimOutboxBatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimOutboxBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimOutboxBatchMock.invocationsDone()
+}
+
+// MinimockClaimOutboxBatchInspect logs each unmet expectation
+func (m *OutboxStorageMock) MinimockClaimOutboxBatchInspect() {
+	for _, e := range m.ClaimOutboxBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxStorageMock.ClaimOutboxBatch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimOutboxBatchCounter := mm_atomic.LoadUint64(&m.afterClaimOutboxBatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimOutboxBatchMock.defaultExpectation != nil && afterClaimOutboxBatchCounter < 1 {
+		if m.ClaimOutboxBatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxStorageMock.ClaimOutboxBatch at\n%s", m.ClaimOutboxBatchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxStorageMock.ClaimOutboxBatch at\n%s with params: %#v", m.ClaimOutboxBatchMock.defaultExpectation.expectationOrigins.origin, *m.ClaimOutboxBatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimOutboxBatch != nil && afterClaimOutboxBatchCounter < 1 {
+		m.t.Errorf("Expected call to OutboxStorageMock.ClaimOutboxBatch at\n%s", m.funcClaimOutboxBatchOrigin)
+	}
+
+	if !m.ClaimOutboxBatchMock.invocationsDone() && afterClaimOutboxBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxStorageMock.ClaimOutboxBatch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimOutboxBatchMock.expectedInvocations), m.ClaimOutboxBatchMock.expectedInvocationsOrigin, afterClaimOutboxBatchCounter)
+	}
+}
+
+type mOutboxStorageMockMarkOutboxCompleted struct {
+	optional           bool
+	mock               *OutboxStorageMock
+	defaultExpectation *OutboxStorageMockMarkOutboxCompletedExpectation
+	expectations       []*OutboxStorageMockMarkOutboxCompletedExpectation
+
+	callArgs []*OutboxStorageMockMarkOutboxCompletedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxStorageMockMarkOutboxCompletedExpectation specifies expectation struct of the OutboxStorage.MarkOutboxCompleted
+type OutboxStorageMockMarkOutboxCompletedExpectation struct {
+	mock               *OutboxStorageMock
+	params             *OutboxStorageMockMarkOutboxCompletedParams
+	paramPtrs          *OutboxStorageMockMarkOutboxCompletedParamPtrs
+	expectationOrigins OutboxStorageMockMarkOutboxCompletedExpectationOrigins
+	results            *OutboxStorageMockMarkOutboxCompletedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxStorageMockMarkOutboxCompletedParams contains parameters of the OutboxStorage.MarkOutboxCompleted
+type OutboxStorageMockMarkOutboxCompletedParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// OutboxStorageMockMarkOutboxCompletedParamPtrs contains pointers to parameters of the OutboxStorage.MarkOutboxCompleted
+type OutboxStorageMockMarkOutboxCompletedParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// OutboxStorageMockMarkOutboxCompletedResults contains results of the OutboxStorage.MarkOutboxCompleted
+type OutboxStorageMockMarkOutboxCompletedResults struct {
+	err error
+}
+
+// OutboxStorageMockMarkOutboxCompletedOrigins contains origins of expectations of the OutboxStorage.MarkOutboxCompleted
+type OutboxStorageMockMarkOutboxCompletedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Optional() *mOutboxStorageMockMarkOutboxCompleted {
+	mmMarkOutboxCompleted.optional = true
+	return mmMarkOutboxCompleted
+}
+
+// Expect sets up expected params for OutboxStorage.MarkOutboxCompleted
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Expect(ctx context.Context, id uuid.UUID) *mOutboxStorageMockMarkOutboxCompleted {
+	if mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Set")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation == nil {
+		mmMarkOutboxCompleted.defaultExpectation = &OutboxStorageMockMarkOutboxCompletedExpectation{}
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation.paramPtrs != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by ExpectParams functions")
+	}
+
+	mmMarkOutboxCompleted.defaultExpectation.params = &OutboxStorageMockMarkOutboxCompletedParams{ctx, id}
+	mmMarkOutboxCompleted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkOutboxCompleted.expectations {
+		if minimock.Equal(e.params, mmMarkOutboxCompleted.defaultExpectation.params) {
+			mmMarkOutboxCompleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkOutboxCompleted.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkOutboxCompleted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxStorage.MarkOutboxCompleted
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) ExpectCtxParam1(ctx context.Context) *mOutboxStorageMockMarkOutboxCompleted {
+	if mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Set")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation == nil {
+		mmMarkOutboxCompleted.defaultExpectation = &OutboxStorageMockMarkOutboxCompletedExpectation{}
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation.params != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Expect")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxCompleted.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxCompletedParamPtrs{}
+	}
+	mmMarkOutboxCompleted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkOutboxCompleted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkOutboxCompleted
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxStorage.MarkOutboxCompleted
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) ExpectIdParam2(id uuid.UUID) *mOutboxStorageMockMarkOutboxCompleted {
+	if mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Set")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation == nil {
+		mmMarkOutboxCompleted.defaultExpectation = &OutboxStorageMockMarkOutboxCompletedExpectation{}
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation.params != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Expect")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxCompleted.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxCompletedParamPtrs{}
+	}
+	mmMarkOutboxCompleted.defaultExpectation.paramPtrs.id = &id
+	mmMarkOutboxCompleted.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkOutboxCompleted
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxStorage.MarkOutboxCompleted
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Inspect(f func(ctx context.Context, id uuid.UUID)) *mOutboxStorageMockMarkOutboxCompleted {
+	if mmMarkOutboxCompleted.mock.inspectFuncMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("Inspect function is already set for OutboxStorageMock.MarkOutboxCompleted")
+	}
+
+	mmMarkOutboxCompleted.mock.inspectFuncMarkOutboxCompleted = f
+
+	return mmMarkOutboxCompleted
+}
+
+// Return sets up results that will be returned by OutboxStorage.MarkOutboxCompleted
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Return(err error) *OutboxStorageMock {
+	if mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Set")
+	}
+
+	if mmMarkOutboxCompleted.defaultExpectation == nil {
+		mmMarkOutboxCompleted.defaultExpectation = &OutboxStorageMockMarkOutboxCompletedExpectation{mock: mmMarkOutboxCompleted.mock}
+	}
+	mmMarkOutboxCompleted.defaultExpectation.results = &OutboxStorageMockMarkOutboxCompletedResults{err}
+	mmMarkOutboxCompleted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxCompleted.mock
+}
+
+// Set uses given function f to mock the OutboxStorage.MarkOutboxCompleted method
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *OutboxStorageMock {
+	if mmMarkOutboxCompleted.defaultExpectation != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("Default expectation is already set for the OutboxStorage.MarkOutboxCompleted method")
+	}
+
+	if len(mmMarkOutboxCompleted.expectations) > 0 {
+		mmMarkOutboxCompleted.mock.t.Fatalf("Some expectations are already set for the OutboxStorage.MarkOutboxCompleted method")
+	}
+
+	mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted = f
+	mmMarkOutboxCompleted.mock.funcMarkOutboxCompletedOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxCompleted.mock
+}
+
+// When sets expectation for the OutboxStorage.MarkOutboxCompleted which will trigger the result defined by the following
+// Then helper
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) When(ctx context.Context, id uuid.UUID) *OutboxStorageMockMarkOutboxCompletedExpectation {
+	if mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.mock.t.Fatalf("OutboxStorageMock.MarkOutboxCompleted mock is already set by Set")
+	}
+
+	expectation := &OutboxStorageMockMarkOutboxCompletedExpectation{
+		mock:               mmMarkOutboxCompleted.mock,
+		params:             &OutboxStorageMockMarkOutboxCompletedParams{ctx, id},
+		expectationOrigins: OutboxStorageMockMarkOutboxCompletedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkOutboxCompleted.expectations = append(mmMarkOutboxCompleted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxStorage.MarkOutboxCompleted return parameters for the expectation previously defined by the When method
+func (e *OutboxStorageMockMarkOutboxCompletedExpectation) Then(err error) *OutboxStorageMock {
+	e.results = &OutboxStorageMockMarkOutboxCompletedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxStorage.MarkOutboxCompleted should be invoked
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Times(n uint64) *mOutboxStorageMockMarkOutboxCompleted {
+	if n == 0 {
+		mmMarkOutboxCompleted.mock.t.Fatalf("Times of OutboxStorageMock.MarkOutboxCompleted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkOutboxCompleted.expectedInvocations, n)
+	mmMarkOutboxCompleted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxCompleted
+}
+
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) invocationsDone() bool {
+	if len(mmMarkOutboxCompleted.expectations) == 0 && mmMarkOutboxCompleted.defaultExpectation == nil && mmMarkOutboxCompleted.mock.funcMarkOutboxCompleted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkOutboxCompleted.mock.afterMarkOutboxCompletedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkOutboxCompleted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkOutboxCompleted implements mm_storage.OutboxStorage
+func (mmMarkOutboxCompleted *OutboxStorageMock) MarkOutboxCompleted(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmMarkOutboxCompleted.beforeMarkOutboxCompletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkOutboxCompleted.afterMarkOutboxCompletedCounter, 1)
+
+	mmMarkOutboxCompleted.t.Helper()
+
+	if mmMarkOutboxCompleted.inspectFuncMarkOutboxCompleted != nil {
+		mmMarkOutboxCompleted.inspectFuncMarkOutboxCompleted(ctx, id)
+	}
+
+	mm_params := OutboxStorageMockMarkOutboxCompletedParams{ctx, id}
+
+	// Record call args
+	mmMarkOutboxCompleted.MarkOutboxCompletedMock.mutex.Lock()
+	mmMarkOutboxCompleted.MarkOutboxCompletedMock.callArgs = append(mmMarkOutboxCompleted.MarkOutboxCompletedMock.callArgs, &mm_params)
+	mmMarkOutboxCompleted.MarkOutboxCompletedMock.mutex.Unlock()
+
+	for _, e := range mmMarkOutboxCompleted.MarkOutboxCompletedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxStorageMockMarkOutboxCompletedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkOutboxCompleted.t.Errorf("OutboxStorageMock.MarkOutboxCompleted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkOutboxCompleted.t.Errorf("OutboxStorageMock.MarkOutboxCompleted got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkOutboxCompleted.t.Errorf("OutboxStorageMock.MarkOutboxCompleted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkOutboxCompleted.MarkOutboxCompletedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkOutboxCompleted.t.Fatal("No results are set for the OutboxStorageMock.MarkOutboxCompleted")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkOutboxCompleted.funcMarkOutboxCompleted != nil {
+		return mmMarkOutboxCompleted.funcMarkOutboxCompleted(ctx, id)
+	}
+	mmMarkOutboxCompleted.t.Fatalf("Unexpected call to OutboxStorageMock.MarkOutboxCompleted. %v %v", ctx, id)
+	return
+}
+
+// MarkOutboxCompletedAfterCounter returns a count of finished OutboxStorageMock.MarkOutboxCompleted invocations
+func (mmMarkOutboxCompleted *OutboxStorageMock) MarkOutboxCompletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxCompleted.afterMarkOutboxCompletedCounter)
+}
+
+// MarkOutboxCompletedBeforeCounter returns a count of OutboxStorageMock.MarkOutboxCompleted invocations
+func (mmMarkOutboxCompleted *OutboxStorageMock) MarkOutboxCompletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxCompleted.beforeMarkOutboxCompletedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxStorageMock.MarkOutboxCompleted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkOutboxCompleted *mOutboxStorageMockMarkOutboxCompleted) Calls() []*OutboxStorageMockMarkOutboxCompletedParams {
+	mmMarkOutboxCompleted.mutex.RLock()
+
+	argCopy := make([]*OutboxStorageMockMarkOutboxCompletedParams, len(mmMarkOutboxCompleted.callArgs))
+	copy(argCopy, mmMarkOutboxCompleted.callArgs)
+
+	mmMarkOutboxCompleted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkOutboxCompletedDone returns true if the count of the MarkOutboxCompleted invocations corresponds
+// the number of defined expectations
+func (m *OutboxStorageMock) MinimockMarkOutboxCompletedDone() bool {
+	if m.MarkOutboxCompletedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkOutboxCompletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkOutboxCompletedMock.invocationsDone()
+}
+
+// MinimockMarkOutboxCompletedInspect logs each unmet expectation
+func (m *OutboxStorageMock) MinimockMarkOutboxCompletedInspect() {
+	for _, e := range m.MarkOutboxCompletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxCompleted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkOutboxCompletedCounter := mm_atomic.LoadUint64(&m.afterMarkOutboxCompletedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkOutboxCompletedMock.defaultExpectation != nil && afterMarkOutboxCompletedCounter < 1 {
+		if m.MarkOutboxCompletedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxCompleted at\n%s", m.MarkOutboxCompletedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxCompleted at\n%s with params: %#v", m.MarkOutboxCompletedMock.defaultExpectation.expectationOrigins.origin, *m.MarkOutboxCompletedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkOutboxCompleted != nil && afterMarkOutboxCompletedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxCompleted at\n%s", m.funcMarkOutboxCompletedOrigin)
+	}
+
+	if !m.MarkOutboxCompletedMock.invocationsDone() && afterMarkOutboxCompletedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxStorageMock.MarkOutboxCompleted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkOutboxCompletedMock.expectedInvocations), m.MarkOutboxCompletedMock.expectedInvocationsOrigin, afterMarkOutboxCompletedCounter)
+	}
+}
+
+type mOutboxStorageMockMarkOutboxFailed struct {
+	optional           bool
+	mock               *OutboxStorageMock
+	defaultExpectation *OutboxStorageMockMarkOutboxFailedExpectation
+	expectations       []*OutboxStorageMockMarkOutboxFailedExpectation
+
+	callArgs []*OutboxStorageMockMarkOutboxFailedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxStorageMockMarkOutboxFailedExpectation specifies expectation struct of the OutboxStorage.MarkOutboxFailed
+type OutboxStorageMockMarkOutboxFailedExpectation struct {
+	mock               *OutboxStorageMock
+	params             *OutboxStorageMockMarkOutboxFailedParams
+	paramPtrs          *OutboxStorageMockMarkOutboxFailedParamPtrs
+	expectationOrigins OutboxStorageMockMarkOutboxFailedExpectationOrigins
+	results            *OutboxStorageMockMarkOutboxFailedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxStorageMockMarkOutboxFailedParams contains parameters of the OutboxStorage.MarkOutboxFailed
+type OutboxStorageMockMarkOutboxFailedParams struct {
+	ctx           context.Context
+	id            uuid.UUID
+	errText       string
+	nextAttemptAt time.Time
+}
+
+// OutboxStorageMockMarkOutboxFailedParamPtrs contains pointers to parameters of the OutboxStorage.MarkOutboxFailed
+type OutboxStorageMockMarkOutboxFailedParamPtrs struct {
+	ctx           *context.Context
+	id            *uuid.UUID
+	errText       *string
+	nextAttemptAt *time.Time
+}
+
+// OutboxStorageMockMarkOutboxFailedResults contains results of the OutboxStorage.MarkOutboxFailed
+type OutboxStorageMockMarkOutboxFailedResults struct {
+	err error
+}
+
+// OutboxStorageMockMarkOutboxFailedOrigins contains origins of expectations of the OutboxStorage.MarkOutboxFailed
+type OutboxStorageMockMarkOutboxFailedExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originId            string
+	originErrText       string
+	originNextAttemptAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Optional() *mOutboxStorageMockMarkOutboxFailed {
+	mmMarkOutboxFailed.optional = true
+	return mmMarkOutboxFailed
+}
+
+// Expect sets up expected params for OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Expect(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{}
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.paramPtrs != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkOutboxFailed.defaultExpectation.params = &OutboxStorageMockMarkOutboxFailedParams{ctx, id, errText, nextAttemptAt}
+	mmMarkOutboxFailed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkOutboxFailed.expectations {
+		if minimock.Equal(e.params, mmMarkOutboxFailed.defaultExpectation.params) {
+			mmMarkOutboxFailed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkOutboxFailed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkOutboxFailed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) ExpectCtxParam1(ctx context.Context) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{}
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.params != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Expect")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxFailed.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxFailedParamPtrs{}
+	}
+	mmMarkOutboxFailed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkOutboxFailed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkOutboxFailed
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) ExpectIdParam2(id uuid.UUID) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{}
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.params != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Expect")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxFailed.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxFailedParamPtrs{}
+	}
+	mmMarkOutboxFailed.defaultExpectation.paramPtrs.id = &id
+	mmMarkOutboxFailed.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkOutboxFailed
+}
+
+// ExpectErrTextParam3 sets up expected param errText for OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) ExpectErrTextParam3(errText string) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{}
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.params != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Expect")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxFailed.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxFailedParamPtrs{}
+	}
+	mmMarkOutboxFailed.defaultExpectation.paramPtrs.errText = &errText
+	mmMarkOutboxFailed.defaultExpectation.expectationOrigins.originErrText = minimock.CallerInfo(1)
+
+	return mmMarkOutboxFailed
+}
+
+// ExpectNextAttemptAtParam4 sets up expected param nextAttemptAt for OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) ExpectNextAttemptAtParam4(nextAttemptAt time.Time) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{}
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.params != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Expect")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxFailed.defaultExpectation.paramPtrs = &OutboxStorageMockMarkOutboxFailedParamPtrs{}
+	}
+	mmMarkOutboxFailed.defaultExpectation.paramPtrs.nextAttemptAt = &nextAttemptAt
+	mmMarkOutboxFailed.defaultExpectation.expectationOrigins.originNextAttemptAt = minimock.CallerInfo(1)
+
+	return mmMarkOutboxFailed
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Inspect(f func(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time)) *mOutboxStorageMockMarkOutboxFailed {
+	if mmMarkOutboxFailed.mock.inspectFuncMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("Inspect function is already set for OutboxStorageMock.MarkOutboxFailed")
+	}
+
+	mmMarkOutboxFailed.mock.inspectFuncMarkOutboxFailed = f
+
+	return mmMarkOutboxFailed
+}
+
+// Return sets up results that will be returned by OutboxStorage.MarkOutboxFailed
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Return(err error) *OutboxStorageMock {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	if mmMarkOutboxFailed.defaultExpectation == nil {
+		mmMarkOutboxFailed.defaultExpectation = &OutboxStorageMockMarkOutboxFailedExpectation{mock: mmMarkOutboxFailed.mock}
+	}
+	mmMarkOutboxFailed.defaultExpectation.results = &OutboxStorageMockMarkOutboxFailedResults{err}
+	mmMarkOutboxFailed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxFailed.mock
+}
+
+// Set uses given function f to mock the OutboxStorage.MarkOutboxFailed method
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Set(f func(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) (err error)) *OutboxStorageMock {
+	if mmMarkOutboxFailed.defaultExpectation != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("Default expectation is already set for the OutboxStorage.MarkOutboxFailed method")
+	}
+
+	if len(mmMarkOutboxFailed.expectations) > 0 {
+		mmMarkOutboxFailed.mock.t.Fatalf("Some expectations are already set for the OutboxStorage.MarkOutboxFailed method")
+	}
+
+	mmMarkOutboxFailed.mock.funcMarkOutboxFailed = f
+	mmMarkOutboxFailed.mock.funcMarkOutboxFailedOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxFailed.mock
+}
+
+// When sets expectation for the OutboxStorage.MarkOutboxFailed which will trigger the result defined by the following
+// Then helper
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) When(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) *OutboxStorageMockMarkOutboxFailedExpectation {
+	if mmMarkOutboxFailed.mock.funcMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.mock.t.Fatalf("OutboxStorageMock.MarkOutboxFailed mock is already set by Set")
+	}
+
+	expectation := &OutboxStorageMockMarkOutboxFailedExpectation{
+		mock:               mmMarkOutboxFailed.mock,
+		params:             &OutboxStorageMockMarkOutboxFailedParams{ctx, id, errText, nextAttemptAt},
+		expectationOrigins: OutboxStorageMockMarkOutboxFailedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkOutboxFailed.expectations = append(mmMarkOutboxFailed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxStorage.MarkOutboxFailed return parameters for the expectation previously defined by the When method
+func (e *OutboxStorageMockMarkOutboxFailedExpectation) Then(err error) *OutboxStorageMock {
+	e.results = &OutboxStorageMockMarkOutboxFailedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxStorage.MarkOutboxFailed should be invoked
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Times(n uint64) *mOutboxStorageMockMarkOutboxFailed {
+	if n == 0 {
+		mmMarkOutboxFailed.mock.t.Fatalf("Times of OutboxStorageMock.MarkOutboxFailed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkOutboxFailed.expectedInvocations, n)
+	mmMarkOutboxFailed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkOutboxFailed
+}
+
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) invocationsDone() bool {
+	if len(mmMarkOutboxFailed.expectations) == 0 && mmMarkOutboxFailed.defaultExpectation == nil && mmMarkOutboxFailed.mock.funcMarkOutboxFailed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkOutboxFailed.mock.afterMarkOutboxFailedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkOutboxFailed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkOutboxFailed implements mm_storage.OutboxStorage
+func (mmMarkOutboxFailed *OutboxStorageMock) MarkOutboxFailed(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkOutboxFailed.beforeMarkOutboxFailedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkOutboxFailed.afterMarkOutboxFailedCounter, 1)
+
+	mmMarkOutboxFailed.t.Helper()
+
+	if mmMarkOutboxFailed.inspectFuncMarkOutboxFailed != nil {
+		mmMarkOutboxFailed.inspectFuncMarkOutboxFailed(ctx, id, errText, nextAttemptAt)
+	}
+
+	mm_params := OutboxStorageMockMarkOutboxFailedParams{ctx, id, errText, nextAttemptAt}
+
+	// Record call args
+	mmMarkOutboxFailed.MarkOutboxFailedMock.mutex.Lock()
+	mmMarkOutboxFailed.MarkOutboxFailedMock.callArgs = append(mmMarkOutboxFailed.MarkOutboxFailedMock.callArgs, &mm_params)
+	mmMarkOutboxFailed.MarkOutboxFailedMock.mutex.Unlock()
+
+	for _, e := range mmMarkOutboxFailed.MarkOutboxFailedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxStorageMockMarkOutboxFailedParams{ctx, id, errText, nextAttemptAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkOutboxFailed.t.Errorf("OutboxStorageMock.MarkOutboxFailed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkOutboxFailed.t.Errorf("OutboxStorageMock.MarkOutboxFailed got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.errText != nil && !minimock.Equal(*mm_want_ptrs.errText, mm_got.errText) {
+				mmMarkOutboxFailed.t.Errorf("OutboxStorageMock.MarkOutboxFailed got unexpected parameter errText, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.originErrText, *mm_want_ptrs.errText, mm_got.errText, minimock.Diff(*mm_want_ptrs.errText, mm_got.errText))
+			}
+
+			if mm_want_ptrs.nextAttemptAt != nil && !minimock.Equal(*mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt) {
+				mmMarkOutboxFailed.t.Errorf("OutboxStorageMock.MarkOutboxFailed got unexpected parameter nextAttemptAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.originNextAttemptAt, *mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt, minimock.Diff(*mm_want_ptrs.nextAttemptAt, mm_got.nextAttemptAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkOutboxFailed.t.Errorf("OutboxStorageMock.MarkOutboxFailed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkOutboxFailed.MarkOutboxFailedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkOutboxFailed.t.Fatal("No results are set for the OutboxStorageMock.MarkOutboxFailed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkOutboxFailed.funcMarkOutboxFailed != nil {
+		return mmMarkOutboxFailed.funcMarkOutboxFailed(ctx, id, errText, nextAttemptAt)
+	}
+	mmMarkOutboxFailed.t.Fatalf("Unexpected call to OutboxStorageMock.MarkOutboxFailed. %v %v %v %v", ctx, id, errText, nextAttemptAt)
+	return
+}
+
+// MarkOutboxFailedAfterCounter returns a count of finished OutboxStorageMock.MarkOutboxFailed invocations
+func (mmMarkOutboxFailed *OutboxStorageMock) MarkOutboxFailedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxFailed.afterMarkOutboxFailedCounter)
+}
+
+// MarkOutboxFailedBeforeCounter returns a count of OutboxStorageMock.MarkOutboxFailed invocations
+func (mmMarkOutboxFailed *OutboxStorageMock) MarkOutboxFailedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxFailed.beforeMarkOutboxFailedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxStorageMock.MarkOutboxFailed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkOutboxFailed *mOutboxStorageMockMarkOutboxFailed) Calls() []*OutboxStorageMockMarkOutboxFailedParams {
+	mmMarkOutboxFailed.mutex.RLock()
+
+	argCopy := make([]*OutboxStorageMockMarkOutboxFailedParams, len(mmMarkOutboxFailed.callArgs))
+	copy(argCopy, mmMarkOutboxFailed.callArgs)
+
+	mmMarkOutboxFailed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkOutboxFailedDone returns true if the count of the MarkOutboxFailed invocations corresponds
+// the number of defined expectations
+func (m *OutboxStorageMock) MinimockMarkOutboxFailedDone() bool {
+	if m.MarkOutboxFailedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkOutboxFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkOutboxFailedMock.invocationsDone()
+}
+
+// MinimockMarkOutboxFailedInspect logs each unmet expectation
+func (m *OutboxStorageMock) MinimockMarkOutboxFailedInspect() {
+	for _, e := range m.MarkOutboxFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxFailed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkOutboxFailedCounter := mm_atomic.LoadUint64(&m.afterMarkOutboxFailedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkOutboxFailedMock.defaultExpectation != nil && afterMarkOutboxFailedCounter < 1 {
+		if m.MarkOutboxFailedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxFailed at\n%s", m.MarkOutboxFailedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxFailed at\n%s with params: %#v", m.MarkOutboxFailedMock.defaultExpectation.expectationOrigins.origin, *m.MarkOutboxFailedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkOutboxFailed != nil && afterMarkOutboxFailedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxStorageMock.MarkOutboxFailed at\n%s", m.funcMarkOutboxFailedOrigin)
+	}
+
+	if !m.MarkOutboxFailedMock.invocationsDone() && afterMarkOutboxFailedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxStorageMock.MarkOutboxFailed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkOutboxFailedMock.expectedInvocations), m.MarkOutboxFailedMock.expectedInvocationsOrigin, afterMarkOutboxFailedCounter)
+	}
+}
+
+type mOutboxStorageMockOutboxStats struct {
+	optional           bool
+	mock               *OutboxStorageMock
+	defaultExpectation *OutboxStorageMockOutboxStatsExpectation
+	expectations       []*OutboxStorageMockOutboxStatsExpectation
+
+	callArgs []*OutboxStorageMockOutboxStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxStorageMockOutboxStatsExpectation specifies expectation struct of the OutboxStorage.OutboxStats
+type OutboxStorageMockOutboxStatsExpectation struct {
+	mock               *OutboxStorageMock
+	params             *OutboxStorageMockOutboxStatsParams
+	paramPtrs          *OutboxStorageMockOutboxStatsParamPtrs
+	expectationOrigins OutboxStorageMockOutboxStatsExpectationOrigins
+	results            *OutboxStorageMockOutboxStatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxStorageMockOutboxStatsParams contains parameters of the OutboxStorage.OutboxStats
+type OutboxStorageMockOutboxStatsParams struct {
+	ctx         context.Context
+	maxAttempts int
+}
+
+// OutboxStorageMockOutboxStatsParamPtrs contains pointers to parameters of the OutboxStorage.OutboxStats
+type OutboxStorageMockOutboxStatsParamPtrs struct {
+	ctx         *context.Context
+	maxAttempts *int
+}
+
+// OutboxStorageMockOutboxStatsResults contains results of the OutboxStorage.OutboxStats
+type OutboxStorageMockOutboxStatsResults struct {
+	o1  models.OutboxStats
+	err error
+}
+
+// OutboxStorageMockOutboxStatsOrigins contains origins of expectations of the OutboxStorage.OutboxStats
+type OutboxStorageMockOutboxStatsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originMaxAttempts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Optional() *mOutboxStorageMockOutboxStats {
+	mmOutboxStats.optional = true
+	return mmOutboxStats
+}
+
+// Expect sets up expected params for OutboxStorage.OutboxStats
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Expect(ctx context.Context, maxAttempts int) *mOutboxStorageMockOutboxStats {
+	if mmOutboxStats.mock.funcOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Set")
+	}
+
+	if mmOutboxStats.defaultExpectation == nil {
+		mmOutboxStats.defaultExpectation = &OutboxStorageMockOutboxStatsExpectation{}
+	}
+
+	if mmOutboxStats.defaultExpectation.paramPtrs != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by ExpectParams functions")
+	}
+
+	mmOutboxStats.defaultExpectation.params = &OutboxStorageMockOutboxStatsParams{ctx, maxAttempts}
+	mmOutboxStats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOutboxStats.expectations {
+		if minimock.Equal(e.params, mmOutboxStats.defaultExpectation.params) {
+			mmOutboxStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOutboxStats.defaultExpectation.params)
+		}
+	}
+
+	return mmOutboxStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxStorage.OutboxStats
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) ExpectCtxParam1(ctx context.Context) *mOutboxStorageMockOutboxStats {
+	if mmOutboxStats.mock.funcOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Set")
+	}
+
+	if mmOutboxStats.defaultExpectation == nil {
+		mmOutboxStats.defaultExpectation = &OutboxStorageMockOutboxStatsExpectation{}
+	}
+
+	if mmOutboxStats.defaultExpectation.params != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Expect")
+	}
+
+	if mmOutboxStats.defaultExpectation.paramPtrs == nil {
+		mmOutboxStats.defaultExpectation.paramPtrs = &OutboxStorageMockOutboxStatsParamPtrs{}
+	}
+	mmOutboxStats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOutboxStats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOutboxStats
+}
+
+// ExpectMaxAttemptsParam2 sets up expected param maxAttempts for OutboxStorage.OutboxStats
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) ExpectMaxAttemptsParam2(maxAttempts int) *mOutboxStorageMockOutboxStats {
+	if mmOutboxStats.mock.funcOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Set")
+	}
+
+	if mmOutboxStats.defaultExpectation == nil {
+		mmOutboxStats.defaultExpectation = &OutboxStorageMockOutboxStatsExpectation{}
+	}
+
+	if mmOutboxStats.defaultExpectation.params != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Expect")
+	}
+
+	if mmOutboxStats.defaultExpectation.paramPtrs == nil {
+		mmOutboxStats.defaultExpectation.paramPtrs = &OutboxStorageMockOutboxStatsParamPtrs{}
+	}
+	mmOutboxStats.defaultExpectation.paramPtrs.maxAttempts = &maxAttempts
+	mmOutboxStats.defaultExpectation.expectationOrigins.originMaxAttempts = minimock.CallerInfo(1)
+
+	return mmOutboxStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxStorage.OutboxStats
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Inspect(f func(ctx context.Context, maxAttempts int)) *mOutboxStorageMockOutboxStats {
+	if mmOutboxStats.mock.inspectFuncOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("Inspect function is already set for OutboxStorageMock.OutboxStats")
+	}
+
+	mmOutboxStats.mock.inspectFuncOutboxStats = f
+
+	return mmOutboxStats
+}
+
+// Return sets up results that will be returned by OutboxStorage.OutboxStats
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Return(o1 models.OutboxStats, err error) *OutboxStorageMock {
+	if mmOutboxStats.mock.funcOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Set")
+	}
+
+	if mmOutboxStats.defaultExpectation == nil {
+		mmOutboxStats.defaultExpectation = &OutboxStorageMockOutboxStatsExpectation{mock: mmOutboxStats.mock}
+	}
+	mmOutboxStats.defaultExpectation.results = &OutboxStorageMockOutboxStatsResults{o1, err}
+	mmOutboxStats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOutboxStats.mock
+}
+
+// Set uses given function f to mock the OutboxStorage.OutboxStats method
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Set(f func(ctx context.Context, maxAttempts int) (o1 models.OutboxStats, err error)) *OutboxStorageMock {
+	if mmOutboxStats.defaultExpectation != nil {
+		mmOutboxStats.mock.t.Fatalf("Default expectation is already set for the OutboxStorage.OutboxStats method")
+	}
+
+	if len(mmOutboxStats.expectations) > 0 {
+		mmOutboxStats.mock.t.Fatalf("Some expectations are already set for the OutboxStorage.OutboxStats method")
+	}
+
+	mmOutboxStats.mock.funcOutboxStats = f
+	mmOutboxStats.mock.funcOutboxStatsOrigin = minimock.CallerInfo(1)
+	return mmOutboxStats.mock
+}
+
+// When sets expectation for the OutboxStorage.OutboxStats which will trigger the result defined by the following
+// Then helper
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) When(ctx context.Context, maxAttempts int) *OutboxStorageMockOutboxStatsExpectation {
+	if mmOutboxStats.mock.funcOutboxStats != nil {
+		mmOutboxStats.mock.t.Fatalf("OutboxStorageMock.OutboxStats mock is already set by Set")
+	}
+
+	expectation := &OutboxStorageMockOutboxStatsExpectation{
+		mock:               mmOutboxStats.mock,
+		params:             &OutboxStorageMockOutboxStatsParams{ctx, maxAttempts},
+		expectationOrigins: OutboxStorageMockOutboxStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOutboxStats.expectations = append(mmOutboxStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxStorage.OutboxStats return parameters for the expectation previously defined by the When method
+func (e *OutboxStorageMockOutboxStatsExpectation) Then(o1 models.OutboxStats, err error) *OutboxStorageMock {
+	e.results = &OutboxStorageMockOutboxStatsResults{o1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxStorage.OutboxStats should be invoked
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Times(n uint64) *mOutboxStorageMockOutboxStats {
+	if n == 0 {
+		mmOutboxStats.mock.t.Fatalf("Times of OutboxStorageMock.OutboxStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOutboxStats.expectedInvocations, n)
+	mmOutboxStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOutboxStats
+}
+
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) invocationsDone() bool {
+	if len(mmOutboxStats.expectations) == 0 && mmOutboxStats.defaultExpectation == nil && mmOutboxStats.mock.funcOutboxStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOutboxStats.mock.afterOutboxStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOutboxStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OutboxStats implements mm_storage.OutboxStorage
+func (mmOutboxStats *OutboxStorageMock) OutboxStats(ctx context.Context, maxAttempts int) (o1 models.OutboxStats, err error) {
+	mm_atomic.AddUint64(&mmOutboxStats.beforeOutboxStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmOutboxStats.afterOutboxStatsCounter, 1)
+
+	mmOutboxStats.t.Helper()
+
+	if mmOutboxStats.inspectFuncOutboxStats != nil {
+		mmOutboxStats.inspectFuncOutboxStats(ctx, maxAttempts)
+	}
+
+	mm_params := OutboxStorageMockOutboxStatsParams{ctx, maxAttempts}
+
+	// Record call args
+	mmOutboxStats.OutboxStatsMock.mutex.Lock()
+	mmOutboxStats.OutboxStatsMock.callArgs = append(mmOutboxStats.OutboxStatsMock.callArgs, &mm_params)
+	mmOutboxStats.OutboxStatsMock.mutex.Unlock()
+
+	for _, e := range mmOutboxStats.OutboxStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmOutboxStats.OutboxStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOutboxStats.OutboxStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmOutboxStats.OutboxStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmOutboxStats.OutboxStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxStorageMockOutboxStatsParams{ctx, maxAttempts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOutboxStats.t.Errorf("OutboxStorageMock.OutboxStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOutboxStats.OutboxStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxAttempts != nil && !minimock.Equal(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts) {
+				mmOutboxStats.t.Errorf("OutboxStorageMock.OutboxStats got unexpected parameter maxAttempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOutboxStats.OutboxStatsMock.defaultExpectation.expectationOrigins.originMaxAttempts, *mm_want_ptrs.maxAttempts, mm_got.maxAttempts, minimock.Diff(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOutboxStats.t.Errorf("OutboxStorageMock.OutboxStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOutboxStats.OutboxStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOutboxStats.OutboxStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOutboxStats.t.Fatal("No results are set for the OutboxStorageMock.OutboxStats")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmOutboxStats.funcOutboxStats != nil {
+		return mmOutboxStats.funcOutboxStats(ctx, maxAttempts)
+	}
+	mmOutboxStats.t.Fatalf("Unexpected call to OutboxStorageMock.OutboxStats. %v %v", ctx, maxAttempts)
+	return
+}
+
+// OutboxStatsAfterCounter returns a count of finished OutboxStorageMock.OutboxStats invocations
+func (mmOutboxStats *OutboxStorageMock) OutboxStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOutboxStats.afterOutboxStatsCounter)
+}
+
+// OutboxStatsBeforeCounter returns a count of OutboxStorageMock.OutboxStats invocations
+func (mmOutboxStats *OutboxStorageMock) OutboxStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOutboxStats.beforeOutboxStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxStorageMock.OutboxStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOutboxStats *mOutboxStorageMockOutboxStats) Calls() []*OutboxStorageMockOutboxStatsParams {
+	mmOutboxStats.mutex.RLock()
+
+	argCopy := make([]*OutboxStorageMockOutboxStatsParams, len(mmOutboxStats.callArgs))
+	copy(argCopy, mmOutboxStats.callArgs)
+
+	mmOutboxStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOutboxStatsDone returns true if the count of the OutboxStats invocations corresponds
+// the number of defined expectations
+func (m *OutboxStorageMock) MinimockOutboxStatsDone() bool {
+	if m.OutboxStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OutboxStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OutboxStatsMock.invocationsDone()
+}
+
+// MinimockOutboxStatsInspect logs each unmet expectation
+func (m *OutboxStorageMock) MinimockOutboxStatsInspect() {
+	for _, e := range m.OutboxStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxStorageMock.OutboxStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOutboxStatsCounter := mm_atomic.LoadUint64(&m.afterOutboxStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OutboxStatsMock.defaultExpectation != nil && afterOutboxStatsCounter < 1 {
+		if m.OutboxStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxStorageMock.OutboxStats at\n%s", m.OutboxStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxStorageMock.OutboxStats at\n%s with params: %#v", m.OutboxStatsMock.defaultExpectation.expectationOrigins.origin, *m.OutboxStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOutboxStats != nil && afterOutboxStatsCounter < 1 {
+		m.t.Errorf("Expected call to OutboxStorageMock.OutboxStats at\n%s", m.funcOutboxStatsOrigin)
+	}
+
+	if !m.OutboxStatsMock.invocationsDone() && afterOutboxStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxStorageMock.OutboxStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OutboxStatsMock.expectedInvocations), m.OutboxStatsMock.expectedInvocationsOrigin, afterOutboxStatsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimOutboxBatchInspect()
+
+			m.MinimockMarkOutboxCompletedInspect()
+
+			m.MinimockMarkOutboxFailedInspect()
+
+			m.MinimockOutboxStatsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimOutboxBatchDone() &&
+		m.MinimockMarkOutboxCompletedDone() &&
+		m.MinimockMarkOutboxFailedDone() &&
+		m.MinimockOutboxStatsDone()
+}
@@ -0,0 +1,836 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.ProcessedEventStorage -o processed_event_storage_mock.go -n ProcessedEventStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ProcessedEventStorageMock implements mm_storage.ProcessedEventStorage
+type ProcessedEventStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcMarkEventProcessedTx          func(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) (b1 bool, err error)
+	funcMarkEventProcessedTxOrigin    string
+	inspectFuncMarkEventProcessedTx   func(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string)
+	afterMarkEventProcessedTxCounter  uint64
+	beforeMarkEventProcessedTxCounter uint64
+	MarkEventProcessedTxMock          mProcessedEventStorageMockMarkEventProcessedTx
+
+	funcWithTransaction          func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error)
+	funcWithTransactionOrigin    string
+	inspectFuncWithTransaction   func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error)
+	afterWithTransactionCounter  uint64
+	beforeWithTransactionCounter uint64
+	WithTransactionMock          mProcessedEventStorageMockWithTransaction
+}
+
+// NewProcessedEventStorageMock returns a mock for mm_storage.ProcessedEventStorage
+func NewProcessedEventStorageMock(t minimock.Tester) *ProcessedEventStorageMock {
+	m := &ProcessedEventStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.MarkEventProcessedTxMock = mProcessedEventStorageMockMarkEventProcessedTx{mock: m}
+	m.MarkEventProcessedTxMock.callArgs = []*ProcessedEventStorageMockMarkEventProcessedTxParams{}
+
+	m.WithTransactionMock = mProcessedEventStorageMockWithTransaction{mock: m}
+	m.WithTransactionMock.callArgs = []*ProcessedEventStorageMockWithTransactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mProcessedEventStorageMockMarkEventProcessedTx struct {
+	optional           bool
+	mock               *ProcessedEventStorageMock
+	defaultExpectation *ProcessedEventStorageMockMarkEventProcessedTxExpectation
+	expectations       []*ProcessedEventStorageMockMarkEventProcessedTxExpectation
+
+	callArgs []*ProcessedEventStorageMockMarkEventProcessedTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProcessedEventStorageMockMarkEventProcessedTxExpectation specifies expectation struct of the ProcessedEventStorage.MarkEventProcessedTx
+type ProcessedEventStorageMockMarkEventProcessedTxExpectation struct {
+	mock               *ProcessedEventStorageMock
+	params             *ProcessedEventStorageMockMarkEventProcessedTxParams
+	paramPtrs          *ProcessedEventStorageMockMarkEventProcessedTxParamPtrs
+	expectationOrigins ProcessedEventStorageMockMarkEventProcessedTxExpectationOrigins
+	results            *ProcessedEventStorageMockMarkEventProcessedTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProcessedEventStorageMockMarkEventProcessedTxParams contains parameters of the ProcessedEventStorage.MarkEventProcessedTx
+type ProcessedEventStorageMockMarkEventProcessedTxParams struct {
+	ctx       context.Context
+	tx        pgx.Tx
+	eventID   uuid.UUID
+	eventType string
+}
+
+// ProcessedEventStorageMockMarkEventProcessedTxParamPtrs contains pointers to parameters of the ProcessedEventStorage.MarkEventProcessedTx
+type ProcessedEventStorageMockMarkEventProcessedTxParamPtrs struct {
+	ctx       *context.Context
+	tx        *pgx.Tx
+	eventID   *uuid.UUID
+	eventType *string
+}
+
+// ProcessedEventStorageMockMarkEventProcessedTxResults contains results of the ProcessedEventStorage.MarkEventProcessedTx
+type ProcessedEventStorageMockMarkEventProcessedTxResults struct {
+	b1  bool
+	err error
+}
+
+// ProcessedEventStorageMockMarkEventProcessedTxOrigins contains origins of expectations of the ProcessedEventStorage.MarkEventProcessedTx
+type ProcessedEventStorageMockMarkEventProcessedTxExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTx        string
+	originEventID   string
+	originEventType string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Optional() *mProcessedEventStorageMockMarkEventProcessedTx {
+	mmMarkEventProcessedTx.optional = true
+	return mmMarkEventProcessedTx
+}
+
+// Expect sets up expected params for ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Expect(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{}
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.paramPtrs != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEventProcessedTx.defaultExpectation.params = &ProcessedEventStorageMockMarkEventProcessedTxParams{ctx, tx, eventID, eventType}
+	mmMarkEventProcessedTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkEventProcessedTx.expectations {
+		if minimock.Equal(e.params, mmMarkEventProcessedTx.defaultExpectation.params) {
+			mmMarkEventProcessedTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEventProcessedTx.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEventProcessedTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) ExpectCtxParam1(ctx context.Context) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{}
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.params != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Expect")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.paramPtrs == nil {
+		mmMarkEventProcessedTx.defaultExpectation.paramPtrs = &ProcessedEventStorageMockMarkEventProcessedTxParamPtrs{}
+	}
+	mmMarkEventProcessedTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkEventProcessedTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkEventProcessedTx
+}
+
+// ExpectTxParam2 sets up expected param tx for ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) ExpectTxParam2(tx pgx.Tx) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{}
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.params != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Expect")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.paramPtrs == nil {
+		mmMarkEventProcessedTx.defaultExpectation.paramPtrs = &ProcessedEventStorageMockMarkEventProcessedTxParamPtrs{}
+	}
+	mmMarkEventProcessedTx.defaultExpectation.paramPtrs.tx = &tx
+	mmMarkEventProcessedTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmMarkEventProcessedTx
+}
+
+// ExpectEventIDParam3 sets up expected param eventID for ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) ExpectEventIDParam3(eventID uuid.UUID) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{}
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.params != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Expect")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.paramPtrs == nil {
+		mmMarkEventProcessedTx.defaultExpectation.paramPtrs = &ProcessedEventStorageMockMarkEventProcessedTxParamPtrs{}
+	}
+	mmMarkEventProcessedTx.defaultExpectation.paramPtrs.eventID = &eventID
+	mmMarkEventProcessedTx.defaultExpectation.expectationOrigins.originEventID = minimock.CallerInfo(1)
+
+	return mmMarkEventProcessedTx
+}
+
+// ExpectEventTypeParam4 sets up expected param eventType for ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) ExpectEventTypeParam4(eventType string) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{}
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.params != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Expect")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation.paramPtrs == nil {
+		mmMarkEventProcessedTx.defaultExpectation.paramPtrs = &ProcessedEventStorageMockMarkEventProcessedTxParamPtrs{}
+	}
+	mmMarkEventProcessedTx.defaultExpectation.paramPtrs.eventType = &eventType
+	mmMarkEventProcessedTx.defaultExpectation.expectationOrigins.originEventType = minimock.CallerInfo(1)
+
+	return mmMarkEventProcessedTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Inspect(f func(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string)) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if mmMarkEventProcessedTx.mock.inspectFuncMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("Inspect function is already set for ProcessedEventStorageMock.MarkEventProcessedTx")
+	}
+
+	mmMarkEventProcessedTx.mock.inspectFuncMarkEventProcessedTx = f
+
+	return mmMarkEventProcessedTx
+}
+
+// Return sets up results that will be returned by ProcessedEventStorage.MarkEventProcessedTx
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Return(b1 bool, err error) *ProcessedEventStorageMock {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	if mmMarkEventProcessedTx.defaultExpectation == nil {
+		mmMarkEventProcessedTx.defaultExpectation = &ProcessedEventStorageMockMarkEventProcessedTxExpectation{mock: mmMarkEventProcessedTx.mock}
+	}
+	mmMarkEventProcessedTx.defaultExpectation.results = &ProcessedEventStorageMockMarkEventProcessedTxResults{b1, err}
+	mmMarkEventProcessedTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkEventProcessedTx.mock
+}
+
+// Set uses given function f to mock the ProcessedEventStorage.MarkEventProcessedTx method
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Set(f func(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) (b1 bool, err error)) *ProcessedEventStorageMock {
+	if mmMarkEventProcessedTx.defaultExpectation != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("Default expectation is already set for the ProcessedEventStorage.MarkEventProcessedTx method")
+	}
+
+	if len(mmMarkEventProcessedTx.expectations) > 0 {
+		mmMarkEventProcessedTx.mock.t.Fatalf("Some expectations are already set for the ProcessedEventStorage.MarkEventProcessedTx method")
+	}
+
+	mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx = f
+	mmMarkEventProcessedTx.mock.funcMarkEventProcessedTxOrigin = minimock.CallerInfo(1)
+	return mmMarkEventProcessedTx.mock
+}
+
+// When sets expectation for the ProcessedEventStorage.MarkEventProcessedTx which will trigger the result defined by the following
+// Then helper
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) When(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) *ProcessedEventStorageMockMarkEventProcessedTxExpectation {
+	if mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.mock.t.Fatalf("ProcessedEventStorageMock.MarkEventProcessedTx mock is already set by Set")
+	}
+
+	expectation := &ProcessedEventStorageMockMarkEventProcessedTxExpectation{
+		mock:               mmMarkEventProcessedTx.mock,
+		params:             &ProcessedEventStorageMockMarkEventProcessedTxParams{ctx, tx, eventID, eventType},
+		expectationOrigins: ProcessedEventStorageMockMarkEventProcessedTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkEventProcessedTx.expectations = append(mmMarkEventProcessedTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProcessedEventStorage.MarkEventProcessedTx return parameters for the expectation previously defined by the When method
+func (e *ProcessedEventStorageMockMarkEventProcessedTxExpectation) Then(b1 bool, err error) *ProcessedEventStorageMock {
+	e.results = &ProcessedEventStorageMockMarkEventProcessedTxResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ProcessedEventStorage.MarkEventProcessedTx should be invoked
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Times(n uint64) *mProcessedEventStorageMockMarkEventProcessedTx {
+	if n == 0 {
+		mmMarkEventProcessedTx.mock.t.Fatalf("Times of ProcessedEventStorageMock.MarkEventProcessedTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEventProcessedTx.expectedInvocations, n)
+	mmMarkEventProcessedTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkEventProcessedTx
+}
+
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) invocationsDone() bool {
+	if len(mmMarkEventProcessedTx.expectations) == 0 && mmMarkEventProcessedTx.defaultExpectation == nil && mmMarkEventProcessedTx.mock.funcMarkEventProcessedTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEventProcessedTx.mock.afterMarkEventProcessedTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEventProcessedTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEventProcessedTx implements mm_storage.ProcessedEventStorage
+func (mmMarkEventProcessedTx *ProcessedEventStorageMock) MarkEventProcessedTx(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMarkEventProcessedTx.beforeMarkEventProcessedTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEventProcessedTx.afterMarkEventProcessedTxCounter, 1)
+
+	mmMarkEventProcessedTx.t.Helper()
+
+	if mmMarkEventProcessedTx.inspectFuncMarkEventProcessedTx != nil {
+		mmMarkEventProcessedTx.inspectFuncMarkEventProcessedTx(ctx, tx, eventID, eventType)
+	}
+
+	mm_params := ProcessedEventStorageMockMarkEventProcessedTxParams{ctx, tx, eventID, eventType}
+
+	// Record call args
+	mmMarkEventProcessedTx.MarkEventProcessedTxMock.mutex.Lock()
+	mmMarkEventProcessedTx.MarkEventProcessedTxMock.callArgs = append(mmMarkEventProcessedTx.MarkEventProcessedTxMock.callArgs, &mm_params)
+	mmMarkEventProcessedTx.MarkEventProcessedTxMock.mutex.Unlock()
+
+	for _, e := range mmMarkEventProcessedTx.MarkEventProcessedTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.paramPtrs
+
+		mm_got := ProcessedEventStorageMockMarkEventProcessedTxParams{ctx, tx, eventID, eventType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEventProcessedTx.t.Errorf("ProcessedEventStorageMock.MarkEventProcessedTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmMarkEventProcessedTx.t.Errorf("ProcessedEventStorageMock.MarkEventProcessedTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.eventID != nil && !minimock.Equal(*mm_want_ptrs.eventID, mm_got.eventID) {
+				mmMarkEventProcessedTx.t.Errorf("ProcessedEventStorageMock.MarkEventProcessedTx got unexpected parameter eventID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.originEventID, *mm_want_ptrs.eventID, mm_got.eventID, minimock.Diff(*mm_want_ptrs.eventID, mm_got.eventID))
+			}
+
+			if mm_want_ptrs.eventType != nil && !minimock.Equal(*mm_want_ptrs.eventType, mm_got.eventType) {
+				mmMarkEventProcessedTx.t.Errorf("ProcessedEventStorageMock.MarkEventProcessedTx got unexpected parameter eventType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.originEventType, *mm_want_ptrs.eventType, mm_got.eventType, minimock.Diff(*mm_want_ptrs.eventType, mm_got.eventType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEventProcessedTx.t.Errorf("ProcessedEventStorageMock.MarkEventProcessedTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEventProcessedTx.MarkEventProcessedTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEventProcessedTx.t.Fatal("No results are set for the ProcessedEventStorageMock.MarkEventProcessedTx")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMarkEventProcessedTx.funcMarkEventProcessedTx != nil {
+		return mmMarkEventProcessedTx.funcMarkEventProcessedTx(ctx, tx, eventID, eventType)
+	}
+	mmMarkEventProcessedTx.t.Fatalf("Unexpected call to ProcessedEventStorageMock.MarkEventProcessedTx. %v %v %v %v", ctx, tx, eventID, eventType)
+	return
+}
+
+// MarkEventProcessedTxAfterCounter returns a count of finished ProcessedEventStorageMock.MarkEventProcessedTx invocations
+func (mmMarkEventProcessedTx *ProcessedEventStorageMock) MarkEventProcessedTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventProcessedTx.afterMarkEventProcessedTxCounter)
+}
+
+// MarkEventProcessedTxBeforeCounter returns a count of ProcessedEventStorageMock.MarkEventProcessedTx invocations
+func (mmMarkEventProcessedTx *ProcessedEventStorageMock) MarkEventProcessedTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventProcessedTx.beforeMarkEventProcessedTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProcessedEventStorageMock.MarkEventProcessedTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEventProcessedTx *mProcessedEventStorageMockMarkEventProcessedTx) Calls() []*ProcessedEventStorageMockMarkEventProcessedTxParams {
+	mmMarkEventProcessedTx.mutex.RLock()
+
+	argCopy := make([]*ProcessedEventStorageMockMarkEventProcessedTxParams, len(mmMarkEventProcessedTx.callArgs))
+	copy(argCopy, mmMarkEventProcessedTx.callArgs)
+
+	mmMarkEventProcessedTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEventProcessedTxDone returns true if the count of the MarkEventProcessedTx invocations corresponds
+// the number of defined expectations
+func (m *ProcessedEventStorageMock) MinimockMarkEventProcessedTxDone() bool {
+	if m.MarkEventProcessedTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkEventProcessedTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkEventProcessedTxMock.invocationsDone()
+}
+
+// MinimockMarkEventProcessedTxInspect logs each unmet expectation
+func (m *ProcessedEventStorageMock) MinimockMarkEventProcessedTxInspect() {
+	for _, e := range m.MarkEventProcessedTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.MarkEventProcessedTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkEventProcessedTxCounter := mm_atomic.LoadUint64(&m.afterMarkEventProcessedTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkEventProcessedTxMock.defaultExpectation != nil && afterMarkEventProcessedTxCounter < 1 {
+		if m.MarkEventProcessedTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.MarkEventProcessedTx at\n%s", m.MarkEventProcessedTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.MarkEventProcessedTx at\n%s with params: %#v", m.MarkEventProcessedTxMock.defaultExpectation.expectationOrigins.origin, *m.MarkEventProcessedTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkEventProcessedTx != nil && afterMarkEventProcessedTxCounter < 1 {
+		m.t.Errorf("Expected call to ProcessedEventStorageMock.MarkEventProcessedTx at\n%s", m.funcMarkEventProcessedTxOrigin)
+	}
+
+	if !m.MarkEventProcessedTxMock.invocationsDone() && afterMarkEventProcessedTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProcessedEventStorageMock.MarkEventProcessedTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEventProcessedTxMock.expectedInvocations), m.MarkEventProcessedTxMock.expectedInvocationsOrigin, afterMarkEventProcessedTxCounter)
+	}
+}
+
+type mProcessedEventStorageMockWithTransaction struct {
+	optional           bool
+	mock               *ProcessedEventStorageMock
+	defaultExpectation *ProcessedEventStorageMockWithTransactionExpectation
+	expectations       []*ProcessedEventStorageMockWithTransactionExpectation
+
+	callArgs []*ProcessedEventStorageMockWithTransactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProcessedEventStorageMockWithTransactionExpectation specifies expectation struct of the ProcessedEventStorage.WithTransaction
+type ProcessedEventStorageMockWithTransactionExpectation struct {
+	mock               *ProcessedEventStorageMock
+	params             *ProcessedEventStorageMockWithTransactionParams
+	paramPtrs          *ProcessedEventStorageMockWithTransactionParamPtrs
+	expectationOrigins ProcessedEventStorageMockWithTransactionExpectationOrigins
+	results            *ProcessedEventStorageMockWithTransactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProcessedEventStorageMockWithTransactionParams contains parameters of the ProcessedEventStorage.WithTransaction
+type ProcessedEventStorageMockWithTransactionParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context, tx pgx.Tx) error
+}
+
+// ProcessedEventStorageMockWithTransactionParamPtrs contains pointers to parameters of the ProcessedEventStorage.WithTransaction
+type ProcessedEventStorageMockWithTransactionParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context, tx pgx.Tx) error
+}
+
+// ProcessedEventStorageMockWithTransactionResults contains results of the ProcessedEventStorage.WithTransaction
+type ProcessedEventStorageMockWithTransactionResults struct {
+	err error
+}
+
+// ProcessedEventStorageMockWithTransactionOrigins contains origins of expectations of the ProcessedEventStorage.WithTransaction
+type ProcessedEventStorageMockWithTransactionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Optional() *mProcessedEventStorageMockWithTransaction {
+	mmWithTransaction.optional = true
+	return mmWithTransaction
+}
+
+// Expect sets up expected params for ProcessedEventStorage.WithTransaction
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Expect(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) *mProcessedEventStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &ProcessedEventStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by ExpectParams functions")
+	}
+
+	mmWithTransaction.defaultExpectation.params = &ProcessedEventStorageMockWithTransactionParams{ctx, fn}
+	mmWithTransaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWithTransaction.expectations {
+		if minimock.Equal(e.params, mmWithTransaction.defaultExpectation.params) {
+			mmWithTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWithTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmWithTransaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProcessedEventStorage.WithTransaction
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) ExpectCtxParam1(ctx context.Context) *mProcessedEventStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &ProcessedEventStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.params != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Expect")
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithTransaction.defaultExpectation.paramPtrs = &ProcessedEventStorageMockWithTransactionParamPtrs{}
+	}
+	mmWithTransaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWithTransaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWithTransaction
+}
+
+// ExpectFnParam2 sets up expected param fn for ProcessedEventStorage.WithTransaction
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) ExpectFnParam2(fn func(ctx context.Context, tx pgx.Tx) error) *mProcessedEventStorageMockWithTransaction {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &ProcessedEventStorageMockWithTransactionExpectation{}
+	}
+
+	if mmWithTransaction.defaultExpectation.params != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Expect")
+	}
+
+	if mmWithTransaction.defaultExpectation.paramPtrs == nil {
+		mmWithTransaction.defaultExpectation.paramPtrs = &ProcessedEventStorageMockWithTransactionParamPtrs{}
+	}
+	mmWithTransaction.defaultExpectation.paramPtrs.fn = &fn
+	mmWithTransaction.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWithTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProcessedEventStorage.WithTransaction
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Inspect(f func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error)) *mProcessedEventStorageMockWithTransaction {
+	if mmWithTransaction.mock.inspectFuncWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("Inspect function is already set for ProcessedEventStorageMock.WithTransaction")
+	}
+
+	mmWithTransaction.mock.inspectFuncWithTransaction = f
+
+	return mmWithTransaction
+}
+
+// Return sets up results that will be returned by ProcessedEventStorage.WithTransaction
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Return(err error) *ProcessedEventStorageMock {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	if mmWithTransaction.defaultExpectation == nil {
+		mmWithTransaction.defaultExpectation = &ProcessedEventStorageMockWithTransactionExpectation{mock: mmWithTransaction.mock}
+	}
+	mmWithTransaction.defaultExpectation.results = &ProcessedEventStorageMockWithTransactionResults{err}
+	mmWithTransaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction.mock
+}
+
+// Set uses given function f to mock the ProcessedEventStorage.WithTransaction method
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Set(f func(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error)) *ProcessedEventStorageMock {
+	if mmWithTransaction.defaultExpectation != nil {
+		mmWithTransaction.mock.t.Fatalf("Default expectation is already set for the ProcessedEventStorage.WithTransaction method")
+	}
+
+	if len(mmWithTransaction.expectations) > 0 {
+		mmWithTransaction.mock.t.Fatalf("Some expectations are already set for the ProcessedEventStorage.WithTransaction method")
+	}
+
+	mmWithTransaction.mock.funcWithTransaction = f
+	mmWithTransaction.mock.funcWithTransactionOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction.mock
+}
+
+// When sets expectation for the ProcessedEventStorage.WithTransaction which will trigger the result defined by the following
+// Then helper
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) When(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) *ProcessedEventStorageMockWithTransactionExpectation {
+	if mmWithTransaction.mock.funcWithTransaction != nil {
+		mmWithTransaction.mock.t.Fatalf("ProcessedEventStorageMock.WithTransaction mock is already set by Set")
+	}
+
+	expectation := &ProcessedEventStorageMockWithTransactionExpectation{
+		mock:               mmWithTransaction.mock,
+		params:             &ProcessedEventStorageMockWithTransactionParams{ctx, fn},
+		expectationOrigins: ProcessedEventStorageMockWithTransactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWithTransaction.expectations = append(mmWithTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProcessedEventStorage.WithTransaction return parameters for the expectation previously defined by the When method
+func (e *ProcessedEventStorageMockWithTransactionExpectation) Then(err error) *ProcessedEventStorageMock {
+	e.results = &ProcessedEventStorageMockWithTransactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProcessedEventStorage.WithTransaction should be invoked
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Times(n uint64) *mProcessedEventStorageMockWithTransaction {
+	if n == 0 {
+		mmWithTransaction.mock.t.Fatalf("Times of ProcessedEventStorageMock.WithTransaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWithTransaction.expectedInvocations, n)
+	mmWithTransaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWithTransaction
+}
+
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) invocationsDone() bool {
+	if len(mmWithTransaction.expectations) == 0 && mmWithTransaction.defaultExpectation == nil && mmWithTransaction.mock.funcWithTransaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWithTransaction.mock.afterWithTransactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWithTransaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WithTransaction implements mm_storage.ProcessedEventStorage
+func (mmWithTransaction *ProcessedEventStorageMock) WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) (err error) {
+	mm_atomic.AddUint64(&mmWithTransaction.beforeWithTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmWithTransaction.afterWithTransactionCounter, 1)
+
+	mmWithTransaction.t.Helper()
+
+	if mmWithTransaction.inspectFuncWithTransaction != nil {
+		mmWithTransaction.inspectFuncWithTransaction(ctx, fn)
+	}
+
+	mm_params := ProcessedEventStorageMockWithTransactionParams{ctx, fn}
+
+	// Record call args
+	mmWithTransaction.WithTransactionMock.mutex.Lock()
+	mmWithTransaction.WithTransactionMock.callArgs = append(mmWithTransaction.WithTransactionMock.callArgs, &mm_params)
+	mmWithTransaction.WithTransactionMock.mutex.Unlock()
+
+	for _, e := range mmWithTransaction.WithTransactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWithTransaction.WithTransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWithTransaction.WithTransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmWithTransaction.WithTransactionMock.defaultExpectation.params
+		mm_want_ptrs := mmWithTransaction.WithTransactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ProcessedEventStorageMockWithTransactionParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWithTransaction.t.Errorf("ProcessedEventStorageMock.WithTransaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWithTransaction.t.Errorf("ProcessedEventStorageMock.WithTransaction got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWithTransaction.t.Errorf("ProcessedEventStorageMock.WithTransaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWithTransaction.WithTransactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWithTransaction.WithTransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWithTransaction.t.Fatal("No results are set for the ProcessedEventStorageMock.WithTransaction")
+		}
+		return (*mm_results).err
+	}
+	if mmWithTransaction.funcWithTransaction != nil {
+		return mmWithTransaction.funcWithTransaction(ctx, fn)
+	}
+	mmWithTransaction.t.Fatalf("Unexpected call to ProcessedEventStorageMock.WithTransaction. %v %v", ctx, fn)
+	return
+}
+
+// WithTransactionAfterCounter returns a count of finished ProcessedEventStorageMock.WithTransaction invocations
+func (mmWithTransaction *ProcessedEventStorageMock) WithTransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithTransaction.afterWithTransactionCounter)
+}
+
+// WithTransactionBeforeCounter returns a count of ProcessedEventStorageMock.WithTransaction invocations
+func (mmWithTransaction *ProcessedEventStorageMock) WithTransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithTransaction.beforeWithTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProcessedEventStorageMock.WithTransaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWithTransaction *mProcessedEventStorageMockWithTransaction) Calls() []*ProcessedEventStorageMockWithTransactionParams {
+	mmWithTransaction.mutex.RLock()
+
+	argCopy := make([]*ProcessedEventStorageMockWithTransactionParams, len(mmWithTransaction.callArgs))
+	copy(argCopy, mmWithTransaction.callArgs)
+
+	mmWithTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWithTransactionDone returns true if the count of the WithTransaction invocations corresponds
+// the number of defined expectations
+func (m *ProcessedEventStorageMock) MinimockWithTransactionDone() bool {
+	if m.WithTransactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WithTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WithTransactionMock.invocationsDone()
+}
+
+// MinimockWithTransactionInspect logs each unmet expectation
+func (m *ProcessedEventStorageMock) MinimockWithTransactionInspect() {
+	for _, e := range m.WithTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.WithTransaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWithTransactionCounter := mm_atomic.LoadUint64(&m.afterWithTransactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WithTransactionMock.defaultExpectation != nil && afterWithTransactionCounter < 1 {
+		if m.WithTransactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.WithTransaction at\n%s", m.WithTransactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProcessedEventStorageMock.WithTransaction at\n%s with params: %#v", m.WithTransactionMock.defaultExpectation.expectationOrigins.origin, *m.WithTransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWithTransaction != nil && afterWithTransactionCounter < 1 {
+		m.t.Errorf("Expected call to ProcessedEventStorageMock.WithTransaction at\n%s", m.funcWithTransactionOrigin)
+	}
+
+	if !m.WithTransactionMock.invocationsDone() && afterWithTransactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProcessedEventStorageMock.WithTransaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WithTransactionMock.expectedInvocations), m.WithTransactionMock.expectedInvocationsOrigin, afterWithTransactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ProcessedEventStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockMarkEventProcessedTxInspect()
+
+			m.MinimockWithTransactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ProcessedEventStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ProcessedEventStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockMarkEventProcessedTxDone() &&
+		m.MinimockWithTransactionDone()
+}
@@ -0,0 +1,774 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.RetentionStorage -o retention_storage_mock.go -n RetentionStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RetentionStorageMock implements mm_storage.RetentionStorage
+type RetentionStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPurgeDeletedOrders          func(ctx context.Context, before time.Time, limit int) (i1 int64, err error)
+	funcPurgeDeletedOrdersOrigin    string
+	inspectFuncPurgeDeletedOrders   func(ctx context.Context, before time.Time, limit int)
+	afterPurgeDeletedOrdersCounter  uint64
+	beforePurgeDeletedOrdersCounter uint64
+	PurgeDeletedOrdersMock          mRetentionStorageMockPurgeDeletedOrders
+
+	funcPurgeExpiredIdempotencyKeys          func(ctx context.Context) (i1 int64, err error)
+	funcPurgeExpiredIdempotencyKeysOrigin    string
+	inspectFuncPurgeExpiredIdempotencyKeys   func(ctx context.Context)
+	afterPurgeExpiredIdempotencyKeysCounter  uint64
+	beforePurgeExpiredIdempotencyKeysCounter uint64
+	PurgeExpiredIdempotencyKeysMock          mRetentionStorageMockPurgeExpiredIdempotencyKeys
+}
+
+// NewRetentionStorageMock returns a mock for mm_storage.RetentionStorage
+func NewRetentionStorageMock(t minimock.Tester) *RetentionStorageMock {
+	m := &RetentionStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PurgeDeletedOrdersMock = mRetentionStorageMockPurgeDeletedOrders{mock: m}
+	m.PurgeDeletedOrdersMock.callArgs = []*RetentionStorageMockPurgeDeletedOrdersParams{}
+
+	m.PurgeExpiredIdempotencyKeysMock = mRetentionStorageMockPurgeExpiredIdempotencyKeys{mock: m}
+	m.PurgeExpiredIdempotencyKeysMock.callArgs = []*RetentionStorageMockPurgeExpiredIdempotencyKeysParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRetentionStorageMockPurgeDeletedOrders struct {
+	optional           bool
+	mock               *RetentionStorageMock
+	defaultExpectation *RetentionStorageMockPurgeDeletedOrdersExpectation
+	expectations       []*RetentionStorageMockPurgeDeletedOrdersExpectation
+
+	callArgs []*RetentionStorageMockPurgeDeletedOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RetentionStorageMockPurgeDeletedOrdersExpectation specifies expectation struct of the RetentionStorage.PurgeDeletedOrders
+type RetentionStorageMockPurgeDeletedOrdersExpectation struct {
+	mock               *RetentionStorageMock
+	params             *RetentionStorageMockPurgeDeletedOrdersParams
+	paramPtrs          *RetentionStorageMockPurgeDeletedOrdersParamPtrs
+	expectationOrigins RetentionStorageMockPurgeDeletedOrdersExpectationOrigins
+	results            *RetentionStorageMockPurgeDeletedOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RetentionStorageMockPurgeDeletedOrdersParams contains parameters of the RetentionStorage.PurgeDeletedOrders
+type RetentionStorageMockPurgeDeletedOrdersParams struct {
+	ctx    context.Context
+	before time.Time
+	limit  int
+}
+
+// RetentionStorageMockPurgeDeletedOrdersParamPtrs contains pointers to parameters of the RetentionStorage.PurgeDeletedOrders
+type RetentionStorageMockPurgeDeletedOrdersParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+	limit  *int
+}
+
+// RetentionStorageMockPurgeDeletedOrdersResults contains results of the RetentionStorage.PurgeDeletedOrders
+type RetentionStorageMockPurgeDeletedOrdersResults struct {
+	i1  int64
+	err error
+}
+
+// RetentionStorageMockPurgeDeletedOrdersOrigins contains origins of expectations of the RetentionStorage.PurgeDeletedOrders
+type RetentionStorageMockPurgeDeletedOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Optional() *mRetentionStorageMockPurgeDeletedOrders {
+	mmPurgeDeletedOrders.optional = true
+	return mmPurgeDeletedOrders
+}
+
+// Expect sets up expected params for RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Expect(ctx context.Context, before time.Time, limit int) *mRetentionStorageMockPurgeDeletedOrders {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation == nil {
+		mmPurgeDeletedOrders.defaultExpectation = &RetentionStorageMockPurgeDeletedOrdersExpectation{}
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedOrders.defaultExpectation.params = &RetentionStorageMockPurgeDeletedOrdersParams{ctx, before, limit}
+	mmPurgeDeletedOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedOrders.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedOrders.defaultExpectation.params) {
+			mmPurgeDeletedOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) ExpectCtxParam1(ctx context.Context) *mRetentionStorageMockPurgeDeletedOrders {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation == nil {
+		mmPurgeDeletedOrders.defaultExpectation = &RetentionStorageMockPurgeDeletedOrdersExpectation{}
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.params != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedOrders.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeDeletedOrdersParamPtrs{}
+	}
+	mmPurgeDeletedOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedOrders
+}
+
+// ExpectBeforeParam2 sets up expected param before for RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) ExpectBeforeParam2(before time.Time) *mRetentionStorageMockPurgeDeletedOrders {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation == nil {
+		mmPurgeDeletedOrders.defaultExpectation = &RetentionStorageMockPurgeDeletedOrdersExpectation{}
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.params != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedOrders.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeDeletedOrdersParamPtrs{}
+	}
+	mmPurgeDeletedOrders.defaultExpectation.paramPtrs.before = &before
+	mmPurgeDeletedOrders.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedOrders
+}
+
+// ExpectLimitParam3 sets up expected param limit for RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) ExpectLimitParam3(limit int) *mRetentionStorageMockPurgeDeletedOrders {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation == nil {
+		mmPurgeDeletedOrders.defaultExpectation = &RetentionStorageMockPurgeDeletedOrdersExpectation{}
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.params != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedOrders.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeDeletedOrdersParamPtrs{}
+	}
+	mmPurgeDeletedOrders.defaultExpectation.paramPtrs.limit = &limit
+	mmPurgeDeletedOrders.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Inspect(f func(ctx context.Context, before time.Time, limit int)) *mRetentionStorageMockPurgeDeletedOrders {
+	if mmPurgeDeletedOrders.mock.inspectFuncPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("Inspect function is already set for RetentionStorageMock.PurgeDeletedOrders")
+	}
+
+	mmPurgeDeletedOrders.mock.inspectFuncPurgeDeletedOrders = f
+
+	return mmPurgeDeletedOrders
+}
+
+// Return sets up results that will be returned by RetentionStorage.PurgeDeletedOrders
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Return(i1 int64, err error) *RetentionStorageMock {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	if mmPurgeDeletedOrders.defaultExpectation == nil {
+		mmPurgeDeletedOrders.defaultExpectation = &RetentionStorageMockPurgeDeletedOrdersExpectation{mock: mmPurgeDeletedOrders.mock}
+	}
+	mmPurgeDeletedOrders.defaultExpectation.results = &RetentionStorageMockPurgeDeletedOrdersResults{i1, err}
+	mmPurgeDeletedOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedOrders.mock
+}
+
+// Set uses given function f to mock the RetentionStorage.PurgeDeletedOrders method
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Set(f func(ctx context.Context, before time.Time, limit int) (i1 int64, err error)) *RetentionStorageMock {
+	if mmPurgeDeletedOrders.defaultExpectation != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("Default expectation is already set for the RetentionStorage.PurgeDeletedOrders method")
+	}
+
+	if len(mmPurgeDeletedOrders.expectations) > 0 {
+		mmPurgeDeletedOrders.mock.t.Fatalf("Some expectations are already set for the RetentionStorage.PurgeDeletedOrders method")
+	}
+
+	mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders = f
+	mmPurgeDeletedOrders.mock.funcPurgeDeletedOrdersOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedOrders.mock
+}
+
+// When sets expectation for the RetentionStorage.PurgeDeletedOrders which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) When(ctx context.Context, before time.Time, limit int) *RetentionStorageMockPurgeDeletedOrdersExpectation {
+	if mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.mock.t.Fatalf("RetentionStorageMock.PurgeDeletedOrders mock is already set by Set")
+	}
+
+	expectation := &RetentionStorageMockPurgeDeletedOrdersExpectation{
+		mock:               mmPurgeDeletedOrders.mock,
+		params:             &RetentionStorageMockPurgeDeletedOrdersParams{ctx, before, limit},
+		expectationOrigins: RetentionStorageMockPurgeDeletedOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedOrders.expectations = append(mmPurgeDeletedOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RetentionStorage.PurgeDeletedOrders return parameters for the expectation previously defined by the When method
+func (e *RetentionStorageMockPurgeDeletedOrdersExpectation) Then(i1 int64, err error) *RetentionStorageMock {
+	e.results = &RetentionStorageMockPurgeDeletedOrdersResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RetentionStorage.PurgeDeletedOrders should be invoked
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Times(n uint64) *mRetentionStorageMockPurgeDeletedOrders {
+	if n == 0 {
+		mmPurgeDeletedOrders.mock.t.Fatalf("Times of RetentionStorageMock.PurgeDeletedOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedOrders.expectedInvocations, n)
+	mmPurgeDeletedOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedOrders
+}
+
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) invocationsDone() bool {
+	if len(mmPurgeDeletedOrders.expectations) == 0 && mmPurgeDeletedOrders.defaultExpectation == nil && mmPurgeDeletedOrders.mock.funcPurgeDeletedOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedOrders.mock.afterPurgeDeletedOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedOrders implements mm_storage.RetentionStorage
+func (mmPurgeDeletedOrders *RetentionStorageMock) PurgeDeletedOrders(ctx context.Context, before time.Time, limit int) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedOrders.beforePurgeDeletedOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedOrders.afterPurgeDeletedOrdersCounter, 1)
+
+	mmPurgeDeletedOrders.t.Helper()
+
+	if mmPurgeDeletedOrders.inspectFuncPurgeDeletedOrders != nil {
+		mmPurgeDeletedOrders.inspectFuncPurgeDeletedOrders(ctx, before, limit)
+	}
+
+	mm_params := RetentionStorageMockPurgeDeletedOrdersParams{ctx, before, limit}
+
+	// Record call args
+	mmPurgeDeletedOrders.PurgeDeletedOrdersMock.mutex.Lock()
+	mmPurgeDeletedOrders.PurgeDeletedOrdersMock.callArgs = append(mmPurgeDeletedOrders.PurgeDeletedOrdersMock.callArgs, &mm_params)
+	mmPurgeDeletedOrders.PurgeDeletedOrdersMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedOrders.PurgeDeletedOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := RetentionStorageMockPurgeDeletedOrdersParams{ctx, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedOrders.t.Errorf("RetentionStorageMock.PurgeDeletedOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmPurgeDeletedOrders.t.Errorf("RetentionStorageMock.PurgeDeletedOrders got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmPurgeDeletedOrders.t.Errorf("RetentionStorageMock.PurgeDeletedOrders got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedOrders.t.Errorf("RetentionStorageMock.PurgeDeletedOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedOrders.PurgeDeletedOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedOrders.t.Fatal("No results are set for the RetentionStorageMock.PurgeDeletedOrders")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeletedOrders.funcPurgeDeletedOrders != nil {
+		return mmPurgeDeletedOrders.funcPurgeDeletedOrders(ctx, before, limit)
+	}
+	mmPurgeDeletedOrders.t.Fatalf("Unexpected call to RetentionStorageMock.PurgeDeletedOrders. %v %v %v", ctx, before, limit)
+	return
+}
+
+// PurgeDeletedOrdersAfterCounter returns a count of finished RetentionStorageMock.PurgeDeletedOrders invocations
+func (mmPurgeDeletedOrders *RetentionStorageMock) PurgeDeletedOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedOrders.afterPurgeDeletedOrdersCounter)
+}
+
+// PurgeDeletedOrdersBeforeCounter returns a count of RetentionStorageMock.PurgeDeletedOrders invocations
+func (mmPurgeDeletedOrders *RetentionStorageMock) PurgeDeletedOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedOrders.beforePurgeDeletedOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RetentionStorageMock.PurgeDeletedOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedOrders *mRetentionStorageMockPurgeDeletedOrders) Calls() []*RetentionStorageMockPurgeDeletedOrdersParams {
+	mmPurgeDeletedOrders.mutex.RLock()
+
+	argCopy := make([]*RetentionStorageMockPurgeDeletedOrdersParams, len(mmPurgeDeletedOrders.callArgs))
+	copy(argCopy, mmPurgeDeletedOrders.callArgs)
+
+	mmPurgeDeletedOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedOrdersDone returns true if the count of the PurgeDeletedOrders invocations corresponds
+// the number of defined expectations
+func (m *RetentionStorageMock) MinimockPurgeDeletedOrdersDone() bool {
+	if m.PurgeDeletedOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedOrdersMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedOrdersInspect logs each unmet expectation
+func (m *RetentionStorageMock) MinimockPurgeDeletedOrdersInspect() {
+	for _, e := range m.PurgeDeletedOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeDeletedOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedOrdersCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedOrdersMock.defaultExpectation != nil && afterPurgeDeletedOrdersCounter < 1 {
+		if m.PurgeDeletedOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeDeletedOrders at\n%s", m.PurgeDeletedOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeDeletedOrders at\n%s with params: %#v", m.PurgeDeletedOrdersMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedOrders != nil && afterPurgeDeletedOrdersCounter < 1 {
+		m.t.Errorf("Expected call to RetentionStorageMock.PurgeDeletedOrders at\n%s", m.funcPurgeDeletedOrdersOrigin)
+	}
+
+	if !m.PurgeDeletedOrdersMock.invocationsDone() && afterPurgeDeletedOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RetentionStorageMock.PurgeDeletedOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedOrdersMock.expectedInvocations), m.PurgeDeletedOrdersMock.expectedInvocationsOrigin, afterPurgeDeletedOrdersCounter)
+	}
+}
+
+type mRetentionStorageMockPurgeExpiredIdempotencyKeys struct {
+	optional           bool
+	mock               *RetentionStorageMock
+	defaultExpectation *RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation
+	expectations       []*RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation
+
+	callArgs []*RetentionStorageMockPurgeExpiredIdempotencyKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation specifies expectation struct of the RetentionStorage.PurgeExpiredIdempotencyKeys
+type RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation struct {
+	mock               *RetentionStorageMock
+	params             *RetentionStorageMockPurgeExpiredIdempotencyKeysParams
+	paramPtrs          *RetentionStorageMockPurgeExpiredIdempotencyKeysParamPtrs
+	expectationOrigins RetentionStorageMockPurgeExpiredIdempotencyKeysExpectationOrigins
+	results            *RetentionStorageMockPurgeExpiredIdempotencyKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RetentionStorageMockPurgeExpiredIdempotencyKeysParams contains parameters of the RetentionStorage.PurgeExpiredIdempotencyKeys
+type RetentionStorageMockPurgeExpiredIdempotencyKeysParams struct {
+	ctx context.Context
+}
+
+// RetentionStorageMockPurgeExpiredIdempotencyKeysParamPtrs contains pointers to parameters of the RetentionStorage.PurgeExpiredIdempotencyKeys
+type RetentionStorageMockPurgeExpiredIdempotencyKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// RetentionStorageMockPurgeExpiredIdempotencyKeysResults contains results of the RetentionStorage.PurgeExpiredIdempotencyKeys
+type RetentionStorageMockPurgeExpiredIdempotencyKeysResults struct {
+	i1  int64
+	err error
+}
+
+// RetentionStorageMockPurgeExpiredIdempotencyKeysOrigins contains origins of expectations of the RetentionStorage.PurgeExpiredIdempotencyKeys
+type RetentionStorageMockPurgeExpiredIdempotencyKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Optional() *mRetentionStorageMockPurgeExpiredIdempotencyKeys {
+	mmPurgeExpiredIdempotencyKeys.optional = true
+	return mmPurgeExpiredIdempotencyKeys
+}
+
+// Expect sets up expected params for RetentionStorage.PurgeExpiredIdempotencyKeys
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Expect(ctx context.Context) *mRetentionStorageMockPurgeExpiredIdempotencyKeys {
+	if mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmPurgeExpiredIdempotencyKeys.defaultExpectation = &RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation.paramPtrs != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.params = &RetentionStorageMockPurgeExpiredIdempotencyKeysParams{ctx}
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeExpiredIdempotencyKeys.expectations {
+		if minimock.Equal(e.params, mmPurgeExpiredIdempotencyKeys.defaultExpectation.params) {
+			mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeExpiredIdempotencyKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeExpiredIdempotencyKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RetentionStorage.PurgeExpiredIdempotencyKeys
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) ExpectCtxParam1(ctx context.Context) *mRetentionStorageMockPurgeExpiredIdempotencyKeys {
+	if mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmPurgeExpiredIdempotencyKeys.defaultExpectation = &RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation.params != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by Expect")
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation.paramPtrs == nil {
+		mmPurgeExpiredIdempotencyKeys.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeExpiredIdempotencyKeysParamPtrs{}
+	}
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeExpiredIdempotencyKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the RetentionStorage.PurgeExpiredIdempotencyKeys
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Inspect(f func(ctx context.Context)) *mRetentionStorageMockPurgeExpiredIdempotencyKeys {
+	if mmPurgeExpiredIdempotencyKeys.mock.inspectFuncPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("Inspect function is already set for RetentionStorageMock.PurgeExpiredIdempotencyKeys")
+	}
+
+	mmPurgeExpiredIdempotencyKeys.mock.inspectFuncPurgeExpiredIdempotencyKeys = f
+
+	return mmPurgeExpiredIdempotencyKeys
+}
+
+// Return sets up results that will be returned by RetentionStorage.PurgeExpiredIdempotencyKeys
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Return(i1 int64, err error) *RetentionStorageMock {
+	if mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmPurgeExpiredIdempotencyKeys.defaultExpectation = &RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation{mock: mmPurgeExpiredIdempotencyKeys.mock}
+	}
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.results = &RetentionStorageMockPurgeExpiredIdempotencyKeysResults{i1, err}
+	mmPurgeExpiredIdempotencyKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredIdempotencyKeys.mock
+}
+
+// Set uses given function f to mock the RetentionStorage.PurgeExpiredIdempotencyKeys method
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Set(f func(ctx context.Context) (i1 int64, err error)) *RetentionStorageMock {
+	if mmPurgeExpiredIdempotencyKeys.defaultExpectation != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("Default expectation is already set for the RetentionStorage.PurgeExpiredIdempotencyKeys method")
+	}
+
+	if len(mmPurgeExpiredIdempotencyKeys.expectations) > 0 {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("Some expectations are already set for the RetentionStorage.PurgeExpiredIdempotencyKeys method")
+	}
+
+	mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys = f
+	mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeysOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredIdempotencyKeys.mock
+}
+
+// When sets expectation for the RetentionStorage.PurgeExpiredIdempotencyKeys which will trigger the result defined by the following
+// Then helper
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) When(ctx context.Context) *RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation {
+	if mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("RetentionStorageMock.PurgeExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	expectation := &RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation{
+		mock:               mmPurgeExpiredIdempotencyKeys.mock,
+		params:             &RetentionStorageMockPurgeExpiredIdempotencyKeysParams{ctx},
+		expectationOrigins: RetentionStorageMockPurgeExpiredIdempotencyKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeExpiredIdempotencyKeys.expectations = append(mmPurgeExpiredIdempotencyKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RetentionStorage.PurgeExpiredIdempotencyKeys return parameters for the expectation previously defined by the When method
+func (e *RetentionStorageMockPurgeExpiredIdempotencyKeysExpectation) Then(i1 int64, err error) *RetentionStorageMock {
+	e.results = &RetentionStorageMockPurgeExpiredIdempotencyKeysResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RetentionStorage.PurgeExpiredIdempotencyKeys should be invoked
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Times(n uint64) *mRetentionStorageMockPurgeExpiredIdempotencyKeys {
+	if n == 0 {
+		mmPurgeExpiredIdempotencyKeys.mock.t.Fatalf("Times of RetentionStorageMock.PurgeExpiredIdempotencyKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeExpiredIdempotencyKeys.expectedInvocations, n)
+	mmPurgeExpiredIdempotencyKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeExpiredIdempotencyKeys
+}
+
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) invocationsDone() bool {
+	if len(mmPurgeExpiredIdempotencyKeys.expectations) == 0 && mmPurgeExpiredIdempotencyKeys.defaultExpectation == nil && mmPurgeExpiredIdempotencyKeys.mock.funcPurgeExpiredIdempotencyKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredIdempotencyKeys.mock.afterPurgeExpiredIdempotencyKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeExpiredIdempotencyKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeExpiredIdempotencyKeys implements mm_storage.RetentionStorage
+func (mmPurgeExpiredIdempotencyKeys *RetentionStorageMock) PurgeExpiredIdempotencyKeys(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeExpiredIdempotencyKeys.beforePurgeExpiredIdempotencyKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeExpiredIdempotencyKeys.afterPurgeExpiredIdempotencyKeysCounter, 1)
+
+	mmPurgeExpiredIdempotencyKeys.t.Helper()
+
+	if mmPurgeExpiredIdempotencyKeys.inspectFuncPurgeExpiredIdempotencyKeys != nil {
+		mmPurgeExpiredIdempotencyKeys.inspectFuncPurgeExpiredIdempotencyKeys(ctx)
+	}
+
+	mm_params := RetentionStorageMockPurgeExpiredIdempotencyKeysParams{ctx}
+
+	// Record call args
+	mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.mutex.Lock()
+	mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.callArgs = append(mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.callArgs, &mm_params)
+	mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.mutex.Unlock()
+
+	for _, e := range mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := RetentionStorageMockPurgeExpiredIdempotencyKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeExpiredIdempotencyKeys.t.Errorf("RetentionStorageMock.PurgeExpiredIdempotencyKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeExpiredIdempotencyKeys.t.Errorf("RetentionStorageMock.PurgeExpiredIdempotencyKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeExpiredIdempotencyKeys.PurgeExpiredIdempotencyKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeExpiredIdempotencyKeys.t.Fatal("No results are set for the RetentionStorageMock.PurgeExpiredIdempotencyKeys")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeExpiredIdempotencyKeys.funcPurgeExpiredIdempotencyKeys != nil {
+		return mmPurgeExpiredIdempotencyKeys.funcPurgeExpiredIdempotencyKeys(ctx)
+	}
+	mmPurgeExpiredIdempotencyKeys.t.Fatalf("Unexpected call to RetentionStorageMock.PurgeExpiredIdempotencyKeys. %v", ctx)
+	return
+}
+
+// PurgeExpiredIdempotencyKeysAfterCounter returns a count of finished RetentionStorageMock.PurgeExpiredIdempotencyKeys invocations
+func (mmPurgeExpiredIdempotencyKeys *RetentionStorageMock) PurgeExpiredIdempotencyKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredIdempotencyKeys.afterPurgeExpiredIdempotencyKeysCounter)
+}
+
+// PurgeExpiredIdempotencyKeysBeforeCounter returns a count of RetentionStorageMock.PurgeExpiredIdempotencyKeys invocations
+func (mmPurgeExpiredIdempotencyKeys *RetentionStorageMock) PurgeExpiredIdempotencyKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeExpiredIdempotencyKeys.beforePurgeExpiredIdempotencyKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to RetentionStorageMock.PurgeExpiredIdempotencyKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeExpiredIdempotencyKeys *mRetentionStorageMockPurgeExpiredIdempotencyKeys) Calls() []*RetentionStorageMockPurgeExpiredIdempotencyKeysParams {
+	mmPurgeExpiredIdempotencyKeys.mutex.RLock()
+
+	argCopy := make([]*RetentionStorageMockPurgeExpiredIdempotencyKeysParams, len(mmPurgeExpiredIdempotencyKeys.callArgs))
+	copy(argCopy, mmPurgeExpiredIdempotencyKeys.callArgs)
+
+	mmPurgeExpiredIdempotencyKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeExpiredIdempotencyKeysDone returns true if the count of the PurgeExpiredIdempotencyKeys invocations corresponds
+// the number of defined expectations
+func (m *RetentionStorageMock) MinimockPurgeExpiredIdempotencyKeysDone() bool {
+	if m.PurgeExpiredIdempotencyKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeExpiredIdempotencyKeysMock.invocationsDone()
+}
+
+// MinimockPurgeExpiredIdempotencyKeysInspect logs each unmet expectation
+func (m *RetentionStorageMock) MinimockPurgeExpiredIdempotencyKeysInspect() {
+	for _, e := range m.PurgeExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeExpiredIdempotencyKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeExpiredIdempotencyKeysCounter := mm_atomic.LoadUint64(&m.afterPurgeExpiredIdempotencyKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeExpiredIdempotencyKeysMock.defaultExpectation != nil && afterPurgeExpiredIdempotencyKeysCounter < 1 {
+		if m.PurgeExpiredIdempotencyKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeExpiredIdempotencyKeys at\n%s", m.PurgeExpiredIdempotencyKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeExpiredIdempotencyKeys at\n%s with params: %#v", m.PurgeExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *m.PurgeExpiredIdempotencyKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeExpiredIdempotencyKeys != nil && afterPurgeExpiredIdempotencyKeysCounter < 1 {
+		m.t.Errorf("Expected call to RetentionStorageMock.PurgeExpiredIdempotencyKeys at\n%s", m.funcPurgeExpiredIdempotencyKeysOrigin)
+	}
+
+	if !m.PurgeExpiredIdempotencyKeysMock.invocationsDone() && afterPurgeExpiredIdempotencyKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to RetentionStorageMock.PurgeExpiredIdempotencyKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeExpiredIdempotencyKeysMock.expectedInvocations), m.PurgeExpiredIdempotencyKeysMock.expectedInvocationsOrigin, afterPurgeExpiredIdempotencyKeysCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RetentionStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPurgeDeletedOrdersInspect()
+
+			m.MinimockPurgeExpiredIdempotencyKeysInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RetentionStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RetentionStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPurgeDeletedOrdersDone() &&
+		m.MinimockPurgeExpiredIdempotencyKeysDone()
+}
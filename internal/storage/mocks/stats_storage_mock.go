@@ -0,0 +1,387 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.StatsStorage -o stats_storage_mock.go -n StatsStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"PWZ1.0/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// StatsStorageMock implements mm_storage.StatsStorage
+type StatsStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBusinessStats          func(ctx context.Context) (b1 models.BusinessStats, err error)
+	funcBusinessStatsOrigin    string
+	inspectFuncBusinessStats   func(ctx context.Context)
+	afterBusinessStatsCounter  uint64
+	beforeBusinessStatsCounter uint64
+	BusinessStatsMock          mStatsStorageMockBusinessStats
+}
+
+// NewStatsStorageMock returns a mock for mm_storage.StatsStorage
+func NewStatsStorageMock(t minimock.Tester) *StatsStorageMock {
+	m := &StatsStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BusinessStatsMock = mStatsStorageMockBusinessStats{mock: m}
+	m.BusinessStatsMock.callArgs = []*StatsStorageMockBusinessStatsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mStatsStorageMockBusinessStats struct {
+	optional           bool
+	mock               *StatsStorageMock
+	defaultExpectation *StatsStorageMockBusinessStatsExpectation
+	expectations       []*StatsStorageMockBusinessStatsExpectation
+
+	callArgs []*StatsStorageMockBusinessStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StatsStorageMockBusinessStatsExpectation specifies expectation struct of the StatsStorage.BusinessStats
+type StatsStorageMockBusinessStatsExpectation struct {
+	mock               *StatsStorageMock
+	params             *StatsStorageMockBusinessStatsParams
+	paramPtrs          *StatsStorageMockBusinessStatsParamPtrs
+	expectationOrigins StatsStorageMockBusinessStatsExpectationOrigins
+	results            *StatsStorageMockBusinessStatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StatsStorageMockBusinessStatsParams contains parameters of the StatsStorage.BusinessStats
+type StatsStorageMockBusinessStatsParams struct {
+	ctx context.Context
+}
+
+// StatsStorageMockBusinessStatsParamPtrs contains pointers to parameters of the StatsStorage.BusinessStats
+type StatsStorageMockBusinessStatsParamPtrs struct {
+	ctx *context.Context
+}
+
+// StatsStorageMockBusinessStatsResults contains results of the StatsStorage.BusinessStats
+type StatsStorageMockBusinessStatsResults struct {
+	b1  models.BusinessStats
+	err error
+}
+
+// StatsStorageMockBusinessStatsOrigins contains origins of expectations of the StatsStorage.BusinessStats
+type StatsStorageMockBusinessStatsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Optional() *mStatsStorageMockBusinessStats {
+	mmBusinessStats.optional = true
+	return mmBusinessStats
+}
+
+// Expect sets up expected params for StatsStorage.BusinessStats
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Expect(ctx context.Context) *mStatsStorageMockBusinessStats {
+	if mmBusinessStats.mock.funcBusinessStats != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by Set")
+	}
+
+	if mmBusinessStats.defaultExpectation == nil {
+		mmBusinessStats.defaultExpectation = &StatsStorageMockBusinessStatsExpectation{}
+	}
+
+	if mmBusinessStats.defaultExpectation.paramPtrs != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by ExpectParams functions")
+	}
+
+	mmBusinessStats.defaultExpectation.params = &StatsStorageMockBusinessStatsParams{ctx}
+	mmBusinessStats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBusinessStats.expectations {
+		if minimock.Equal(e.params, mmBusinessStats.defaultExpectation.params) {
+			mmBusinessStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBusinessStats.defaultExpectation.params)
+		}
+	}
+
+	return mmBusinessStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StatsStorage.BusinessStats
+func (mmBusinessStats *mStatsStorageMockBusinessStats) ExpectCtxParam1(ctx context.Context) *mStatsStorageMockBusinessStats {
+	if mmBusinessStats.mock.funcBusinessStats != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by Set")
+	}
+
+	if mmBusinessStats.defaultExpectation == nil {
+		mmBusinessStats.defaultExpectation = &StatsStorageMockBusinessStatsExpectation{}
+	}
+
+	if mmBusinessStats.defaultExpectation.params != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by Expect")
+	}
+
+	if mmBusinessStats.defaultExpectation.paramPtrs == nil {
+		mmBusinessStats.defaultExpectation.paramPtrs = &StatsStorageMockBusinessStatsParamPtrs{}
+	}
+	mmBusinessStats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBusinessStats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBusinessStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the StatsStorage.BusinessStats
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Inspect(f func(ctx context.Context)) *mStatsStorageMockBusinessStats {
+	if mmBusinessStats.mock.inspectFuncBusinessStats != nil {
+		mmBusinessStats.mock.t.Fatalf("Inspect function is already set for StatsStorageMock.BusinessStats")
+	}
+
+	mmBusinessStats.mock.inspectFuncBusinessStats = f
+
+	return mmBusinessStats
+}
+
+// Return sets up results that will be returned by StatsStorage.BusinessStats
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Return(b1 models.BusinessStats, err error) *StatsStorageMock {
+	if mmBusinessStats.mock.funcBusinessStats != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by Set")
+	}
+
+	if mmBusinessStats.defaultExpectation == nil {
+		mmBusinessStats.defaultExpectation = &StatsStorageMockBusinessStatsExpectation{mock: mmBusinessStats.mock}
+	}
+	mmBusinessStats.defaultExpectation.results = &StatsStorageMockBusinessStatsResults{b1, err}
+	mmBusinessStats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBusinessStats.mock
+}
+
+// Set uses given function f to mock the StatsStorage.BusinessStats method
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Set(f func(ctx context.Context) (b1 models.BusinessStats, err error)) *StatsStorageMock {
+	if mmBusinessStats.defaultExpectation != nil {
+		mmBusinessStats.mock.t.Fatalf("Default expectation is already set for the StatsStorage.BusinessStats method")
+	}
+
+	if len(mmBusinessStats.expectations) > 0 {
+		mmBusinessStats.mock.t.Fatalf("Some expectations are already set for the StatsStorage.BusinessStats method")
+	}
+
+	mmBusinessStats.mock.funcBusinessStats = f
+	mmBusinessStats.mock.funcBusinessStatsOrigin = minimock.CallerInfo(1)
+	return mmBusinessStats.mock
+}
+
+// When sets expectation for the StatsStorage.BusinessStats which will trigger the result defined by the following
+// Then helper
+func (mmBusinessStats *mStatsStorageMockBusinessStats) When(ctx context.Context) *StatsStorageMockBusinessStatsExpectation {
+	if mmBusinessStats.mock.funcBusinessStats != nil {
+		mmBusinessStats.mock.t.Fatalf("StatsStorageMock.BusinessStats mock is already set by Set")
+	}
+
+	expectation := &StatsStorageMockBusinessStatsExpectation{
+		mock:               mmBusinessStats.mock,
+		params:             &StatsStorageMockBusinessStatsParams{ctx},
+		expectationOrigins: StatsStorageMockBusinessStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBusinessStats.expectations = append(mmBusinessStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StatsStorage.BusinessStats return parameters for the expectation previously defined by the When method
+func (e *StatsStorageMockBusinessStatsExpectation) Then(b1 models.BusinessStats, err error) *StatsStorageMock {
+	e.results = &StatsStorageMockBusinessStatsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times StatsStorage.BusinessStats should be invoked
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Times(n uint64) *mStatsStorageMockBusinessStats {
+	if n == 0 {
+		mmBusinessStats.mock.t.Fatalf("Times of StatsStorageMock.BusinessStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBusinessStats.expectedInvocations, n)
+	mmBusinessStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBusinessStats
+}
+
+func (mmBusinessStats *mStatsStorageMockBusinessStats) invocationsDone() bool {
+	if len(mmBusinessStats.expectations) == 0 && mmBusinessStats.defaultExpectation == nil && mmBusinessStats.mock.funcBusinessStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBusinessStats.mock.afterBusinessStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBusinessStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BusinessStats implements mm_storage.StatsStorage
+func (mmBusinessStats *StatsStorageMock) BusinessStats(ctx context.Context) (b1 models.BusinessStats, err error) {
+	mm_atomic.AddUint64(&mmBusinessStats.beforeBusinessStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmBusinessStats.afterBusinessStatsCounter, 1)
+
+	mmBusinessStats.t.Helper()
+
+	if mmBusinessStats.inspectFuncBusinessStats != nil {
+		mmBusinessStats.inspectFuncBusinessStats(ctx)
+	}
+
+	mm_params := StatsStorageMockBusinessStatsParams{ctx}
+
+	// Record call args
+	mmBusinessStats.BusinessStatsMock.mutex.Lock()
+	mmBusinessStats.BusinessStatsMock.callArgs = append(mmBusinessStats.BusinessStatsMock.callArgs, &mm_params)
+	mmBusinessStats.BusinessStatsMock.mutex.Unlock()
+
+	for _, e := range mmBusinessStats.BusinessStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmBusinessStats.BusinessStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBusinessStats.BusinessStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmBusinessStats.BusinessStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmBusinessStats.BusinessStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := StatsStorageMockBusinessStatsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBusinessStats.t.Errorf("StatsStorageMock.BusinessStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBusinessStats.BusinessStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBusinessStats.t.Errorf("StatsStorageMock.BusinessStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBusinessStats.BusinessStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBusinessStats.BusinessStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBusinessStats.t.Fatal("No results are set for the StatsStorageMock.BusinessStats")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmBusinessStats.funcBusinessStats != nil {
+		return mmBusinessStats.funcBusinessStats(ctx)
+	}
+	mmBusinessStats.t.Fatalf("Unexpected call to StatsStorageMock.BusinessStats. %v", ctx)
+	return
+}
+
+// BusinessStatsAfterCounter returns a count of finished StatsStorageMock.BusinessStats invocations
+func (mmBusinessStats *StatsStorageMock) BusinessStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBusinessStats.afterBusinessStatsCounter)
+}
+
+// BusinessStatsBeforeCounter returns a count of StatsStorageMock.BusinessStats invocations
+func (mmBusinessStats *StatsStorageMock) BusinessStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBusinessStats.beforeBusinessStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StatsStorageMock.BusinessStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBusinessStats *mStatsStorageMockBusinessStats) Calls() []*StatsStorageMockBusinessStatsParams {
+	mmBusinessStats.mutex.RLock()
+
+	argCopy := make([]*StatsStorageMockBusinessStatsParams, len(mmBusinessStats.callArgs))
+	copy(argCopy, mmBusinessStats.callArgs)
+
+	mmBusinessStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBusinessStatsDone returns true if the count of the BusinessStats invocations corresponds
+// the number of defined expectations
+func (m *StatsStorageMock) MinimockBusinessStatsDone() bool {
+	if m.BusinessStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BusinessStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BusinessStatsMock.invocationsDone()
+}
+
+// MinimockBusinessStatsInspect logs each unmet expectation
+func (m *StatsStorageMock) MinimockBusinessStatsInspect() {
+	for _, e := range m.BusinessStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StatsStorageMock.BusinessStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBusinessStatsCounter := mm_atomic.LoadUint64(&m.afterBusinessStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BusinessStatsMock.defaultExpectation != nil && afterBusinessStatsCounter < 1 {
+		if m.BusinessStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StatsStorageMock.BusinessStats at\n%s", m.BusinessStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StatsStorageMock.BusinessStats at\n%s with params: %#v", m.BusinessStatsMock.defaultExpectation.expectationOrigins.origin, *m.BusinessStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBusinessStats != nil && afterBusinessStatsCounter < 1 {
+		m.t.Errorf("Expected call to StatsStorageMock.BusinessStats at\n%s", m.funcBusinessStatsOrigin)
+	}
+
+	if !m.BusinessStatsMock.invocationsDone() && afterBusinessStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StatsStorageMock.BusinessStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BusinessStatsMock.expectedInvocations), m.BusinessStatsMock.expectedInvocationsOrigin, afterBusinessStatsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StatsStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBusinessStatsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StatsStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StatsStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBusinessStatsDone()
+}
@@ -20,20 +20,35 @@ type StorageMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDeleteOrder          func(ctx context.Context, id uint64) (err error)
-	funcDeleteOrderOrigin    string
-	inspectFuncDeleteOrder   func(ctx context.Context, id uint64)
-	afterDeleteOrderCounter  uint64
-	beforeDeleteOrderCounter uint64
-	DeleteOrderMock          mStorageMockDeleteOrder
-
-	funcGetHistory          func(ctx context.Context, page uint32, count uint32) (oa1 []models.OrderHistory, err error)
+	funcDeleteOrderTx          func(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) (err error)
+	funcDeleteOrderTxOrigin    string
+	inspectFuncDeleteOrderTx   func(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor)
+	afterDeleteOrderTxCounter  uint64
+	beforeDeleteOrderTxCounter uint64
+	DeleteOrderTxMock          mStorageMockDeleteOrderTx
+
+	funcExistingOrderIDsTx func(ctx context.Context, tx pgx.Tx, ids []uint64) (m1 map[uint64]struct {
+	}, err error)
+	funcExistingOrderIDsTxOrigin    string
+	inspectFuncExistingOrderIDsTx   func(ctx context.Context, tx pgx.Tx, ids []uint64)
+	afterExistingOrderIDsTxCounter  uint64
+	beforeExistingOrderIDsTxCounter uint64
+	ExistingOrderIDsTxMock          mStorageMockExistingOrderIDsTx
+
+	funcGetHistory          func(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) (oa1 []models.OrderHistory, err error)
 	funcGetHistoryOrigin    string
-	inspectFuncGetHistory   func(ctx context.Context, page uint32, count uint32)
+	inspectFuncGetHistory   func(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32)
 	afterGetHistoryCounter  uint64
 	beforeGetHistoryCounter uint64
 	GetHistoryMock          mStorageMockGetHistory
 
+	funcGetImportJobForUpdateTx          func(ctx context.Context, tx pgx.Tx, id string) (i1 models.ImportJob, err error)
+	funcGetImportJobForUpdateTxOrigin    string
+	inspectFuncGetImportJobForUpdateTx   func(ctx context.Context, tx pgx.Tx, id string)
+	afterGetImportJobForUpdateTxCounter  uint64
+	beforeGetImportJobForUpdateTxCounter uint64
+	GetImportJobForUpdateTxMock          mStorageMockGetImportJobForUpdateTx
+
 	funcGetOrder          func(ctx context.Context, id uint64) (o1 models.Order, err error)
 	funcGetOrderOrigin    string
 	inspectFuncGetOrder   func(ctx context.Context, id uint64)
@@ -41,6 +56,20 @@ type StorageMock struct {
 	beforeGetOrderCounter uint64
 	GetOrderMock          mStorageMockGetOrder
 
+	funcGetOrderForUpdateTx          func(ctx context.Context, tx pgx.Tx, id uint64) (o1 models.Order, err error)
+	funcGetOrderForUpdateTxOrigin    string
+	inspectFuncGetOrderForUpdateTx   func(ctx context.Context, tx pgx.Tx, id uint64)
+	afterGetOrderForUpdateTxCounter  uint64
+	beforeGetOrderForUpdateTxCounter uint64
+	GetOrderForUpdateTxMock          mStorageMockGetOrderForUpdateTx
+
+	funcGetOrderHistory          func(ctx context.Context, orderID uint64) (oa1 []models.OrderHistory, err error)
+	funcGetOrderHistoryOrigin    string
+	inspectFuncGetOrderHistory   func(ctx context.Context, orderID uint64)
+	afterGetOrderHistoryCounter  uint64
+	beforeGetOrderHistoryCounter uint64
+	GetOrderHistoryMock          mStorageMockGetOrderHistory
+
 	funcListOrders          func(ctx context.Context) (oa1 []models.Order, err error)
 	funcListOrdersOrigin    string
 	inspectFuncListOrders   func(ctx context.Context)
@@ -48,16 +77,51 @@ type StorageMock struct {
 	beforeListOrdersCounter uint64
 	ListOrdersMock          mStorageMockListOrders
 
-	funcSaveOrderTx          func(ctx context.Context, tx pgx.Tx, order models.Order) (err error)
+	funcQueryOrders          func(ctx context.Context, filter models.OrderFilter) (o1 models.OrdersPage, err error)
+	funcQueryOrdersOrigin    string
+	inspectFuncQueryOrders   func(ctx context.Context, filter models.OrderFilter)
+	afterQueryOrdersCounter  uint64
+	beforeQueryOrdersCounter uint64
+	QueryOrdersMock          mStorageMockQueryOrders
+
+	funcSaveEventTx          func(ctx context.Context, tx pgx.Tx, order models.Event) (err error)
+	funcSaveEventTxOrigin    string
+	inspectFuncSaveEventTx   func(ctx context.Context, tx pgx.Tx, order models.Event)
+	afterSaveEventTxCounter  uint64
+	beforeSaveEventTxCounter uint64
+	SaveEventTxMock          mStorageMockSaveEventTx
+
+	funcSaveOrderTx          func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error)
 	funcSaveOrderTxOrigin    string
-	inspectFuncSaveOrderTx   func(ctx context.Context, tx pgx.Tx, order models.Order)
+	inspectFuncSaveOrderTx   func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor)
 	afterSaveOrderTxCounter  uint64
 	beforeSaveOrderTxCounter uint64
 	SaveOrderTxMock          mStorageMockSaveOrderTx
 
-	funcUpdateOrderTx          func(ctx context.Context, tx pgx.Tx, order models.Order) (err error)
+	funcSaveOrdersTx          func(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) (err error)
+	funcSaveOrdersTxOrigin    string
+	inspectFuncSaveOrdersTx   func(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor)
+	afterSaveOrdersTxCounter  uint64
+	beforeSaveOrdersTxCounter uint64
+	SaveOrdersTxMock          mStorageMockSaveOrdersTx
+
+	funcStartImportJob          func(ctx context.Context, id string) (i1 models.ImportJob, err error)
+	funcStartImportJobOrigin    string
+	inspectFuncStartImportJob   func(ctx context.Context, id string)
+	afterStartImportJobCounter  uint64
+	beforeStartImportJobCounter uint64
+	StartImportJobMock          mStorageMockStartImportJob
+
+	funcUpdateImportJobTx          func(ctx context.Context, tx pgx.Tx, job models.ImportJob) (err error)
+	funcUpdateImportJobTxOrigin    string
+	inspectFuncUpdateImportJobTx   func(ctx context.Context, tx pgx.Tx, job models.ImportJob)
+	afterUpdateImportJobTxCounter  uint64
+	beforeUpdateImportJobTxCounter uint64
+	UpdateImportJobTxMock          mStorageMockUpdateImportJobTx
+
+	funcUpdateOrderTx          func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error)
 	funcUpdateOrderTxOrigin    string
-	inspectFuncUpdateOrderTx   func(ctx context.Context, tx pgx.Tx, order models.Order)
+	inspectFuncUpdateOrderTx   func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor)
 	afterUpdateOrderTxCounter  uint64
 	beforeUpdateOrderTxCounter uint64
 	UpdateOrderTxMock          mStorageMockUpdateOrderTx
@@ -78,21 +142,48 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.DeleteOrderMock = mStorageMockDeleteOrder{mock: m}
-	m.DeleteOrderMock.callArgs = []*StorageMockDeleteOrderParams{}
+	m.DeleteOrderTxMock = mStorageMockDeleteOrderTx{mock: m}
+	m.DeleteOrderTxMock.callArgs = []*StorageMockDeleteOrderTxParams{}
+
+	m.ExistingOrderIDsTxMock = mStorageMockExistingOrderIDsTx{mock: m}
+	m.ExistingOrderIDsTxMock.callArgs = []*StorageMockExistingOrderIDsTxParams{}
 
 	m.GetHistoryMock = mStorageMockGetHistory{mock: m}
 	m.GetHistoryMock.callArgs = []*StorageMockGetHistoryParams{}
 
+	m.GetImportJobForUpdateTxMock = mStorageMockGetImportJobForUpdateTx{mock: m}
+	m.GetImportJobForUpdateTxMock.callArgs = []*StorageMockGetImportJobForUpdateTxParams{}
+
 	m.GetOrderMock = mStorageMockGetOrder{mock: m}
 	m.GetOrderMock.callArgs = []*StorageMockGetOrderParams{}
 
+	m.GetOrderForUpdateTxMock = mStorageMockGetOrderForUpdateTx{mock: m}
+	m.GetOrderForUpdateTxMock.callArgs = []*StorageMockGetOrderForUpdateTxParams{}
+
+	m.GetOrderHistoryMock = mStorageMockGetOrderHistory{mock: m}
+	m.GetOrderHistoryMock.callArgs = []*StorageMockGetOrderHistoryParams{}
+
 	m.ListOrdersMock = mStorageMockListOrders{mock: m}
 	m.ListOrdersMock.callArgs = []*StorageMockListOrdersParams{}
 
+	m.QueryOrdersMock = mStorageMockQueryOrders{mock: m}
+	m.QueryOrdersMock.callArgs = []*StorageMockQueryOrdersParams{}
+
+	m.SaveEventTxMock = mStorageMockSaveEventTx{mock: m}
+	m.SaveEventTxMock.callArgs = []*StorageMockSaveEventTxParams{}
+
 	m.SaveOrderTxMock = mStorageMockSaveOrderTx{mock: m}
 	m.SaveOrderTxMock.callArgs = []*StorageMockSaveOrderTxParams{}
 
+	m.SaveOrdersTxMock = mStorageMockSaveOrdersTx{mock: m}
+	m.SaveOrdersTxMock.callArgs = []*StorageMockSaveOrdersTxParams{}
+
+	m.StartImportJobMock = mStorageMockStartImportJob{mock: m}
+	m.StartImportJobMock.callArgs = []*StorageMockStartImportJobParams{}
+
+	m.UpdateImportJobTxMock = mStorageMockUpdateImportJobTx{mock: m}
+	m.UpdateImportJobTxMock.callArgs = []*StorageMockUpdateImportJobTxParams{}
+
 	m.UpdateOrderTxMock = mStorageMockUpdateOrderTx{mock: m}
 	m.UpdateOrderTxMock.callArgs = []*StorageMockUpdateOrderTxParams{}
 
@@ -104,52 +195,461 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 	return m
 }
 
-type mStorageMockDeleteOrder struct {
+type mStorageMockDeleteOrderTx struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockDeleteOrderTxExpectation
+	expectations       []*StorageMockDeleteOrderTxExpectation
+
+	callArgs []*StorageMockDeleteOrderTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockDeleteOrderTxExpectation specifies expectation struct of the Storage.DeleteOrderTx
+type StorageMockDeleteOrderTxExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockDeleteOrderTxParams
+	paramPtrs          *StorageMockDeleteOrderTxParamPtrs
+	expectationOrigins StorageMockDeleteOrderTxExpectationOrigins
+	results            *StorageMockDeleteOrderTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockDeleteOrderTxParams contains parameters of the Storage.DeleteOrderTx
+type StorageMockDeleteOrderTxParams struct {
+	ctx   context.Context
+	tx    pgx.Tx
+	id    uint64
+	actor models.Actor
+}
+
+// StorageMockDeleteOrderTxParamPtrs contains pointers to parameters of the Storage.DeleteOrderTx
+type StorageMockDeleteOrderTxParamPtrs struct {
+	ctx   *context.Context
+	tx    *pgx.Tx
+	id    *uint64
+	actor *models.Actor
+}
+
+// StorageMockDeleteOrderTxResults contains results of the Storage.DeleteOrderTx
+type StorageMockDeleteOrderTxResults struct {
+	err error
+}
+
+// StorageMockDeleteOrderTxOrigins contains origins of expectations of the Storage.DeleteOrderTx
+type StorageMockDeleteOrderTxExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTx    string
+	originId    string
+	originActor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Optional() *mStorageMockDeleteOrderTx {
+	mmDeleteOrderTx.optional = true
+	return mmDeleteOrderTx
+}
+
+// Expect sets up expected params for Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Expect(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{}
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.paramPtrs != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOrderTx.defaultExpectation.params = &StorageMockDeleteOrderTxParams{ctx, tx, id, actor}
+	mmDeleteOrderTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOrderTx.expectations {
+		if minimock.Equal(e.params, mmDeleteOrderTx.defaultExpectation.params) {
+			mmDeleteOrderTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrderTx.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOrderTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) ExpectCtxParam1(ctx context.Context) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{}
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.params != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Expect")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrderTx.defaultExpectation.paramPtrs = &StorageMockDeleteOrderTxParamPtrs{}
+	}
+	mmDeleteOrderTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOrderTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOrderTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{}
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.params != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Expect")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrderTx.defaultExpectation.paramPtrs = &StorageMockDeleteOrderTxParamPtrs{}
+	}
+	mmDeleteOrderTx.defaultExpectation.paramPtrs.tx = &tx
+	mmDeleteOrderTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmDeleteOrderTx
+}
+
+// ExpectIdParam3 sets up expected param id for Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) ExpectIdParam3(id uint64) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{}
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.params != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Expect")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrderTx.defaultExpectation.paramPtrs = &StorageMockDeleteOrderTxParamPtrs{}
+	}
+	mmDeleteOrderTx.defaultExpectation.paramPtrs.id = &id
+	mmDeleteOrderTx.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteOrderTx
+}
+
+// ExpectActorParam4 sets up expected param actor for Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) ExpectActorParam4(actor models.Actor) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{}
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.params != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Expect")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrderTx.defaultExpectation.paramPtrs = &StorageMockDeleteOrderTxParamPtrs{}
+	}
+	mmDeleteOrderTx.defaultExpectation.paramPtrs.actor = &actor
+	mmDeleteOrderTx.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmDeleteOrderTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Inspect(f func(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor)) *mStorageMockDeleteOrderTx {
+	if mmDeleteOrderTx.mock.inspectFuncDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("Inspect function is already set for StorageMock.DeleteOrderTx")
+	}
+
+	mmDeleteOrderTx.mock.inspectFuncDeleteOrderTx = f
+
+	return mmDeleteOrderTx
+}
+
+// Return sets up results that will be returned by Storage.DeleteOrderTx
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Return(err error) *StorageMock {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	if mmDeleteOrderTx.defaultExpectation == nil {
+		mmDeleteOrderTx.defaultExpectation = &StorageMockDeleteOrderTxExpectation{mock: mmDeleteOrderTx.mock}
+	}
+	mmDeleteOrderTx.defaultExpectation.results = &StorageMockDeleteOrderTxResults{err}
+	mmDeleteOrderTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrderTx.mock
+}
+
+// Set uses given function f to mock the Storage.DeleteOrderTx method
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Set(f func(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) (err error)) *StorageMock {
+	if mmDeleteOrderTx.defaultExpectation != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("Default expectation is already set for the Storage.DeleteOrderTx method")
+	}
+
+	if len(mmDeleteOrderTx.expectations) > 0 {
+		mmDeleteOrderTx.mock.t.Fatalf("Some expectations are already set for the Storage.DeleteOrderTx method")
+	}
+
+	mmDeleteOrderTx.mock.funcDeleteOrderTx = f
+	mmDeleteOrderTx.mock.funcDeleteOrderTxOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrderTx.mock
+}
+
+// When sets expectation for the Storage.DeleteOrderTx which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) When(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) *StorageMockDeleteOrderTxExpectation {
+	if mmDeleteOrderTx.mock.funcDeleteOrderTx != nil {
+		mmDeleteOrderTx.mock.t.Fatalf("StorageMock.DeleteOrderTx mock is already set by Set")
+	}
+
+	expectation := &StorageMockDeleteOrderTxExpectation{
+		mock:               mmDeleteOrderTx.mock,
+		params:             &StorageMockDeleteOrderTxParams{ctx, tx, id, actor},
+		expectationOrigins: StorageMockDeleteOrderTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOrderTx.expectations = append(mmDeleteOrderTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.DeleteOrderTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockDeleteOrderTxExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockDeleteOrderTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.DeleteOrderTx should be invoked
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Times(n uint64) *mStorageMockDeleteOrderTx {
+	if n == 0 {
+		mmDeleteOrderTx.mock.t.Fatalf("Times of StorageMock.DeleteOrderTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOrderTx.expectedInvocations, n)
+	mmDeleteOrderTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrderTx
+}
+
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) invocationsDone() bool {
+	if len(mmDeleteOrderTx.expectations) == 0 && mmDeleteOrderTx.defaultExpectation == nil && mmDeleteOrderTx.mock.funcDeleteOrderTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrderTx.mock.afterDeleteOrderTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrderTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOrderTx implements mm_storage.Storage
+func (mmDeleteOrderTx *StorageMock) DeleteOrderTx(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOrderTx.beforeDeleteOrderTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOrderTx.afterDeleteOrderTxCounter, 1)
+
+	mmDeleteOrderTx.t.Helper()
+
+	if mmDeleteOrderTx.inspectFuncDeleteOrderTx != nil {
+		mmDeleteOrderTx.inspectFuncDeleteOrderTx(ctx, tx, id, actor)
+	}
+
+	mm_params := StorageMockDeleteOrderTxParams{ctx, tx, id, actor}
+
+	// Record call args
+	mmDeleteOrderTx.DeleteOrderTxMock.mutex.Lock()
+	mmDeleteOrderTx.DeleteOrderTxMock.callArgs = append(mmDeleteOrderTx.DeleteOrderTxMock.callArgs, &mm_params)
+	mmDeleteOrderTx.DeleteOrderTxMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOrderTx.DeleteOrderTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockDeleteOrderTxParams{ctx, tx, id, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOrderTx.t.Errorf("StorageMock.DeleteOrderTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmDeleteOrderTx.t.Errorf("StorageMock.DeleteOrderTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteOrderTx.t.Errorf("StorageMock.DeleteOrderTx got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmDeleteOrderTx.t.Errorf("StorageMock.DeleteOrderTx got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOrderTx.t.Errorf("StorageMock.DeleteOrderTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOrderTx.DeleteOrderTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOrderTx.t.Fatal("No results are set for the StorageMock.DeleteOrderTx")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOrderTx.funcDeleteOrderTx != nil {
+		return mmDeleteOrderTx.funcDeleteOrderTx(ctx, tx, id, actor)
+	}
+	mmDeleteOrderTx.t.Fatalf("Unexpected call to StorageMock.DeleteOrderTx. %v %v %v %v", ctx, tx, id, actor)
+	return
+}
+
+// DeleteOrderTxAfterCounter returns a count of finished StorageMock.DeleteOrderTx invocations
+func (mmDeleteOrderTx *StorageMock) DeleteOrderTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrderTx.afterDeleteOrderTxCounter)
+}
+
+// DeleteOrderTxBeforeCounter returns a count of StorageMock.DeleteOrderTx invocations
+func (mmDeleteOrderTx *StorageMock) DeleteOrderTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrderTx.beforeDeleteOrderTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.DeleteOrderTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOrderTx *mStorageMockDeleteOrderTx) Calls() []*StorageMockDeleteOrderTxParams {
+	mmDeleteOrderTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockDeleteOrderTxParams, len(mmDeleteOrderTx.callArgs))
+	copy(argCopy, mmDeleteOrderTx.callArgs)
+
+	mmDeleteOrderTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOrderTxDone returns true if the count of the DeleteOrderTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockDeleteOrderTxDone() bool {
+	if m.DeleteOrderTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOrderTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOrderTxMock.invocationsDone()
+}
+
+// MinimockDeleteOrderTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockDeleteOrderTxInspect() {
+	for _, e := range m.DeleteOrderTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.DeleteOrderTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOrderTxCounter := mm_atomic.LoadUint64(&m.afterDeleteOrderTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOrderTxMock.defaultExpectation != nil && afterDeleteOrderTxCounter < 1 {
+		if m.DeleteOrderTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.DeleteOrderTx at\n%s", m.DeleteOrderTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.DeleteOrderTx at\n%s with params: %#v", m.DeleteOrderTxMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrderTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOrderTx != nil && afterDeleteOrderTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.DeleteOrderTx at\n%s", m.funcDeleteOrderTxOrigin)
+	}
+
+	if !m.DeleteOrderTxMock.invocationsDone() && afterDeleteOrderTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.DeleteOrderTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOrderTxMock.expectedInvocations), m.DeleteOrderTxMock.expectedInvocationsOrigin, afterDeleteOrderTxCounter)
+	}
+}
+
+type mStorageMockExistingOrderIDsTx struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockDeleteOrderExpectation
-	expectations       []*StorageMockDeleteOrderExpectation
+	defaultExpectation *StorageMockExistingOrderIDsTxExpectation
+	expectations       []*StorageMockExistingOrderIDsTxExpectation
 
-	callArgs []*StorageMockDeleteOrderParams
+	callArgs []*StorageMockExistingOrderIDsTxParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockDeleteOrderExpectation specifies expectation struct of the Storage.DeleteOrder
-type StorageMockDeleteOrderExpectation struct {
+// StorageMockExistingOrderIDsTxExpectation specifies expectation struct of the Storage.ExistingOrderIDsTx
+type StorageMockExistingOrderIDsTxExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockDeleteOrderParams
-	paramPtrs          *StorageMockDeleteOrderParamPtrs
-	expectationOrigins StorageMockDeleteOrderExpectationOrigins
-	results            *StorageMockDeleteOrderResults
+	params             *StorageMockExistingOrderIDsTxParams
+	paramPtrs          *StorageMockExistingOrderIDsTxParamPtrs
+	expectationOrigins StorageMockExistingOrderIDsTxExpectationOrigins
+	results            *StorageMockExistingOrderIDsTxResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockDeleteOrderParams contains parameters of the Storage.DeleteOrder
-type StorageMockDeleteOrderParams struct {
+// StorageMockExistingOrderIDsTxParams contains parameters of the Storage.ExistingOrderIDsTx
+type StorageMockExistingOrderIDsTxParams struct {
 	ctx context.Context
-	id  uint64
+	tx  pgx.Tx
+	ids []uint64
 }
 
-// StorageMockDeleteOrderParamPtrs contains pointers to parameters of the Storage.DeleteOrder
-type StorageMockDeleteOrderParamPtrs struct {
+// StorageMockExistingOrderIDsTxParamPtrs contains pointers to parameters of the Storage.ExistingOrderIDsTx
+type StorageMockExistingOrderIDsTxParamPtrs struct {
 	ctx *context.Context
-	id  *uint64
+	tx  *pgx.Tx
+	ids *[]uint64
 }
 
-// StorageMockDeleteOrderResults contains results of the Storage.DeleteOrder
-type StorageMockDeleteOrderResults struct {
+// StorageMockExistingOrderIDsTxResults contains results of the Storage.ExistingOrderIDsTx
+type StorageMockExistingOrderIDsTxResults struct {
+	m1 map[uint64]struct {
+	}
 	err error
 }
 
-// StorageMockDeleteOrderOrigins contains origins of expectations of the Storage.DeleteOrder
-type StorageMockDeleteOrderExpectationOrigins struct {
+// StorageMockExistingOrderIDsTxOrigins contains origins of expectations of the Storage.ExistingOrderIDsTx
+type StorageMockExistingOrderIDsTxExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originTx  string
+	originIds string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -157,292 +657,324 @@ type StorageMockDeleteOrderExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteOrder *mStorageMockDeleteOrder) Optional() *mStorageMockDeleteOrder {
-	mmDeleteOrder.optional = true
-	return mmDeleteOrder
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Optional() *mStorageMockExistingOrderIDsTx {
+	mmExistingOrderIDsTx.optional = true
+	return mmExistingOrderIDsTx
 }
 
-// Expect sets up expected params for Storage.DeleteOrder
-func (mmDeleteOrder *mStorageMockDeleteOrder) Expect(ctx context.Context, id uint64) *mStorageMockDeleteOrder {
-	if mmDeleteOrder.mock.funcDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Set")
+// Expect sets up expected params for Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Expect(ctx context.Context, tx pgx.Tx, ids []uint64) *mStorageMockExistingOrderIDsTx {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
 	}
 
-	if mmDeleteOrder.defaultExpectation == nil {
-		mmDeleteOrder.defaultExpectation = &StorageMockDeleteOrderExpectation{}
+	if mmExistingOrderIDsTx.defaultExpectation == nil {
+		mmExistingOrderIDsTx.defaultExpectation = &StorageMockExistingOrderIDsTxExpectation{}
 	}
 
-	if mmDeleteOrder.defaultExpectation.paramPtrs != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by ExpectParams functions")
+	if mmExistingOrderIDsTx.defaultExpectation.paramPtrs != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteOrder.defaultExpectation.params = &StorageMockDeleteOrderParams{ctx, id}
-	mmDeleteOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteOrder.expectations {
-		if minimock.Equal(e.params, mmDeleteOrder.defaultExpectation.params) {
-			mmDeleteOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrder.defaultExpectation.params)
+	mmExistingOrderIDsTx.defaultExpectation.params = &StorageMockExistingOrderIDsTxParams{ctx, tx, ids}
+	mmExistingOrderIDsTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExistingOrderIDsTx.expectations {
+		if minimock.Equal(e.params, mmExistingOrderIDsTx.defaultExpectation.params) {
+			mmExistingOrderIDsTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExistingOrderIDsTx.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteOrder
+	return mmExistingOrderIDsTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) ExpectCtxParam1(ctx context.Context) *mStorageMockExistingOrderIDsTx {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
+	}
+
+	if mmExistingOrderIDsTx.defaultExpectation == nil {
+		mmExistingOrderIDsTx.defaultExpectation = &StorageMockExistingOrderIDsTxExpectation{}
+	}
+
+	if mmExistingOrderIDsTx.defaultExpectation.params != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Expect")
+	}
+
+	if mmExistingOrderIDsTx.defaultExpectation.paramPtrs == nil {
+		mmExistingOrderIDsTx.defaultExpectation.paramPtrs = &StorageMockExistingOrderIDsTxParamPtrs{}
+	}
+	mmExistingOrderIDsTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExistingOrderIDsTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExistingOrderIDsTx
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.DeleteOrder
-func (mmDeleteOrder *mStorageMockDeleteOrder) ExpectCtxParam1(ctx context.Context) *mStorageMockDeleteOrder {
-	if mmDeleteOrder.mock.funcDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Set")
+// ExpectTxParam2 sets up expected param tx for Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockExistingOrderIDsTx {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
 	}
 
-	if mmDeleteOrder.defaultExpectation == nil {
-		mmDeleteOrder.defaultExpectation = &StorageMockDeleteOrderExpectation{}
+	if mmExistingOrderIDsTx.defaultExpectation == nil {
+		mmExistingOrderIDsTx.defaultExpectation = &StorageMockExistingOrderIDsTxExpectation{}
 	}
 
-	if mmDeleteOrder.defaultExpectation.params != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Expect")
+	if mmExistingOrderIDsTx.defaultExpectation.params != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Expect")
 	}
 
-	if mmDeleteOrder.defaultExpectation.paramPtrs == nil {
-		mmDeleteOrder.defaultExpectation.paramPtrs = &StorageMockDeleteOrderParamPtrs{}
+	if mmExistingOrderIDsTx.defaultExpectation.paramPtrs == nil {
+		mmExistingOrderIDsTx.defaultExpectation.paramPtrs = &StorageMockExistingOrderIDsTxParamPtrs{}
 	}
-	mmDeleteOrder.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmExistingOrderIDsTx.defaultExpectation.paramPtrs.tx = &tx
+	mmExistingOrderIDsTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
 
-	return mmDeleteOrder
+	return mmExistingOrderIDsTx
 }
 
-// ExpectIdParam2 sets up expected param id for Storage.DeleteOrder
-func (mmDeleteOrder *mStorageMockDeleteOrder) ExpectIdParam2(id uint64) *mStorageMockDeleteOrder {
-	if mmDeleteOrder.mock.funcDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Set")
+// ExpectIdsParam3 sets up expected param ids for Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) ExpectIdsParam3(ids []uint64) *mStorageMockExistingOrderIDsTx {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
 	}
 
-	if mmDeleteOrder.defaultExpectation == nil {
-		mmDeleteOrder.defaultExpectation = &StorageMockDeleteOrderExpectation{}
+	if mmExistingOrderIDsTx.defaultExpectation == nil {
+		mmExistingOrderIDsTx.defaultExpectation = &StorageMockExistingOrderIDsTxExpectation{}
 	}
 
-	if mmDeleteOrder.defaultExpectation.params != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Expect")
+	if mmExistingOrderIDsTx.defaultExpectation.params != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Expect")
 	}
 
-	if mmDeleteOrder.defaultExpectation.paramPtrs == nil {
-		mmDeleteOrder.defaultExpectation.paramPtrs = &StorageMockDeleteOrderParamPtrs{}
+	if mmExistingOrderIDsTx.defaultExpectation.paramPtrs == nil {
+		mmExistingOrderIDsTx.defaultExpectation.paramPtrs = &StorageMockExistingOrderIDsTxParamPtrs{}
 	}
-	mmDeleteOrder.defaultExpectation.paramPtrs.id = &id
-	mmDeleteOrder.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmExistingOrderIDsTx.defaultExpectation.paramPtrs.ids = &ids
+	mmExistingOrderIDsTx.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
 
-	return mmDeleteOrder
+	return mmExistingOrderIDsTx
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.DeleteOrder
-func (mmDeleteOrder *mStorageMockDeleteOrder) Inspect(f func(ctx context.Context, id uint64)) *mStorageMockDeleteOrder {
-	if mmDeleteOrder.mock.inspectFuncDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("Inspect function is already set for StorageMock.DeleteOrder")
+// Inspect accepts an inspector function that has same arguments as the Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Inspect(f func(ctx context.Context, tx pgx.Tx, ids []uint64)) *mStorageMockExistingOrderIDsTx {
+	if mmExistingOrderIDsTx.mock.inspectFuncExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("Inspect function is already set for StorageMock.ExistingOrderIDsTx")
 	}
 
-	mmDeleteOrder.mock.inspectFuncDeleteOrder = f
+	mmExistingOrderIDsTx.mock.inspectFuncExistingOrderIDsTx = f
 
-	return mmDeleteOrder
+	return mmExistingOrderIDsTx
 }
 
-// Return sets up results that will be returned by Storage.DeleteOrder
-func (mmDeleteOrder *mStorageMockDeleteOrder) Return(err error) *StorageMock {
-	if mmDeleteOrder.mock.funcDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Set")
+// Return sets up results that will be returned by Storage.ExistingOrderIDsTx
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Return(m1 map[uint64]struct {
+}, err error) *StorageMock {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
 	}
 
-	if mmDeleteOrder.defaultExpectation == nil {
-		mmDeleteOrder.defaultExpectation = &StorageMockDeleteOrderExpectation{mock: mmDeleteOrder.mock}
+	if mmExistingOrderIDsTx.defaultExpectation == nil {
+		mmExistingOrderIDsTx.defaultExpectation = &StorageMockExistingOrderIDsTxExpectation{mock: mmExistingOrderIDsTx.mock}
 	}
-	mmDeleteOrder.defaultExpectation.results = &StorageMockDeleteOrderResults{err}
-	mmDeleteOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteOrder.mock
+	mmExistingOrderIDsTx.defaultExpectation.results = &StorageMockExistingOrderIDsTxResults{m1, err}
+	mmExistingOrderIDsTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExistingOrderIDsTx.mock
 }
 
-// Set uses given function f to mock the Storage.DeleteOrder method
-func (mmDeleteOrder *mStorageMockDeleteOrder) Set(f func(ctx context.Context, id uint64) (err error)) *StorageMock {
-	if mmDeleteOrder.defaultExpectation != nil {
-		mmDeleteOrder.mock.t.Fatalf("Default expectation is already set for the Storage.DeleteOrder method")
+// Set uses given function f to mock the Storage.ExistingOrderIDsTx method
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Set(f func(ctx context.Context, tx pgx.Tx, ids []uint64) (m1 map[uint64]struct {
+}, err error)) *StorageMock {
+	if mmExistingOrderIDsTx.defaultExpectation != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("Default expectation is already set for the Storage.ExistingOrderIDsTx method")
 	}
 
-	if len(mmDeleteOrder.expectations) > 0 {
-		mmDeleteOrder.mock.t.Fatalf("Some expectations are already set for the Storage.DeleteOrder method")
+	if len(mmExistingOrderIDsTx.expectations) > 0 {
+		mmExistingOrderIDsTx.mock.t.Fatalf("Some expectations are already set for the Storage.ExistingOrderIDsTx method")
 	}
 
-	mmDeleteOrder.mock.funcDeleteOrder = f
-	mmDeleteOrder.mock.funcDeleteOrderOrigin = minimock.CallerInfo(1)
-	return mmDeleteOrder.mock
+	mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx = f
+	mmExistingOrderIDsTx.mock.funcExistingOrderIDsTxOrigin = minimock.CallerInfo(1)
+	return mmExistingOrderIDsTx.mock
 }
 
-// When sets expectation for the Storage.DeleteOrder which will trigger the result defined by the following
+// When sets expectation for the Storage.ExistingOrderIDsTx which will trigger the result defined by the following
 // Then helper
-func (mmDeleteOrder *mStorageMockDeleteOrder) When(ctx context.Context, id uint64) *StorageMockDeleteOrderExpectation {
-	if mmDeleteOrder.mock.funcDeleteOrder != nil {
-		mmDeleteOrder.mock.t.Fatalf("StorageMock.DeleteOrder mock is already set by Set")
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) When(ctx context.Context, tx pgx.Tx, ids []uint64) *StorageMockExistingOrderIDsTxExpectation {
+	if mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.mock.t.Fatalf("StorageMock.ExistingOrderIDsTx mock is already set by Set")
 	}
 
-	expectation := &StorageMockDeleteOrderExpectation{
-		mock:               mmDeleteOrder.mock,
-		params:             &StorageMockDeleteOrderParams{ctx, id},
-		expectationOrigins: StorageMockDeleteOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockExistingOrderIDsTxExpectation{
+		mock:               mmExistingOrderIDsTx.mock,
+		params:             &StorageMockExistingOrderIDsTxParams{ctx, tx, ids},
+		expectationOrigins: StorageMockExistingOrderIDsTxExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteOrder.expectations = append(mmDeleteOrder.expectations, expectation)
+	mmExistingOrderIDsTx.expectations = append(mmExistingOrderIDsTx.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.DeleteOrder return parameters for the expectation previously defined by the When method
-func (e *StorageMockDeleteOrderExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockDeleteOrderResults{err}
+// Then sets up Storage.ExistingOrderIDsTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockExistingOrderIDsTxExpectation) Then(m1 map[uint64]struct {
+}, err error) *StorageMock {
+	e.results = &StorageMockExistingOrderIDsTxResults{m1, err}
 	return e.mock
 }
 
-// Times sets number of times Storage.DeleteOrder should be invoked
-func (mmDeleteOrder *mStorageMockDeleteOrder) Times(n uint64) *mStorageMockDeleteOrder {
+// Times sets number of times Storage.ExistingOrderIDsTx should be invoked
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Times(n uint64) *mStorageMockExistingOrderIDsTx {
 	if n == 0 {
-		mmDeleteOrder.mock.t.Fatalf("Times of StorageMock.DeleteOrder mock can not be zero")
+		mmExistingOrderIDsTx.mock.t.Fatalf("Times of StorageMock.ExistingOrderIDsTx mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteOrder.expectedInvocations, n)
-	mmDeleteOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteOrder
+	mm_atomic.StoreUint64(&mmExistingOrderIDsTx.expectedInvocations, n)
+	mmExistingOrderIDsTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExistingOrderIDsTx
 }
 
-func (mmDeleteOrder *mStorageMockDeleteOrder) invocationsDone() bool {
-	if len(mmDeleteOrder.expectations) == 0 && mmDeleteOrder.defaultExpectation == nil && mmDeleteOrder.mock.funcDeleteOrder == nil {
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) invocationsDone() bool {
+	if len(mmExistingOrderIDsTx.expectations) == 0 && mmExistingOrderIDsTx.defaultExpectation == nil && mmExistingOrderIDsTx.mock.funcExistingOrderIDsTx == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrder.mock.afterDeleteOrderCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrder.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmExistingOrderIDsTx.mock.afterExistingOrderIDsTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExistingOrderIDsTx.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteOrder implements mm_storage.Storage
-func (mmDeleteOrder *StorageMock) DeleteOrder(ctx context.Context, id uint64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteOrder.beforeDeleteOrderCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteOrder.afterDeleteOrderCounter, 1)
+// ExistingOrderIDsTx implements mm_storage.Storage
+func (mmExistingOrderIDsTx *StorageMock) ExistingOrderIDsTx(ctx context.Context, tx pgx.Tx, ids []uint64) (m1 map[uint64]struct {
+}, err error) {
+	mm_atomic.AddUint64(&mmExistingOrderIDsTx.beforeExistingOrderIDsTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmExistingOrderIDsTx.afterExistingOrderIDsTxCounter, 1)
 
-	mmDeleteOrder.t.Helper()
+	mmExistingOrderIDsTx.t.Helper()
 
-	if mmDeleteOrder.inspectFuncDeleteOrder != nil {
-		mmDeleteOrder.inspectFuncDeleteOrder(ctx, id)
+	if mmExistingOrderIDsTx.inspectFuncExistingOrderIDsTx != nil {
+		mmExistingOrderIDsTx.inspectFuncExistingOrderIDsTx(ctx, tx, ids)
 	}
 
-	mm_params := StorageMockDeleteOrderParams{ctx, id}
+	mm_params := StorageMockExistingOrderIDsTxParams{ctx, tx, ids}
 
 	// Record call args
-	mmDeleteOrder.DeleteOrderMock.mutex.Lock()
-	mmDeleteOrder.DeleteOrderMock.callArgs = append(mmDeleteOrder.DeleteOrderMock.callArgs, &mm_params)
-	mmDeleteOrder.DeleteOrderMock.mutex.Unlock()
+	mmExistingOrderIDsTx.ExistingOrderIDsTxMock.mutex.Lock()
+	mmExistingOrderIDsTx.ExistingOrderIDsTxMock.callArgs = append(mmExistingOrderIDsTx.ExistingOrderIDsTxMock.callArgs, &mm_params)
+	mmExistingOrderIDsTx.ExistingOrderIDsTxMock.mutex.Unlock()
 
-	for _, e := range mmDeleteOrder.DeleteOrderMock.expectations {
+	for _, e := range mmExistingOrderIDsTx.ExistingOrderIDsTxMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.m1, e.results.err
 		}
 	}
 
-	if mmDeleteOrder.DeleteOrderMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteOrder.DeleteOrderMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteOrder.DeleteOrderMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteOrder.DeleteOrderMock.defaultExpectation.paramPtrs
+	if mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.params
+		mm_want_ptrs := mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockDeleteOrderParams{ctx, id}
+		mm_got := StorageMockExistingOrderIDsTxParams{ctx, tx, ids}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteOrder.t.Errorf("StorageMock.DeleteOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmExistingOrderIDsTx.t.Errorf("StorageMock.ExistingOrderIDsTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteOrder.t.Errorf("StorageMock.DeleteOrder got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmExistingOrderIDsTx.t.Errorf("StorageMock.ExistingOrderIDsTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmExistingOrderIDsTx.t.Errorf("StorageMock.ExistingOrderIDsTx got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteOrder.t.Errorf("StorageMock.DeleteOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmExistingOrderIDsTx.t.Errorf("StorageMock.ExistingOrderIDsTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteOrder.DeleteOrderMock.defaultExpectation.results
+		mm_results := mmExistingOrderIDsTx.ExistingOrderIDsTxMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteOrder.t.Fatal("No results are set for the StorageMock.DeleteOrder")
+			mmExistingOrderIDsTx.t.Fatal("No results are set for the StorageMock.ExistingOrderIDsTx")
 		}
-		return (*mm_results).err
+		return (*mm_results).m1, (*mm_results).err
 	}
-	if mmDeleteOrder.funcDeleteOrder != nil {
-		return mmDeleteOrder.funcDeleteOrder(ctx, id)
+	if mmExistingOrderIDsTx.funcExistingOrderIDsTx != nil {
+		return mmExistingOrderIDsTx.funcExistingOrderIDsTx(ctx, tx, ids)
 	}
-	mmDeleteOrder.t.Fatalf("Unexpected call to StorageMock.DeleteOrder. %v %v", ctx, id)
+	mmExistingOrderIDsTx.t.Fatalf("Unexpected call to StorageMock.ExistingOrderIDsTx. %v %v %v", ctx, tx, ids)
 	return
 }
 
-// DeleteOrderAfterCounter returns a count of finished StorageMock.DeleteOrder invocations
-func (mmDeleteOrder *StorageMock) DeleteOrderAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteOrder.afterDeleteOrderCounter)
+// ExistingOrderIDsTxAfterCounter returns a count of finished StorageMock.ExistingOrderIDsTx invocations
+func (mmExistingOrderIDsTx *StorageMock) ExistingOrderIDsTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExistingOrderIDsTx.afterExistingOrderIDsTxCounter)
 }
 
-// DeleteOrderBeforeCounter returns a count of StorageMock.DeleteOrder invocations
-func (mmDeleteOrder *StorageMock) DeleteOrderBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteOrder.beforeDeleteOrderCounter)
+// ExistingOrderIDsTxBeforeCounter returns a count of StorageMock.ExistingOrderIDsTx invocations
+func (mmExistingOrderIDsTx *StorageMock) ExistingOrderIDsTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExistingOrderIDsTx.beforeExistingOrderIDsTxCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.DeleteOrder.
+// Calls returns a list of arguments used in each call to StorageMock.ExistingOrderIDsTx.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteOrder *mStorageMockDeleteOrder) Calls() []*StorageMockDeleteOrderParams {
-	mmDeleteOrder.mutex.RLock()
+func (mmExistingOrderIDsTx *mStorageMockExistingOrderIDsTx) Calls() []*StorageMockExistingOrderIDsTxParams {
+	mmExistingOrderIDsTx.mutex.RLock()
 
-	argCopy := make([]*StorageMockDeleteOrderParams, len(mmDeleteOrder.callArgs))
-	copy(argCopy, mmDeleteOrder.callArgs)
+	argCopy := make([]*StorageMockExistingOrderIDsTxParams, len(mmExistingOrderIDsTx.callArgs))
+	copy(argCopy, mmExistingOrderIDsTx.callArgs)
 
-	mmDeleteOrder.mutex.RUnlock()
+	mmExistingOrderIDsTx.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteOrderDone returns true if the count of the DeleteOrder invocations corresponds
+// MinimockExistingOrderIDsTxDone returns true if the count of the ExistingOrderIDsTx invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockDeleteOrderDone() bool {
-	if m.DeleteOrderMock.optional {
+func (m *StorageMock) MinimockExistingOrderIDsTxDone() bool {
+	if m.ExistingOrderIDsTxMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteOrderMock.expectations {
+	for _, e := range m.ExistingOrderIDsTxMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteOrderMock.invocationsDone()
+	return m.ExistingOrderIDsTxMock.invocationsDone()
 }
 
-// MinimockDeleteOrderInspect logs each unmet expectation
-func (m *StorageMock) MinimockDeleteOrderInspect() {
-	for _, e := range m.DeleteOrderMock.expectations {
+// MinimockExistingOrderIDsTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockExistingOrderIDsTxInspect() {
+	for _, e := range m.ExistingOrderIDsTxMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.DeleteOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.ExistingOrderIDsTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteOrderCounter := mm_atomic.LoadUint64(&m.afterDeleteOrderCounter)
+	afterExistingOrderIDsTxCounter := mm_atomic.LoadUint64(&m.afterExistingOrderIDsTxCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteOrderMock.defaultExpectation != nil && afterDeleteOrderCounter < 1 {
-		if m.DeleteOrderMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.DeleteOrder at\n%s", m.DeleteOrderMock.defaultExpectation.returnOrigin)
+	if m.ExistingOrderIDsTxMock.defaultExpectation != nil && afterExistingOrderIDsTxCounter < 1 {
+		if m.ExistingOrderIDsTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ExistingOrderIDsTx at\n%s", m.ExistingOrderIDsTxMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.DeleteOrder at\n%s with params: %#v", m.DeleteOrderMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrderMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.ExistingOrderIDsTx at\n%s with params: %#v", m.ExistingOrderIDsTxMock.defaultExpectation.expectationOrigins.origin, *m.ExistingOrderIDsTxMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteOrder != nil && afterDeleteOrderCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.DeleteOrder at\n%s", m.funcDeleteOrderOrigin)
+	if m.funcExistingOrderIDsTx != nil && afterExistingOrderIDsTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ExistingOrderIDsTx at\n%s", m.funcExistingOrderIDsTxOrigin)
 	}
 
-	if !m.DeleteOrderMock.invocationsDone() && afterDeleteOrderCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.DeleteOrder at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteOrderMock.expectedInvocations), m.DeleteOrderMock.expectedInvocationsOrigin, afterDeleteOrderCounter)
+	if !m.ExistingOrderIDsTxMock.invocationsDone() && afterExistingOrderIDsTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ExistingOrderIDsTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExistingOrderIDsTxMock.expectedInvocations), m.ExistingOrderIDsTxMock.expectedInvocationsOrigin, afterExistingOrderIDsTxCounter)
 	}
 }
 
@@ -472,16 +1004,18 @@ type StorageMockGetHistoryExpectation struct {
 
 // StorageMockGetHistoryParams contains parameters of the Storage.GetHistory
 type StorageMockGetHistoryParams struct {
-	ctx   context.Context
-	page  uint32
-	count uint32
+	ctx    context.Context
+	filter models.HistoryFilter
+	page   uint32
+	count  uint32
 }
 
 // StorageMockGetHistoryParamPtrs contains pointers to parameters of the Storage.GetHistory
 type StorageMockGetHistoryParamPtrs struct {
-	ctx   *context.Context
-	page  *uint32
-	count *uint32
+	ctx    *context.Context
+	filter *models.HistoryFilter
+	page   *uint32
+	count  *uint32
 }
 
 // StorageMockGetHistoryResults contains results of the Storage.GetHistory
@@ -492,10 +1026,11 @@ type StorageMockGetHistoryResults struct {
 
 // StorageMockGetHistoryOrigins contains origins of expectations of the Storage.GetHistory
 type StorageMockGetHistoryExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originPage  string
-	originCount string
+	origin       string
+	originCtx    string
+	originFilter string
+	originPage   string
+	originCount  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -509,7 +1044,7 @@ func (mmGetHistory *mStorageMockGetHistory) Optional() *mStorageMockGetHistory {
 }
 
 // Expect sets up expected params for Storage.GetHistory
-func (mmGetHistory *mStorageMockGetHistory) Expect(ctx context.Context, page uint32, count uint32) *mStorageMockGetHistory {
+func (mmGetHistory *mStorageMockGetHistory) Expect(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) *mStorageMockGetHistory {
 	if mmGetHistory.mock.funcGetHistory != nil {
 		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Set")
 	}
@@ -522,7 +1057,7 @@ func (mmGetHistory *mStorageMockGetHistory) Expect(ctx context.Context, page uin
 		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by ExpectParams functions")
 	}
 
-	mmGetHistory.defaultExpectation.params = &StorageMockGetHistoryParams{ctx, page, count}
+	mmGetHistory.defaultExpectation.params = &StorageMockGetHistoryParams{ctx, filter, page, count}
 	mmGetHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetHistory.expectations {
 		if minimock.Equal(e.params, mmGetHistory.defaultExpectation.params) {
@@ -556,8 +1091,31 @@ func (mmGetHistory *mStorageMockGetHistory) ExpectCtxParam1(ctx context.Context)
 	return mmGetHistory
 }
 
-// ExpectPageParam2 sets up expected param page for Storage.GetHistory
-func (mmGetHistory *mStorageMockGetHistory) ExpectPageParam2(page uint32) *mStorageMockGetHistory {
+// ExpectFilterParam2 sets up expected param filter for Storage.GetHistory
+func (mmGetHistory *mStorageMockGetHistory) ExpectFilterParam2(filter models.HistoryFilter) *mStorageMockGetHistory {
+	if mmGetHistory.mock.funcGetHistory != nil {
+		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Set")
+	}
+
+	if mmGetHistory.defaultExpectation == nil {
+		mmGetHistory.defaultExpectation = &StorageMockGetHistoryExpectation{}
+	}
+
+	if mmGetHistory.defaultExpectation.params != nil {
+		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Expect")
+	}
+
+	if mmGetHistory.defaultExpectation.paramPtrs == nil {
+		mmGetHistory.defaultExpectation.paramPtrs = &StorageMockGetHistoryParamPtrs{}
+	}
+	mmGetHistory.defaultExpectation.paramPtrs.filter = &filter
+	mmGetHistory.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetHistory
+}
+
+// ExpectPageParam3 sets up expected param page for Storage.GetHistory
+func (mmGetHistory *mStorageMockGetHistory) ExpectPageParam3(page uint32) *mStorageMockGetHistory {
 	if mmGetHistory.mock.funcGetHistory != nil {
 		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Set")
 	}
@@ -579,8 +1137,8 @@ func (mmGetHistory *mStorageMockGetHistory) ExpectPageParam2(page uint32) *mStor
 	return mmGetHistory
 }
 
-// ExpectCountParam3 sets up expected param count for Storage.GetHistory
-func (mmGetHistory *mStorageMockGetHistory) ExpectCountParam3(count uint32) *mStorageMockGetHistory {
+// ExpectCountParam4 sets up expected param count for Storage.GetHistory
+func (mmGetHistory *mStorageMockGetHistory) ExpectCountParam4(count uint32) *mStorageMockGetHistory {
 	if mmGetHistory.mock.funcGetHistory != nil {
 		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Set")
 	}
@@ -603,7 +1161,7 @@ func (mmGetHistory *mStorageMockGetHistory) ExpectCountParam3(count uint32) *mSt
 }
 
 // Inspect accepts an inspector function that has same arguments as the Storage.GetHistory
-func (mmGetHistory *mStorageMockGetHistory) Inspect(f func(ctx context.Context, page uint32, count uint32)) *mStorageMockGetHistory {
+func (mmGetHistory *mStorageMockGetHistory) Inspect(f func(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32)) *mStorageMockGetHistory {
 	if mmGetHistory.mock.inspectFuncGetHistory != nil {
 		mmGetHistory.mock.t.Fatalf("Inspect function is already set for StorageMock.GetHistory")
 	}
@@ -628,7 +1186,7 @@ func (mmGetHistory *mStorageMockGetHistory) Return(oa1 []models.OrderHistory, er
 }
 
 // Set uses given function f to mock the Storage.GetHistory method
-func (mmGetHistory *mStorageMockGetHistory) Set(f func(ctx context.Context, page uint32, count uint32) (oa1 []models.OrderHistory, err error)) *StorageMock {
+func (mmGetHistory *mStorageMockGetHistory) Set(f func(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) (oa1 []models.OrderHistory, err error)) *StorageMock {
 	if mmGetHistory.defaultExpectation != nil {
 		mmGetHistory.mock.t.Fatalf("Default expectation is already set for the Storage.GetHistory method")
 	}
@@ -644,14 +1202,14 @@ func (mmGetHistory *mStorageMockGetHistory) Set(f func(ctx context.Context, page
 
 // When sets expectation for the Storage.GetHistory which will trigger the result defined by the following
 // Then helper
-func (mmGetHistory *mStorageMockGetHistory) When(ctx context.Context, page uint32, count uint32) *StorageMockGetHistoryExpectation {
+func (mmGetHistory *mStorageMockGetHistory) When(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) *StorageMockGetHistoryExpectation {
 	if mmGetHistory.mock.funcGetHistory != nil {
 		mmGetHistory.mock.t.Fatalf("StorageMock.GetHistory mock is already set by Set")
 	}
 
 	expectation := &StorageMockGetHistoryExpectation{
 		mock:               mmGetHistory.mock,
-		params:             &StorageMockGetHistoryParams{ctx, page, count},
+		params:             &StorageMockGetHistoryParams{ctx, filter, page, count},
 		expectationOrigins: StorageMockGetHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetHistory.expectations = append(mmGetHistory.expectations, expectation)
@@ -686,17 +1244,17 @@ func (mmGetHistory *mStorageMockGetHistory) invocationsDone() bool {
 }
 
 // GetHistory implements mm_storage.Storage
-func (mmGetHistory *StorageMock) GetHistory(ctx context.Context, page uint32, count uint32) (oa1 []models.OrderHistory, err error) {
+func (mmGetHistory *StorageMock) GetHistory(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) (oa1 []models.OrderHistory, err error) {
 	mm_atomic.AddUint64(&mmGetHistory.beforeGetHistoryCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetHistory.afterGetHistoryCounter, 1)
 
 	mmGetHistory.t.Helper()
 
 	if mmGetHistory.inspectFuncGetHistory != nil {
-		mmGetHistory.inspectFuncGetHistory(ctx, page, count)
+		mmGetHistory.inspectFuncGetHistory(ctx, filter, page, count)
 	}
 
-	mm_params := StorageMockGetHistoryParams{ctx, page, count}
+	mm_params := StorageMockGetHistoryParams{ctx, filter, page, count}
 
 	// Record call args
 	mmGetHistory.GetHistoryMock.mutex.Lock()
@@ -715,7 +1273,7 @@ func (mmGetHistory *StorageMock) GetHistory(ctx context.Context, page uint32, co
 		mm_want := mmGetHistory.GetHistoryMock.defaultExpectation.params
 		mm_want_ptrs := mmGetHistory.GetHistoryMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockGetHistoryParams{ctx, page, count}
+		mm_got := StorageMockGetHistoryParams{ctx, filter, page, count}
 
 		if mm_want_ptrs != nil {
 
@@ -724,6 +1282,11 @@ func (mmGetHistory *StorageMock) GetHistory(ctx context.Context, page uint32, co
 					mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetHistory.t.Errorf("StorageMock.GetHistory got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
 			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
 				mmGetHistory.t.Errorf("StorageMock.GetHistory got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetHistory.GetHistoryMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
@@ -746,9 +1309,9 @@ func (mmGetHistory *StorageMock) GetHistory(ctx context.Context, page uint32, co
 		return (*mm_results).oa1, (*mm_results).err
 	}
 	if mmGetHistory.funcGetHistory != nil {
-		return mmGetHistory.funcGetHistory(ctx, page, count)
+		return mmGetHistory.funcGetHistory(ctx, filter, page, count)
 	}
-	mmGetHistory.t.Fatalf("Unexpected call to StorageMock.GetHistory. %v %v %v", ctx, page, count)
+	mmGetHistory.t.Fatalf("Unexpected call to StorageMock.GetHistory. %v %v %v %v", ctx, filter, page, count)
 	return
 }
 
@@ -820,6 +1383,380 @@ func (m *StorageMock) MinimockGetHistoryInspect() {
 	}
 }
 
+type mStorageMockGetImportJobForUpdateTx struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetImportJobForUpdateTxExpectation
+	expectations       []*StorageMockGetImportJobForUpdateTxExpectation
+
+	callArgs []*StorageMockGetImportJobForUpdateTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetImportJobForUpdateTxExpectation specifies expectation struct of the Storage.GetImportJobForUpdateTx
+type StorageMockGetImportJobForUpdateTxExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetImportJobForUpdateTxParams
+	paramPtrs          *StorageMockGetImportJobForUpdateTxParamPtrs
+	expectationOrigins StorageMockGetImportJobForUpdateTxExpectationOrigins
+	results            *StorageMockGetImportJobForUpdateTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetImportJobForUpdateTxParams contains parameters of the Storage.GetImportJobForUpdateTx
+type StorageMockGetImportJobForUpdateTxParams struct {
+	ctx context.Context
+	tx  pgx.Tx
+	id  string
+}
+
+// StorageMockGetImportJobForUpdateTxParamPtrs contains pointers to parameters of the Storage.GetImportJobForUpdateTx
+type StorageMockGetImportJobForUpdateTxParamPtrs struct {
+	ctx *context.Context
+	tx  *pgx.Tx
+	id  *string
+}
+
+// StorageMockGetImportJobForUpdateTxResults contains results of the Storage.GetImportJobForUpdateTx
+type StorageMockGetImportJobForUpdateTxResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// StorageMockGetImportJobForUpdateTxOrigins contains origins of expectations of the Storage.GetImportJobForUpdateTx
+type StorageMockGetImportJobForUpdateTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originTx  string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Optional() *mStorageMockGetImportJobForUpdateTx {
+	mmGetImportJobForUpdateTx.optional = true
+	return mmGetImportJobForUpdateTx
+}
+
+// Expect sets up expected params for Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Expect(ctx context.Context, tx pgx.Tx, id string) *mStorageMockGetImportJobForUpdateTx {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation = &StorageMockGetImportJobForUpdateTxExpectation{}
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by ExpectParams functions")
+	}
+
+	mmGetImportJobForUpdateTx.defaultExpectation.params = &StorageMockGetImportJobForUpdateTxParams{ctx, tx, id}
+	mmGetImportJobForUpdateTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetImportJobForUpdateTx.expectations {
+		if minimock.Equal(e.params, mmGetImportJobForUpdateTx.defaultExpectation.params) {
+			mmGetImportJobForUpdateTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetImportJobForUpdateTx.defaultExpectation.params)
+		}
+	}
+
+	return mmGetImportJobForUpdateTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) ExpectCtxParam1(ctx context.Context) *mStorageMockGetImportJobForUpdateTx {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation = &StorageMockGetImportJobForUpdateTxExpectation{}
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.params != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Expect")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetImportJobForUpdateTxParamPtrs{}
+	}
+	mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetImportJobForUpdateTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetImportJobForUpdateTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockGetImportJobForUpdateTx {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation = &StorageMockGetImportJobForUpdateTxExpectation{}
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.params != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Expect")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetImportJobForUpdateTxParamPtrs{}
+	}
+	mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs.tx = &tx
+	mmGetImportJobForUpdateTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmGetImportJobForUpdateTx
+}
+
+// ExpectIdParam3 sets up expected param id for Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) ExpectIdParam3(id string) *mStorageMockGetImportJobForUpdateTx {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation = &StorageMockGetImportJobForUpdateTxExpectation{}
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.params != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Expect")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetImportJobForUpdateTxParamPtrs{}
+	}
+	mmGetImportJobForUpdateTx.defaultExpectation.paramPtrs.id = &id
+	mmGetImportJobForUpdateTx.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetImportJobForUpdateTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Inspect(f func(ctx context.Context, tx pgx.Tx, id string)) *mStorageMockGetImportJobForUpdateTx {
+	if mmGetImportJobForUpdateTx.mock.inspectFuncGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("Inspect function is already set for StorageMock.GetImportJobForUpdateTx")
+	}
+
+	mmGetImportJobForUpdateTx.mock.inspectFuncGetImportJobForUpdateTx = f
+
+	return mmGetImportJobForUpdateTx
+}
+
+// Return sets up results that will be returned by Storage.GetImportJobForUpdateTx
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Return(i1 models.ImportJob, err error) *StorageMock {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	if mmGetImportJobForUpdateTx.defaultExpectation == nil {
+		mmGetImportJobForUpdateTx.defaultExpectation = &StorageMockGetImportJobForUpdateTxExpectation{mock: mmGetImportJobForUpdateTx.mock}
+	}
+	mmGetImportJobForUpdateTx.defaultExpectation.results = &StorageMockGetImportJobForUpdateTxResults{i1, err}
+	mmGetImportJobForUpdateTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetImportJobForUpdateTx.mock
+}
+
+// Set uses given function f to mock the Storage.GetImportJobForUpdateTx method
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Set(f func(ctx context.Context, tx pgx.Tx, id string) (i1 models.ImportJob, err error)) *StorageMock {
+	if mmGetImportJobForUpdateTx.defaultExpectation != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("Default expectation is already set for the Storage.GetImportJobForUpdateTx method")
+	}
+
+	if len(mmGetImportJobForUpdateTx.expectations) > 0 {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("Some expectations are already set for the Storage.GetImportJobForUpdateTx method")
+	}
+
+	mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx = f
+	mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTxOrigin = minimock.CallerInfo(1)
+	return mmGetImportJobForUpdateTx.mock
+}
+
+// When sets expectation for the Storage.GetImportJobForUpdateTx which will trigger the result defined by the following
+// Then helper
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) When(ctx context.Context, tx pgx.Tx, id string) *StorageMockGetImportJobForUpdateTxExpectation {
+	if mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("StorageMock.GetImportJobForUpdateTx mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetImportJobForUpdateTxExpectation{
+		mock:               mmGetImportJobForUpdateTx.mock,
+		params:             &StorageMockGetImportJobForUpdateTxParams{ctx, tx, id},
+		expectationOrigins: StorageMockGetImportJobForUpdateTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetImportJobForUpdateTx.expectations = append(mmGetImportJobForUpdateTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetImportJobForUpdateTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetImportJobForUpdateTxExpectation) Then(i1 models.ImportJob, err error) *StorageMock {
+	e.results = &StorageMockGetImportJobForUpdateTxResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetImportJobForUpdateTx should be invoked
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Times(n uint64) *mStorageMockGetImportJobForUpdateTx {
+	if n == 0 {
+		mmGetImportJobForUpdateTx.mock.t.Fatalf("Times of StorageMock.GetImportJobForUpdateTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetImportJobForUpdateTx.expectedInvocations, n)
+	mmGetImportJobForUpdateTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetImportJobForUpdateTx
+}
+
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) invocationsDone() bool {
+	if len(mmGetImportJobForUpdateTx.expectations) == 0 && mmGetImportJobForUpdateTx.defaultExpectation == nil && mmGetImportJobForUpdateTx.mock.funcGetImportJobForUpdateTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetImportJobForUpdateTx.mock.afterGetImportJobForUpdateTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetImportJobForUpdateTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetImportJobForUpdateTx implements mm_storage.Storage
+func (mmGetImportJobForUpdateTx *StorageMock) GetImportJobForUpdateTx(ctx context.Context, tx pgx.Tx, id string) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmGetImportJobForUpdateTx.beforeGetImportJobForUpdateTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetImportJobForUpdateTx.afterGetImportJobForUpdateTxCounter, 1)
+
+	mmGetImportJobForUpdateTx.t.Helper()
+
+	if mmGetImportJobForUpdateTx.inspectFuncGetImportJobForUpdateTx != nil {
+		mmGetImportJobForUpdateTx.inspectFuncGetImportJobForUpdateTx(ctx, tx, id)
+	}
+
+	mm_params := StorageMockGetImportJobForUpdateTxParams{ctx, tx, id}
+
+	// Record call args
+	mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.mutex.Lock()
+	mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.callArgs = append(mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.callArgs, &mm_params)
+	mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.mutex.Unlock()
+
+	for _, e := range mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.params
+		mm_want_ptrs := mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetImportJobForUpdateTxParams{ctx, tx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetImportJobForUpdateTx.t.Errorf("StorageMock.GetImportJobForUpdateTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmGetImportJobForUpdateTx.t.Errorf("StorageMock.GetImportJobForUpdateTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetImportJobForUpdateTx.t.Errorf("StorageMock.GetImportJobForUpdateTx got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetImportJobForUpdateTx.t.Errorf("StorageMock.GetImportJobForUpdateTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetImportJobForUpdateTx.GetImportJobForUpdateTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetImportJobForUpdateTx.t.Fatal("No results are set for the StorageMock.GetImportJobForUpdateTx")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetImportJobForUpdateTx.funcGetImportJobForUpdateTx != nil {
+		return mmGetImportJobForUpdateTx.funcGetImportJobForUpdateTx(ctx, tx, id)
+	}
+	mmGetImportJobForUpdateTx.t.Fatalf("Unexpected call to StorageMock.GetImportJobForUpdateTx. %v %v %v", ctx, tx, id)
+	return
+}
+
+// GetImportJobForUpdateTxAfterCounter returns a count of finished StorageMock.GetImportJobForUpdateTx invocations
+func (mmGetImportJobForUpdateTx *StorageMock) GetImportJobForUpdateTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetImportJobForUpdateTx.afterGetImportJobForUpdateTxCounter)
+}
+
+// GetImportJobForUpdateTxBeforeCounter returns a count of StorageMock.GetImportJobForUpdateTx invocations
+func (mmGetImportJobForUpdateTx *StorageMock) GetImportJobForUpdateTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetImportJobForUpdateTx.beforeGetImportJobForUpdateTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetImportJobForUpdateTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetImportJobForUpdateTx *mStorageMockGetImportJobForUpdateTx) Calls() []*StorageMockGetImportJobForUpdateTxParams {
+	mmGetImportJobForUpdateTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetImportJobForUpdateTxParams, len(mmGetImportJobForUpdateTx.callArgs))
+	copy(argCopy, mmGetImportJobForUpdateTx.callArgs)
+
+	mmGetImportJobForUpdateTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetImportJobForUpdateTxDone returns true if the count of the GetImportJobForUpdateTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetImportJobForUpdateTxDone() bool {
+	if m.GetImportJobForUpdateTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetImportJobForUpdateTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetImportJobForUpdateTxMock.invocationsDone()
+}
+
+// MinimockGetImportJobForUpdateTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetImportJobForUpdateTxInspect() {
+	for _, e := range m.GetImportJobForUpdateTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetImportJobForUpdateTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetImportJobForUpdateTxCounter := mm_atomic.LoadUint64(&m.afterGetImportJobForUpdateTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetImportJobForUpdateTxMock.defaultExpectation != nil && afterGetImportJobForUpdateTxCounter < 1 {
+		if m.GetImportJobForUpdateTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetImportJobForUpdateTx at\n%s", m.GetImportJobForUpdateTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetImportJobForUpdateTx at\n%s with params: %#v", m.GetImportJobForUpdateTxMock.defaultExpectation.expectationOrigins.origin, *m.GetImportJobForUpdateTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetImportJobForUpdateTx != nil && afterGetImportJobForUpdateTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetImportJobForUpdateTx at\n%s", m.funcGetImportJobForUpdateTxOrigin)
+	}
+
+	if !m.GetImportJobForUpdateTxMock.invocationsDone() && afterGetImportJobForUpdateTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetImportJobForUpdateTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetImportJobForUpdateTxMock.expectedInvocations), m.GetImportJobForUpdateTxMock.expectedInvocationsOrigin, afterGetImportJobForUpdateTxCounter)
+	}
+}
+
 type mStorageMockGetOrder struct {
 	optional           bool
 	mock               *StorageMock
@@ -1163,50 +2100,56 @@ func (m *StorageMock) MinimockGetOrderInspect() {
 	}
 }
 
-type mStorageMockListOrders struct {
+type mStorageMockGetOrderForUpdateTx struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockListOrdersExpectation
-	expectations       []*StorageMockListOrdersExpectation
+	defaultExpectation *StorageMockGetOrderForUpdateTxExpectation
+	expectations       []*StorageMockGetOrderForUpdateTxExpectation
 
-	callArgs []*StorageMockListOrdersParams
+	callArgs []*StorageMockGetOrderForUpdateTxParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockListOrdersExpectation specifies expectation struct of the Storage.ListOrders
-type StorageMockListOrdersExpectation struct {
+// StorageMockGetOrderForUpdateTxExpectation specifies expectation struct of the Storage.GetOrderForUpdateTx
+type StorageMockGetOrderForUpdateTxExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockListOrdersParams
-	paramPtrs          *StorageMockListOrdersParamPtrs
-	expectationOrigins StorageMockListOrdersExpectationOrigins
-	results            *StorageMockListOrdersResults
+	params             *StorageMockGetOrderForUpdateTxParams
+	paramPtrs          *StorageMockGetOrderForUpdateTxParamPtrs
+	expectationOrigins StorageMockGetOrderForUpdateTxExpectationOrigins
+	results            *StorageMockGetOrderForUpdateTxResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockListOrdersParams contains parameters of the Storage.ListOrders
-type StorageMockListOrdersParams struct {
+// StorageMockGetOrderForUpdateTxParams contains parameters of the Storage.GetOrderForUpdateTx
+type StorageMockGetOrderForUpdateTxParams struct {
 	ctx context.Context
+	tx  pgx.Tx
+	id  uint64
 }
 
-// StorageMockListOrdersParamPtrs contains pointers to parameters of the Storage.ListOrders
-type StorageMockListOrdersParamPtrs struct {
+// StorageMockGetOrderForUpdateTxParamPtrs contains pointers to parameters of the Storage.GetOrderForUpdateTx
+type StorageMockGetOrderForUpdateTxParamPtrs struct {
 	ctx *context.Context
+	tx  *pgx.Tx
+	id  *uint64
 }
 
-// StorageMockListOrdersResults contains results of the Storage.ListOrders
-type StorageMockListOrdersResults struct {
-	oa1 []models.Order
+// StorageMockGetOrderForUpdateTxResults contains results of the Storage.GetOrderForUpdateTx
+type StorageMockGetOrderForUpdateTxResults struct {
+	o1  models.Order
 	err error
 }
 
-// StorageMockListOrdersOrigins contains origins of expectations of the Storage.ListOrders
-type StorageMockListOrdersExpectationOrigins struct {
+// StorageMockGetOrderForUpdateTxOrigins contains origins of expectations of the Storage.GetOrderForUpdateTx
+type StorageMockGetOrderForUpdateTxExpectationOrigins struct {
 	origin    string
 	originCtx string
+	originTx  string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1214,316 +2157,2894 @@ type StorageMockListOrdersExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListOrders *mStorageMockListOrders) Optional() *mStorageMockListOrders {
-	mmListOrders.optional = true
-	return mmListOrders
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Optional() *mStorageMockGetOrderForUpdateTx {
+	mmGetOrderForUpdateTx.optional = true
+	return mmGetOrderForUpdateTx
 }
 
-// Expect sets up expected params for Storage.ListOrders
-func (mmListOrders *mStorageMockListOrders) Expect(ctx context.Context) *mStorageMockListOrders {
-	if mmListOrders.mock.funcListOrders != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+// Expect sets up expected params for Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Expect(ctx context.Context, tx pgx.Tx, id uint64) *mStorageMockGetOrderForUpdateTx {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	if mmListOrders.defaultExpectation == nil {
-		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{}
+	if mmGetOrderForUpdateTx.defaultExpectation == nil {
+		mmGetOrderForUpdateTx.defaultExpectation = &StorageMockGetOrderForUpdateTxExpectation{}
 	}
 
-	if mmListOrders.defaultExpectation.paramPtrs != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by ExpectParams functions")
+	if mmGetOrderForUpdateTx.defaultExpectation.paramPtrs != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by ExpectParams functions")
 	}
 
-	mmListOrders.defaultExpectation.params = &StorageMockListOrdersParams{ctx}
-	mmListOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListOrders.expectations {
-		if minimock.Equal(e.params, mmListOrders.defaultExpectation.params) {
-			mmListOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrders.defaultExpectation.params)
+	mmGetOrderForUpdateTx.defaultExpectation.params = &StorageMockGetOrderForUpdateTxParams{ctx, tx, id}
+	mmGetOrderForUpdateTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderForUpdateTx.expectations {
+		if minimock.Equal(e.params, mmGetOrderForUpdateTx.defaultExpectation.params) {
+			mmGetOrderForUpdateTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderForUpdateTx.defaultExpectation.params)
 		}
 	}
 
-	return mmListOrders
+	return mmGetOrderForUpdateTx
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.ListOrders
-func (mmListOrders *mStorageMockListOrders) ExpectCtxParam1(ctx context.Context) *mStorageMockListOrders {
-	if mmListOrders.mock.funcListOrders != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) ExpectCtxParam1(ctx context.Context) *mStorageMockGetOrderForUpdateTx {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	if mmListOrders.defaultExpectation == nil {
-		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{}
+	if mmGetOrderForUpdateTx.defaultExpectation == nil {
+		mmGetOrderForUpdateTx.defaultExpectation = &StorageMockGetOrderForUpdateTxExpectation{}
 	}
 
-	if mmListOrders.defaultExpectation.params != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Expect")
+	if mmGetOrderForUpdateTx.defaultExpectation.params != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Expect")
 	}
 
-	if mmListOrders.defaultExpectation.paramPtrs == nil {
-		mmListOrders.defaultExpectation.paramPtrs = &StorageMockListOrdersParamPtrs{}
+	if mmGetOrderForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetOrderForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetOrderForUpdateTxParamPtrs{}
 	}
-	mmListOrders.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetOrderForUpdateTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderForUpdateTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListOrders
+	return mmGetOrderForUpdateTx
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.ListOrders
-func (mmListOrders *mStorageMockListOrders) Inspect(f func(ctx context.Context)) *mStorageMockListOrders {
-	if mmListOrders.mock.inspectFuncListOrders != nil {
-		mmListOrders.mock.t.Fatalf("Inspect function is already set for StorageMock.ListOrders")
+// ExpectTxParam2 sets up expected param tx for Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockGetOrderForUpdateTx {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	mmListOrders.mock.inspectFuncListOrders = f
+	if mmGetOrderForUpdateTx.defaultExpectation == nil {
+		mmGetOrderForUpdateTx.defaultExpectation = &StorageMockGetOrderForUpdateTxExpectation{}
+	}
 
-	return mmListOrders
+	if mmGetOrderForUpdateTx.defaultExpectation.params != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Expect")
+	}
+
+	if mmGetOrderForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetOrderForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetOrderForUpdateTxParamPtrs{}
+	}
+	mmGetOrderForUpdateTx.defaultExpectation.paramPtrs.tx = &tx
+	mmGetOrderForUpdateTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmGetOrderForUpdateTx
 }
 
-// Return sets up results that will be returned by Storage.ListOrders
-func (mmListOrders *mStorageMockListOrders) Return(oa1 []models.Order, err error) *StorageMock {
-	if mmListOrders.mock.funcListOrders != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+// ExpectIdParam3 sets up expected param id for Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) ExpectIdParam3(id uint64) *mStorageMockGetOrderForUpdateTx {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	if mmListOrders.defaultExpectation == nil {
-		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{mock: mmListOrders.mock}
+	if mmGetOrderForUpdateTx.defaultExpectation == nil {
+		mmGetOrderForUpdateTx.defaultExpectation = &StorageMockGetOrderForUpdateTxExpectation{}
 	}
-	mmListOrders.defaultExpectation.results = &StorageMockListOrdersResults{oa1, err}
-	mmListOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListOrders.mock
+
+	if mmGetOrderForUpdateTx.defaultExpectation.params != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Expect")
+	}
+
+	if mmGetOrderForUpdateTx.defaultExpectation.paramPtrs == nil {
+		mmGetOrderForUpdateTx.defaultExpectation.paramPtrs = &StorageMockGetOrderForUpdateTxParamPtrs{}
+	}
+	mmGetOrderForUpdateTx.defaultExpectation.paramPtrs.id = &id
+	mmGetOrderForUpdateTx.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetOrderForUpdateTx
 }
 
-// Set uses given function f to mock the Storage.ListOrders method
-func (mmListOrders *mStorageMockListOrders) Set(f func(ctx context.Context) (oa1 []models.Order, err error)) *StorageMock {
-	if mmListOrders.defaultExpectation != nil {
-		mmListOrders.mock.t.Fatalf("Default expectation is already set for the Storage.ListOrders method")
+// Inspect accepts an inspector function that has same arguments as the Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Inspect(f func(ctx context.Context, tx pgx.Tx, id uint64)) *mStorageMockGetOrderForUpdateTx {
+	if mmGetOrderForUpdateTx.mock.inspectFuncGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("Inspect function is already set for StorageMock.GetOrderForUpdateTx")
 	}
 
-	if len(mmListOrders.expectations) > 0 {
-		mmListOrders.mock.t.Fatalf("Some expectations are already set for the Storage.ListOrders method")
+	mmGetOrderForUpdateTx.mock.inspectFuncGetOrderForUpdateTx = f
+
+	return mmGetOrderForUpdateTx
+}
+
+// Return sets up results that will be returned by Storage.GetOrderForUpdateTx
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Return(o1 models.Order, err error) *StorageMock {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	mmListOrders.mock.funcListOrders = f
-	mmListOrders.mock.funcListOrdersOrigin = minimock.CallerInfo(1)
-	return mmListOrders.mock
+	if mmGetOrderForUpdateTx.defaultExpectation == nil {
+		mmGetOrderForUpdateTx.defaultExpectation = &StorageMockGetOrderForUpdateTxExpectation{mock: mmGetOrderForUpdateTx.mock}
+	}
+	mmGetOrderForUpdateTx.defaultExpectation.results = &StorageMockGetOrderForUpdateTxResults{o1, err}
+	mmGetOrderForUpdateTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderForUpdateTx.mock
 }
 
-// When sets expectation for the Storage.ListOrders which will trigger the result defined by the following
+// Set uses given function f to mock the Storage.GetOrderForUpdateTx method
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Set(f func(ctx context.Context, tx pgx.Tx, id uint64) (o1 models.Order, err error)) *StorageMock {
+	if mmGetOrderForUpdateTx.defaultExpectation != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("Default expectation is already set for the Storage.GetOrderForUpdateTx method")
+	}
+
+	if len(mmGetOrderForUpdateTx.expectations) > 0 {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("Some expectations are already set for the Storage.GetOrderForUpdateTx method")
+	}
+
+	mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx = f
+	mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTxOrigin = minimock.CallerInfo(1)
+	return mmGetOrderForUpdateTx.mock
+}
+
+// When sets expectation for the Storage.GetOrderForUpdateTx which will trigger the result defined by the following
 // Then helper
-func (mmListOrders *mStorageMockListOrders) When(ctx context.Context) *StorageMockListOrdersExpectation {
-	if mmListOrders.mock.funcListOrders != nil {
-		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) When(ctx context.Context, tx pgx.Tx, id uint64) *StorageMockGetOrderForUpdateTxExpectation {
+	if mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.mock.t.Fatalf("StorageMock.GetOrderForUpdateTx mock is already set by Set")
 	}
 
-	expectation := &StorageMockListOrdersExpectation{
-		mock:               mmListOrders.mock,
-		params:             &StorageMockListOrdersParams{ctx},
-		expectationOrigins: StorageMockListOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockGetOrderForUpdateTxExpectation{
+		mock:               mmGetOrderForUpdateTx.mock,
+		params:             &StorageMockGetOrderForUpdateTxParams{ctx, tx, id},
+		expectationOrigins: StorageMockGetOrderForUpdateTxExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListOrders.expectations = append(mmListOrders.expectations, expectation)
+	mmGetOrderForUpdateTx.expectations = append(mmGetOrderForUpdateTx.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.ListOrders return parameters for the expectation previously defined by the When method
-func (e *StorageMockListOrdersExpectation) Then(oa1 []models.Order, err error) *StorageMock {
-	e.results = &StorageMockListOrdersResults{oa1, err}
+// Then sets up Storage.GetOrderForUpdateTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetOrderForUpdateTxExpectation) Then(o1 models.Order, err error) *StorageMock {
+	e.results = &StorageMockGetOrderForUpdateTxResults{o1, err}
 	return e.mock
 }
 
-// Times sets number of times Storage.ListOrders should be invoked
-func (mmListOrders *mStorageMockListOrders) Times(n uint64) *mStorageMockListOrders {
+// Times sets number of times Storage.GetOrderForUpdateTx should be invoked
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Times(n uint64) *mStorageMockGetOrderForUpdateTx {
 	if n == 0 {
-		mmListOrders.mock.t.Fatalf("Times of StorageMock.ListOrders mock can not be zero")
+		mmGetOrderForUpdateTx.mock.t.Fatalf("Times of StorageMock.GetOrderForUpdateTx mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListOrders.expectedInvocations, n)
-	mmListOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListOrders
+	mm_atomic.StoreUint64(&mmGetOrderForUpdateTx.expectedInvocations, n)
+	mmGetOrderForUpdateTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderForUpdateTx
 }
 
-func (mmListOrders *mStorageMockListOrders) invocationsDone() bool {
-	if len(mmListOrders.expectations) == 0 && mmListOrders.defaultExpectation == nil && mmListOrders.mock.funcListOrders == nil {
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) invocationsDone() bool {
+	if len(mmGetOrderForUpdateTx.expectations) == 0 && mmGetOrderForUpdateTx.defaultExpectation == nil && mmGetOrderForUpdateTx.mock.funcGetOrderForUpdateTx == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListOrders.mock.afterListOrdersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListOrders.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderForUpdateTx.mock.afterGetOrderForUpdateTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderForUpdateTx.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListOrders implements mm_storage.Storage
-func (mmListOrders *StorageMock) ListOrders(ctx context.Context) (oa1 []models.Order, err error) {
-	mm_atomic.AddUint64(&mmListOrders.beforeListOrdersCounter, 1)
-	defer mm_atomic.AddUint64(&mmListOrders.afterListOrdersCounter, 1)
+// GetOrderForUpdateTx implements mm_storage.Storage
+func (mmGetOrderForUpdateTx *StorageMock) GetOrderForUpdateTx(ctx context.Context, tx pgx.Tx, id uint64) (o1 models.Order, err error) {
+	mm_atomic.AddUint64(&mmGetOrderForUpdateTx.beforeGetOrderForUpdateTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderForUpdateTx.afterGetOrderForUpdateTxCounter, 1)
 
-	mmListOrders.t.Helper()
+	mmGetOrderForUpdateTx.t.Helper()
 
-	if mmListOrders.inspectFuncListOrders != nil {
-		mmListOrders.inspectFuncListOrders(ctx)
+	if mmGetOrderForUpdateTx.inspectFuncGetOrderForUpdateTx != nil {
+		mmGetOrderForUpdateTx.inspectFuncGetOrderForUpdateTx(ctx, tx, id)
 	}
 
-	mm_params := StorageMockListOrdersParams{ctx}
+	mm_params := StorageMockGetOrderForUpdateTxParams{ctx, tx, id}
 
 	// Record call args
-	mmListOrders.ListOrdersMock.mutex.Lock()
-	mmListOrders.ListOrdersMock.callArgs = append(mmListOrders.ListOrdersMock.callArgs, &mm_params)
-	mmListOrders.ListOrdersMock.mutex.Unlock()
+	mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.mutex.Lock()
+	mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.callArgs = append(mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.callArgs, &mm_params)
+	mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.mutex.Unlock()
 
-	for _, e := range mmListOrders.ListOrdersMock.expectations {
+	for _, e := range mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetOrderForUpdateTxParams{ctx, tx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrderForUpdateTx.t.Errorf("StorageMock.GetOrderForUpdateTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmGetOrderForUpdateTx.t.Errorf("StorageMock.GetOrderForUpdateTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetOrderForUpdateTx.t.Errorf("StorageMock.GetOrderForUpdateTx got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderForUpdateTx.t.Errorf("StorageMock.GetOrderForUpdateTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderForUpdateTx.GetOrderForUpdateTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderForUpdateTx.t.Fatal("No results are set for the StorageMock.GetOrderForUpdateTx")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmGetOrderForUpdateTx.funcGetOrderForUpdateTx != nil {
+		return mmGetOrderForUpdateTx.funcGetOrderForUpdateTx(ctx, tx, id)
+	}
+	mmGetOrderForUpdateTx.t.Fatalf("Unexpected call to StorageMock.GetOrderForUpdateTx. %v %v %v", ctx, tx, id)
+	return
+}
+
+// GetOrderForUpdateTxAfterCounter returns a count of finished StorageMock.GetOrderForUpdateTx invocations
+func (mmGetOrderForUpdateTx *StorageMock) GetOrderForUpdateTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderForUpdateTx.afterGetOrderForUpdateTxCounter)
+}
+
+// GetOrderForUpdateTxBeforeCounter returns a count of StorageMock.GetOrderForUpdateTx invocations
+func (mmGetOrderForUpdateTx *StorageMock) GetOrderForUpdateTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderForUpdateTx.beforeGetOrderForUpdateTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetOrderForUpdateTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderForUpdateTx *mStorageMockGetOrderForUpdateTx) Calls() []*StorageMockGetOrderForUpdateTxParams {
+	mmGetOrderForUpdateTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetOrderForUpdateTxParams, len(mmGetOrderForUpdateTx.callArgs))
+	copy(argCopy, mmGetOrderForUpdateTx.callArgs)
+
+	mmGetOrderForUpdateTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderForUpdateTxDone returns true if the count of the GetOrderForUpdateTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetOrderForUpdateTxDone() bool {
+	if m.GetOrderForUpdateTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderForUpdateTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderForUpdateTxMock.invocationsDone()
+}
+
+// MinimockGetOrderForUpdateTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetOrderForUpdateTxInspect() {
+	for _, e := range m.GetOrderForUpdateTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetOrderForUpdateTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderForUpdateTxCounter := mm_atomic.LoadUint64(&m.afterGetOrderForUpdateTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderForUpdateTxMock.defaultExpectation != nil && afterGetOrderForUpdateTxCounter < 1 {
+		if m.GetOrderForUpdateTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetOrderForUpdateTx at\n%s", m.GetOrderForUpdateTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetOrderForUpdateTx at\n%s with params: %#v", m.GetOrderForUpdateTxMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderForUpdateTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderForUpdateTx != nil && afterGetOrderForUpdateTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetOrderForUpdateTx at\n%s", m.funcGetOrderForUpdateTxOrigin)
+	}
+
+	if !m.GetOrderForUpdateTxMock.invocationsDone() && afterGetOrderForUpdateTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetOrderForUpdateTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderForUpdateTxMock.expectedInvocations), m.GetOrderForUpdateTxMock.expectedInvocationsOrigin, afterGetOrderForUpdateTxCounter)
+	}
+}
+
+type mStorageMockGetOrderHistory struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetOrderHistoryExpectation
+	expectations       []*StorageMockGetOrderHistoryExpectation
+
+	callArgs []*StorageMockGetOrderHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetOrderHistoryExpectation specifies expectation struct of the Storage.GetOrderHistory
+type StorageMockGetOrderHistoryExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetOrderHistoryParams
+	paramPtrs          *StorageMockGetOrderHistoryParamPtrs
+	expectationOrigins StorageMockGetOrderHistoryExpectationOrigins
+	results            *StorageMockGetOrderHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetOrderHistoryParams contains parameters of the Storage.GetOrderHistory
+type StorageMockGetOrderHistoryParams struct {
+	ctx     context.Context
+	orderID uint64
+}
+
+// StorageMockGetOrderHistoryParamPtrs contains pointers to parameters of the Storage.GetOrderHistory
+type StorageMockGetOrderHistoryParamPtrs struct {
+	ctx     *context.Context
+	orderID *uint64
+}
+
+// StorageMockGetOrderHistoryResults contains results of the Storage.GetOrderHistory
+type StorageMockGetOrderHistoryResults struct {
+	oa1 []models.OrderHistory
+	err error
+}
+
+// StorageMockGetOrderHistoryOrigins contains origins of expectations of the Storage.GetOrderHistory
+type StorageMockGetOrderHistoryExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Optional() *mStorageMockGetOrderHistory {
+	mmGetOrderHistory.optional = true
+	return mmGetOrderHistory
+}
+
+// Expect sets up expected params for Storage.GetOrderHistory
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Expect(ctx context.Context, orderID uint64) *mStorageMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &StorageMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrderHistory.defaultExpectation.params = &StorageMockGetOrderHistoryParams{ctx, orderID}
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderHistory.expectations {
+		if minimock.Equal(e.params, mmGetOrderHistory.defaultExpectation.params) {
+			mmGetOrderHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetOrderHistory
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) ExpectCtxParam1(ctx context.Context) *mStorageMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &StorageMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &StorageMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for Storage.GetOrderHistory
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) ExpectOrderIDParam2(orderID uint64) *mStorageMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &StorageMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &StorageMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetOrderHistory
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Inspect(f func(ctx context.Context, orderID uint64)) *mStorageMockGetOrderHistory {
+	if mmGetOrderHistory.mock.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Inspect function is already set for StorageMock.GetOrderHistory")
+	}
+
+	mmGetOrderHistory.mock.inspectFuncGetOrderHistory = f
+
+	return mmGetOrderHistory
+}
+
+// Return sets up results that will be returned by Storage.GetOrderHistory
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Return(oa1 []models.OrderHistory, err error) *StorageMock {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &StorageMockGetOrderHistoryExpectation{mock: mmGetOrderHistory.mock}
+	}
+	mmGetOrderHistory.defaultExpectation.results = &StorageMockGetOrderHistoryResults{oa1, err}
+	mmGetOrderHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// Set uses given function f to mock the Storage.GetOrderHistory method
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Set(f func(ctx context.Context, orderID uint64) (oa1 []models.OrderHistory, err error)) *StorageMock {
+	if mmGetOrderHistory.defaultExpectation != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Default expectation is already set for the Storage.GetOrderHistory method")
+	}
+
+	if len(mmGetOrderHistory.expectations) > 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Some expectations are already set for the Storage.GetOrderHistory method")
+	}
+
+	mmGetOrderHistory.mock.funcGetOrderHistory = f
+	mmGetOrderHistory.mock.funcGetOrderHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// When sets expectation for the Storage.GetOrderHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) When(ctx context.Context, orderID uint64) *StorageMockGetOrderHistoryExpectation {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("StorageMock.GetOrderHistory mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetOrderHistoryExpectation{
+		mock:               mmGetOrderHistory.mock,
+		params:             &StorageMockGetOrderHistoryParams{ctx, orderID},
+		expectationOrigins: StorageMockGetOrderHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrderHistory.expectations = append(mmGetOrderHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetOrderHistory return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetOrderHistoryExpectation) Then(oa1 []models.OrderHistory, err error) *StorageMock {
+	e.results = &StorageMockGetOrderHistoryResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetOrderHistory should be invoked
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Times(n uint64) *mStorageMockGetOrderHistory {
+	if n == 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Times of StorageMock.GetOrderHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrderHistory.expectedInvocations, n)
+	mmGetOrderHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory
+}
+
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) invocationsDone() bool {
+	if len(mmGetOrderHistory.expectations) == 0 && mmGetOrderHistory.defaultExpectation == nil && mmGetOrderHistory.mock.funcGetOrderHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.mock.afterGetOrderHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrderHistory implements mm_storage.Storage
+func (mmGetOrderHistory *StorageMock) GetOrderHistory(ctx context.Context, orderID uint64) (oa1 []models.OrderHistory, err error) {
+	mm_atomic.AddUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter, 1)
+
+	mmGetOrderHistory.t.Helper()
+
+	if mmGetOrderHistory.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.inspectFuncGetOrderHistory(ctx, orderID)
+	}
+
+	mm_params := StorageMockGetOrderHistoryParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Lock()
+	mmGetOrderHistory.GetOrderHistoryMock.callArgs = append(mmGetOrderHistory.GetOrderHistoryMock.callArgs, &mm_params)
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderHistory.GetOrderHistoryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.oa1, e.results.err
 		}
 	}
 
-	if mmListOrders.ListOrdersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListOrders.ListOrdersMock.defaultExpectation.Counter, 1)
-		mm_want := mmListOrders.ListOrdersMock.defaultExpectation.params
-		mm_want_ptrs := mmListOrders.ListOrdersMock.defaultExpectation.paramPtrs
+	if mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockListOrdersParams{ctx}
+		mm_got := StorageMockGetOrderHistoryParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListOrders.t.Errorf("StorageMock.ListOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetOrderHistory.t.Errorf("StorageMock.GetOrderHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrderHistory.t.Errorf("StorageMock.GetOrderHistory got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderHistory.t.Errorf("StorageMock.GetOrderHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderHistory.t.Fatal("No results are set for the StorageMock.GetOrderHistory")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetOrderHistory.funcGetOrderHistory != nil {
+		return mmGetOrderHistory.funcGetOrderHistory(ctx, orderID)
+	}
+	mmGetOrderHistory.t.Fatalf("Unexpected call to StorageMock.GetOrderHistory. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderHistoryAfterCounter returns a count of finished StorageMock.GetOrderHistory invocations
+func (mmGetOrderHistory *StorageMock) GetOrderHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter)
+}
+
+// GetOrderHistoryBeforeCounter returns a count of StorageMock.GetOrderHistory invocations
+func (mmGetOrderHistory *StorageMock) GetOrderHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetOrderHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderHistory *mStorageMockGetOrderHistory) Calls() []*StorageMockGetOrderHistoryParams {
+	mmGetOrderHistory.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetOrderHistoryParams, len(mmGetOrderHistory.callArgs))
+	copy(argCopy, mmGetOrderHistory.callArgs)
+
+	mmGetOrderHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderHistoryDone returns true if the count of the GetOrderHistory invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetOrderHistoryDone() bool {
+	if m.GetOrderHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderHistoryMock.invocationsDone()
+}
+
+// MinimockGetOrderHistoryInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetOrderHistoryInspect() {
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetOrderHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderHistoryCounter := mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderHistoryMock.defaultExpectation != nil && afterGetOrderHistoryCounter < 1 {
+		if m.GetOrderHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetOrderHistory at\n%s", m.GetOrderHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetOrderHistory at\n%s with params: %#v", m.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderHistory != nil && afterGetOrderHistoryCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetOrderHistory at\n%s", m.funcGetOrderHistoryOrigin)
+	}
+
+	if !m.GetOrderHistoryMock.invocationsDone() && afterGetOrderHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetOrderHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderHistoryMock.expectedInvocations), m.GetOrderHistoryMock.expectedInvocationsOrigin, afterGetOrderHistoryCounter)
+	}
+}
+
+type mStorageMockListOrders struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockListOrdersExpectation
+	expectations       []*StorageMockListOrdersExpectation
+
+	callArgs []*StorageMockListOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockListOrdersExpectation specifies expectation struct of the Storage.ListOrders
+type StorageMockListOrdersExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockListOrdersParams
+	paramPtrs          *StorageMockListOrdersParamPtrs
+	expectationOrigins StorageMockListOrdersExpectationOrigins
+	results            *StorageMockListOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockListOrdersParams contains parameters of the Storage.ListOrders
+type StorageMockListOrdersParams struct {
+	ctx context.Context
+}
+
+// StorageMockListOrdersParamPtrs contains pointers to parameters of the Storage.ListOrders
+type StorageMockListOrdersParamPtrs struct {
+	ctx *context.Context
+}
+
+// StorageMockListOrdersResults contains results of the Storage.ListOrders
+type StorageMockListOrdersResults struct {
+	oa1 []models.Order
+	err error
+}
+
+// StorageMockListOrdersOrigins contains origins of expectations of the Storage.ListOrders
+type StorageMockListOrdersExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrders *mStorageMockListOrders) Optional() *mStorageMockListOrders {
+	mmListOrders.optional = true
+	return mmListOrders
+}
+
+// Expect sets up expected params for Storage.ListOrders
+func (mmListOrders *mStorageMockListOrders) Expect(ctx context.Context) *mStorageMockListOrders {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{}
+	}
+
+	if mmListOrders.defaultExpectation.paramPtrs != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by ExpectParams functions")
+	}
+
+	mmListOrders.defaultExpectation.params = &StorageMockListOrdersParams{ctx}
+	mmListOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrders.expectations {
+		if minimock.Equal(e.params, mmListOrders.defaultExpectation.params) {
+			mmListOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.ListOrders
+func (mmListOrders *mStorageMockListOrders) ExpectCtxParam1(ctx context.Context) *mStorageMockListOrders {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{}
+	}
+
+	if mmListOrders.defaultExpectation.params != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Expect")
+	}
+
+	if mmListOrders.defaultExpectation.paramPtrs == nil {
+		mmListOrders.defaultExpectation.paramPtrs = &StorageMockListOrdersParamPtrs{}
+	}
+	mmListOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.ListOrders
+func (mmListOrders *mStorageMockListOrders) Inspect(f func(ctx context.Context)) *mStorageMockListOrders {
+	if mmListOrders.mock.inspectFuncListOrders != nil {
+		mmListOrders.mock.t.Fatalf("Inspect function is already set for StorageMock.ListOrders")
+	}
+
+	mmListOrders.mock.inspectFuncListOrders = f
+
+	return mmListOrders
+}
+
+// Return sets up results that will be returned by Storage.ListOrders
+func (mmListOrders *mStorageMockListOrders) Return(oa1 []models.Order, err error) *StorageMock {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &StorageMockListOrdersExpectation{mock: mmListOrders.mock}
+	}
+	mmListOrders.defaultExpectation.results = &StorageMockListOrdersResults{oa1, err}
+	mmListOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrders.mock
+}
+
+// Set uses given function f to mock the Storage.ListOrders method
+func (mmListOrders *mStorageMockListOrders) Set(f func(ctx context.Context) (oa1 []models.Order, err error)) *StorageMock {
+	if mmListOrders.defaultExpectation != nil {
+		mmListOrders.mock.t.Fatalf("Default expectation is already set for the Storage.ListOrders method")
+	}
+
+	if len(mmListOrders.expectations) > 0 {
+		mmListOrders.mock.t.Fatalf("Some expectations are already set for the Storage.ListOrders method")
+	}
+
+	mmListOrders.mock.funcListOrders = f
+	mmListOrders.mock.funcListOrdersOrigin = minimock.CallerInfo(1)
+	return mmListOrders.mock
+}
+
+// When sets expectation for the Storage.ListOrders which will trigger the result defined by the following
+// Then helper
+func (mmListOrders *mStorageMockListOrders) When(ctx context.Context) *StorageMockListOrdersExpectation {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("StorageMock.ListOrders mock is already set by Set")
+	}
+
+	expectation := &StorageMockListOrdersExpectation{
+		mock:               mmListOrders.mock,
+		params:             &StorageMockListOrdersParams{ctx},
+		expectationOrigins: StorageMockListOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrders.expectations = append(mmListOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.ListOrders return parameters for the expectation previously defined by the When method
+func (e *StorageMockListOrdersExpectation) Then(oa1 []models.Order, err error) *StorageMock {
+	e.results = &StorageMockListOrdersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.ListOrders should be invoked
+func (mmListOrders *mStorageMockListOrders) Times(n uint64) *mStorageMockListOrders {
+	if n == 0 {
+		mmListOrders.mock.t.Fatalf("Times of StorageMock.ListOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrders.expectedInvocations, n)
+	mmListOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrders
+}
+
+func (mmListOrders *mStorageMockListOrders) invocationsDone() bool {
+	if len(mmListOrders.expectations) == 0 && mmListOrders.defaultExpectation == nil && mmListOrders.mock.funcListOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrders.mock.afterListOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrders implements mm_storage.Storage
+func (mmListOrders *StorageMock) ListOrders(ctx context.Context) (oa1 []models.Order, err error) {
+	mm_atomic.AddUint64(&mmListOrders.beforeListOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrders.afterListOrdersCounter, 1)
+
+	mmListOrders.t.Helper()
+
+	if mmListOrders.inspectFuncListOrders != nil {
+		mmListOrders.inspectFuncListOrders(ctx)
+	}
+
+	mm_params := StorageMockListOrdersParams{ctx}
+
+	// Record call args
+	mmListOrders.ListOrdersMock.mutex.Lock()
+	mmListOrders.ListOrdersMock.callArgs = append(mmListOrders.ListOrdersMock.callArgs, &mm_params)
+	mmListOrders.ListOrdersMock.mutex.Unlock()
+
+	for _, e := range mmListOrders.ListOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListOrders.ListOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrders.ListOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrders.ListOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrders.ListOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockListOrdersParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOrders.t.Errorf("StorageMock.ListOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrders.t.Errorf("StorageMock.ListOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrders.ListOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrders.t.Fatal("No results are set for the StorageMock.ListOrders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListOrders.funcListOrders != nil {
+		return mmListOrders.funcListOrders(ctx)
+	}
+	mmListOrders.t.Fatalf("Unexpected call to StorageMock.ListOrders. %v", ctx)
+	return
+}
+
+// ListOrdersAfterCounter returns a count of finished StorageMock.ListOrders invocations
+func (mmListOrders *StorageMock) ListOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrders.afterListOrdersCounter)
+}
+
+// ListOrdersBeforeCounter returns a count of StorageMock.ListOrders invocations
+func (mmListOrders *StorageMock) ListOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrders.beforeListOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.ListOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrders *mStorageMockListOrders) Calls() []*StorageMockListOrdersParams {
+	mmListOrders.mutex.RLock()
+
+	argCopy := make([]*StorageMockListOrdersParams, len(mmListOrders.callArgs))
+	copy(argCopy, mmListOrders.callArgs)
+
+	mmListOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrdersDone returns true if the count of the ListOrders invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockListOrdersDone() bool {
+	if m.ListOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrdersMock.invocationsDone()
+}
+
+// MinimockListOrdersInspect logs each unmet expectation
+func (m *StorageMock) MinimockListOrdersInspect() {
+	for _, e := range m.ListOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrdersCounter := mm_atomic.LoadUint64(&m.afterListOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrdersMock.defaultExpectation != nil && afterListOrdersCounter < 1 {
+		if m.ListOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s", m.ListOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s with params: %#v", m.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ListOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrders != nil && afterListOrdersCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s", m.funcListOrdersOrigin)
+	}
+
+	if !m.ListOrdersMock.invocationsDone() && afterListOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ListOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrdersMock.expectedInvocations), m.ListOrdersMock.expectedInvocationsOrigin, afterListOrdersCounter)
+	}
+}
+
+type mStorageMockQueryOrders struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockQueryOrdersExpectation
+	expectations       []*StorageMockQueryOrdersExpectation
+
+	callArgs []*StorageMockQueryOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockQueryOrdersExpectation specifies expectation struct of the Storage.QueryOrders
+type StorageMockQueryOrdersExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockQueryOrdersParams
+	paramPtrs          *StorageMockQueryOrdersParamPtrs
+	expectationOrigins StorageMockQueryOrdersExpectationOrigins
+	results            *StorageMockQueryOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockQueryOrdersParams contains parameters of the Storage.QueryOrders
+type StorageMockQueryOrdersParams struct {
+	ctx    context.Context
+	filter models.OrderFilter
+}
+
+// StorageMockQueryOrdersParamPtrs contains pointers to parameters of the Storage.QueryOrders
+type StorageMockQueryOrdersParamPtrs struct {
+	ctx    *context.Context
+	filter *models.OrderFilter
+}
+
+// StorageMockQueryOrdersResults contains results of the Storage.QueryOrders
+type StorageMockQueryOrdersResults struct {
+	o1  models.OrdersPage
+	err error
+}
+
+// StorageMockQueryOrdersOrigins contains origins of expectations of the Storage.QueryOrders
+type StorageMockQueryOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQueryOrders *mStorageMockQueryOrders) Optional() *mStorageMockQueryOrders {
+	mmQueryOrders.optional = true
+	return mmQueryOrders
+}
+
+// Expect sets up expected params for Storage.QueryOrders
+func (mmQueryOrders *mStorageMockQueryOrders) Expect(ctx context.Context, filter models.OrderFilter) *mStorageMockQueryOrders {
+	if mmQueryOrders.mock.funcQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Set")
+	}
+
+	if mmQueryOrders.defaultExpectation == nil {
+		mmQueryOrders.defaultExpectation = &StorageMockQueryOrdersExpectation{}
+	}
+
+	if mmQueryOrders.defaultExpectation.paramPtrs != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by ExpectParams functions")
+	}
+
+	mmQueryOrders.defaultExpectation.params = &StorageMockQueryOrdersParams{ctx, filter}
+	mmQueryOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQueryOrders.expectations {
+		if minimock.Equal(e.params, mmQueryOrders.defaultExpectation.params) {
+			mmQueryOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQueryOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmQueryOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.QueryOrders
+func (mmQueryOrders *mStorageMockQueryOrders) ExpectCtxParam1(ctx context.Context) *mStorageMockQueryOrders {
+	if mmQueryOrders.mock.funcQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Set")
+	}
+
+	if mmQueryOrders.defaultExpectation == nil {
+		mmQueryOrders.defaultExpectation = &StorageMockQueryOrdersExpectation{}
+	}
+
+	if mmQueryOrders.defaultExpectation.params != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Expect")
+	}
+
+	if mmQueryOrders.defaultExpectation.paramPtrs == nil {
+		mmQueryOrders.defaultExpectation.paramPtrs = &StorageMockQueryOrdersParamPtrs{}
+	}
+	mmQueryOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQueryOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQueryOrders
+}
+
+// ExpectFilterParam2 sets up expected param filter for Storage.QueryOrders
+func (mmQueryOrders *mStorageMockQueryOrders) ExpectFilterParam2(filter models.OrderFilter) *mStorageMockQueryOrders {
+	if mmQueryOrders.mock.funcQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Set")
+	}
+
+	if mmQueryOrders.defaultExpectation == nil {
+		mmQueryOrders.defaultExpectation = &StorageMockQueryOrdersExpectation{}
+	}
+
+	if mmQueryOrders.defaultExpectation.params != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Expect")
+	}
+
+	if mmQueryOrders.defaultExpectation.paramPtrs == nil {
+		mmQueryOrders.defaultExpectation.paramPtrs = &StorageMockQueryOrdersParamPtrs{}
+	}
+	mmQueryOrders.defaultExpectation.paramPtrs.filter = &filter
+	mmQueryOrders.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmQueryOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.QueryOrders
+func (mmQueryOrders *mStorageMockQueryOrders) Inspect(f func(ctx context.Context, filter models.OrderFilter)) *mStorageMockQueryOrders {
+	if mmQueryOrders.mock.inspectFuncQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("Inspect function is already set for StorageMock.QueryOrders")
+	}
+
+	mmQueryOrders.mock.inspectFuncQueryOrders = f
+
+	return mmQueryOrders
+}
+
+// Return sets up results that will be returned by Storage.QueryOrders
+func (mmQueryOrders *mStorageMockQueryOrders) Return(o1 models.OrdersPage, err error) *StorageMock {
+	if mmQueryOrders.mock.funcQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Set")
+	}
+
+	if mmQueryOrders.defaultExpectation == nil {
+		mmQueryOrders.defaultExpectation = &StorageMockQueryOrdersExpectation{mock: mmQueryOrders.mock}
+	}
+	mmQueryOrders.defaultExpectation.results = &StorageMockQueryOrdersResults{o1, err}
+	mmQueryOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQueryOrders.mock
+}
+
+// Set uses given function f to mock the Storage.QueryOrders method
+func (mmQueryOrders *mStorageMockQueryOrders) Set(f func(ctx context.Context, filter models.OrderFilter) (o1 models.OrdersPage, err error)) *StorageMock {
+	if mmQueryOrders.defaultExpectation != nil {
+		mmQueryOrders.mock.t.Fatalf("Default expectation is already set for the Storage.QueryOrders method")
+	}
+
+	if len(mmQueryOrders.expectations) > 0 {
+		mmQueryOrders.mock.t.Fatalf("Some expectations are already set for the Storage.QueryOrders method")
+	}
+
+	mmQueryOrders.mock.funcQueryOrders = f
+	mmQueryOrders.mock.funcQueryOrdersOrigin = minimock.CallerInfo(1)
+	return mmQueryOrders.mock
+}
+
+// When sets expectation for the Storage.QueryOrders which will trigger the result defined by the following
+// Then helper
+func (mmQueryOrders *mStorageMockQueryOrders) When(ctx context.Context, filter models.OrderFilter) *StorageMockQueryOrdersExpectation {
+	if mmQueryOrders.mock.funcQueryOrders != nil {
+		mmQueryOrders.mock.t.Fatalf("StorageMock.QueryOrders mock is already set by Set")
+	}
+
+	expectation := &StorageMockQueryOrdersExpectation{
+		mock:               mmQueryOrders.mock,
+		params:             &StorageMockQueryOrdersParams{ctx, filter},
+		expectationOrigins: StorageMockQueryOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQueryOrders.expectations = append(mmQueryOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.QueryOrders return parameters for the expectation previously defined by the When method
+func (e *StorageMockQueryOrdersExpectation) Then(o1 models.OrdersPage, err error) *StorageMock {
+	e.results = &StorageMockQueryOrdersResults{o1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.QueryOrders should be invoked
+func (mmQueryOrders *mStorageMockQueryOrders) Times(n uint64) *mStorageMockQueryOrders {
+	if n == 0 {
+		mmQueryOrders.mock.t.Fatalf("Times of StorageMock.QueryOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQueryOrders.expectedInvocations, n)
+	mmQueryOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQueryOrders
+}
+
+func (mmQueryOrders *mStorageMockQueryOrders) invocationsDone() bool {
+	if len(mmQueryOrders.expectations) == 0 && mmQueryOrders.defaultExpectation == nil && mmQueryOrders.mock.funcQueryOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQueryOrders.mock.afterQueryOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQueryOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QueryOrders implements mm_storage.Storage
+func (mmQueryOrders *StorageMock) QueryOrders(ctx context.Context, filter models.OrderFilter) (o1 models.OrdersPage, err error) {
+	mm_atomic.AddUint64(&mmQueryOrders.beforeQueryOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmQueryOrders.afterQueryOrdersCounter, 1)
+
+	mmQueryOrders.t.Helper()
+
+	if mmQueryOrders.inspectFuncQueryOrders != nil {
+		mmQueryOrders.inspectFuncQueryOrders(ctx, filter)
+	}
+
+	mm_params := StorageMockQueryOrdersParams{ctx, filter}
+
+	// Record call args
+	mmQueryOrders.QueryOrdersMock.mutex.Lock()
+	mmQueryOrders.QueryOrdersMock.callArgs = append(mmQueryOrders.QueryOrdersMock.callArgs, &mm_params)
+	mmQueryOrders.QueryOrdersMock.mutex.Unlock()
+
+	for _, e := range mmQueryOrders.QueryOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmQueryOrders.QueryOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQueryOrders.QueryOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmQueryOrders.QueryOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmQueryOrders.QueryOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockQueryOrdersParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQueryOrders.t.Errorf("StorageMock.QueryOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryOrders.QueryOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmQueryOrders.t.Errorf("StorageMock.QueryOrders got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryOrders.QueryOrdersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryOrders.t.Errorf("StorageMock.QueryOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQueryOrders.QueryOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQueryOrders.QueryOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQueryOrders.t.Fatal("No results are set for the StorageMock.QueryOrders")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmQueryOrders.funcQueryOrders != nil {
+		return mmQueryOrders.funcQueryOrders(ctx, filter)
+	}
+	mmQueryOrders.t.Fatalf("Unexpected call to StorageMock.QueryOrders. %v %v", ctx, filter)
+	return
+}
+
+// QueryOrdersAfterCounter returns a count of finished StorageMock.QueryOrders invocations
+func (mmQueryOrders *StorageMock) QueryOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryOrders.afterQueryOrdersCounter)
+}
+
+// QueryOrdersBeforeCounter returns a count of StorageMock.QueryOrders invocations
+func (mmQueryOrders *StorageMock) QueryOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryOrders.beforeQueryOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.QueryOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQueryOrders *mStorageMockQueryOrders) Calls() []*StorageMockQueryOrdersParams {
+	mmQueryOrders.mutex.RLock()
+
+	argCopy := make([]*StorageMockQueryOrdersParams, len(mmQueryOrders.callArgs))
+	copy(argCopy, mmQueryOrders.callArgs)
+
+	mmQueryOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryOrdersDone returns true if the count of the QueryOrders invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockQueryOrdersDone() bool {
+	if m.QueryOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryOrdersMock.invocationsDone()
+}
+
+// MinimockQueryOrdersInspect logs each unmet expectation
+func (m *StorageMock) MinimockQueryOrdersInspect() {
+	for _, e := range m.QueryOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.QueryOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQueryOrdersCounter := mm_atomic.LoadUint64(&m.afterQueryOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryOrdersMock.defaultExpectation != nil && afterQueryOrdersCounter < 1 {
+		if m.QueryOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.QueryOrders at\n%s", m.QueryOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.QueryOrders at\n%s with params: %#v", m.QueryOrdersMock.defaultExpectation.expectationOrigins.origin, *m.QueryOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQueryOrders != nil && afterQueryOrdersCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.QueryOrders at\n%s", m.funcQueryOrdersOrigin)
+	}
+
+	if !m.QueryOrdersMock.invocationsDone() && afterQueryOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.QueryOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryOrdersMock.expectedInvocations), m.QueryOrdersMock.expectedInvocationsOrigin, afterQueryOrdersCounter)
+	}
+}
+
+type mStorageMockSaveEventTx struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveEventTxExpectation
+	expectations       []*StorageMockSaveEventTxExpectation
+
+	callArgs []*StorageMockSaveEventTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockSaveEventTxExpectation specifies expectation struct of the Storage.SaveEventTx
+type StorageMockSaveEventTxExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockSaveEventTxParams
+	paramPtrs          *StorageMockSaveEventTxParamPtrs
+	expectationOrigins StorageMockSaveEventTxExpectationOrigins
+	results            *StorageMockSaveEventTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockSaveEventTxParams contains parameters of the Storage.SaveEventTx
+type StorageMockSaveEventTxParams struct {
+	ctx   context.Context
+	tx    pgx.Tx
+	order models.Event
+}
+
+// StorageMockSaveEventTxParamPtrs contains pointers to parameters of the Storage.SaveEventTx
+type StorageMockSaveEventTxParamPtrs struct {
+	ctx   *context.Context
+	tx    *pgx.Tx
+	order *models.Event
+}
+
+// StorageMockSaveEventTxResults contains results of the Storage.SaveEventTx
+type StorageMockSaveEventTxResults struct {
+	err error
+}
+
+// StorageMockSaveEventTxOrigins contains origins of expectations of the Storage.SaveEventTx
+type StorageMockSaveEventTxExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTx    string
+	originOrder string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveEventTx *mStorageMockSaveEventTx) Optional() *mStorageMockSaveEventTx {
+	mmSaveEventTx.optional = true
+	return mmSaveEventTx
+}
+
+// Expect sets up expected params for Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) Expect(ctx context.Context, tx pgx.Tx, order models.Event) *mStorageMockSaveEventTx {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	if mmSaveEventTx.defaultExpectation == nil {
+		mmSaveEventTx.defaultExpectation = &StorageMockSaveEventTxExpectation{}
+	}
+
+	if mmSaveEventTx.defaultExpectation.paramPtrs != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by ExpectParams functions")
+	}
+
+	mmSaveEventTx.defaultExpectation.params = &StorageMockSaveEventTxParams{ctx, tx, order}
+	mmSaveEventTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveEventTx.expectations {
+		if minimock.Equal(e.params, mmSaveEventTx.defaultExpectation.params) {
+			mmSaveEventTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveEventTx.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveEventTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) ExpectCtxParam1(ctx context.Context) *mStorageMockSaveEventTx {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	if mmSaveEventTx.defaultExpectation == nil {
+		mmSaveEventTx.defaultExpectation = &StorageMockSaveEventTxExpectation{}
+	}
+
+	if mmSaveEventTx.defaultExpectation.params != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Expect")
+	}
+
+	if mmSaveEventTx.defaultExpectation.paramPtrs == nil {
+		mmSaveEventTx.defaultExpectation.paramPtrs = &StorageMockSaveEventTxParamPtrs{}
+	}
+	mmSaveEventTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveEventTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveEventTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockSaveEventTx {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	if mmSaveEventTx.defaultExpectation == nil {
+		mmSaveEventTx.defaultExpectation = &StorageMockSaveEventTxExpectation{}
+	}
+
+	if mmSaveEventTx.defaultExpectation.params != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Expect")
+	}
+
+	if mmSaveEventTx.defaultExpectation.paramPtrs == nil {
+		mmSaveEventTx.defaultExpectation.paramPtrs = &StorageMockSaveEventTxParamPtrs{}
+	}
+	mmSaveEventTx.defaultExpectation.paramPtrs.tx = &tx
+	mmSaveEventTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmSaveEventTx
+}
+
+// ExpectOrderParam3 sets up expected param order for Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) ExpectOrderParam3(order models.Event) *mStorageMockSaveEventTx {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	if mmSaveEventTx.defaultExpectation == nil {
+		mmSaveEventTx.defaultExpectation = &StorageMockSaveEventTxExpectation{}
+	}
+
+	if mmSaveEventTx.defaultExpectation.params != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Expect")
+	}
+
+	if mmSaveEventTx.defaultExpectation.paramPtrs == nil {
+		mmSaveEventTx.defaultExpectation.paramPtrs = &StorageMockSaveEventTxParamPtrs{}
+	}
+	mmSaveEventTx.defaultExpectation.paramPtrs.order = &order
+	mmSaveEventTx.defaultExpectation.expectationOrigins.originOrder = minimock.CallerInfo(1)
+
+	return mmSaveEventTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) Inspect(f func(ctx context.Context, tx pgx.Tx, order models.Event)) *mStorageMockSaveEventTx {
+	if mmSaveEventTx.mock.inspectFuncSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveEventTx")
+	}
+
+	mmSaveEventTx.mock.inspectFuncSaveEventTx = f
+
+	return mmSaveEventTx
+}
+
+// Return sets up results that will be returned by Storage.SaveEventTx
+func (mmSaveEventTx *mStorageMockSaveEventTx) Return(err error) *StorageMock {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	if mmSaveEventTx.defaultExpectation == nil {
+		mmSaveEventTx.defaultExpectation = &StorageMockSaveEventTxExpectation{mock: mmSaveEventTx.mock}
+	}
+	mmSaveEventTx.defaultExpectation.results = &StorageMockSaveEventTxResults{err}
+	mmSaveEventTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveEventTx.mock
+}
+
+// Set uses given function f to mock the Storage.SaveEventTx method
+func (mmSaveEventTx *mStorageMockSaveEventTx) Set(f func(ctx context.Context, tx pgx.Tx, order models.Event) (err error)) *StorageMock {
+	if mmSaveEventTx.defaultExpectation != nil {
+		mmSaveEventTx.mock.t.Fatalf("Default expectation is already set for the Storage.SaveEventTx method")
+	}
+
+	if len(mmSaveEventTx.expectations) > 0 {
+		mmSaveEventTx.mock.t.Fatalf("Some expectations are already set for the Storage.SaveEventTx method")
+	}
+
+	mmSaveEventTx.mock.funcSaveEventTx = f
+	mmSaveEventTx.mock.funcSaveEventTxOrigin = minimock.CallerInfo(1)
+	return mmSaveEventTx.mock
+}
+
+// When sets expectation for the Storage.SaveEventTx which will trigger the result defined by the following
+// Then helper
+func (mmSaveEventTx *mStorageMockSaveEventTx) When(ctx context.Context, tx pgx.Tx, order models.Event) *StorageMockSaveEventTxExpectation {
+	if mmSaveEventTx.mock.funcSaveEventTx != nil {
+		mmSaveEventTx.mock.t.Fatalf("StorageMock.SaveEventTx mock is already set by Set")
+	}
+
+	expectation := &StorageMockSaveEventTxExpectation{
+		mock:               mmSaveEventTx.mock,
+		params:             &StorageMockSaveEventTxParams{ctx, tx, order},
+		expectationOrigins: StorageMockSaveEventTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveEventTx.expectations = append(mmSaveEventTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.SaveEventTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveEventTxExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveEventTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.SaveEventTx should be invoked
+func (mmSaveEventTx *mStorageMockSaveEventTx) Times(n uint64) *mStorageMockSaveEventTx {
+	if n == 0 {
+		mmSaveEventTx.mock.t.Fatalf("Times of StorageMock.SaveEventTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveEventTx.expectedInvocations, n)
+	mmSaveEventTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveEventTx
+}
+
+func (mmSaveEventTx *mStorageMockSaveEventTx) invocationsDone() bool {
+	if len(mmSaveEventTx.expectations) == 0 && mmSaveEventTx.defaultExpectation == nil && mmSaveEventTx.mock.funcSaveEventTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveEventTx.mock.afterSaveEventTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveEventTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveEventTx implements mm_storage.Storage
+func (mmSaveEventTx *StorageMock) SaveEventTx(ctx context.Context, tx pgx.Tx, order models.Event) (err error) {
+	mm_atomic.AddUint64(&mmSaveEventTx.beforeSaveEventTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveEventTx.afterSaveEventTxCounter, 1)
+
+	mmSaveEventTx.t.Helper()
+
+	if mmSaveEventTx.inspectFuncSaveEventTx != nil {
+		mmSaveEventTx.inspectFuncSaveEventTx(ctx, tx, order)
+	}
+
+	mm_params := StorageMockSaveEventTxParams{ctx, tx, order}
+
+	// Record call args
+	mmSaveEventTx.SaveEventTxMock.mutex.Lock()
+	mmSaveEventTx.SaveEventTxMock.callArgs = append(mmSaveEventTx.SaveEventTxMock.callArgs, &mm_params)
+	mmSaveEventTx.SaveEventTxMock.mutex.Unlock()
+
+	for _, e := range mmSaveEventTx.SaveEventTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveEventTx.SaveEventTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveEventTx.SaveEventTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveEventTx.SaveEventTxMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveEventTx.SaveEventTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockSaveEventTxParams{ctx, tx, order}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveEventTx.t.Errorf("StorageMock.SaveEventTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveEventTx.SaveEventTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmSaveEventTx.t.Errorf("StorageMock.SaveEventTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveEventTx.SaveEventTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmSaveEventTx.t.Errorf("StorageMock.SaveEventTx got unexpected parameter order, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveEventTx.SaveEventTxMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveEventTx.t.Errorf("StorageMock.SaveEventTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveEventTx.SaveEventTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveEventTx.SaveEventTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveEventTx.t.Fatal("No results are set for the StorageMock.SaveEventTx")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveEventTx.funcSaveEventTx != nil {
+		return mmSaveEventTx.funcSaveEventTx(ctx, tx, order)
+	}
+	mmSaveEventTx.t.Fatalf("Unexpected call to StorageMock.SaveEventTx. %v %v %v", ctx, tx, order)
+	return
+}
+
+// SaveEventTxAfterCounter returns a count of finished StorageMock.SaveEventTx invocations
+func (mmSaveEventTx *StorageMock) SaveEventTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveEventTx.afterSaveEventTxCounter)
+}
+
+// SaveEventTxBeforeCounter returns a count of StorageMock.SaveEventTx invocations
+func (mmSaveEventTx *StorageMock) SaveEventTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveEventTx.beforeSaveEventTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.SaveEventTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveEventTx *mStorageMockSaveEventTx) Calls() []*StorageMockSaveEventTxParams {
+	mmSaveEventTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockSaveEventTxParams, len(mmSaveEventTx.callArgs))
+	copy(argCopy, mmSaveEventTx.callArgs)
+
+	mmSaveEventTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveEventTxDone returns true if the count of the SaveEventTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockSaveEventTxDone() bool {
+	if m.SaveEventTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveEventTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveEventTxMock.invocationsDone()
+}
+
+// MinimockSaveEventTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveEventTxInspect() {
+	for _, e := range m.SaveEventTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.SaveEventTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveEventTxCounter := mm_atomic.LoadUint64(&m.afterSaveEventTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveEventTxMock.defaultExpectation != nil && afterSaveEventTxCounter < 1 {
+		if m.SaveEventTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.SaveEventTx at\n%s", m.SaveEventTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SaveEventTx at\n%s with params: %#v", m.SaveEventTxMock.defaultExpectation.expectationOrigins.origin, *m.SaveEventTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveEventTx != nil && afterSaveEventTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.SaveEventTx at\n%s", m.funcSaveEventTxOrigin)
+	}
+
+	if !m.SaveEventTxMock.invocationsDone() && afterSaveEventTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.SaveEventTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveEventTxMock.expectedInvocations), m.SaveEventTxMock.expectedInvocationsOrigin, afterSaveEventTxCounter)
+	}
+}
+
+type mStorageMockSaveOrderTx struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveOrderTxExpectation
+	expectations       []*StorageMockSaveOrderTxExpectation
+
+	callArgs []*StorageMockSaveOrderTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockSaveOrderTxExpectation specifies expectation struct of the Storage.SaveOrderTx
+type StorageMockSaveOrderTxExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockSaveOrderTxParams
+	paramPtrs          *StorageMockSaveOrderTxParamPtrs
+	expectationOrigins StorageMockSaveOrderTxExpectationOrigins
+	results            *StorageMockSaveOrderTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockSaveOrderTxParams contains parameters of the Storage.SaveOrderTx
+type StorageMockSaveOrderTxParams struct {
+	ctx   context.Context
+	tx    pgx.Tx
+	order models.Order
+	actor models.Actor
+}
+
+// StorageMockSaveOrderTxParamPtrs contains pointers to parameters of the Storage.SaveOrderTx
+type StorageMockSaveOrderTxParamPtrs struct {
+	ctx   *context.Context
+	tx    *pgx.Tx
+	order *models.Order
+	actor *models.Actor
+}
+
+// StorageMockSaveOrderTxResults contains results of the Storage.SaveOrderTx
+type StorageMockSaveOrderTxResults struct {
+	err error
+}
+
+// StorageMockSaveOrderTxOrigins contains origins of expectations of the Storage.SaveOrderTx
+type StorageMockSaveOrderTxExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTx    string
+	originOrder string
+	originActor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Optional() *mStorageMockSaveOrderTx {
+	mmSaveOrderTx.optional = true
+	return mmSaveOrderTx
+}
+
+// Expect sets up expected params for Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Expect(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	}
+
+	if mmSaveOrderTx.defaultExpectation.paramPtrs != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by ExpectParams functions")
+	}
+
+	mmSaveOrderTx.defaultExpectation.params = &StorageMockSaveOrderTxParams{ctx, tx, order, actor}
+	mmSaveOrderTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveOrderTx.expectations {
+		if minimock.Equal(e.params, mmSaveOrderTx.defaultExpectation.params) {
+			mmSaveOrderTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveOrderTx.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveOrderTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectCtxParam1(ctx context.Context) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	}
+
+	if mmSaveOrderTx.defaultExpectation.params != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	}
+
+	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	}
+	mmSaveOrderTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveOrderTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveOrderTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	}
+
+	if mmSaveOrderTx.defaultExpectation.params != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	}
+
+	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	}
+	mmSaveOrderTx.defaultExpectation.paramPtrs.tx = &tx
+	mmSaveOrderTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmSaveOrderTx
+}
+
+// ExpectOrderParam3 sets up expected param order for Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectOrderParam3(order models.Order) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	}
+
+	if mmSaveOrderTx.defaultExpectation.params != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	}
+
+	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	}
+	mmSaveOrderTx.defaultExpectation.paramPtrs.order = &order
+	mmSaveOrderTx.defaultExpectation.expectationOrigins.originOrder = minimock.CallerInfo(1)
+
+	return mmSaveOrderTx
+}
+
+// ExpectActorParam4 sets up expected param actor for Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectActorParam4(actor models.Actor) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	}
+
+	if mmSaveOrderTx.defaultExpectation.params != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	}
+
+	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	}
+	mmSaveOrderTx.defaultExpectation.paramPtrs.actor = &actor
+	mmSaveOrderTx.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmSaveOrderTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Inspect(f func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor)) *mStorageMockSaveOrderTx {
+	if mmSaveOrderTx.mock.inspectFuncSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveOrderTx")
+	}
+
+	mmSaveOrderTx.mock.inspectFuncSaveOrderTx = f
+
+	return mmSaveOrderTx
+}
+
+// Return sets up results that will be returned by Storage.SaveOrderTx
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Return(err error) *StorageMock {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	if mmSaveOrderTx.defaultExpectation == nil {
+		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{mock: mmSaveOrderTx.mock}
+	}
+	mmSaveOrderTx.defaultExpectation.results = &StorageMockSaveOrderTxResults{err}
+	mmSaveOrderTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveOrderTx.mock
+}
+
+// Set uses given function f to mock the Storage.SaveOrderTx method
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Set(f func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error)) *StorageMock {
+	if mmSaveOrderTx.defaultExpectation != nil {
+		mmSaveOrderTx.mock.t.Fatalf("Default expectation is already set for the Storage.SaveOrderTx method")
+	}
+
+	if len(mmSaveOrderTx.expectations) > 0 {
+		mmSaveOrderTx.mock.t.Fatalf("Some expectations are already set for the Storage.SaveOrderTx method")
+	}
+
+	mmSaveOrderTx.mock.funcSaveOrderTx = f
+	mmSaveOrderTx.mock.funcSaveOrderTxOrigin = minimock.CallerInfo(1)
+	return mmSaveOrderTx.mock
+}
+
+// When sets expectation for the Storage.SaveOrderTx which will trigger the result defined by the following
+// Then helper
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) When(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) *StorageMockSaveOrderTxExpectation {
+	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
+		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+	}
+
+	expectation := &StorageMockSaveOrderTxExpectation{
+		mock:               mmSaveOrderTx.mock,
+		params:             &StorageMockSaveOrderTxParams{ctx, tx, order, actor},
+		expectationOrigins: StorageMockSaveOrderTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveOrderTx.expectations = append(mmSaveOrderTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.SaveOrderTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveOrderTxExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveOrderTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.SaveOrderTx should be invoked
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Times(n uint64) *mStorageMockSaveOrderTx {
+	if n == 0 {
+		mmSaveOrderTx.mock.t.Fatalf("Times of StorageMock.SaveOrderTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveOrderTx.expectedInvocations, n)
+	mmSaveOrderTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveOrderTx
+}
+
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) invocationsDone() bool {
+	if len(mmSaveOrderTx.expectations) == 0 && mmSaveOrderTx.defaultExpectation == nil && mmSaveOrderTx.mock.funcSaveOrderTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveOrderTx.mock.afterSaveOrderTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveOrderTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveOrderTx implements mm_storage.Storage
+func (mmSaveOrderTx *StorageMock) SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error) {
+	mm_atomic.AddUint64(&mmSaveOrderTx.beforeSaveOrderTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveOrderTx.afterSaveOrderTxCounter, 1)
+
+	mmSaveOrderTx.t.Helper()
+
+	if mmSaveOrderTx.inspectFuncSaveOrderTx != nil {
+		mmSaveOrderTx.inspectFuncSaveOrderTx(ctx, tx, order, actor)
+	}
+
+	mm_params := StorageMockSaveOrderTxParams{ctx, tx, order, actor}
+
+	// Record call args
+	mmSaveOrderTx.SaveOrderTxMock.mutex.Lock()
+	mmSaveOrderTx.SaveOrderTxMock.callArgs = append(mmSaveOrderTx.SaveOrderTxMock.callArgs, &mm_params)
+	mmSaveOrderTx.SaveOrderTxMock.mutex.Unlock()
+
+	for _, e := range mmSaveOrderTx.SaveOrderTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveOrderTx.SaveOrderTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockSaveOrderTxParams{ctx, tx, order, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter order, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveOrderTx.t.Fatal("No results are set for the StorageMock.SaveOrderTx")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveOrderTx.funcSaveOrderTx != nil {
+		return mmSaveOrderTx.funcSaveOrderTx(ctx, tx, order, actor)
+	}
+	mmSaveOrderTx.t.Fatalf("Unexpected call to StorageMock.SaveOrderTx. %v %v %v %v", ctx, tx, order, actor)
+	return
+}
+
+// SaveOrderTxAfterCounter returns a count of finished StorageMock.SaveOrderTx invocations
+func (mmSaveOrderTx *StorageMock) SaveOrderTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOrderTx.afterSaveOrderTxCounter)
+}
+
+// SaveOrderTxBeforeCounter returns a count of StorageMock.SaveOrderTx invocations
+func (mmSaveOrderTx *StorageMock) SaveOrderTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOrderTx.beforeSaveOrderTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.SaveOrderTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveOrderTx *mStorageMockSaveOrderTx) Calls() []*StorageMockSaveOrderTxParams {
+	mmSaveOrderTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockSaveOrderTxParams, len(mmSaveOrderTx.callArgs))
+	copy(argCopy, mmSaveOrderTx.callArgs)
+
+	mmSaveOrderTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveOrderTxDone returns true if the count of the SaveOrderTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockSaveOrderTxDone() bool {
+	if m.SaveOrderTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveOrderTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveOrderTxMock.invocationsDone()
+}
+
+// MinimockSaveOrderTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveOrderTxInspect() {
+	for _, e := range m.SaveOrderTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveOrderTxCounter := mm_atomic.LoadUint64(&m.afterSaveOrderTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveOrderTxMock.defaultExpectation != nil && afterSaveOrderTxCounter < 1 {
+		if m.SaveOrderTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s", m.SaveOrderTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s with params: %#v", m.SaveOrderTxMock.defaultExpectation.expectationOrigins.origin, *m.SaveOrderTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveOrderTx != nil && afterSaveOrderTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s", m.funcSaveOrderTxOrigin)
+	}
+
+	if !m.SaveOrderTxMock.invocationsDone() && afterSaveOrderTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.SaveOrderTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveOrderTxMock.expectedInvocations), m.SaveOrderTxMock.expectedInvocationsOrigin, afterSaveOrderTxCounter)
+	}
+}
+
+type mStorageMockSaveOrdersTx struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockSaveOrdersTxExpectation
+	expectations       []*StorageMockSaveOrdersTxExpectation
+
+	callArgs []*StorageMockSaveOrdersTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockSaveOrdersTxExpectation specifies expectation struct of the Storage.SaveOrdersTx
+type StorageMockSaveOrdersTxExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockSaveOrdersTxParams
+	paramPtrs          *StorageMockSaveOrdersTxParamPtrs
+	expectationOrigins StorageMockSaveOrdersTxExpectationOrigins
+	results            *StorageMockSaveOrdersTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockSaveOrdersTxParams contains parameters of the Storage.SaveOrdersTx
+type StorageMockSaveOrdersTxParams struct {
+	ctx    context.Context
+	tx     pgx.Tx
+	orders []models.Order
+	actor  models.Actor
+}
+
+// StorageMockSaveOrdersTxParamPtrs contains pointers to parameters of the Storage.SaveOrdersTx
+type StorageMockSaveOrdersTxParamPtrs struct {
+	ctx    *context.Context
+	tx     *pgx.Tx
+	orders *[]models.Order
+	actor  *models.Actor
+}
+
+// StorageMockSaveOrdersTxResults contains results of the Storage.SaveOrdersTx
+type StorageMockSaveOrdersTxResults struct {
+	err error
+}
+
+// StorageMockSaveOrdersTxOrigins contains origins of expectations of the Storage.SaveOrdersTx
+type StorageMockSaveOrdersTxExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTx     string
+	originOrders string
+	originActor  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Optional() *mStorageMockSaveOrdersTx {
+	mmSaveOrdersTx.optional = true
+	return mmSaveOrdersTx
+}
+
+// Expect sets up expected params for Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Expect(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{}
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.paramPtrs != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by ExpectParams functions")
+	}
+
+	mmSaveOrdersTx.defaultExpectation.params = &StorageMockSaveOrdersTxParams{ctx, tx, orders, actor}
+	mmSaveOrdersTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveOrdersTx.expectations {
+		if minimock.Equal(e.params, mmSaveOrdersTx.defaultExpectation.params) {
+			mmSaveOrdersTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveOrdersTx.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveOrdersTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) ExpectCtxParam1(ctx context.Context) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{}
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.params != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Expect")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrdersTx.defaultExpectation.paramPtrs = &StorageMockSaveOrdersTxParamPtrs{}
+	}
+	mmSaveOrdersTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveOrdersTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveOrdersTx
+}
+
+// ExpectTxParam2 sets up expected param tx for Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{}
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.params != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Expect")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrdersTx.defaultExpectation.paramPtrs = &StorageMockSaveOrdersTxParamPtrs{}
+	}
+	mmSaveOrdersTx.defaultExpectation.paramPtrs.tx = &tx
+	mmSaveOrdersTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+
+	return mmSaveOrdersTx
+}
+
+// ExpectOrdersParam3 sets up expected param orders for Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) ExpectOrdersParam3(orders []models.Order) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{}
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.params != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Expect")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrdersTx.defaultExpectation.paramPtrs = &StorageMockSaveOrdersTxParamPtrs{}
+	}
+	mmSaveOrdersTx.defaultExpectation.paramPtrs.orders = &orders
+	mmSaveOrdersTx.defaultExpectation.expectationOrigins.originOrders = minimock.CallerInfo(1)
+
+	return mmSaveOrdersTx
+}
+
+// ExpectActorParam4 sets up expected param actor for Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) ExpectActorParam4(actor models.Actor) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{}
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.params != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Expect")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation.paramPtrs == nil {
+		mmSaveOrdersTx.defaultExpectation.paramPtrs = &StorageMockSaveOrdersTxParamPtrs{}
+	}
+	mmSaveOrdersTx.defaultExpectation.paramPtrs.actor = &actor
+	mmSaveOrdersTx.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmSaveOrdersTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Inspect(f func(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor)) *mStorageMockSaveOrdersTx {
+	if mmSaveOrdersTx.mock.inspectFuncSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveOrdersTx")
+	}
+
+	mmSaveOrdersTx.mock.inspectFuncSaveOrdersTx = f
+
+	return mmSaveOrdersTx
+}
+
+// Return sets up results that will be returned by Storage.SaveOrdersTx
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Return(err error) *StorageMock {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	if mmSaveOrdersTx.defaultExpectation == nil {
+		mmSaveOrdersTx.defaultExpectation = &StorageMockSaveOrdersTxExpectation{mock: mmSaveOrdersTx.mock}
+	}
+	mmSaveOrdersTx.defaultExpectation.results = &StorageMockSaveOrdersTxResults{err}
+	mmSaveOrdersTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveOrdersTx.mock
+}
+
+// Set uses given function f to mock the Storage.SaveOrdersTx method
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Set(f func(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) (err error)) *StorageMock {
+	if mmSaveOrdersTx.defaultExpectation != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("Default expectation is already set for the Storage.SaveOrdersTx method")
+	}
+
+	if len(mmSaveOrdersTx.expectations) > 0 {
+		mmSaveOrdersTx.mock.t.Fatalf("Some expectations are already set for the Storage.SaveOrdersTx method")
+	}
+
+	mmSaveOrdersTx.mock.funcSaveOrdersTx = f
+	mmSaveOrdersTx.mock.funcSaveOrdersTxOrigin = minimock.CallerInfo(1)
+	return mmSaveOrdersTx.mock
+}
+
+// When sets expectation for the Storage.SaveOrdersTx which will trigger the result defined by the following
+// Then helper
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) When(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) *StorageMockSaveOrdersTxExpectation {
+	if mmSaveOrdersTx.mock.funcSaveOrdersTx != nil {
+		mmSaveOrdersTx.mock.t.Fatalf("StorageMock.SaveOrdersTx mock is already set by Set")
+	}
+
+	expectation := &StorageMockSaveOrdersTxExpectation{
+		mock:               mmSaveOrdersTx.mock,
+		params:             &StorageMockSaveOrdersTxParams{ctx, tx, orders, actor},
+		expectationOrigins: StorageMockSaveOrdersTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveOrdersTx.expectations = append(mmSaveOrdersTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.SaveOrdersTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockSaveOrdersTxExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockSaveOrdersTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.SaveOrdersTx should be invoked
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Times(n uint64) *mStorageMockSaveOrdersTx {
+	if n == 0 {
+		mmSaveOrdersTx.mock.t.Fatalf("Times of StorageMock.SaveOrdersTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveOrdersTx.expectedInvocations, n)
+	mmSaveOrdersTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveOrdersTx
+}
+
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) invocationsDone() bool {
+	if len(mmSaveOrdersTx.expectations) == 0 && mmSaveOrdersTx.defaultExpectation == nil && mmSaveOrdersTx.mock.funcSaveOrdersTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveOrdersTx.mock.afterSaveOrdersTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveOrdersTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveOrdersTx implements mm_storage.Storage
+func (mmSaveOrdersTx *StorageMock) SaveOrdersTx(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) (err error) {
+	mm_atomic.AddUint64(&mmSaveOrdersTx.beforeSaveOrdersTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveOrdersTx.afterSaveOrdersTxCounter, 1)
+
+	mmSaveOrdersTx.t.Helper()
+
+	if mmSaveOrdersTx.inspectFuncSaveOrdersTx != nil {
+		mmSaveOrdersTx.inspectFuncSaveOrdersTx(ctx, tx, orders, actor)
+	}
+
+	mm_params := StorageMockSaveOrdersTxParams{ctx, tx, orders, actor}
+
+	// Record call args
+	mmSaveOrdersTx.SaveOrdersTxMock.mutex.Lock()
+	mmSaveOrdersTx.SaveOrdersTxMock.callArgs = append(mmSaveOrdersTx.SaveOrdersTxMock.callArgs, &mm_params)
+	mmSaveOrdersTx.SaveOrdersTxMock.mutex.Unlock()
+
+	for _, e := range mmSaveOrdersTx.SaveOrdersTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockSaveOrdersTxParams{ctx, tx, orders, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveOrdersTx.t.Errorf("StorageMock.SaveOrdersTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
+				mmSaveOrdersTx.t.Errorf("StorageMock.SaveOrdersTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+			}
+
+			if mm_want_ptrs.orders != nil && !minimock.Equal(*mm_want_ptrs.orders, mm_got.orders) {
+				mmSaveOrdersTx.t.Errorf("StorageMock.SaveOrdersTx got unexpected parameter orders, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.expectationOrigins.originOrders, *mm_want_ptrs.orders, mm_got.orders, minimock.Diff(*mm_want_ptrs.orders, mm_got.orders))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmSaveOrdersTx.t.Errorf("StorageMock.SaveOrdersTx got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveOrdersTx.t.Errorf("StorageMock.SaveOrdersTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveOrdersTx.SaveOrdersTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveOrdersTx.t.Fatal("No results are set for the StorageMock.SaveOrdersTx")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveOrdersTx.funcSaveOrdersTx != nil {
+		return mmSaveOrdersTx.funcSaveOrdersTx(ctx, tx, orders, actor)
+	}
+	mmSaveOrdersTx.t.Fatalf("Unexpected call to StorageMock.SaveOrdersTx. %v %v %v %v", ctx, tx, orders, actor)
+	return
+}
+
+// SaveOrdersTxAfterCounter returns a count of finished StorageMock.SaveOrdersTx invocations
+func (mmSaveOrdersTx *StorageMock) SaveOrdersTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOrdersTx.afterSaveOrdersTxCounter)
+}
+
+// SaveOrdersTxBeforeCounter returns a count of StorageMock.SaveOrdersTx invocations
+func (mmSaveOrdersTx *StorageMock) SaveOrdersTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOrdersTx.beforeSaveOrdersTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.SaveOrdersTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveOrdersTx *mStorageMockSaveOrdersTx) Calls() []*StorageMockSaveOrdersTxParams {
+	mmSaveOrdersTx.mutex.RLock()
+
+	argCopy := make([]*StorageMockSaveOrdersTxParams, len(mmSaveOrdersTx.callArgs))
+	copy(argCopy, mmSaveOrdersTx.callArgs)
+
+	mmSaveOrdersTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveOrdersTxDone returns true if the count of the SaveOrdersTx invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockSaveOrdersTxDone() bool {
+	if m.SaveOrdersTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveOrdersTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveOrdersTxMock.invocationsDone()
+}
+
+// MinimockSaveOrdersTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockSaveOrdersTxInspect() {
+	for _, e := range m.SaveOrdersTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.SaveOrdersTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveOrdersTxCounter := mm_atomic.LoadUint64(&m.afterSaveOrdersTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveOrdersTxMock.defaultExpectation != nil && afterSaveOrdersTxCounter < 1 {
+		if m.SaveOrdersTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.SaveOrdersTx at\n%s", m.SaveOrdersTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.SaveOrdersTx at\n%s with params: %#v", m.SaveOrdersTxMock.defaultExpectation.expectationOrigins.origin, *m.SaveOrdersTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveOrdersTx != nil && afterSaveOrdersTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.SaveOrdersTx at\n%s", m.funcSaveOrdersTxOrigin)
+	}
+
+	if !m.SaveOrdersTxMock.invocationsDone() && afterSaveOrdersTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.SaveOrdersTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveOrdersTxMock.expectedInvocations), m.SaveOrdersTxMock.expectedInvocationsOrigin, afterSaveOrdersTxCounter)
+	}
+}
+
+type mStorageMockStartImportJob struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockStartImportJobExpectation
+	expectations       []*StorageMockStartImportJobExpectation
+
+	callArgs []*StorageMockStartImportJobParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockStartImportJobExpectation specifies expectation struct of the Storage.StartImportJob
+type StorageMockStartImportJobExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockStartImportJobParams
+	paramPtrs          *StorageMockStartImportJobParamPtrs
+	expectationOrigins StorageMockStartImportJobExpectationOrigins
+	results            *StorageMockStartImportJobResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockStartImportJobParams contains parameters of the Storage.StartImportJob
+type StorageMockStartImportJobParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockStartImportJobParamPtrs contains pointers to parameters of the Storage.StartImportJob
+type StorageMockStartImportJobParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockStartImportJobResults contains results of the Storage.StartImportJob
+type StorageMockStartImportJobResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// StorageMockStartImportJobOrigins contains origins of expectations of the Storage.StartImportJob
+type StorageMockStartImportJobExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartImportJob *mStorageMockStartImportJob) Optional() *mStorageMockStartImportJob {
+	mmStartImportJob.optional = true
+	return mmStartImportJob
+}
+
+// Expect sets up expected params for Storage.StartImportJob
+func (mmStartImportJob *mStorageMockStartImportJob) Expect(ctx context.Context, id string) *mStorageMockStartImportJob {
+	if mmStartImportJob.mock.funcStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Set")
+	}
+
+	if mmStartImportJob.defaultExpectation == nil {
+		mmStartImportJob.defaultExpectation = &StorageMockStartImportJobExpectation{}
+	}
+
+	if mmStartImportJob.defaultExpectation.paramPtrs != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by ExpectParams functions")
+	}
+
+	mmStartImportJob.defaultExpectation.params = &StorageMockStartImportJobParams{ctx, id}
+	mmStartImportJob.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartImportJob.expectations {
+		if minimock.Equal(e.params, mmStartImportJob.defaultExpectation.params) {
+			mmStartImportJob.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartImportJob.defaultExpectation.params)
+		}
+	}
+
+	return mmStartImportJob
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.StartImportJob
+func (mmStartImportJob *mStorageMockStartImportJob) ExpectCtxParam1(ctx context.Context) *mStorageMockStartImportJob {
+	if mmStartImportJob.mock.funcStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Set")
+	}
+
+	if mmStartImportJob.defaultExpectation == nil {
+		mmStartImportJob.defaultExpectation = &StorageMockStartImportJobExpectation{}
+	}
+
+	if mmStartImportJob.defaultExpectation.params != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Expect")
+	}
+
+	if mmStartImportJob.defaultExpectation.paramPtrs == nil {
+		mmStartImportJob.defaultExpectation.paramPtrs = &StorageMockStartImportJobParamPtrs{}
+	}
+	mmStartImportJob.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartImportJob.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartImportJob
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.StartImportJob
+func (mmStartImportJob *mStorageMockStartImportJob) ExpectIdParam2(id string) *mStorageMockStartImportJob {
+	if mmStartImportJob.mock.funcStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Set")
+	}
+
+	if mmStartImportJob.defaultExpectation == nil {
+		mmStartImportJob.defaultExpectation = &StorageMockStartImportJobExpectation{}
+	}
+
+	if mmStartImportJob.defaultExpectation.params != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Expect")
+	}
+
+	if mmStartImportJob.defaultExpectation.paramPtrs == nil {
+		mmStartImportJob.defaultExpectation.paramPtrs = &StorageMockStartImportJobParamPtrs{}
+	}
+	mmStartImportJob.defaultExpectation.paramPtrs.id = &id
+	mmStartImportJob.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmStartImportJob
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.StartImportJob
+func (mmStartImportJob *mStorageMockStartImportJob) Inspect(f func(ctx context.Context, id string)) *mStorageMockStartImportJob {
+	if mmStartImportJob.mock.inspectFuncStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("Inspect function is already set for StorageMock.StartImportJob")
+	}
+
+	mmStartImportJob.mock.inspectFuncStartImportJob = f
+
+	return mmStartImportJob
+}
+
+// Return sets up results that will be returned by Storage.StartImportJob
+func (mmStartImportJob *mStorageMockStartImportJob) Return(i1 models.ImportJob, err error) *StorageMock {
+	if mmStartImportJob.mock.funcStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Set")
+	}
+
+	if mmStartImportJob.defaultExpectation == nil {
+		mmStartImportJob.defaultExpectation = &StorageMockStartImportJobExpectation{mock: mmStartImportJob.mock}
+	}
+	mmStartImportJob.defaultExpectation.results = &StorageMockStartImportJobResults{i1, err}
+	mmStartImportJob.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartImportJob.mock
+}
+
+// Set uses given function f to mock the Storage.StartImportJob method
+func (mmStartImportJob *mStorageMockStartImportJob) Set(f func(ctx context.Context, id string) (i1 models.ImportJob, err error)) *StorageMock {
+	if mmStartImportJob.defaultExpectation != nil {
+		mmStartImportJob.mock.t.Fatalf("Default expectation is already set for the Storage.StartImportJob method")
+	}
+
+	if len(mmStartImportJob.expectations) > 0 {
+		mmStartImportJob.mock.t.Fatalf("Some expectations are already set for the Storage.StartImportJob method")
+	}
+
+	mmStartImportJob.mock.funcStartImportJob = f
+	mmStartImportJob.mock.funcStartImportJobOrigin = minimock.CallerInfo(1)
+	return mmStartImportJob.mock
+}
+
+// When sets expectation for the Storage.StartImportJob which will trigger the result defined by the following
+// Then helper
+func (mmStartImportJob *mStorageMockStartImportJob) When(ctx context.Context, id string) *StorageMockStartImportJobExpectation {
+	if mmStartImportJob.mock.funcStartImportJob != nil {
+		mmStartImportJob.mock.t.Fatalf("StorageMock.StartImportJob mock is already set by Set")
+	}
+
+	expectation := &StorageMockStartImportJobExpectation{
+		mock:               mmStartImportJob.mock,
+		params:             &StorageMockStartImportJobParams{ctx, id},
+		expectationOrigins: StorageMockStartImportJobExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartImportJob.expectations = append(mmStartImportJob.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.StartImportJob return parameters for the expectation previously defined by the When method
+func (e *StorageMockStartImportJobExpectation) Then(i1 models.ImportJob, err error) *StorageMock {
+	e.results = &StorageMockStartImportJobResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.StartImportJob should be invoked
+func (mmStartImportJob *mStorageMockStartImportJob) Times(n uint64) *mStorageMockStartImportJob {
+	if n == 0 {
+		mmStartImportJob.mock.t.Fatalf("Times of StorageMock.StartImportJob mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartImportJob.expectedInvocations, n)
+	mmStartImportJob.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartImportJob
+}
+
+func (mmStartImportJob *mStorageMockStartImportJob) invocationsDone() bool {
+	if len(mmStartImportJob.expectations) == 0 && mmStartImportJob.defaultExpectation == nil && mmStartImportJob.mock.funcStartImportJob == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartImportJob.mock.afterStartImportJobCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartImportJob.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartImportJob implements mm_storage.Storage
+func (mmStartImportJob *StorageMock) StartImportJob(ctx context.Context, id string) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmStartImportJob.beforeStartImportJobCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartImportJob.afterStartImportJobCounter, 1)
+
+	mmStartImportJob.t.Helper()
+
+	if mmStartImportJob.inspectFuncStartImportJob != nil {
+		mmStartImportJob.inspectFuncStartImportJob(ctx, id)
+	}
+
+	mm_params := StorageMockStartImportJobParams{ctx, id}
+
+	// Record call args
+	mmStartImportJob.StartImportJobMock.mutex.Lock()
+	mmStartImportJob.StartImportJobMock.callArgs = append(mmStartImportJob.StartImportJobMock.callArgs, &mm_params)
+	mmStartImportJob.StartImportJobMock.mutex.Unlock()
+
+	for _, e := range mmStartImportJob.StartImportJobMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmStartImportJob.StartImportJobMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartImportJob.StartImportJobMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartImportJob.StartImportJobMock.defaultExpectation.params
+		mm_want_ptrs := mmStartImportJob.StartImportJobMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockStartImportJobParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartImportJob.t.Errorf("StorageMock.StartImportJob got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartImportJob.StartImportJobMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmStartImportJob.t.Errorf("StorageMock.StartImportJob got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartImportJob.StartImportJobMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListOrders.t.Errorf("StorageMock.ListOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmStartImportJob.t.Errorf("StorageMock.StartImportJob got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartImportJob.StartImportJobMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListOrders.ListOrdersMock.defaultExpectation.results
+		mm_results := mmStartImportJob.StartImportJobMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListOrders.t.Fatal("No results are set for the StorageMock.ListOrders")
+			mmStartImportJob.t.Fatal("No results are set for the StorageMock.StartImportJob")
 		}
-		return (*mm_results).oa1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmListOrders.funcListOrders != nil {
-		return mmListOrders.funcListOrders(ctx)
+	if mmStartImportJob.funcStartImportJob != nil {
+		return mmStartImportJob.funcStartImportJob(ctx, id)
 	}
-	mmListOrders.t.Fatalf("Unexpected call to StorageMock.ListOrders. %v", ctx)
+	mmStartImportJob.t.Fatalf("Unexpected call to StorageMock.StartImportJob. %v %v", ctx, id)
 	return
 }
 
-// ListOrdersAfterCounter returns a count of finished StorageMock.ListOrders invocations
-func (mmListOrders *StorageMock) ListOrdersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListOrders.afterListOrdersCounter)
+// StartImportJobAfterCounter returns a count of finished StorageMock.StartImportJob invocations
+func (mmStartImportJob *StorageMock) StartImportJobAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartImportJob.afterStartImportJobCounter)
 }
 
-// ListOrdersBeforeCounter returns a count of StorageMock.ListOrders invocations
-func (mmListOrders *StorageMock) ListOrdersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListOrders.beforeListOrdersCounter)
+// StartImportJobBeforeCounter returns a count of StorageMock.StartImportJob invocations
+func (mmStartImportJob *StorageMock) StartImportJobBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartImportJob.beforeStartImportJobCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.ListOrders.
+// Calls returns a list of arguments used in each call to StorageMock.StartImportJob.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListOrders *mStorageMockListOrders) Calls() []*StorageMockListOrdersParams {
-	mmListOrders.mutex.RLock()
+func (mmStartImportJob *mStorageMockStartImportJob) Calls() []*StorageMockStartImportJobParams {
+	mmStartImportJob.mutex.RLock()
 
-	argCopy := make([]*StorageMockListOrdersParams, len(mmListOrders.callArgs))
-	copy(argCopy, mmListOrders.callArgs)
+	argCopy := make([]*StorageMockStartImportJobParams, len(mmStartImportJob.callArgs))
+	copy(argCopy, mmStartImportJob.callArgs)
 
-	mmListOrders.mutex.RUnlock()
+	mmStartImportJob.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListOrdersDone returns true if the count of the ListOrders invocations corresponds
+// MinimockStartImportJobDone returns true if the count of the StartImportJob invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockListOrdersDone() bool {
-	if m.ListOrdersMock.optional {
+func (m *StorageMock) MinimockStartImportJobDone() bool {
+	if m.StartImportJobMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListOrdersMock.expectations {
+	for _, e := range m.StartImportJobMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListOrdersMock.invocationsDone()
+	return m.StartImportJobMock.invocationsDone()
 }
 
-// MinimockListOrdersInspect logs each unmet expectation
-func (m *StorageMock) MinimockListOrdersInspect() {
-	for _, e := range m.ListOrdersMock.expectations {
+// MinimockStartImportJobInspect logs each unmet expectation
+func (m *StorageMock) MinimockStartImportJobInspect() {
+	for _, e := range m.StartImportJobMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.StartImportJob at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListOrdersCounter := mm_atomic.LoadUint64(&m.afterListOrdersCounter)
+	afterStartImportJobCounter := mm_atomic.LoadUint64(&m.afterStartImportJobCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListOrdersMock.defaultExpectation != nil && afterListOrdersCounter < 1 {
-		if m.ListOrdersMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s", m.ListOrdersMock.defaultExpectation.returnOrigin)
+	if m.StartImportJobMock.defaultExpectation != nil && afterStartImportJobCounter < 1 {
+		if m.StartImportJobMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.StartImportJob at\n%s", m.StartImportJobMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s with params: %#v", m.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ListOrdersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.StartImportJob at\n%s with params: %#v", m.StartImportJobMock.defaultExpectation.expectationOrigins.origin, *m.StartImportJobMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListOrders != nil && afterListOrdersCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.ListOrders at\n%s", m.funcListOrdersOrigin)
+	if m.funcStartImportJob != nil && afterStartImportJobCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.StartImportJob at\n%s", m.funcStartImportJobOrigin)
 	}
 
-	if !m.ListOrdersMock.invocationsDone() && afterListOrdersCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.ListOrders at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListOrdersMock.expectedInvocations), m.ListOrdersMock.expectedInvocationsOrigin, afterListOrdersCounter)
+	if !m.StartImportJobMock.invocationsDone() && afterStartImportJobCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.StartImportJob at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartImportJobMock.expectedInvocations), m.StartImportJobMock.expectedInvocationsOrigin, afterStartImportJobCounter)
 	}
 }
 
-type mStorageMockSaveOrderTx struct {
+type mStorageMockUpdateImportJobTx struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockSaveOrderTxExpectation
-	expectations       []*StorageMockSaveOrderTxExpectation
+	defaultExpectation *StorageMockUpdateImportJobTxExpectation
+	expectations       []*StorageMockUpdateImportJobTxExpectation
 
-	callArgs []*StorageMockSaveOrderTxParams
+	callArgs []*StorageMockUpdateImportJobTxParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockSaveOrderTxExpectation specifies expectation struct of the Storage.SaveOrderTx
-type StorageMockSaveOrderTxExpectation struct {
+// StorageMockUpdateImportJobTxExpectation specifies expectation struct of the Storage.UpdateImportJobTx
+type StorageMockUpdateImportJobTxExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockSaveOrderTxParams
-	paramPtrs          *StorageMockSaveOrderTxParamPtrs
-	expectationOrigins StorageMockSaveOrderTxExpectationOrigins
-	results            *StorageMockSaveOrderTxResults
+	params             *StorageMockUpdateImportJobTxParams
+	paramPtrs          *StorageMockUpdateImportJobTxParamPtrs
+	expectationOrigins StorageMockUpdateImportJobTxExpectationOrigins
+	results            *StorageMockUpdateImportJobTxResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockSaveOrderTxParams contains parameters of the Storage.SaveOrderTx
-type StorageMockSaveOrderTxParams struct {
-	ctx   context.Context
-	tx    pgx.Tx
-	order models.Order
+// StorageMockUpdateImportJobTxParams contains parameters of the Storage.UpdateImportJobTx
+type StorageMockUpdateImportJobTxParams struct {
+	ctx context.Context
+	tx  pgx.Tx
+	job models.ImportJob
 }
 
-// StorageMockSaveOrderTxParamPtrs contains pointers to parameters of the Storage.SaveOrderTx
-type StorageMockSaveOrderTxParamPtrs struct {
-	ctx   *context.Context
-	tx    *pgx.Tx
-	order *models.Order
+// StorageMockUpdateImportJobTxParamPtrs contains pointers to parameters of the Storage.UpdateImportJobTx
+type StorageMockUpdateImportJobTxParamPtrs struct {
+	ctx *context.Context
+	tx  *pgx.Tx
+	job *models.ImportJob
 }
 
-// StorageMockSaveOrderTxResults contains results of the Storage.SaveOrderTx
-type StorageMockSaveOrderTxResults struct {
+// StorageMockUpdateImportJobTxResults contains results of the Storage.UpdateImportJobTx
+type StorageMockUpdateImportJobTxResults struct {
 	err error
 }
 
-// StorageMockSaveOrderTxOrigins contains origins of expectations of the Storage.SaveOrderTx
-type StorageMockSaveOrderTxExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originTx    string
-	originOrder string
+// StorageMockUpdateImportJobTxOrigins contains origins of expectations of the Storage.UpdateImportJobTx
+type StorageMockUpdateImportJobTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originTx  string
+	originJob string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1531,320 +5052,320 @@ type StorageMockSaveOrderTxExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Optional() *mStorageMockSaveOrderTx {
-	mmSaveOrderTx.optional = true
-	return mmSaveOrderTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Optional() *mStorageMockUpdateImportJobTx {
+	mmUpdateImportJobTx.optional = true
+	return mmUpdateImportJobTx
 }
 
-// Expect sets up expected params for Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Expect(ctx context.Context, tx pgx.Tx, order models.Order) *mStorageMockSaveOrderTx {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+// Expect sets up expected params for Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Expect(ctx context.Context, tx pgx.Tx, job models.ImportJob) *mStorageMockUpdateImportJobTx {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	if mmSaveOrderTx.defaultExpectation == nil {
-		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	if mmUpdateImportJobTx.defaultExpectation == nil {
+		mmUpdateImportJobTx.defaultExpectation = &StorageMockUpdateImportJobTxExpectation{}
 	}
 
-	if mmSaveOrderTx.defaultExpectation.paramPtrs != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by ExpectParams functions")
+	if mmUpdateImportJobTx.defaultExpectation.paramPtrs != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by ExpectParams functions")
 	}
 
-	mmSaveOrderTx.defaultExpectation.params = &StorageMockSaveOrderTxParams{ctx, tx, order}
-	mmSaveOrderTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSaveOrderTx.expectations {
-		if minimock.Equal(e.params, mmSaveOrderTx.defaultExpectation.params) {
-			mmSaveOrderTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveOrderTx.defaultExpectation.params)
+	mmUpdateImportJobTx.defaultExpectation.params = &StorageMockUpdateImportJobTxParams{ctx, tx, job}
+	mmUpdateImportJobTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateImportJobTx.expectations {
+		if minimock.Equal(e.params, mmUpdateImportJobTx.defaultExpectation.params) {
+			mmUpdateImportJobTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateImportJobTx.defaultExpectation.params)
 		}
 	}
 
-	return mmSaveOrderTx
+	return mmUpdateImportJobTx
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectCtxParam1(ctx context.Context) *mStorageMockSaveOrderTx {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) ExpectCtxParam1(ctx context.Context) *mStorageMockUpdateImportJobTx {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	if mmSaveOrderTx.defaultExpectation == nil {
-		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	if mmUpdateImportJobTx.defaultExpectation == nil {
+		mmUpdateImportJobTx.defaultExpectation = &StorageMockUpdateImportJobTxExpectation{}
 	}
 
-	if mmSaveOrderTx.defaultExpectation.params != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	if mmUpdateImportJobTx.defaultExpectation.params != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Expect")
 	}
 
-	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
-		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	if mmUpdateImportJobTx.defaultExpectation.paramPtrs == nil {
+		mmUpdateImportJobTx.defaultExpectation.paramPtrs = &StorageMockUpdateImportJobTxParamPtrs{}
 	}
-	mmSaveOrderTx.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSaveOrderTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateImportJobTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateImportJobTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSaveOrderTx
+	return mmUpdateImportJobTx
 }
 
-// ExpectTxParam2 sets up expected param tx for Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockSaveOrderTx {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+// ExpectTxParam2 sets up expected param tx for Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) ExpectTxParam2(tx pgx.Tx) *mStorageMockUpdateImportJobTx {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	if mmSaveOrderTx.defaultExpectation == nil {
-		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	if mmUpdateImportJobTx.defaultExpectation == nil {
+		mmUpdateImportJobTx.defaultExpectation = &StorageMockUpdateImportJobTxExpectation{}
 	}
 
-	if mmSaveOrderTx.defaultExpectation.params != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	if mmUpdateImportJobTx.defaultExpectation.params != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Expect")
 	}
 
-	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
-		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	if mmUpdateImportJobTx.defaultExpectation.paramPtrs == nil {
+		mmUpdateImportJobTx.defaultExpectation.paramPtrs = &StorageMockUpdateImportJobTxParamPtrs{}
 	}
-	mmSaveOrderTx.defaultExpectation.paramPtrs.tx = &tx
-	mmSaveOrderTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
+	mmUpdateImportJobTx.defaultExpectation.paramPtrs.tx = &tx
+	mmUpdateImportJobTx.defaultExpectation.expectationOrigins.originTx = minimock.CallerInfo(1)
 
-	return mmSaveOrderTx
+	return mmUpdateImportJobTx
 }
 
-// ExpectOrderParam3 sets up expected param order for Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) ExpectOrderParam3(order models.Order) *mStorageMockSaveOrderTx {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+// ExpectJobParam3 sets up expected param job for Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) ExpectJobParam3(job models.ImportJob) *mStorageMockUpdateImportJobTx {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	if mmSaveOrderTx.defaultExpectation == nil {
-		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{}
+	if mmUpdateImportJobTx.defaultExpectation == nil {
+		mmUpdateImportJobTx.defaultExpectation = &StorageMockUpdateImportJobTxExpectation{}
 	}
 
-	if mmSaveOrderTx.defaultExpectation.params != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Expect")
+	if mmUpdateImportJobTx.defaultExpectation.params != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Expect")
 	}
 
-	if mmSaveOrderTx.defaultExpectation.paramPtrs == nil {
-		mmSaveOrderTx.defaultExpectation.paramPtrs = &StorageMockSaveOrderTxParamPtrs{}
+	if mmUpdateImportJobTx.defaultExpectation.paramPtrs == nil {
+		mmUpdateImportJobTx.defaultExpectation.paramPtrs = &StorageMockUpdateImportJobTxParamPtrs{}
 	}
-	mmSaveOrderTx.defaultExpectation.paramPtrs.order = &order
-	mmSaveOrderTx.defaultExpectation.expectationOrigins.originOrder = minimock.CallerInfo(1)
+	mmUpdateImportJobTx.defaultExpectation.paramPtrs.job = &job
+	mmUpdateImportJobTx.defaultExpectation.expectationOrigins.originJob = minimock.CallerInfo(1)
 
-	return mmSaveOrderTx
+	return mmUpdateImportJobTx
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Inspect(f func(ctx context.Context, tx pgx.Tx, order models.Order)) *mStorageMockSaveOrderTx {
-	if mmSaveOrderTx.mock.inspectFuncSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("Inspect function is already set for StorageMock.SaveOrderTx")
+// Inspect accepts an inspector function that has same arguments as the Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Inspect(f func(ctx context.Context, tx pgx.Tx, job models.ImportJob)) *mStorageMockUpdateImportJobTx {
+	if mmUpdateImportJobTx.mock.inspectFuncUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("Inspect function is already set for StorageMock.UpdateImportJobTx")
 	}
 
-	mmSaveOrderTx.mock.inspectFuncSaveOrderTx = f
+	mmUpdateImportJobTx.mock.inspectFuncUpdateImportJobTx = f
 
-	return mmSaveOrderTx
+	return mmUpdateImportJobTx
 }
 
-// Return sets up results that will be returned by Storage.SaveOrderTx
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Return(err error) *StorageMock {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+// Return sets up results that will be returned by Storage.UpdateImportJobTx
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Return(err error) *StorageMock {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	if mmSaveOrderTx.defaultExpectation == nil {
-		mmSaveOrderTx.defaultExpectation = &StorageMockSaveOrderTxExpectation{mock: mmSaveOrderTx.mock}
+	if mmUpdateImportJobTx.defaultExpectation == nil {
+		mmUpdateImportJobTx.defaultExpectation = &StorageMockUpdateImportJobTxExpectation{mock: mmUpdateImportJobTx.mock}
 	}
-	mmSaveOrderTx.defaultExpectation.results = &StorageMockSaveOrderTxResults{err}
-	mmSaveOrderTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSaveOrderTx.mock
+	mmUpdateImportJobTx.defaultExpectation.results = &StorageMockUpdateImportJobTxResults{err}
+	mmUpdateImportJobTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateImportJobTx.mock
 }
 
-// Set uses given function f to mock the Storage.SaveOrderTx method
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Set(f func(ctx context.Context, tx pgx.Tx, order models.Order) (err error)) *StorageMock {
-	if mmSaveOrderTx.defaultExpectation != nil {
-		mmSaveOrderTx.mock.t.Fatalf("Default expectation is already set for the Storage.SaveOrderTx method")
+// Set uses given function f to mock the Storage.UpdateImportJobTx method
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Set(f func(ctx context.Context, tx pgx.Tx, job models.ImportJob) (err error)) *StorageMock {
+	if mmUpdateImportJobTx.defaultExpectation != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("Default expectation is already set for the Storage.UpdateImportJobTx method")
 	}
 
-	if len(mmSaveOrderTx.expectations) > 0 {
-		mmSaveOrderTx.mock.t.Fatalf("Some expectations are already set for the Storage.SaveOrderTx method")
+	if len(mmUpdateImportJobTx.expectations) > 0 {
+		mmUpdateImportJobTx.mock.t.Fatalf("Some expectations are already set for the Storage.UpdateImportJobTx method")
 	}
 
-	mmSaveOrderTx.mock.funcSaveOrderTx = f
-	mmSaveOrderTx.mock.funcSaveOrderTxOrigin = minimock.CallerInfo(1)
-	return mmSaveOrderTx.mock
+	mmUpdateImportJobTx.mock.funcUpdateImportJobTx = f
+	mmUpdateImportJobTx.mock.funcUpdateImportJobTxOrigin = minimock.CallerInfo(1)
+	return mmUpdateImportJobTx.mock
 }
 
-// When sets expectation for the Storage.SaveOrderTx which will trigger the result defined by the following
+// When sets expectation for the Storage.UpdateImportJobTx which will trigger the result defined by the following
 // Then helper
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) When(ctx context.Context, tx pgx.Tx, order models.Order) *StorageMockSaveOrderTxExpectation {
-	if mmSaveOrderTx.mock.funcSaveOrderTx != nil {
-		mmSaveOrderTx.mock.t.Fatalf("StorageMock.SaveOrderTx mock is already set by Set")
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) When(ctx context.Context, tx pgx.Tx, job models.ImportJob) *StorageMockUpdateImportJobTxExpectation {
+	if mmUpdateImportJobTx.mock.funcUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.mock.t.Fatalf("StorageMock.UpdateImportJobTx mock is already set by Set")
 	}
 
-	expectation := &StorageMockSaveOrderTxExpectation{
-		mock:               mmSaveOrderTx.mock,
-		params:             &StorageMockSaveOrderTxParams{ctx, tx, order},
-		expectationOrigins: StorageMockSaveOrderTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockUpdateImportJobTxExpectation{
+		mock:               mmUpdateImportJobTx.mock,
+		params:             &StorageMockUpdateImportJobTxParams{ctx, tx, job},
+		expectationOrigins: StorageMockUpdateImportJobTxExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSaveOrderTx.expectations = append(mmSaveOrderTx.expectations, expectation)
+	mmUpdateImportJobTx.expectations = append(mmUpdateImportJobTx.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.SaveOrderTx return parameters for the expectation previously defined by the When method
-func (e *StorageMockSaveOrderTxExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockSaveOrderTxResults{err}
+// Then sets up Storage.UpdateImportJobTx return parameters for the expectation previously defined by the When method
+func (e *StorageMockUpdateImportJobTxExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockUpdateImportJobTxResults{err}
 	return e.mock
 }
 
-// Times sets number of times Storage.SaveOrderTx should be invoked
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Times(n uint64) *mStorageMockSaveOrderTx {
+// Times sets number of times Storage.UpdateImportJobTx should be invoked
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Times(n uint64) *mStorageMockUpdateImportJobTx {
 	if n == 0 {
-		mmSaveOrderTx.mock.t.Fatalf("Times of StorageMock.SaveOrderTx mock can not be zero")
+		mmUpdateImportJobTx.mock.t.Fatalf("Times of StorageMock.UpdateImportJobTx mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSaveOrderTx.expectedInvocations, n)
-	mmSaveOrderTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSaveOrderTx
+	mm_atomic.StoreUint64(&mmUpdateImportJobTx.expectedInvocations, n)
+	mmUpdateImportJobTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateImportJobTx
 }
 
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) invocationsDone() bool {
-	if len(mmSaveOrderTx.expectations) == 0 && mmSaveOrderTx.defaultExpectation == nil && mmSaveOrderTx.mock.funcSaveOrderTx == nil {
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) invocationsDone() bool {
+	if len(mmUpdateImportJobTx.expectations) == 0 && mmUpdateImportJobTx.defaultExpectation == nil && mmUpdateImportJobTx.mock.funcUpdateImportJobTx == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSaveOrderTx.mock.afterSaveOrderTxCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSaveOrderTx.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateImportJobTx.mock.afterUpdateImportJobTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateImportJobTx.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SaveOrderTx implements mm_storage.Storage
-func (mmSaveOrderTx *StorageMock) SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) (err error) {
-	mm_atomic.AddUint64(&mmSaveOrderTx.beforeSaveOrderTxCounter, 1)
-	defer mm_atomic.AddUint64(&mmSaveOrderTx.afterSaveOrderTxCounter, 1)
+// UpdateImportJobTx implements mm_storage.Storage
+func (mmUpdateImportJobTx *StorageMock) UpdateImportJobTx(ctx context.Context, tx pgx.Tx, job models.ImportJob) (err error) {
+	mm_atomic.AddUint64(&mmUpdateImportJobTx.beforeUpdateImportJobTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateImportJobTx.afterUpdateImportJobTxCounter, 1)
 
-	mmSaveOrderTx.t.Helper()
+	mmUpdateImportJobTx.t.Helper()
 
-	if mmSaveOrderTx.inspectFuncSaveOrderTx != nil {
-		mmSaveOrderTx.inspectFuncSaveOrderTx(ctx, tx, order)
+	if mmUpdateImportJobTx.inspectFuncUpdateImportJobTx != nil {
+		mmUpdateImportJobTx.inspectFuncUpdateImportJobTx(ctx, tx, job)
 	}
 
-	mm_params := StorageMockSaveOrderTxParams{ctx, tx, order}
+	mm_params := StorageMockUpdateImportJobTxParams{ctx, tx, job}
 
 	// Record call args
-	mmSaveOrderTx.SaveOrderTxMock.mutex.Lock()
-	mmSaveOrderTx.SaveOrderTxMock.callArgs = append(mmSaveOrderTx.SaveOrderTxMock.callArgs, &mm_params)
-	mmSaveOrderTx.SaveOrderTxMock.mutex.Unlock()
+	mmUpdateImportJobTx.UpdateImportJobTxMock.mutex.Lock()
+	mmUpdateImportJobTx.UpdateImportJobTxMock.callArgs = append(mmUpdateImportJobTx.UpdateImportJobTxMock.callArgs, &mm_params)
+	mmUpdateImportJobTx.UpdateImportJobTxMock.mutex.Unlock()
 
-	for _, e := range mmSaveOrderTx.SaveOrderTxMock.expectations {
+	for _, e := range mmUpdateImportJobTx.UpdateImportJobTxMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSaveOrderTx.SaveOrderTxMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.Counter, 1)
-		mm_want := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.params
-		mm_want_ptrs := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.paramPtrs
+	if mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockSaveOrderTxParams{ctx, tx, order}
+		mm_got := StorageMockUpdateImportJobTxParams{ctx, tx, job}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateImportJobTx.t.Errorf("StorageMock.UpdateImportJobTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.tx != nil && !minimock.Equal(*mm_want_ptrs.tx, mm_got.tx) {
-				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
+				mmUpdateImportJobTx.t.Errorf("StorageMock.UpdateImportJobTx got unexpected parameter tx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.expectationOrigins.originTx, *mm_want_ptrs.tx, mm_got.tx, minimock.Diff(*mm_want_ptrs.tx, mm_got.tx))
 			}
 
-			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
-				mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameter order, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			if mm_want_ptrs.job != nil && !minimock.Equal(*mm_want_ptrs.job, mm_got.job) {
+				mmUpdateImportJobTx.t.Errorf("StorageMock.UpdateImportJobTx got unexpected parameter job, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.expectationOrigins.originJob, *mm_want_ptrs.job, mm_got.job, minimock.Diff(*mm_want_ptrs.job, mm_got.job))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSaveOrderTx.t.Errorf("StorageMock.SaveOrderTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateImportJobTx.t.Errorf("StorageMock.UpdateImportJobTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSaveOrderTx.SaveOrderTxMock.defaultExpectation.results
+		mm_results := mmUpdateImportJobTx.UpdateImportJobTxMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSaveOrderTx.t.Fatal("No results are set for the StorageMock.SaveOrderTx")
+			mmUpdateImportJobTx.t.Fatal("No results are set for the StorageMock.UpdateImportJobTx")
 		}
 		return (*mm_results).err
 	}
-	if mmSaveOrderTx.funcSaveOrderTx != nil {
-		return mmSaveOrderTx.funcSaveOrderTx(ctx, tx, order)
+	if mmUpdateImportJobTx.funcUpdateImportJobTx != nil {
+		return mmUpdateImportJobTx.funcUpdateImportJobTx(ctx, tx, job)
 	}
-	mmSaveOrderTx.t.Fatalf("Unexpected call to StorageMock.SaveOrderTx. %v %v %v", ctx, tx, order)
+	mmUpdateImportJobTx.t.Fatalf("Unexpected call to StorageMock.UpdateImportJobTx. %v %v %v", ctx, tx, job)
 	return
 }
 
-// SaveOrderTxAfterCounter returns a count of finished StorageMock.SaveOrderTx invocations
-func (mmSaveOrderTx *StorageMock) SaveOrderTxAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveOrderTx.afterSaveOrderTxCounter)
+// UpdateImportJobTxAfterCounter returns a count of finished StorageMock.UpdateImportJobTx invocations
+func (mmUpdateImportJobTx *StorageMock) UpdateImportJobTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateImportJobTx.afterUpdateImportJobTxCounter)
 }
 
-// SaveOrderTxBeforeCounter returns a count of StorageMock.SaveOrderTx invocations
-func (mmSaveOrderTx *StorageMock) SaveOrderTxBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveOrderTx.beforeSaveOrderTxCounter)
+// UpdateImportJobTxBeforeCounter returns a count of StorageMock.UpdateImportJobTx invocations
+func (mmUpdateImportJobTx *StorageMock) UpdateImportJobTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateImportJobTx.beforeUpdateImportJobTxCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.SaveOrderTx.
+// Calls returns a list of arguments used in each call to StorageMock.UpdateImportJobTx.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSaveOrderTx *mStorageMockSaveOrderTx) Calls() []*StorageMockSaveOrderTxParams {
-	mmSaveOrderTx.mutex.RLock()
+func (mmUpdateImportJobTx *mStorageMockUpdateImportJobTx) Calls() []*StorageMockUpdateImportJobTxParams {
+	mmUpdateImportJobTx.mutex.RLock()
 
-	argCopy := make([]*StorageMockSaveOrderTxParams, len(mmSaveOrderTx.callArgs))
-	copy(argCopy, mmSaveOrderTx.callArgs)
+	argCopy := make([]*StorageMockUpdateImportJobTxParams, len(mmUpdateImportJobTx.callArgs))
+	copy(argCopy, mmUpdateImportJobTx.callArgs)
 
-	mmSaveOrderTx.mutex.RUnlock()
+	mmUpdateImportJobTx.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSaveOrderTxDone returns true if the count of the SaveOrderTx invocations corresponds
+// MinimockUpdateImportJobTxDone returns true if the count of the UpdateImportJobTx invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockSaveOrderTxDone() bool {
-	if m.SaveOrderTxMock.optional {
+func (m *StorageMock) MinimockUpdateImportJobTxDone() bool {
+	if m.UpdateImportJobTxMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SaveOrderTxMock.expectations {
+	for _, e := range m.UpdateImportJobTxMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SaveOrderTxMock.invocationsDone()
+	return m.UpdateImportJobTxMock.invocationsDone()
 }
 
-// MinimockSaveOrderTxInspect logs each unmet expectation
-func (m *StorageMock) MinimockSaveOrderTxInspect() {
-	for _, e := range m.SaveOrderTxMock.expectations {
+// MinimockUpdateImportJobTxInspect logs each unmet expectation
+func (m *StorageMock) MinimockUpdateImportJobTxInspect() {
+	for _, e := range m.UpdateImportJobTxMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateImportJobTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSaveOrderTxCounter := mm_atomic.LoadUint64(&m.afterSaveOrderTxCounter)
+	afterUpdateImportJobTxCounter := mm_atomic.LoadUint64(&m.afterUpdateImportJobTxCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveOrderTxMock.defaultExpectation != nil && afterSaveOrderTxCounter < 1 {
-		if m.SaveOrderTxMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s", m.SaveOrderTxMock.defaultExpectation.returnOrigin)
+	if m.UpdateImportJobTxMock.defaultExpectation != nil && afterUpdateImportJobTxCounter < 1 {
+		if m.UpdateImportJobTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.UpdateImportJobTx at\n%s", m.UpdateImportJobTxMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s with params: %#v", m.SaveOrderTxMock.defaultExpectation.expectationOrigins.origin, *m.SaveOrderTxMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateImportJobTx at\n%s with params: %#v", m.UpdateImportJobTxMock.defaultExpectation.expectationOrigins.origin, *m.UpdateImportJobTxMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSaveOrderTx != nil && afterSaveOrderTxCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.SaveOrderTx at\n%s", m.funcSaveOrderTxOrigin)
+	if m.funcUpdateImportJobTx != nil && afterUpdateImportJobTxCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.UpdateImportJobTx at\n%s", m.funcUpdateImportJobTxOrigin)
 	}
 
-	if !m.SaveOrderTxMock.invocationsDone() && afterSaveOrderTxCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.SaveOrderTx at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SaveOrderTxMock.expectedInvocations), m.SaveOrderTxMock.expectedInvocationsOrigin, afterSaveOrderTxCounter)
+	if !m.UpdateImportJobTxMock.invocationsDone() && afterUpdateImportJobTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.UpdateImportJobTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateImportJobTxMock.expectedInvocations), m.UpdateImportJobTxMock.expectedInvocationsOrigin, afterUpdateImportJobTxCounter)
 	}
 }
 
@@ -1877,6 +5398,7 @@ type StorageMockUpdateOrderTxParams struct {
 	ctx   context.Context
 	tx    pgx.Tx
 	order models.Order
+	actor models.Actor
 }
 
 // StorageMockUpdateOrderTxParamPtrs contains pointers to parameters of the Storage.UpdateOrderTx
@@ -1884,6 +5406,7 @@ type StorageMockUpdateOrderTxParamPtrs struct {
 	ctx   *context.Context
 	tx    *pgx.Tx
 	order *models.Order
+	actor *models.Actor
 }
 
 // StorageMockUpdateOrderTxResults contains results of the Storage.UpdateOrderTx
@@ -1897,6 +5420,7 @@ type StorageMockUpdateOrderTxExpectationOrigins struct {
 	originCtx   string
 	originTx    string
 	originOrder string
+	originActor string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1910,7 +5434,7 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Optional() *mStorageMockUpdate
 }
 
 // Expect sets up expected params for Storage.UpdateOrderTx
-func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Expect(ctx context.Context, tx pgx.Tx, order models.Order) *mStorageMockUpdateOrderTx {
+func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Expect(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) *mStorageMockUpdateOrderTx {
 	if mmUpdateOrderTx.mock.funcUpdateOrderTx != nil {
 		mmUpdateOrderTx.mock.t.Fatalf("StorageMock.UpdateOrderTx mock is already set by Set")
 	}
@@ -1923,7 +5447,7 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Expect(ctx context.Context, tx
 		mmUpdateOrderTx.mock.t.Fatalf("StorageMock.UpdateOrderTx mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateOrderTx.defaultExpectation.params = &StorageMockUpdateOrderTxParams{ctx, tx, order}
+	mmUpdateOrderTx.defaultExpectation.params = &StorageMockUpdateOrderTxParams{ctx, tx, order, actor}
 	mmUpdateOrderTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmUpdateOrderTx.expectations {
 		if minimock.Equal(e.params, mmUpdateOrderTx.defaultExpectation.params) {
@@ -2003,8 +5527,31 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) ExpectOrderParam3(order models
 	return mmUpdateOrderTx
 }
 
+// ExpectActorParam4 sets up expected param actor for Storage.UpdateOrderTx
+func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) ExpectActorParam4(actor models.Actor) *mStorageMockUpdateOrderTx {
+	if mmUpdateOrderTx.mock.funcUpdateOrderTx != nil {
+		mmUpdateOrderTx.mock.t.Fatalf("StorageMock.UpdateOrderTx mock is already set by Set")
+	}
+
+	if mmUpdateOrderTx.defaultExpectation == nil {
+		mmUpdateOrderTx.defaultExpectation = &StorageMockUpdateOrderTxExpectation{}
+	}
+
+	if mmUpdateOrderTx.defaultExpectation.params != nil {
+		mmUpdateOrderTx.mock.t.Fatalf("StorageMock.UpdateOrderTx mock is already set by Expect")
+	}
+
+	if mmUpdateOrderTx.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrderTx.defaultExpectation.paramPtrs = &StorageMockUpdateOrderTxParamPtrs{}
+	}
+	mmUpdateOrderTx.defaultExpectation.paramPtrs.actor = &actor
+	mmUpdateOrderTx.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmUpdateOrderTx
+}
+
 // Inspect accepts an inspector function that has same arguments as the Storage.UpdateOrderTx
-func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Inspect(f func(ctx context.Context, tx pgx.Tx, order models.Order)) *mStorageMockUpdateOrderTx {
+func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Inspect(f func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor)) *mStorageMockUpdateOrderTx {
 	if mmUpdateOrderTx.mock.inspectFuncUpdateOrderTx != nil {
 		mmUpdateOrderTx.mock.t.Fatalf("Inspect function is already set for StorageMock.UpdateOrderTx")
 	}
@@ -2029,7 +5576,7 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Return(err error) *StorageMock
 }
 
 // Set uses given function f to mock the Storage.UpdateOrderTx method
-func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Set(f func(ctx context.Context, tx pgx.Tx, order models.Order) (err error)) *StorageMock {
+func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Set(f func(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error)) *StorageMock {
 	if mmUpdateOrderTx.defaultExpectation != nil {
 		mmUpdateOrderTx.mock.t.Fatalf("Default expectation is already set for the Storage.UpdateOrderTx method")
 	}
@@ -2045,14 +5592,14 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) Set(f func(ctx context.Context
 
 // When sets expectation for the Storage.UpdateOrderTx which will trigger the result defined by the following
 // Then helper
-func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) When(ctx context.Context, tx pgx.Tx, order models.Order) *StorageMockUpdateOrderTxExpectation {
+func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) When(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) *StorageMockUpdateOrderTxExpectation {
 	if mmUpdateOrderTx.mock.funcUpdateOrderTx != nil {
 		mmUpdateOrderTx.mock.t.Fatalf("StorageMock.UpdateOrderTx mock is already set by Set")
 	}
 
 	expectation := &StorageMockUpdateOrderTxExpectation{
 		mock:               mmUpdateOrderTx.mock,
-		params:             &StorageMockUpdateOrderTxParams{ctx, tx, order},
+		params:             &StorageMockUpdateOrderTxParams{ctx, tx, order, actor},
 		expectationOrigins: StorageMockUpdateOrderTxExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUpdateOrderTx.expectations = append(mmUpdateOrderTx.expectations, expectation)
@@ -2087,17 +5634,17 @@ func (mmUpdateOrderTx *mStorageMockUpdateOrderTx) invocationsDone() bool {
 }
 
 // UpdateOrderTx implements mm_storage.Storage
-func (mmUpdateOrderTx *StorageMock) UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) (err error) {
+func (mmUpdateOrderTx *StorageMock) UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) (err error) {
 	mm_atomic.AddUint64(&mmUpdateOrderTx.beforeUpdateOrderTxCounter, 1)
 	defer mm_atomic.AddUint64(&mmUpdateOrderTx.afterUpdateOrderTxCounter, 1)
 
 	mmUpdateOrderTx.t.Helper()
 
 	if mmUpdateOrderTx.inspectFuncUpdateOrderTx != nil {
-		mmUpdateOrderTx.inspectFuncUpdateOrderTx(ctx, tx, order)
+		mmUpdateOrderTx.inspectFuncUpdateOrderTx(ctx, tx, order, actor)
 	}
 
-	mm_params := StorageMockUpdateOrderTxParams{ctx, tx, order}
+	mm_params := StorageMockUpdateOrderTxParams{ctx, tx, order, actor}
 
 	// Record call args
 	mmUpdateOrderTx.UpdateOrderTxMock.mutex.Lock()
@@ -2116,7 +5663,7 @@ func (mmUpdateOrderTx *StorageMock) UpdateOrderTx(ctx context.Context, tx pgx.Tx
 		mm_want := mmUpdateOrderTx.UpdateOrderTxMock.defaultExpectation.params
 		mm_want_ptrs := mmUpdateOrderTx.UpdateOrderTxMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockUpdateOrderTxParams{ctx, tx, order}
+		mm_got := StorageMockUpdateOrderTxParams{ctx, tx, order, actor}
 
 		if mm_want_ptrs != nil {
 
@@ -2135,6 +5682,11 @@ func (mmUpdateOrderTx *StorageMock) UpdateOrderTx(ctx context.Context, tx pgx.Tx
 					mmUpdateOrderTx.UpdateOrderTxMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
 			}
 
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmUpdateOrderTx.t.Errorf("StorageMock.UpdateOrderTx got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrderTx.UpdateOrderTxMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUpdateOrderTx.t.Errorf("StorageMock.UpdateOrderTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmUpdateOrderTx.UpdateOrderTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -2147,9 +5699,9 @@ func (mmUpdateOrderTx *StorageMock) UpdateOrderTx(ctx context.Context, tx pgx.Tx
 		return (*mm_results).err
 	}
 	if mmUpdateOrderTx.funcUpdateOrderTx != nil {
-		return mmUpdateOrderTx.funcUpdateOrderTx(ctx, tx, order)
+		return mmUpdateOrderTx.funcUpdateOrderTx(ctx, tx, order, actor)
 	}
-	mmUpdateOrderTx.t.Fatalf("Unexpected call to StorageMock.UpdateOrderTx. %v %v %v", ctx, tx, order)
+	mmUpdateOrderTx.t.Fatalf("Unexpected call to StorageMock.UpdateOrderTx. %v %v %v %v", ctx, tx, order, actor)
 	return
 }
 
@@ -2567,16 +6119,34 @@ func (m *StorageMock) MinimockWithTransactionInspect() {
 func (m *StorageMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
-			m.MinimockDeleteOrderInspect()
+			m.MinimockDeleteOrderTxInspect()
+
+			m.MinimockExistingOrderIDsTxInspect()
 
 			m.MinimockGetHistoryInspect()
 
+			m.MinimockGetImportJobForUpdateTxInspect()
+
 			m.MinimockGetOrderInspect()
 
+			m.MinimockGetOrderForUpdateTxInspect()
+
+			m.MinimockGetOrderHistoryInspect()
+
 			m.MinimockListOrdersInspect()
 
+			m.MinimockQueryOrdersInspect()
+
+			m.MinimockSaveEventTxInspect()
+
 			m.MinimockSaveOrderTxInspect()
 
+			m.MinimockSaveOrdersTxInspect()
+
+			m.MinimockStartImportJobInspect()
+
+			m.MinimockUpdateImportJobTxInspect()
+
 			m.MinimockUpdateOrderTxInspect()
 
 			m.MinimockWithTransactionInspect()
@@ -2603,11 +6173,20 @@ func (m *StorageMock) MinimockWait(timeout mm_time.Duration) {
 func (m *StorageMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockDeleteOrderDone() &&
+		m.MinimockDeleteOrderTxDone() &&
+		m.MinimockExistingOrderIDsTxDone() &&
 		m.MinimockGetHistoryDone() &&
+		m.MinimockGetImportJobForUpdateTxDone() &&
 		m.MinimockGetOrderDone() &&
+		m.MinimockGetOrderForUpdateTxDone() &&
+		m.MinimockGetOrderHistoryDone() &&
 		m.MinimockListOrdersDone() &&
+		m.MinimockQueryOrdersDone() &&
+		m.MinimockSaveEventTxDone() &&
 		m.MinimockSaveOrderTxDone() &&
+		m.MinimockSaveOrdersTxDone() &&
+		m.MinimockStartImportJobDone() &&
+		m.MinimockUpdateImportJobTxDone() &&
 		m.MinimockUpdateOrderTxDone() &&
 		m.MinimockWithTransactionDone()
 }
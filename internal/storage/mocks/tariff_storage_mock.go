@@ -0,0 +1,743 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i PWZ1.0/internal/storage.TariffStorage -o tariff_storage_mock.go -n TariffStorageMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"PWZ1.0/internal/models"
+	"github.com/gojuno/minimock/v3"
+)
+
+// TariffStorageMock implements mm_storage.TariffStorage
+type TariffStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListTariffs          func(ctx context.Context) (ta1 []models.Tariff, err error)
+	funcListTariffsOrigin    string
+	inspectFuncListTariffs   func(ctx context.Context)
+	afterListTariffsCounter  uint64
+	beforeListTariffsCounter uint64
+	ListTariffsMock          mTariffStorageMockListTariffs
+
+	funcSaveTariff          func(ctx context.Context, tariff models.Tariff) (b1 bool, err error)
+	funcSaveTariffOrigin    string
+	inspectFuncSaveTariff   func(ctx context.Context, tariff models.Tariff)
+	afterSaveTariffCounter  uint64
+	beforeSaveTariffCounter uint64
+	SaveTariffMock          mTariffStorageMockSaveTariff
+}
+
+// NewTariffStorageMock returns a mock for mm_storage.TariffStorage
+func NewTariffStorageMock(t minimock.Tester) *TariffStorageMock {
+	m := &TariffStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListTariffsMock = mTariffStorageMockListTariffs{mock: m}
+	m.ListTariffsMock.callArgs = []*TariffStorageMockListTariffsParams{}
+
+	m.SaveTariffMock = mTariffStorageMockSaveTariff{mock: m}
+	m.SaveTariffMock.callArgs = []*TariffStorageMockSaveTariffParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTariffStorageMockListTariffs struct {
+	optional           bool
+	mock               *TariffStorageMock
+	defaultExpectation *TariffStorageMockListTariffsExpectation
+	expectations       []*TariffStorageMockListTariffsExpectation
+
+	callArgs []*TariffStorageMockListTariffsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TariffStorageMockListTariffsExpectation specifies expectation struct of the TariffStorage.ListTariffs
+type TariffStorageMockListTariffsExpectation struct {
+	mock               *TariffStorageMock
+	params             *TariffStorageMockListTariffsParams
+	paramPtrs          *TariffStorageMockListTariffsParamPtrs
+	expectationOrigins TariffStorageMockListTariffsExpectationOrigins
+	results            *TariffStorageMockListTariffsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TariffStorageMockListTariffsParams contains parameters of the TariffStorage.ListTariffs
+type TariffStorageMockListTariffsParams struct {
+	ctx context.Context
+}
+
+// TariffStorageMockListTariffsParamPtrs contains pointers to parameters of the TariffStorage.ListTariffs
+type TariffStorageMockListTariffsParamPtrs struct {
+	ctx *context.Context
+}
+
+// TariffStorageMockListTariffsResults contains results of the TariffStorage.ListTariffs
+type TariffStorageMockListTariffsResults struct {
+	ta1 []models.Tariff
+	err error
+}
+
+// TariffStorageMockListTariffsOrigins contains origins of expectations of the TariffStorage.ListTariffs
+type TariffStorageMockListTariffsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListTariffs *mTariffStorageMockListTariffs) Optional() *mTariffStorageMockListTariffs {
+	mmListTariffs.optional = true
+	return mmListTariffs
+}
+
+// Expect sets up expected params for TariffStorage.ListTariffs
+func (mmListTariffs *mTariffStorageMockListTariffs) Expect(ctx context.Context) *mTariffStorageMockListTariffs {
+	if mmListTariffs.mock.funcListTariffs != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by Set")
+	}
+
+	if mmListTariffs.defaultExpectation == nil {
+		mmListTariffs.defaultExpectation = &TariffStorageMockListTariffsExpectation{}
+	}
+
+	if mmListTariffs.defaultExpectation.paramPtrs != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by ExpectParams functions")
+	}
+
+	mmListTariffs.defaultExpectation.params = &TariffStorageMockListTariffsParams{ctx}
+	mmListTariffs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListTariffs.expectations {
+		if minimock.Equal(e.params, mmListTariffs.defaultExpectation.params) {
+			mmListTariffs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTariffs.defaultExpectation.params)
+		}
+	}
+
+	return mmListTariffs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TariffStorage.ListTariffs
+func (mmListTariffs *mTariffStorageMockListTariffs) ExpectCtxParam1(ctx context.Context) *mTariffStorageMockListTariffs {
+	if mmListTariffs.mock.funcListTariffs != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by Set")
+	}
+
+	if mmListTariffs.defaultExpectation == nil {
+		mmListTariffs.defaultExpectation = &TariffStorageMockListTariffsExpectation{}
+	}
+
+	if mmListTariffs.defaultExpectation.params != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by Expect")
+	}
+
+	if mmListTariffs.defaultExpectation.paramPtrs == nil {
+		mmListTariffs.defaultExpectation.paramPtrs = &TariffStorageMockListTariffsParamPtrs{}
+	}
+	mmListTariffs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListTariffs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListTariffs
+}
+
+// Inspect accepts an inspector function that has same arguments as the TariffStorage.ListTariffs
+func (mmListTariffs *mTariffStorageMockListTariffs) Inspect(f func(ctx context.Context)) *mTariffStorageMockListTariffs {
+	if mmListTariffs.mock.inspectFuncListTariffs != nil {
+		mmListTariffs.mock.t.Fatalf("Inspect function is already set for TariffStorageMock.ListTariffs")
+	}
+
+	mmListTariffs.mock.inspectFuncListTariffs = f
+
+	return mmListTariffs
+}
+
+// Return sets up results that will be returned by TariffStorage.ListTariffs
+func (mmListTariffs *mTariffStorageMockListTariffs) Return(ta1 []models.Tariff, err error) *TariffStorageMock {
+	if mmListTariffs.mock.funcListTariffs != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by Set")
+	}
+
+	if mmListTariffs.defaultExpectation == nil {
+		mmListTariffs.defaultExpectation = &TariffStorageMockListTariffsExpectation{mock: mmListTariffs.mock}
+	}
+	mmListTariffs.defaultExpectation.results = &TariffStorageMockListTariffsResults{ta1, err}
+	mmListTariffs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListTariffs.mock
+}
+
+// Set uses given function f to mock the TariffStorage.ListTariffs method
+func (mmListTariffs *mTariffStorageMockListTariffs) Set(f func(ctx context.Context) (ta1 []models.Tariff, err error)) *TariffStorageMock {
+	if mmListTariffs.defaultExpectation != nil {
+		mmListTariffs.mock.t.Fatalf("Default expectation is already set for the TariffStorage.ListTariffs method")
+	}
+
+	if len(mmListTariffs.expectations) > 0 {
+		mmListTariffs.mock.t.Fatalf("Some expectations are already set for the TariffStorage.ListTariffs method")
+	}
+
+	mmListTariffs.mock.funcListTariffs = f
+	mmListTariffs.mock.funcListTariffsOrigin = minimock.CallerInfo(1)
+	return mmListTariffs.mock
+}
+
+// When sets expectation for the TariffStorage.ListTariffs which will trigger the result defined by the following
+// Then helper
+func (mmListTariffs *mTariffStorageMockListTariffs) When(ctx context.Context) *TariffStorageMockListTariffsExpectation {
+	if mmListTariffs.mock.funcListTariffs != nil {
+		mmListTariffs.mock.t.Fatalf("TariffStorageMock.ListTariffs mock is already set by Set")
+	}
+
+	expectation := &TariffStorageMockListTariffsExpectation{
+		mock:               mmListTariffs.mock,
+		params:             &TariffStorageMockListTariffsParams{ctx},
+		expectationOrigins: TariffStorageMockListTariffsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListTariffs.expectations = append(mmListTariffs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TariffStorage.ListTariffs return parameters for the expectation previously defined by the When method
+func (e *TariffStorageMockListTariffsExpectation) Then(ta1 []models.Tariff, err error) *TariffStorageMock {
+	e.results = &TariffStorageMockListTariffsResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times TariffStorage.ListTariffs should be invoked
+func (mmListTariffs *mTariffStorageMockListTariffs) Times(n uint64) *mTariffStorageMockListTariffs {
+	if n == 0 {
+		mmListTariffs.mock.t.Fatalf("Times of TariffStorageMock.ListTariffs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListTariffs.expectedInvocations, n)
+	mmListTariffs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListTariffs
+}
+
+func (mmListTariffs *mTariffStorageMockListTariffs) invocationsDone() bool {
+	if len(mmListTariffs.expectations) == 0 && mmListTariffs.defaultExpectation == nil && mmListTariffs.mock.funcListTariffs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListTariffs.mock.afterListTariffsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListTariffs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListTariffs implements mm_storage.TariffStorage
+func (mmListTariffs *TariffStorageMock) ListTariffs(ctx context.Context) (ta1 []models.Tariff, err error) {
+	mm_atomic.AddUint64(&mmListTariffs.beforeListTariffsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTariffs.afterListTariffsCounter, 1)
+
+	mmListTariffs.t.Helper()
+
+	if mmListTariffs.inspectFuncListTariffs != nil {
+		mmListTariffs.inspectFuncListTariffs(ctx)
+	}
+
+	mm_params := TariffStorageMockListTariffsParams{ctx}
+
+	// Record call args
+	mmListTariffs.ListTariffsMock.mutex.Lock()
+	mmListTariffs.ListTariffsMock.callArgs = append(mmListTariffs.ListTariffsMock.callArgs, &mm_params)
+	mmListTariffs.ListTariffsMock.mutex.Unlock()
+
+	for _, e := range mmListTariffs.ListTariffsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmListTariffs.ListTariffsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTariffs.ListTariffsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTariffs.ListTariffsMock.defaultExpectation.params
+		mm_want_ptrs := mmListTariffs.ListTariffsMock.defaultExpectation.paramPtrs
+
+		mm_got := TariffStorageMockListTariffsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTariffs.t.Errorf("TariffStorageMock.ListTariffs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTariffs.ListTariffsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTariffs.t.Errorf("TariffStorageMock.ListTariffs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListTariffs.ListTariffsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTariffs.ListTariffsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTariffs.t.Fatal("No results are set for the TariffStorageMock.ListTariffs")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmListTariffs.funcListTariffs != nil {
+		return mmListTariffs.funcListTariffs(ctx)
+	}
+	mmListTariffs.t.Fatalf("Unexpected call to TariffStorageMock.ListTariffs. %v", ctx)
+	return
+}
+
+// ListTariffsAfterCounter returns a count of finished TariffStorageMock.ListTariffs invocations
+func (mmListTariffs *TariffStorageMock) ListTariffsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTariffs.afterListTariffsCounter)
+}
+
+// ListTariffsBeforeCounter returns a count of TariffStorageMock.ListTariffs invocations
+func (mmListTariffs *TariffStorageMock) ListTariffsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTariffs.beforeListTariffsCounter)
+}
+
+// Calls returns a list of arguments used in each call to TariffStorageMock.ListTariffs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTariffs *mTariffStorageMockListTariffs) Calls() []*TariffStorageMockListTariffsParams {
+	mmListTariffs.mutex.RLock()
+
+	argCopy := make([]*TariffStorageMockListTariffsParams, len(mmListTariffs.callArgs))
+	copy(argCopy, mmListTariffs.callArgs)
+
+	mmListTariffs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTariffsDone returns true if the count of the ListTariffs invocations corresponds
+// the number of defined expectations
+func (m *TariffStorageMock) MinimockListTariffsDone() bool {
+	if m.ListTariffsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListTariffsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListTariffsMock.invocationsDone()
+}
+
+// MinimockListTariffsInspect logs each unmet expectation
+func (m *TariffStorageMock) MinimockListTariffsInspect() {
+	for _, e := range m.ListTariffsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TariffStorageMock.ListTariffs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListTariffsCounter := mm_atomic.LoadUint64(&m.afterListTariffsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTariffsMock.defaultExpectation != nil && afterListTariffsCounter < 1 {
+		if m.ListTariffsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TariffStorageMock.ListTariffs at\n%s", m.ListTariffsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TariffStorageMock.ListTariffs at\n%s with params: %#v", m.ListTariffsMock.defaultExpectation.expectationOrigins.origin, *m.ListTariffsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTariffs != nil && afterListTariffsCounter < 1 {
+		m.t.Errorf("Expected call to TariffStorageMock.ListTariffs at\n%s", m.funcListTariffsOrigin)
+	}
+
+	if !m.ListTariffsMock.invocationsDone() && afterListTariffsCounter > 0 {
+		m.t.Errorf("Expected %d calls to TariffStorageMock.ListTariffs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListTariffsMock.expectedInvocations), m.ListTariffsMock.expectedInvocationsOrigin, afterListTariffsCounter)
+	}
+}
+
+type mTariffStorageMockSaveTariff struct {
+	optional           bool
+	mock               *TariffStorageMock
+	defaultExpectation *TariffStorageMockSaveTariffExpectation
+	expectations       []*TariffStorageMockSaveTariffExpectation
+
+	callArgs []*TariffStorageMockSaveTariffParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TariffStorageMockSaveTariffExpectation specifies expectation struct of the TariffStorage.SaveTariff
+type TariffStorageMockSaveTariffExpectation struct {
+	mock               *TariffStorageMock
+	params             *TariffStorageMockSaveTariffParams
+	paramPtrs          *TariffStorageMockSaveTariffParamPtrs
+	expectationOrigins TariffStorageMockSaveTariffExpectationOrigins
+	results            *TariffStorageMockSaveTariffResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TariffStorageMockSaveTariffParams contains parameters of the TariffStorage.SaveTariff
+type TariffStorageMockSaveTariffParams struct {
+	ctx    context.Context
+	tariff models.Tariff
+}
+
+// TariffStorageMockSaveTariffParamPtrs contains pointers to parameters of the TariffStorage.SaveTariff
+type TariffStorageMockSaveTariffParamPtrs struct {
+	ctx    *context.Context
+	tariff *models.Tariff
+}
+
+// TariffStorageMockSaveTariffResults contains results of the TariffStorage.SaveTariff
+type TariffStorageMockSaveTariffResults struct {
+	b1  bool
+	err error
+}
+
+// TariffStorageMockSaveTariffOrigins contains origins of expectations of the TariffStorage.SaveTariff
+type TariffStorageMockSaveTariffExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTariff string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Optional() *mTariffStorageMockSaveTariff {
+	mmSaveTariff.optional = true
+	return mmSaveTariff
+}
+
+// Expect sets up expected params for TariffStorage.SaveTariff
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Expect(ctx context.Context, tariff models.Tariff) *mTariffStorageMockSaveTariff {
+	if mmSaveTariff.mock.funcSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Set")
+	}
+
+	if mmSaveTariff.defaultExpectation == nil {
+		mmSaveTariff.defaultExpectation = &TariffStorageMockSaveTariffExpectation{}
+	}
+
+	if mmSaveTariff.defaultExpectation.paramPtrs != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by ExpectParams functions")
+	}
+
+	mmSaveTariff.defaultExpectation.params = &TariffStorageMockSaveTariffParams{ctx, tariff}
+	mmSaveTariff.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveTariff.expectations {
+		if minimock.Equal(e.params, mmSaveTariff.defaultExpectation.params) {
+			mmSaveTariff.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveTariff.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveTariff
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TariffStorage.SaveTariff
+func (mmSaveTariff *mTariffStorageMockSaveTariff) ExpectCtxParam1(ctx context.Context) *mTariffStorageMockSaveTariff {
+	if mmSaveTariff.mock.funcSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Set")
+	}
+
+	if mmSaveTariff.defaultExpectation == nil {
+		mmSaveTariff.defaultExpectation = &TariffStorageMockSaveTariffExpectation{}
+	}
+
+	if mmSaveTariff.defaultExpectation.params != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Expect")
+	}
+
+	if mmSaveTariff.defaultExpectation.paramPtrs == nil {
+		mmSaveTariff.defaultExpectation.paramPtrs = &TariffStorageMockSaveTariffParamPtrs{}
+	}
+	mmSaveTariff.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveTariff.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveTariff
+}
+
+// ExpectTariffParam2 sets up expected param tariff for TariffStorage.SaveTariff
+func (mmSaveTariff *mTariffStorageMockSaveTariff) ExpectTariffParam2(tariff models.Tariff) *mTariffStorageMockSaveTariff {
+	if mmSaveTariff.mock.funcSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Set")
+	}
+
+	if mmSaveTariff.defaultExpectation == nil {
+		mmSaveTariff.defaultExpectation = &TariffStorageMockSaveTariffExpectation{}
+	}
+
+	if mmSaveTariff.defaultExpectation.params != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Expect")
+	}
+
+	if mmSaveTariff.defaultExpectation.paramPtrs == nil {
+		mmSaveTariff.defaultExpectation.paramPtrs = &TariffStorageMockSaveTariffParamPtrs{}
+	}
+	mmSaveTariff.defaultExpectation.paramPtrs.tariff = &tariff
+	mmSaveTariff.defaultExpectation.expectationOrigins.originTariff = minimock.CallerInfo(1)
+
+	return mmSaveTariff
+}
+
+// Inspect accepts an inspector function that has same arguments as the TariffStorage.SaveTariff
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Inspect(f func(ctx context.Context, tariff models.Tariff)) *mTariffStorageMockSaveTariff {
+	if mmSaveTariff.mock.inspectFuncSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("Inspect function is already set for TariffStorageMock.SaveTariff")
+	}
+
+	mmSaveTariff.mock.inspectFuncSaveTariff = f
+
+	return mmSaveTariff
+}
+
+// Return sets up results that will be returned by TariffStorage.SaveTariff
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Return(b1 bool, err error) *TariffStorageMock {
+	if mmSaveTariff.mock.funcSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Set")
+	}
+
+	if mmSaveTariff.defaultExpectation == nil {
+		mmSaveTariff.defaultExpectation = &TariffStorageMockSaveTariffExpectation{mock: mmSaveTariff.mock}
+	}
+	mmSaveTariff.defaultExpectation.results = &TariffStorageMockSaveTariffResults{b1, err}
+	mmSaveTariff.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveTariff.mock
+}
+
+// Set uses given function f to mock the TariffStorage.SaveTariff method
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Set(f func(ctx context.Context, tariff models.Tariff) (b1 bool, err error)) *TariffStorageMock {
+	if mmSaveTariff.defaultExpectation != nil {
+		mmSaveTariff.mock.t.Fatalf("Default expectation is already set for the TariffStorage.SaveTariff method")
+	}
+
+	if len(mmSaveTariff.expectations) > 0 {
+		mmSaveTariff.mock.t.Fatalf("Some expectations are already set for the TariffStorage.SaveTariff method")
+	}
+
+	mmSaveTariff.mock.funcSaveTariff = f
+	mmSaveTariff.mock.funcSaveTariffOrigin = minimock.CallerInfo(1)
+	return mmSaveTariff.mock
+}
+
+// When sets expectation for the TariffStorage.SaveTariff which will trigger the result defined by the following
+// Then helper
+func (mmSaveTariff *mTariffStorageMockSaveTariff) When(ctx context.Context, tariff models.Tariff) *TariffStorageMockSaveTariffExpectation {
+	if mmSaveTariff.mock.funcSaveTariff != nil {
+		mmSaveTariff.mock.t.Fatalf("TariffStorageMock.SaveTariff mock is already set by Set")
+	}
+
+	expectation := &TariffStorageMockSaveTariffExpectation{
+		mock:               mmSaveTariff.mock,
+		params:             &TariffStorageMockSaveTariffParams{ctx, tariff},
+		expectationOrigins: TariffStorageMockSaveTariffExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveTariff.expectations = append(mmSaveTariff.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TariffStorage.SaveTariff return parameters for the expectation previously defined by the When method
+func (e *TariffStorageMockSaveTariffExpectation) Then(b1 bool, err error) *TariffStorageMock {
+	e.results = &TariffStorageMockSaveTariffResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times TariffStorage.SaveTariff should be invoked
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Times(n uint64) *mTariffStorageMockSaveTariff {
+	if n == 0 {
+		mmSaveTariff.mock.t.Fatalf("Times of TariffStorageMock.SaveTariff mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveTariff.expectedInvocations, n)
+	mmSaveTariff.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveTariff
+}
+
+func (mmSaveTariff *mTariffStorageMockSaveTariff) invocationsDone() bool {
+	if len(mmSaveTariff.expectations) == 0 && mmSaveTariff.defaultExpectation == nil && mmSaveTariff.mock.funcSaveTariff == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveTariff.mock.afterSaveTariffCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveTariff.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveTariff implements mm_storage.TariffStorage
+func (mmSaveTariff *TariffStorageMock) SaveTariff(ctx context.Context, tariff models.Tariff) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmSaveTariff.beforeSaveTariffCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveTariff.afterSaveTariffCounter, 1)
+
+	mmSaveTariff.t.Helper()
+
+	if mmSaveTariff.inspectFuncSaveTariff != nil {
+		mmSaveTariff.inspectFuncSaveTariff(ctx, tariff)
+	}
+
+	mm_params := TariffStorageMockSaveTariffParams{ctx, tariff}
+
+	// Record call args
+	mmSaveTariff.SaveTariffMock.mutex.Lock()
+	mmSaveTariff.SaveTariffMock.callArgs = append(mmSaveTariff.SaveTariffMock.callArgs, &mm_params)
+	mmSaveTariff.SaveTariffMock.mutex.Unlock()
+
+	for _, e := range mmSaveTariff.SaveTariffMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmSaveTariff.SaveTariffMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveTariff.SaveTariffMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveTariff.SaveTariffMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveTariff.SaveTariffMock.defaultExpectation.paramPtrs
+
+		mm_got := TariffStorageMockSaveTariffParams{ctx, tariff}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveTariff.t.Errorf("TariffStorageMock.SaveTariff got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTariff.SaveTariffMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tariff != nil && !minimock.Equal(*mm_want_ptrs.tariff, mm_got.tariff) {
+				mmSaveTariff.t.Errorf("TariffStorageMock.SaveTariff got unexpected parameter tariff, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveTariff.SaveTariffMock.defaultExpectation.expectationOrigins.originTariff, *mm_want_ptrs.tariff, mm_got.tariff, minimock.Diff(*mm_want_ptrs.tariff, mm_got.tariff))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveTariff.t.Errorf("TariffStorageMock.SaveTariff got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveTariff.SaveTariffMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveTariff.SaveTariffMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveTariff.t.Fatal("No results are set for the TariffStorageMock.SaveTariff")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmSaveTariff.funcSaveTariff != nil {
+		return mmSaveTariff.funcSaveTariff(ctx, tariff)
+	}
+	mmSaveTariff.t.Fatalf("Unexpected call to TariffStorageMock.SaveTariff. %v %v", ctx, tariff)
+	return
+}
+
+// SaveTariffAfterCounter returns a count of finished TariffStorageMock.SaveTariff invocations
+func (mmSaveTariff *TariffStorageMock) SaveTariffAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTariff.afterSaveTariffCounter)
+}
+
+// SaveTariffBeforeCounter returns a count of TariffStorageMock.SaveTariff invocations
+func (mmSaveTariff *TariffStorageMock) SaveTariffBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveTariff.beforeSaveTariffCounter)
+}
+
+// Calls returns a list of arguments used in each call to TariffStorageMock.SaveTariff.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveTariff *mTariffStorageMockSaveTariff) Calls() []*TariffStorageMockSaveTariffParams {
+	mmSaveTariff.mutex.RLock()
+
+	argCopy := make([]*TariffStorageMockSaveTariffParams, len(mmSaveTariff.callArgs))
+	copy(argCopy, mmSaveTariff.callArgs)
+
+	mmSaveTariff.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveTariffDone returns true if the count of the SaveTariff invocations corresponds
+// the number of defined expectations
+func (m *TariffStorageMock) MinimockSaveTariffDone() bool {
+	if m.SaveTariffMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveTariffMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveTariffMock.invocationsDone()
+}
+
+// MinimockSaveTariffInspect logs each unmet expectation
+func (m *TariffStorageMock) MinimockSaveTariffInspect() {
+	for _, e := range m.SaveTariffMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TariffStorageMock.SaveTariff at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveTariffCounter := mm_atomic.LoadUint64(&m.afterSaveTariffCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveTariffMock.defaultExpectation != nil && afterSaveTariffCounter < 1 {
+		if m.SaveTariffMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TariffStorageMock.SaveTariff at\n%s", m.SaveTariffMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TariffStorageMock.SaveTariff at\n%s with params: %#v", m.SaveTariffMock.defaultExpectation.expectationOrigins.origin, *m.SaveTariffMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveTariff != nil && afterSaveTariffCounter < 1 {
+		m.t.Errorf("Expected call to TariffStorageMock.SaveTariff at\n%s", m.funcSaveTariffOrigin)
+	}
+
+	if !m.SaveTariffMock.invocationsDone() && afterSaveTariffCounter > 0 {
+		m.t.Errorf("Expected %d calls to TariffStorageMock.SaveTariff at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveTariffMock.expectedInvocations), m.SaveTariffMock.expectedInvocationsOrigin, afterSaveTariffCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TariffStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListTariffsInspect()
+
+			m.MinimockSaveTariffInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TariffStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TariffStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListTariffsDone() &&
+		m.MinimockSaveTariffDone()
+}
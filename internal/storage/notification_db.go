@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type NotificationStorage interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+	// SaveNotificationTx возвращает 0, если напоминание по этому заказу уже стоит в очереди
+	SaveNotificationTx(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error)
+	GetNotification(ctx context.Context, id uint32) (models.Notification, error)
+	CancelNotification(ctx context.Context, id uint32) (models.Notification, error)
+	ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) ([]models.Notification, error)
+	MarkNotificationSent(ctx context.Context, id uint32) error
+	MarkNotificationFailed(ctx context.Context, id uint32, errText string, retryAt *time.Time) error
+	ListExpiringOrders(ctx context.Context, before time.Time) ([]models.Order, error)
+}
+
+const notificationColumns = `
+	id, COALESCE(user_id, 0), COALESCE(order_id, 0), kind, title, text, COALESCE(comment, ''), tags,
+	priority, status, attempts, COALESCE(error, ''), scheduled_at, created_at, sent_at
+`
+
+func scanNotification(row pgx.Row) (models.Notification, error) {
+	var n models.Notification
+	err := row.Scan(
+		&n.ID,
+		&n.UserID,
+		&n.OrderID,
+		&n.Kind,
+		&n.Title,
+		&n.Text,
+		&n.Comment,
+		&n.Tags,
+		&n.Priority,
+		&n.Status,
+		&n.Attempts,
+		&n.Error,
+		&n.ScheduledAt,
+		&n.CreatedAt,
+		&n.SentAt,
+	)
+	return n, err
+}
+
+func (ps *PgStorage) SaveNotificationTx(ctx context.Context, tx pgx.Tx, n models.Notification) (uint32, error) {
+	const query = `
+		INSERT INTO notifications (user_id, order_id, kind, title, text, comment, tags, priority, status, scheduled_at)
+		VALUES (NULLIF($1::bigint, 0), NULLIF($2::bigint, 0), $3, $4, $5, NULLIF($6, ''), $7, $8, 'PENDING', $9)
+		ON CONFLICT (order_id, kind) WHERE order_id IS NOT NULL DO NOTHING
+		RETURNING id
+	`
+	if n.Tags == nil {
+		n.Tags = []string{}
+	}
+
+	var id uint32
+	err := tx.QueryRow(ctx, query,
+		int64(n.UserID),
+		int64(n.OrderID),
+		n.Kind,
+		n.Title,
+		n.Text,
+		n.Comment,
+		n.Tags,
+		n.Priority,
+		n.ScheduledAt,
+	).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save notification", "err", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (ps *PgStorage) GetNotification(ctx context.Context, id uint32) (models.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE id = $1`
+
+	n, err := scanNotification(ps.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.Notification{}, domainErrors.ErrNotificationNotFound
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get notification", "err", err)
+	}
+	return n, err
+}
+
+// CancelNotification отменяет только сообщения, которые ещё ждут отправки; взятое планировщиком уже отправляется
+func (ps *PgStorage) CancelNotification(ctx context.Context, id uint32) (models.Notification, error) {
+	query := `
+		UPDATE notifications SET status = 'CANCELLED'
+		WHERE id = $1 AND status = 'PENDING' AND (locked_until IS NULL OR locked_until < now())
+		RETURNING ` + notificationColumns
+
+	n, err := scanNotification(ps.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := ps.GetNotification(ctx, id); err != nil {
+			return models.Notification{}, err
+		}
+		return models.Notification{}, domainErrors.ErrNotificationNotCancellable
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to cancel notification", "err", err)
+	}
+	return n, err
+}
+
+// ClaimDueNotifications берет в аренду на lease сообщения, у которых прошла задержка, в порядке приоритета.
+// Отправка идет уже без блокировок строк; до конца аренды сообщение не берут другие экземпляры и не отменяют,
+// а неотмеченное после нее снова считается готовым.
+func (ps *PgStorage) ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) ([]models.Notification, error) {
+	query := `
+		UPDATE notifications SET locked_until = now() + $2 * interval '1 second'
+		WHERE id IN (
+			SELECT id
+			FROM notifications
+			WHERE status = 'PENDING' AND scheduled_at <= now() AND (locked_until IS NULL OR locked_until < now())
+			ORDER BY priority DESC, scheduled_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + notificationColumns
+
+	rows, err := ps.db.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to claim notifications", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []models.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to scan notification row", "err", err)
+			return nil, err
+		}
+		batch = append(batch, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING не сохраняет порядок подзапроса
+	slices.SortStableFunc(batch, func(a, b models.Notification) int {
+		if a.Priority != b.Priority {
+			return cmp.Compare(b.Priority, a.Priority)
+		}
+		return a.ScheduledAt.Compare(b.ScheduledAt)
+	})
+	return batch, nil
+}
+
+func (ps *PgStorage) MarkNotificationSent(ctx context.Context, id uint32) error {
+	const query = `
+		UPDATE notifications
+		SET status = 'SENT', sent_at = now(), attempts = attempts + 1, error = NULL, locked_until = NULL
+		WHERE id = $1
+	`
+
+	if _, err := ps.db.Exec(ctx, query, id); err != nil {
+		slog.ErrorContext(ctx, "failed to mark notification sent", "err", err)
+		return err
+	}
+	return nil
+}
+
+// MarkNotificationFailed при retryAt == nil помечает сообщение FAILED, иначе откладывает до retryAt
+func (ps *PgStorage) MarkNotificationFailed(ctx context.Context, id uint32, errText string, retryAt *time.Time) error {
+	const query = `
+		UPDATE notifications
+		SET status = CASE WHEN $3::timestamp IS NULL THEN 'FAILED' ELSE 'PENDING' END,
+			scheduled_at = COALESCE($3, scheduled_at),
+			attempts = attempts + 1,
+			error = $2,
+			locked_until = NULL
+		WHERE id = $1
+	`
+
+	if _, err := ps.db.Exec(ctx, query, id, errText, retryAt); err != nil {
+		slog.ErrorContext(ctx, "failed to mark notification failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// ListExpiringOrders заказы, ожидающие клиента, срок хранения которых истекает до before
+func (ps *PgStorage) ListExpiringOrders(ctx context.Context, before time.Time) ([]models.Order, error) {
+	const query = `
+		SELECT id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type
+		FROM orders
+		WHERE status = 'EXPECTS' AND expires_at > now() AND expires_at <= $1
+	`
+
+	rows, err := ps.db.Query(ctx, query, before)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list expiring orders", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []models.Order
+	for rows.Next() {
+		var o models.Order
+		err := rows.Scan(
+			&o.ID,
+			&o.UserID,
+			&o.Status,
+			&o.ExpiresAt,
+			&o.Weight,
+			&o.Price.Amount,
+			&o.Price.Currency,
+			&o.PackageType,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to scan order row", "err", err)
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, rows.Err()
+}
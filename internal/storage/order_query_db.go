@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"PWZ1.0/internal/models"
+)
+
+// QueryOrders выбирает заказы по фильтру на стороне БД
+func (ps *PgStorage) QueryOrders(ctx context.Context, filter models.OrderFilter) (models.OrdersPage, error) {
+	base := &sqlBuilder{}
+	conds := orderConditions(base, filter)
+
+	// total считается без last_n, курсора и страницы
+	countQuery := `SELECT count(*) FROM orders` + where(conds)
+
+	var page models.OrdersPage
+	if err := ps.db.QueryRow(ctx, countQuery, base.args...).Scan(&page.Total); err != nil {
+		slog.ErrorContext(ctx, "failed to count orders", "err", err)
+		return models.OrdersPage{}, err
+	}
+
+	q := &sqlBuilder{args: append([]any(nil), base.args...)}
+	if filter.LastN > 0 {
+		conds = append(conds, lastNCondition(q, conds, filter.LastN))
+	}
+	if filter.Cursor != nil {
+		conds = append(conds, cursorCondition(q, filter))
+	}
+
+	query := `
+		SELECT id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type, deleted_at,
+			COALESCE(tariff_version, '')
+		FROM orders` + where(conds) + orderBy(filter)
+	if filter.Limit > 0 {
+		query += ` LIMIT ` + q.arg(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query += ` OFFSET ` + q.arg(filter.Offset)
+	}
+
+	rows, err := ps.db.Query(ctx, query, q.args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query orders", "err", err)
+		return models.OrdersPage{}, err
+	}
+	defer rows.Close()
+
+	page.Orders = make([]models.Order, 0)
+	for rows.Next() {
+		var o models.Order
+		err := rows.Scan(
+			&o.ID,
+			&o.UserID,
+			&o.Status,
+			&o.ExpiresAt,
+			&o.Weight,
+			&o.Price.Amount,
+			&o.Price.Currency,
+			&o.PackageType,
+			&o.DeletedAt,
+			&o.TariffVersion,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to scan order row", "err", err)
+			return models.OrdersPage{}, err
+		}
+		page.Orders = append(page.Orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return models.OrdersPage{}, err
+	}
+
+	if filter.Limit > 0 && len(page.Orders) == int(filter.Limit) {
+		last := page.Orders[len(page.Orders)-1]
+		page.NextCursor = &models.OrderCursor{ID: last.ID, ExpiresAt: last.ExpiresAt}
+	}
+
+	return page, nil
+}
+
+type sqlBuilder struct {
+	args []any
+}
+
+// arg добавляет параметр запроса и возвращает его плейсхолдер
+func (b *sqlBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func orderConditions(b *sqlBuilder, filter models.OrderFilter) []string {
+	var conds []string
+
+	if !filter.IncludeDeleted {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	if filter.UserID != 0 {
+		conds = append(conds, "user_id = "+b.arg(int64(filter.UserID)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		conds = append(conds, "status = ANY("+b.arg(statuses)+")")
+	}
+	if !filter.ExpiresFrom.IsZero() {
+		conds = append(conds, "expires_at >= "+b.arg(filter.ExpiresFrom))
+	}
+	if !filter.ExpiresTo.IsZero() {
+		conds = append(conds, "expires_at < "+b.arg(filter.ExpiresTo))
+	}
+
+	return conds
+}
+
+func historyConditions(b *sqlBuilder, filter models.HistoryFilter) []string {
+	var conds []string
+
+	if filter.UserID != 0 {
+		conds = append(conds, "order_id IN (SELECT id FROM orders WHERE user_id = "+b.arg(int64(filter.UserID))+")")
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, string(s))
+		}
+		conds = append(conds, "status = ANY("+b.arg(statuses)+")")
+	}
+	if !filter.CreatedFrom.IsZero() {
+		conds = append(conds, "created_at >= "+b.arg(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		conds = append(conds, "created_at < "+b.arg(filter.CreatedTo))
+	}
+
+	return conds
+}
+
+// lastNCondition оставляет N последних по ID заказов из подходящих под conds
+func lastNCondition(b *sqlBuilder, conds []string, n uint32) string {
+	// условия уже привязаны к параметрам, подзапрос переиспользует их
+	return "id IN (SELECT id FROM orders" + where(conds) + " ORDER BY id DESC LIMIT " + b.arg(n) + ")"
+}
+
+func cursorCondition(b *sqlBuilder, filter models.OrderFilter) string {
+	op := ">"
+	if filter.Desc {
+		op = "<"
+	}
+
+	switch filter.SortBy {
+	case models.SortByExpiresAt:
+		return fmt.Sprintf("(expires_at, id) %s (%s, %s)", op, b.arg(filter.Cursor.ExpiresAt), b.arg(int64(filter.Cursor.ID)))
+	default:
+		return fmt.Sprintf("id %s %s", op, b.arg(int64(filter.Cursor.ID)))
+	}
+}
+
+func orderBy(filter models.OrderFilter) string {
+	dir := " ASC"
+	if filter.Desc {
+		dir = " DESC"
+	}
+
+	switch filter.SortBy {
+	case models.SortByExpiresAt:
+		return " ORDER BY expires_at" + dir + ", id" + dir
+	default:
+		return " ORDER BY id" + dir
+	}
+}
+
+func where(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"PWZ1.0/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type OutboxStorage interface {
+	ClaimOutboxBatch(ctx context.Context, limit, maxAttempts int, staleAfter time.Duration) ([]models.OutboxMessage, error)
+	MarkOutboxCompleted(ctx context.Context, id uuid.UUID) error
+	MarkOutboxFailed(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) error
+	OutboxStats(ctx context.Context, maxAttempts int) (models.OutboxStats, error)
+}
+
+// ClaimOutboxBatch забирает пачку событий на отправку и переводит их в PROCESSING.
+// Строки, зависшие в PROCESSING дольше staleAfter (воркер упал), забираются повторно.
+func (ps *PgStorage) ClaimOutboxBatch(ctx context.Context, limit, maxAttempts int, staleAfter time.Duration) ([]models.OutboxMessage, error) {
+	const query = `
+		UPDATE outbox
+		SET status = 'PROCESSING', attempts = attempts + 1, updated_at = now()
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE attempts < $2
+			  AND (
+				(status IN ('CREATED', 'FAILED') AND next_attempt_at <= now())
+				OR (status = 'PROCESSING' AND updated_at < now() - $3 * interval '1 millisecond')
+			  )
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, payload, attempts, created_at
+	`
+
+	rows, err := ps.db.Query(ctx, query, limit, maxAttempts, staleAfter.Milliseconds())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to claim outbox batch", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []models.OutboxMessage
+	for rows.Next() {
+		var m models.OutboxMessage
+		if err := rows.Scan(&m.ID, &m.Payload, &m.Attempts, &m.CreatedAt); err != nil {
+			slog.ErrorContext(ctx, "failed to scan outbox row", "err", err)
+			return nil, err
+		}
+		batch = append(batch, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING не сохраняет порядок подзапроса
+	sort.Slice(batch, func(i, j int) bool {
+		return batch[i].CreatedAt.Before(batch[j].CreatedAt)
+	})
+
+	return batch, nil
+}
+
+func (ps *PgStorage) MarkOutboxCompleted(ctx context.Context, id uuid.UUID) error {
+	const query = `
+		UPDATE outbox
+		SET status = 'COMPLETED', error = NULL, sent_at = now(), updated_at = now()
+		WHERE id = $1
+	`
+
+	if _, err := ps.db.Exec(ctx, query, id); err != nil {
+		slog.ErrorContext(ctx, "failed to mark outbox event completed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (ps *PgStorage) MarkOutboxFailed(ctx context.Context, id uuid.UUID, errText string, nextAttemptAt time.Time) error {
+	const query = `
+		UPDATE outbox
+		SET status = 'FAILED', error = $2, next_attempt_at = $3, updated_at = now()
+		WHERE id = $1
+	`
+
+	if _, err := ps.db.Exec(ctx, query, id, errText, nextAttemptAt); err != nil {
+		slog.ErrorContext(ctx, "failed to mark outbox event failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// OutboxStats считает события, которые ещё будут отправлены, и время создания самого старого из них
+func (ps *PgStorage) OutboxStats(ctx context.Context, maxAttempts int) (models.OutboxStats, error) {
+	const query = `
+		SELECT count(*), COALESCE(min(created_at), now())
+		FROM outbox
+		WHERE status IN ('CREATED', 'PROCESSING')
+		   OR (status = 'FAILED' AND attempts < $1)
+	`
+
+	var stats models.OutboxStats
+	err := ps.db.QueryRow(ctx, query, maxAttempts).Scan(&stats.Pending, &stats.OldestCreatedAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get outbox stats", "err", err)
+	}
+	return stats, err
+}
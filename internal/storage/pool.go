@@ -0,0 +1,21 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"PWZ1.0/internal/tracing"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPool pgxpool.New, в котором каждый запрос пишется спаном трассировки и в лог через QueryLogger;
+// запросы дольше slowQuery попадают в лог как медленные, 0 - без порога
+func NewPool(ctx context.Context, dsn string, slowQuery time.Duration) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ConnConfig.Tracer = multitracer.New(tracing.QueryTracer{}, QueryLogger{SlowThreshold: slowQuery})
+	return pgxpool.NewWithConfig(ctx, cfg)
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ProcessedEventStorage interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
+	// MarkEventProcessedTx возвращает false, если событие уже было обработано
+	MarkEventProcessedTx(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) (bool, error)
+}
+
+func (ps *PgStorage) MarkEventProcessedTx(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, eventType string) (bool, error) {
+	const query = `
+		INSERT INTO processed_events (event_id, event_type)
+		VALUES ($1, $2)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, eventID, eventType)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to mark event processed", "err", err)
+		return false, err
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// QueryLogger пишет запросы к базе вместо печати каждого SQL: медленнее SlowThreshold - warn, ошибки - warn,
+// остальные - debug, их видно, только если поднять уровень лога. Строковые аргументы скрыты: в них имена,
+// тексты уведомлений и тела событий, числа, даты и UUID видны как есть
+type QueryLogger struct {
+	SlowThreshold time.Duration
+}
+
+type queryLogKey struct{}
+
+type queryStart struct {
+	sql   string
+	args  []any
+	start time.Time
+}
+
+func (l QueryLogger) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryLogKey{}, queryStart{sql: data.SQL, args: data.Args, start: time.Now()})
+}
+
+func (l QueryLogger) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	q, ok := ctx.Value(queryLogKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	l.log(ctx, q, data.CommandTag.RowsAffected(), data.Err)
+}
+
+func (l QueryLogger) TraceCopyFromStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	sql := "COPY " + data.TableName.Sanitize() + " (" + strings.Join(data.ColumnNames, ", ") + ")"
+	return context.WithValue(ctx, queryLogKey{}, queryStart{sql: sql, start: time.Now()})
+}
+
+func (l QueryLogger) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	q, ok := ctx.Value(queryLogKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	l.log(ctx, q, data.CommandTag.RowsAffected(), data.Err)
+}
+
+func (l QueryLogger) log(ctx context.Context, q queryStart, rows int64, err error) {
+	duration := time.Since(q.start)
+
+	level, msg := slog.LevelDebug, "query"
+	switch {
+	case err != nil:
+		level, msg = slog.LevelWarn, "query failed"
+	case l.SlowThreshold > 0 && duration >= l.SlowThreshold:
+		level, msg = slog.LevelWarn, "slow query"
+	}
+	if !slog.Default().Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("sql", compactSQL(q.sql)),
+		slog.Any("args", RedactArgs(q.args)),
+		slog.Duration("duration", duration),
+		slog.Int64("rows", rows),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("err", err))
+	}
+	slog.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// compactSQL запрос в одну строку: в исходниках запросы с отступами и переносами
+func compactSQL(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
+
+const redacted = "***"
+
+// RedactArgs аргументы запроса для лога: числа, bool, даты, UUID и их срезы как есть, остальное - ***
+func RedactArgs(args []any) []any {
+	out := make([]any, len(args))
+	for i, arg := range args {
+		out[i] = redactArg(arg)
+	}
+	return out
+}
+
+func redactArg(arg any) any {
+	switch arg.(type) {
+	case nil, time.Time, time.Duration, uuid.UUID:
+		return arg
+	}
+
+	rv := reflect.ValueOf(arg)
+	switch {
+	case isNumeric(rv.Kind()), rv.Kind() == reflect.Bool:
+		return arg
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 && isNumeric(rv.Type().Elem().Kind()):
+		return arg
+	}
+	return redacted
+}
+
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactArgs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	id := uuid.New()
+	args := []any{int64(42), "Иван Петров", []byte("payload"), now, id, true, []int64{1, 2}, []string{"a"}, nil, 1.5}
+
+	assert.Equal(t, []any{int64(42), redacted, redacted, now, id, true, []int64{1, 2}, redacted, nil, 1.5}, RedactArgs(args))
+}
+
+// меняет slog.Default, поэтому без t.Parallel
+func TestQueryLogger_Levels(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	l := QueryLogger{SlowThreshold: time.Second}
+	q := queryStart{sql: "SELECT *\n\t\tFROM orders WHERE name = $1", args: []any{"secret"}}
+
+	// быстрый запрос - debug, при уровне info не пишется
+	q.start = time.Now()
+	l.log(context.Background(), q, 1, nil)
+	assert.Empty(t, buf.String())
+
+	q.start = time.Now().Add(-2 * time.Second)
+	l.log(context.Background(), q, 1, nil)
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), `msg="slow query"`)
+	assert.Contains(t, buf.String(), `sql="SELECT * FROM orders WHERE name = $1"`)
+	assert.NotContains(t, buf.String(), "secret")
+
+	buf.Reset()
+	q.start = time.Now()
+	l.log(context.Background(), q, 0, errors.New("boom"))
+	assert.Contains(t, buf.String(), `msg="query failed"`)
+	assert.Contains(t, buf.String(), "err=boom")
+
+	// без порога медленные запросы не выделяются
+	buf.Reset()
+	q.start = time.Now().Add(-time.Hour)
+	QueryLogger{}.log(context.Background(), q, 1, nil)
+	assert.Empty(t, buf.String())
+}
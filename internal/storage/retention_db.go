@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type RetentionStorage interface {
+	// PurgeDeletedOrders физически удаляет не больше limit заказов, возвращенных курьеру раньше before, вместе с историей
+	PurgeDeletedOrders(ctx context.Context, before time.Time, limit int) (int64, error)
+	// PurgeExpiredIdempotencyKeys удаляет истекшие ключи идемпотентности
+	PurgeExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+func (ps *PgStorage) PurgeDeletedOrders(ctx context.Context, before time.Time, limit int) (int64, error) {
+	const query = `
+		DELETE FROM orders
+		WHERE id IN (
+			SELECT id FROM orders
+			WHERE deleted_at IS NOT NULL AND deleted_at < $1
+			ORDER BY deleted_at
+			LIMIT $2
+		)
+	`
+
+	cmdTag, err := ps.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to purge deleted orders", "err", err)
+		return 0, err
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+func (ps *PgStorage) PurgeExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM idempotency_keys WHERE expires_at < now()`
+
+	cmdTag, err := ps.db.Exec(ctx, query)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to purge idempotency keys", "err", err)
+		return 0, err
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
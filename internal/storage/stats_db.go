@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+
+	"PWZ1.0/internal/models"
+	"github.com/jackc/pgx/v5"
+)
+
+type StatsStorage interface {
+	// BusinessStats агрегаты по всем заказам для дашборда, каждый вызов - полный проход по таблице
+	BusinessStats(ctx context.Context) (models.BusinessStats, error)
+}
+
+func (ps *PgStorage) BusinessStats(ctx context.Context) (models.BusinessStats, error) {
+	// все числа из одного снимка базы
+	tx, err := ps.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin stats transaction", "err", err)
+		return models.BusinessStats{}, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	stats, err := ps.businessStatsTx(ctx, tx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to collect business stats", "err", err)
+		return models.BusinessStats{}, err
+	}
+
+	return stats, nil
+}
+
+func (ps *PgStorage) businessStatsTx(ctx context.Context, tx pgx.Tx) (models.BusinessStats, error) {
+	stats := models.BusinessStats{ByStatus: make(map[models.OrderStatus]int64)}
+
+	const byStatusQuery = `SELECT status, count(*) FROM orders GROUP BY status`
+
+	rows, err := tx.Query(ctx, byStatusQuery)
+	if err != nil {
+		return stats, err
+	}
+	for rows.Next() {
+		var (
+			status models.OrderStatus
+			count  int64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			rows.Close()
+			return stats, err
+		}
+		stats.ByStatus[status] = count
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return stats, err
+	}
+
+	const storedQuery = `
+		SELECT
+			count(*) FILTER (WHERE status = 'EXPECTS' AND expires_at < now()),
+			count(*) FILTER (WHERE status = 'RETURNED'),
+			COALESCE(sum(weight_grams) FILTER (WHERE status IN ('EXPECTS', 'RETURNED')), 0)::bigint
+		FROM orders
+	`
+
+	if err := tx.QueryRow(ctx, storedQuery).Scan(&stats.ExpiredExpects, &stats.ReturnsAwaitingCourier, &stats.StoredWeight); err != nil {
+		return stats, err
+	}
+
+	const valueQuery = `
+		SELECT COALESCE(NULLIF(package_type, ''), 'unspecified'), currency, sum(price_minor)::bigint
+		FROM orders
+		WHERE status IN ('EXPECTS', 'RETURNED')
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+
+	rows, err = tx.Query(ctx, valueQuery)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var v models.PackageValue
+		if err := rows.Scan(&v.Package, &v.Value.Currency, &v.Value.Amount); err != nil {
+			return stats, err
+		}
+		stats.StoredValue = append(stats.StoredValue, v)
+	}
+	return stats, rows.Err()
+}
@@ -4,27 +4,40 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"log"
+	"log/slog"
 	"time"
 
 	"PWZ1.0/internal/models"
 	"PWZ1.0/internal/models/domainErrors"
 
-	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Storage interface {
 	GetOrder(ctx context.Context, id uint64) (models.Order, error)
-	DeleteOrder(ctx context.Context, id uint64) error
+	// GetOrderForUpdateTx блокирует строку заказа до конца транзакции
+	GetOrderForUpdateTx(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error)
+	DeleteOrderTx(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) error
 	ListOrders(ctx context.Context) ([]models.Order, error)
-	GetHistory(ctx context.Context, page uint32, count uint32) ([]models.OrderHistory, error)
-	SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) error
-	UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) error
+	QueryOrders(ctx context.Context, filter models.OrderFilter) (models.OrdersPage, error)
+	GetHistory(ctx context.Context, filter models.HistoryFilter, page uint32, count uint32) ([]models.OrderHistory, error)
+	GetOrderHistory(ctx context.Context, orderID uint64) ([]models.OrderHistory, error)
+	// SaveOrderTx, UpdateOrderTx и DeleteOrderTx пишут смену статуса в историю заказа вместе с actor
+	SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) error
+	UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) error
 	WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
 	//TODO: новая
 	SaveEventTx(ctx context.Context, tx pgx.Tx, order models.Event) error
+
+	// SaveOrdersTx сохраняет пачку новых заказов одним COPY
+	SaveOrdersTx(ctx context.Context, tx pgx.Tx, orders []models.Order, actor models.Actor) error
+	ExistingOrderIDsTx(ctx context.Context, tx pgx.Tx, ids []uint64) (map[uint64]struct{}, error)
+	StartImportJob(ctx context.Context, id string) (models.ImportJob, error)
+	// GetImportJobForUpdateTx блокирует задание, чтобы два потока с одним job_id не сохранили одну пачку дважды
+	GetImportJobForUpdateTx(ctx context.Context, tx pgx.Tx, id string) (models.ImportJob, error)
+	UpdateImportJobTx(ctx context.Context, tx pgx.Tx, job models.ImportJob) error
 }
 
 type PgStorage struct {
@@ -35,50 +48,45 @@ func NewPgStorage(db *pgxpool.Pool) *PgStorage {
 	return &PgStorage{db: db}
 }
 
-func (ps *PgStorage) logQuery(ctx context.Context, query string, args ...interface{}) {
-	log.Printf("SQL: %s\nArgs: %v\n", query, args)
-}
-
 func (ps *PgStorage) WithTransaction(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
 	start := time.Now()
-	log.Println("Starting transaction")
+	slog.DebugContext(ctx, "starting transaction")
 
 	tx, err := ps.db.Begin(ctx)
 	if err != nil {
-		log.Printf("Failed to begin transaction: %v\n", err)
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
 		return err
 	}
 
 	defer func() {
 		if p := recover(); p != nil {
-			log.Printf("Transaction panic: %v, rolling back\n", p)
+			slog.ErrorContext(ctx, "transaction panic, rolling back", "panic", p)
 			_ = tx.Rollback(ctx)
 			panic(p)
 		}
 	}()
 
 	if err := fn(ctx, tx); err != nil {
-		log.Printf("Transaction failed: %v, rolling back\n", err)
+		slog.InfoContext(ctx, "transaction failed, rolling back", "err", err)
 		_ = tx.Rollback(ctx)
 		return err
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		log.Printf("Failed to commit transaction: %v\n", err)
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
 		return err
 	}
 
-	log.Printf("Transaction committed (duration: %v)\n", time.Since(start))
+	slog.DebugContext(ctx, "transaction committed", "duration", time.Since(start))
 	return nil
 }
 
-func (ps *PgStorage) SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) error {
+func (ps *PgStorage) SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) error {
 	const query = `
-		INSERT INTO orders (id, user_id, status, expires_at, weight, total_price, package_type)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO orders (id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type, tariff_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''))
 	`
-	ps.logQuery(ctx, query, order.ID, order.UserID, order.Status, order.ExpiresAt, order.Weight, order.Price, order.PackageType)
 
 	_, err := tx.Exec(ctx, query,
 		order.ID,
@@ -86,27 +94,23 @@ func (ps *PgStorage) SaveOrderTx(ctx context.Context, tx pgx.Tx, order models.Or
 		order.Status,
 		order.ExpiresAt,
 		order.Weight,
-		order.Price,
+		order.Price.Amount,
+		order.Price.Currency.OrDefault(),
 		order.PackageType,
+		order.TariffVersion,
 	)
 	if err != nil {
 		if isUniqueViolation(err) {
-			log.Printf("Duplicate order: %v\n", order.ID)
+			slog.InfoContext(ctx, "duplicate order", "order_id", order.ID)
 			return domainErrors.ErrDuplicateOrder
 		}
-		log.Printf("Failed to save order: %v\n", err)
+		slog.ErrorContext(ctx, "failed to save order", "err", err)
 		return err
 	}
 
-	const historyQuery = `
-		INSERT INTO order_history (order_id, status)
-		VALUES ($1, $2)
-	`
-	ps.logQuery(ctx, historyQuery, order.ID, order.Status)
-
-	_, err = tx.Exec(ctx, historyQuery, order.ID, order.Status)
+	err = ps.saveHistoryTx(ctx, tx, order, actor)
 	if err != nil {
-		log.Printf("Failed to save order history: %v\n", err)
+		slog.ErrorContext(ctx, "failed to save order history", "err", err)
 	}
 	return err
 }
@@ -115,7 +119,7 @@ func (ps *PgStorage) SaveEventTx(ctx context.Context, tx pgx.Tx, event models.Ev
 
 	payload, err := json.Marshal(event)
 	if err != nil {
-		log.Printf("Failed to marshal event: %v\n", err)
+		slog.ErrorContext(ctx, "failed to marshal event", "err", err)
 		return err
 	}
 
@@ -124,37 +128,26 @@ func (ps *PgStorage) SaveEventTx(ctx context.Context, tx pgx.Tx, event models.Ev
 		VALUES ($1, $2, 'CREATED', now())
 	`
 
-	ps.logQuery(ctx, query, event.EventID, string(payload))
-
 	_, err = tx.Exec(ctx, query,
 		event.EventID,
 		payload,
 	)
 
 	if err != nil {
-		log.Printf("Failed to insert into outbox: %v\n", err)
+		slog.ErrorContext(ctx, "failed to insert into outbox", "err", err)
 		return err
 	}
 
 	return nil
 }
 
-func (ps *PgStorage) UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order) error {
+func (ps *PgStorage) UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) error {
 	const query = `
 		UPDATE orders
-		SET user_id = $2, status = $3, expires_at = $4, weight = $5,
-			total_price = $6, package_type = $7
+		SET user_id = $2, status = $3, expires_at = $4, weight_grams = $5,
+			price_minor = $6, currency = $7, package_type = $8
 		WHERE id = $1
 	`
-	ps.logQuery(ctx, query,
-		order.ID,
-		order.UserID,
-		order.Status,
-		order.ExpiresAt,
-		order.Weight,
-		order.Price,
-		order.PackageType,
-	)
 
 	cmdTag, err := tx.Exec(ctx, query,
 		order.ID,
@@ -162,87 +155,124 @@ func (ps *PgStorage) UpdateOrderTx(ctx context.Context, tx pgx.Tx, order models.
 		order.Status,
 		order.ExpiresAt,
 		order.Weight,
-		order.Price,
+		order.Price.Amount,
+		order.Price.Currency.OrDefault(),
 		order.PackageType,
 	)
 	if err != nil {
-		log.Printf("Failed to update order: %v\n", err)
+		slog.ErrorContext(ctx, "failed to update order", "err", err)
 		return err
 	}
 	if cmdTag.RowsAffected() == 0 {
-		log.Printf("Order not found for update: %v\n", order.ID)
+		slog.InfoContext(ctx, "order not found for update", "order_id", order.ID)
 		return domainErrors.ErrOrderNotFound
 	}
 
-	const historyQuery = `
-		INSERT INTO order_history (order_id, status)
-		VALUES ($1, $2)
-	`
-	ps.logQuery(ctx, historyQuery, order.ID, order.Status)
-
-	_, err = tx.Exec(ctx, historyQuery, order.ID, order.Status)
+	err = ps.saveHistoryTx(ctx, tx, order, actor)
 	if err != nil {
-		log.Printf("Failed to update order history: %v\n", err)
+		slog.ErrorContext(ctx, "failed to update order history", "err", err)
 	}
 	return err
 }
 
+func (ps *PgStorage) saveHistoryTx(ctx context.Context, tx pgx.Tx, order models.Order, actor models.Actor) error {
+	const query = `
+		INSERT INTO order_history (order_id, status, actor_type, actor_id, actor_subject)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := tx.Exec(ctx, query, order.ID, order.Status, actor.Type, actor.ID, actor.Subject)
+	return err
+}
+
 func (ps *PgStorage) GetOrder(ctx context.Context, id uint64) (models.Order, error) {
 	const query = `
-		SELECT id, user_id, status, expires_at, weight, total_price, package_type
+		SELECT id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type, deleted_at,
+			COALESCE(tariff_version, '')
+		FROM orders WHERE id = $1
+	`
+	return ps.getOrder(ctx, ps.db, query, id)
+}
+
+func (ps *PgStorage) GetOrderForUpdateTx(ctx context.Context, tx pgx.Tx, id uint64) (models.Order, error) {
+	const query = `
+		SELECT id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type, deleted_at,
+			COALESCE(tariff_version, '')
 		FROM orders WHERE id = $1
+		FOR UPDATE
 	`
-	ps.logQuery(ctx, query, id)
+	return ps.getOrder(ctx, tx, query, id)
+}
+
+// rowQuerier общий интерфейс пула и транзакции для чтения одной строки
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func (ps *PgStorage) getOrder(ctx context.Context, q rowQuerier, query string, id uint64) (models.Order, error) {
 
 	var order models.Order
-	err := ps.db.QueryRow(ctx, query, id).Scan(
+	err := q.QueryRow(ctx, query, id).Scan(
 		&order.ID,
 		&order.UserID,
 		&order.Status,
 		&order.ExpiresAt,
 		&order.Weight,
-		&order.Price,
+		&order.Price.Amount,
+		&order.Price.Currency,
 		&order.PackageType,
+		&order.DeletedAt,
+		&order.TariffVersion,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
-		log.Printf("Order not found: %v\n", id)
+		slog.InfoContext(ctx, "order not found", "order_id", id)
 		return models.Order{}, domainErrors.ErrOrderNotFound
 	}
 	if err != nil {
-		log.Printf("Failed to get order: %v\n", err)
+		slog.ErrorContext(ctx, "failed to get order", "err", err)
 	}
 	return order, err
 }
 
-func (ps *PgStorage) DeleteOrder(ctx context.Context, id uint64) error {
-	const query = `DELETE FROM orders WHERE id = $1`
-	ps.logQuery(ctx, query, id)
+// DeleteOrderTx помечает заказ возвращенным курьеру, строка и история заказа сохраняются до очистки по сроку хранения
+func (ps *PgStorage) DeleteOrderTx(ctx context.Context, tx pgx.Tx, id uint64, actor models.Actor) error {
+	const query = `
+		WITH deleted AS (
+			UPDATE orders
+			SET status = $2, deleted_at = now()
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id, status
+		)
+		INSERT INTO order_history (order_id, status, actor_type, actor_id, actor_subject)
+		SELECT id, status, $3, $4, $5 FROM deleted
+	`
 
-	cmdTag, err := ps.db.Exec(ctx, query, id)
+	cmdTag, err := tx.Exec(ctx, query, id, models.StatusDeleted, actor.Type, actor.ID, actor.Subject)
 	if err != nil {
-		log.Printf("Failed to delete order: %v\n", err)
+		slog.ErrorContext(ctx, "failed to delete order", "err", err)
 		return err
 	}
 
 	if cmdTag.RowsAffected() == 0 {
-		log.Printf("Order not found for deletion: %v\n", id)
+		slog.InfoContext(ctx, "order not found for deletion", "order_id", id)
 		return domainErrors.ErrOrderNotFound
 	}
 
-	log.Printf("Order deleted: %v\n", id)
+	slog.InfoContext(ctx, "order deleted", "order_id", id)
 	return nil
 }
 
 func (ps *PgStorage) ListOrders(ctx context.Context) ([]models.Order, error) {
 	const query = `
-		SELECT id, user_id, status, expires_at, weight, total_price, package_type
+		SELECT id, user_id, status, expires_at, weight_grams, price_minor, currency, package_type, deleted_at,
+			COALESCE(tariff_version, '')
 		FROM orders
+		WHERE deleted_at IS NULL
 	`
-	ps.logQuery(ctx, query)
 
 	rows, err := ps.db.Query(ctx, query)
 	if err != nil {
-		log.Printf("Failed to list orders: %v\n", err)
+		slog.ErrorContext(ctx, "failed to list orders", "err", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -256,37 +286,78 @@ func (ps *PgStorage) ListOrders(ctx context.Context) ([]models.Order, error) {
 			&o.Status,
 			&o.ExpiresAt,
 			&o.Weight,
-			&o.Price,
+			&o.Price.Amount,
+			&o.Price.Currency,
 			&o.PackageType,
+			&o.DeletedAt,
+			&o.TariffVersion,
 		)
 		if err != nil {
-			log.Printf("Failed to scan order row: %v\n", err)
+			slog.ErrorContext(ctx, "failed to scan order row", "err", err)
 			return nil, err
 		}
 		orders = append(orders, o)
 	}
 
-	log.Printf("Listed %d orders\n", len(orders))
+	slog.DebugContext(ctx, "listed orders", "count", len(orders))
 	return orders, rows.Err()
 }
 
-func (ps *PgStorage) GetHistory(ctx context.Context, page, count uint32) ([]models.OrderHistory, error) {
+func (ps *PgStorage) GetHistory(ctx context.Context, filter models.HistoryFilter, page, count uint32) ([]models.OrderHistory, error) {
 	if count == 0 {
 		count = 50
 	}
 	offset := page * count
 
+	q := &sqlBuilder{}
+	query := `
+		SELECT id, order_id, status, created_at, actor_type, actor_id, actor_subject
+		FROM order_history` + where(historyConditions(q, filter)) + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + q.arg(count) + ` OFFSET ` + q.arg(offset)
+
+	rows, err := ps.db.Query(ctx, query, q.args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get order history", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []models.OrderHistory
+	for rows.Next() {
+		var h models.OrderHistory
+		err := rows.Scan(
+			&h.ID,
+			&h.OrderID,
+			&h.Status,
+			&h.CreatedAt,
+			&h.Actor.Type,
+			&h.Actor.ID,
+			&h.Actor.Subject,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to scan history row", "err", err)
+			return nil, err
+		}
+		history = append(history, h)
+	}
+
+	slog.DebugContext(ctx, "retrieved history entries", "count", len(history))
+	return history, rows.Err()
+}
+
+// GetOrderHistory история одного заказа в хронологическом порядке
+func (ps *PgStorage) GetOrderHistory(ctx context.Context, orderID uint64) ([]models.OrderHistory, error) {
 	const query = `
-		SELECT id, order_id, status, created_at
+		SELECT id, order_id, status, created_at, actor_type, actor_id, actor_subject
 		FROM order_history
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
+		WHERE order_id = $1
+		ORDER BY created_at, id
 	`
-	ps.logQuery(ctx, query, count, offset)
 
-	rows, err := ps.db.Query(ctx, query, count, offset)
+	rows, err := ps.db.Query(ctx, query, orderID)
 	if err != nil {
-		log.Printf("Failed to get order history: %v\n", err)
+		slog.ErrorContext(ctx, "failed to get order history", "order_id", orderID, "err", err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -299,15 +370,17 @@ func (ps *PgStorage) GetHistory(ctx context.Context, page, count uint32) ([]mode
 			&h.OrderID,
 			&h.Status,
 			&h.CreatedAt,
+			&h.Actor.Type,
+			&h.Actor.ID,
+			&h.Actor.Subject,
 		)
 		if err != nil {
-			log.Printf("Failed to scan history row: %v\n", err)
+			slog.ErrorContext(ctx, "failed to scan history row", "err", err)
 			return nil, err
 		}
 		history = append(history, h)
 	}
 
-	log.Printf("Retrieved %d history entries\n", len(history))
 	return history, rows.Err()
 }
 
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	t.Parallel()
+
+	// ошибки приходят от pgx/v5, PgError из другого модуля errors.As не находит
+	assert.True(t, isUniqueViolation(fmt.Errorf("copy: %w", &pgconn.PgError{Code: "23505"})))
+	assert.False(t, isUniqueViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, isUniqueViolation(errors.New("23505")))
+}
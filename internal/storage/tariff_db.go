@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"PWZ1.0/internal/models"
+)
+
+type TariffStorage interface {
+	// SaveTariff сохраняет новую версию, уже сохраненные версии не меняются, чтобы цены старых заказов оставались воспроизводимыми
+	SaveTariff(ctx context.Context, tariff models.Tariff) (bool, error)
+	ListTariffs(ctx context.Context) ([]models.Tariff, error)
+}
+
+func (ps *PgStorage) SaveTariff(ctx context.Context, tariff models.Tariff) (bool, error) {
+	body, err := json.Marshal(tariff)
+	if err != nil {
+		return false, err
+	}
+
+	const query = `
+		INSERT INTO tariffs (version, valid_from, body)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (version) DO NOTHING
+	`
+
+	cmdTag, err := ps.db.Exec(ctx, query, tariff.Version, tariff.ValidFrom, body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save tariff", "err", err)
+		return false, err
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
+
+func (ps *PgStorage) ListTariffs(ctx context.Context) ([]models.Tariff, error) {
+	const query = `
+		SELECT version, valid_from, body
+		FROM tariffs
+		ORDER BY valid_from
+	`
+
+	rows, err := ps.db.Query(ctx, query)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list tariffs", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tariffs []models.Tariff
+	for rows.Next() {
+		var (
+			t    models.Tariff
+			body []byte
+		)
+		if err := rows.Scan(&t.Version, &t.ValidFrom, &body); err != nil {
+			slog.ErrorContext(ctx, "failed to scan tariff row", "err", err)
+			return nil, err
+		}
+		if err := json.Unmarshal(body, &t); err != nil {
+			slog.ErrorContext(ctx, "failed to decode tariff", "version", t.Version, "err", err)
+			return nil, err
+		}
+		tariffs = append(tariffs, t)
+	}
+
+	return tariffs, rows.Err()
+}
@@ -0,0 +1,81 @@
+package tariff
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"PWZ1.0/internal/models"
+)
+
+var ErrNoActiveTariff = errors.New("нет действующего тарифа")
+
+// Provider источник тарифов для приемки заказов и API
+type Provider interface {
+	// Active тариф, действующий в момент at
+	Active(at time.Time) (models.Tariff, error)
+	// Get тариф по версии, чтобы пересчитать цену уже принятого заказа
+	Get(version string) (models.Tariff, bool)
+	All() []models.Tariff
+}
+
+// Book неизменяемый набор версий тарифа, упорядоченный по дате начала действия
+type Book struct {
+	tariffs []models.Tariff
+}
+
+func NewBook(tariffs ...models.Tariff) (*Book, error) {
+	sorted := append([]models.Tariff(nil), tariffs...)
+	models.SortTariffs(sorted)
+
+	seen := make(map[string]struct{}, len(sorted))
+	for i, t := range sorted {
+		if t.Version == "" {
+			return nil, errors.New("tariff without version")
+		}
+		if _, ok := seen[t.Version]; ok {
+			return nil, fmt.Errorf("duplicate tariff version %q", t.Version)
+		}
+		seen[t.Version] = struct{}{}
+
+		sorted[i].Currency = t.Currency.OrDefault()
+
+		if len(t.Packages) == 0 {
+			return nil, fmt.Errorf("tariff %q has no packages", t.Version)
+		}
+		for pkg, p := range t.Packages {
+			if p.Surcharge < 0 || p.WeightLimit < 0 {
+				return nil, fmt.Errorf("tariff %q: negative values for package %q", t.Version, pkg)
+			}
+		}
+		for _, tier := range t.WeightTiers {
+			if tier.FromWeight < 0 || tier.Surcharge < 0 {
+				return nil, fmt.Errorf("tariff %q: negative weight tier", t.Version)
+			}
+		}
+	}
+
+	return &Book{tariffs: sorted}, nil
+}
+
+func (b *Book) Active(at time.Time) (models.Tariff, error) {
+	for i := len(b.tariffs) - 1; i >= 0; i-- {
+		if !b.tariffs[i].ValidFrom.After(at) {
+			return b.tariffs[i], nil
+		}
+	}
+	return models.Tariff{}, ErrNoActiveTariff
+}
+
+func (b *Book) Get(version string) (models.Tariff, bool) {
+	for _, t := range b.tariffs {
+		if t.Version == version {
+			return t, true
+		}
+	}
+	return models.Tariff{}, false
+}
+
+func (b *Book) All() []models.Tariff {
+	return append([]models.Tariff(nil), b.tariffs...)
+}
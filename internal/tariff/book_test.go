@@ -0,0 +1,205 @@
+package tariff
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/models/domainErrors"
+	"PWZ1.0/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	julyFirst   = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	augustFirst = time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testTariff(version string, validFrom time.Time, boxSurcharge int64) models.Tariff {
+	return models.Tariff{
+		Version:   version,
+		ValidFrom: validFrom,
+		Packages: map[models.PackageType]models.PackageTariff{
+			models.PackageBox: {Surcharge: boxSurcharge, WeightLimit: 30000},
+		},
+	}
+}
+
+func TestBook_Active(t *testing.T) {
+	t.Parallel()
+
+	book, err := NewBook(testTariff("v3", augustFirst, 3000), testTariff("v2", julyFirst, 2500))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		at      time.Time
+		want    string
+		wantErr error
+	}{
+		{"before first version", julyFirst.Add(-time.Second), "", ErrNoActiveTariff},
+		{"first day of v2", julyFirst, "v2", nil},
+		{"between versions", augustFirst.Add(-time.Second), "v2", nil},
+		{"after last version", augustFirst.Add(time.Hour), "v3", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := book.Active(tt.at)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Version)
+		})
+	}
+
+	old, ok := book.Get("v2")
+	require.True(t, ok)
+	assert.Equal(t, int64(2500), old.Packages[models.PackageBox].Surcharge)
+	assert.Equal(t, []string{"v2", "v3"}, []string{book.All()[0].Version, book.All()[1].Version})
+}
+
+func TestNewBook_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewBook(testTariff("v2", julyFirst, 2500), testTariff("v2", augustFirst, 3000))
+	assert.Error(t, err, "дубликат версии")
+
+	_, err = NewBook(testTariff("", julyFirst, 2500))
+	assert.Error(t, err, "пустая версия")
+
+	_, err = NewBook(testTariff("v2", julyFirst, -1))
+	assert.Error(t, err, "отрицательная надбавка")
+}
+
+func TestTariff_WeightTiers(t *testing.T) {
+	t.Parallel()
+
+	tariff := models.Tariff{
+		Version:  "tiers",
+		Currency: models.CurrencyRUB,
+		Packages: map[models.PackageType]models.PackageTariff{
+			models.PackageBox: {Surcharge: 2000, WeightLimit: 30000},
+		},
+		WeightTiers: []models.WeightTier{
+			{FromWeight: 10000, Surcharge: 1500},
+			{FromWeight: 5000, Surcharge: 500},
+		},
+	}
+
+	tests := []struct {
+		weight  models.Grams
+		want    int64
+		wantErr error
+	}{
+		{1000, 2000, nil},
+		{5000, 2500, nil},
+		{9999, 2500, nil},
+		{10000, 3500, nil},
+		{30000, 0, domainErrors.ErrWeightTooHeavy},
+	}
+	for _, tt := range tests {
+		order := models.Order{PackageType: models.PackageBox, Weight: tt.weight, Price: models.NewMoney(9999, models.CurrencyRUB)}
+		err := tariff.Apply(&order)
+		if tt.wantErr != nil {
+			assert.ErrorIs(t, err, tt.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, models.NewMoney(9999+tt.want, models.CurrencyRUB), order.Price, "weight %v", tt.weight)
+		assert.Equal(t, "tiers", order.TariffVersion)
+	}
+
+	order := models.Order{PackageType: models.PackageBag, Weight: 1000}
+	assert.ErrorIs(t, tariff.Apply(&order), domainErrors.ErrInvalidPackage)
+
+	order = models.Order{PackageType: models.PackageBox, Weight: 1000, Price: models.NewMoney(100, models.Currency("USD"))}
+	assert.ErrorIs(t, tariff.Apply(&order), domainErrors.ErrCurrencyMismatch)
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "tariffs.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+tariffs:
+  - version: v2
+    valid_from: 2025-07-01T00:00:00Z
+    currency: RUB
+    packages:
+      box: {surcharge_minor: 2500, weight_limit_g: 30000}
+      tape: {surcharge_minor: 200}
+    weight_tiers:
+      - {from_weight_g: 10000, surcharge_minor: 1500}
+`), 0o600))
+
+	jsonPath := filepath.Join(dir, "tariffs.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"tariffs": [{
+		"version": "v2",
+		"valid_from": "2025-07-01T00:00:00Z",
+		"currency": "RUB",
+		"packages": {"box": {"surcharge_minor": 2500, "weight_limit_g": 30000}, "tape": {"surcharge_minor": 200}},
+		"weight_tiers": [{"from_weight_g": 10000, "surcharge_minor": 1500}]
+	}]}`), 0o600))
+
+	fromYAML, err := LoadFile(yamlPath)
+	require.NoError(t, err)
+	fromJSON, err := LoadFile(jsonPath)
+	require.NoError(t, err)
+
+	require.Len(t, fromYAML, 1)
+	assert.Equal(t, fromJSON, fromYAML)
+	assert.True(t, julyFirst.Equal(fromYAML[0].ValidFrom))
+	assert.Equal(t, models.PackageTariff{Surcharge: 2500, WeightLimit: 30000}, fromYAML[0].Packages[models.PackageBox])
+}
+
+func TestInit_StoresFileVersions(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "tariffs.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"tariffs": [{"version": "v2", "valid_from": "2025-07-01T00:00:00Z", "packages": {"box": {"surcharge_minor": 2500}}}]}`), 0o600))
+
+	var stored []models.Tariff
+	m := mocks.NewTariffStorageMock(t)
+	m.SaveTariffMock.Set(func(_ context.Context, t models.Tariff) (bool, error) {
+		stored = append(stored, t)
+		return true, nil
+	})
+	m.ListTariffsMock.Set(func(context.Context) ([]models.Tariff, error) {
+		return append([]models.Tariff{models.DefaultTariff}, stored...), nil
+	})
+
+	book, err := Init(context.Background(), m, path)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+
+	active, err := book.Active(augustFirst)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", active.Version)
+
+	old, err := book.Active(julyFirst.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, models.DefaultTariffVersion, old.Version)
+}
+
+func TestInit_SeedsDefaultTariff(t *testing.T) {
+	t.Parallel()
+
+	m := mocks.NewTariffStorageMock(t)
+	m.ListTariffsMock.Return(nil, nil)
+	m.SaveTariffMock.Expect(context.Background(), models.DefaultTariff).Return(true, nil)
+
+	book, err := Init(context.Background(), m, "")
+	require.NoError(t, err)
+
+	active, err := book.Active(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, models.DefaultTariffVersion, active.Version)
+}
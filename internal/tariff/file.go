@@ -0,0 +1,38 @@
+package tariff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"PWZ1.0/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// fileFormat содержимое файла тарифов
+type fileFormat struct {
+	Tariffs []models.Tariff `json:"tariffs" yaml:"tariffs"`
+}
+
+// LoadFile читает тарифы из YAML (.yaml, .yml) или JSON файла
+func LoadFile(path string) ([]models.Tariff, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f fileFormat
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &f)
+	default:
+		err = json.Unmarshal(data, &f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse tariffs %s: %w", path, err)
+	}
+
+	return f.Tariffs, nil
+}
@@ -0,0 +1,47 @@
+package tariff
+
+import (
+	"context"
+	"log/slog"
+
+	"PWZ1.0/internal/models"
+	"PWZ1.0/internal/storage"
+)
+
+// Init сохраняет новые версии из файла в БД и собирает Book из всех сохраненных версий.
+// Пустой path означает, что используются только версии из БД.
+func Init(ctx context.Context, storage storage.TariffStorage, path string) (*Book, error) {
+	if path != "" {
+		tariffs, err := LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		// проверяем файл до записи, чтобы не сохранить половину
+		if _, err := NewBook(tariffs...); err != nil {
+			return nil, err
+		}
+
+		for _, t := range tariffs {
+			saved, err := storage.SaveTariff(ctx, t)
+			if err != nil {
+				return nil, err
+			}
+			if !saved {
+				slog.InfoContext(ctx, "tariff already stored, file version ignored", "version", t.Version)
+			}
+		}
+	}
+
+	tariffs, err := storage.ListTariffs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tariffs) == 0 {
+		if _, err := storage.SaveTariff(ctx, models.DefaultTariff); err != nil {
+			return nil, err
+		}
+		tariffs = []models.Tariff{models.DefaultTariff}
+	}
+
+	return NewBook(tariffs...)
+}
@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LevelHandler GET - текущий уровень, PUT ?level=debug - новый уровень для всего процесса
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			if err := SetLevel(r.URL.Query().Get("level")); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			Logger.InfoContext(r.Context(), "log level changed", "level", Level().String())
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"level": Level().String()})
+	})
+}
@@ -3,24 +3,92 @@ package logger
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 
 	"PWZ1.0/internal/models/domainErrors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// значения формата для Init
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
 var Logger *slog.Logger
 
+// level общий для всех логгеров процесса, меняется на ходу через SetLevel или LevelHandler
+var level = new(slog.LevelVar)
+
+// InitLogger текстовый лог уровня info, до загрузки конфига и в тестах
 func InitLogger() {
-	handler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	})
-	Logger = slog.New(handler)
+	_ = Init(os.Stdout, FormatText, "info")
+}
+
+// Init ставит Logger и slog.Default; log.Fatal при запуске тоже идет через этот логгер, поэтому формат один у всех строк.
+// format - text или json, lvl - debug, info, warn или error
+func Init(w io.Writer, format, lvl string) error {
+	if err := SetLevel(lvl); err != nil {
+		return err
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch format {
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+
+	Logger = slog.New(contextHandler{handler})
+	slog.SetDefault(Logger)
+	return nil
+}
+
+// SetLevel меняет уровень всех логгеров без перезапуска
+func SetLevel(lvl string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(lvl)); err != nil {
+		return err
+	}
+	level.Set(l)
+	return nil
+}
+
+// Level текущий уровень
+func Level() slog.Level {
+	return level.Level()
+}
+
+// contextHandler добавляет к каждой записи request_id и trace_id из ctx
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
 }
 
 func LogErrorWithCode(ctx context.Context, err error, message string) {
 	errCode := domainErrors.ErrorCodes[err]
-
-	formatted := fmt.Sprintf("ERROR: %s: %s", errCode, message)
-	Logger.ErrorContext(ctx, formatted)
+	Logger.ErrorContext(ctx, message, "code", errCode, "err", err)
 }
@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// тесты меняют общий логгер и уровень, поэтому без t.Parallel
+
+func initBuffer(t *testing.T, format, lvl string) *bytes.Buffer {
+	t.Helper()
+	prevLogger, prevDefault, prevLevel := Logger, slog.Default(), Level()
+	t.Cleanup(func() {
+		Logger = prevLogger
+		slog.SetDefault(prevDefault)
+		level.Set(prevLevel)
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, Init(&buf, format, lvl))
+	return &buf
+}
+
+func TestInit_ContextAttrs(t *testing.T) {
+	buf := initBuffer(t, FormatJSON, "info")
+
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(WithRequestID(context.Background(), "req-1"),
+		trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}}))
+	slog.InfoContext(ctx, "accept order", "order_id", 42)
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "accept order", line["msg"])
+	assert.Equal(t, "req-1", line["request_id"])
+	assert.Equal(t, traceID.String(), line["trace_id"])
+	assert.InDelta(t, 42, line["order_id"], 0)
+
+	// вне запроса лишних полей нет
+	buf.Reset()
+	Logger.With("component", "relay").Info("started")
+	line = nil
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "relay", line["component"])
+	assert.NotContains(t, line, "request_id")
+	assert.NotContains(t, line, "trace_id")
+}
+
+func TestInit_Invalid(t *testing.T) {
+	initBuffer(t, FormatText, "info")
+
+	assert.Error(t, Init(&bytes.Buffer{}, "xml", "info"))
+	assert.Error(t, Init(&bytes.Buffer{}, FormatText, "verbose"))
+}
+
+func TestLevelHandler(t *testing.T) {
+	buf := initBuffer(t, FormatText, "info")
+	handler := LevelHandler()
+
+	slog.Debug("hidden")
+	assert.Empty(t, buf.String())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/loglevel?level=debug", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"level":"DEBUG"}`, rec.Body.String())
+	assert.Equal(t, slog.LevelDebug, Level())
+
+	buf.Reset()
+	slog.Debug("visible")
+	assert.Contains(t, buf.String(), "visible")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/loglevel?level=loud", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, slog.LevelDebug, Level())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/loglevel", nil))
+	assert.JSONEq(t, `{"level":"DEBUG"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/loglevel", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestEnsureRequestID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "abc-123", EnsureRequestID("abc-123"))
+
+	for _, id := range []string{"", "with space", "line\nbreak", "юникод", strings.Repeat("a", maxRequestIDLen+1)} {
+		got := EnsureRequestID(id)
+		assert.NotEqual(t, id, got)
+		assert.Len(t, got, 36, "uuid instead of %q", id)
+	}
+}
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader заголовок HTTP и ключ метаданных gRPC, в котором приходит и возвращается ID запроса
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLen длиннее - не ID, а мусор или попытка засорить логи; такой ID заменяется новым
+const maxRequestIDLen = 128
+
+type requestIDKey struct{}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID ID запроса из ctx, пустая строка вне запроса
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// EnsureRequestID ID, пришедший от клиента, или новый, если его нет или он не похож на ID
+func EnsureRequestID(id string) string {
+	if id == "" || len(id) > maxRequestIDLen {
+		return uuid.NewString()
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return uuid.NewString()
+		}
+	}
+	return id
+}
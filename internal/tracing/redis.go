@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RedisHook спан на каждую команду Redis внутри трассировки запроса: client.AddHook(tracing.RedisHook{}).
+// Проверки здоровья идут без трассировки и спанов не пишут
+type RedisHook struct{}
+
+func (RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !hasParent(ctx) {
+			return next(ctx, cmd)
+		}
+		ctx, span := startRedis(ctx, cmd.Name())
+		err := next(ctx, cmd)
+		endRedis(span, err)
+		return err
+	}
+}
+
+// ProcessPipelineHook конвейер - один спан pipeline со списком команд
+func (RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !hasParent(ctx) {
+			return next(ctx, cmds)
+		}
+		names := make([]string, 0, len(cmds))
+		for _, cmd := range cmds {
+			names = append(names, cmd.Name())
+		}
+		ctx, span := startRedis(ctx, "pipeline")
+		span.SetAttributes(attribute.StringSlice("db.redis.commands", names))
+		err := next(ctx, cmds)
+		endRedis(span, err)
+		return err
+	}
+}
+
+func startRedis(ctx context.Context, command string) (context.Context, trace.Span) {
+	return Start(ctx, "redis "+command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "redis"),
+			attribute.String("db.operation", command),
+		),
+	)
+}
+
+// endRedis отсутствие ключа - обычный промах кэша, а не ошибка
+func endRedis(span trace.Span, err error) {
+	if errors.Is(err, redis.Nil) {
+		err = nil
+	}
+	End(span, err)
+}
@@ -0,0 +1,98 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PWZ1.0/internal/tracing/tracingtest"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// тесты меняют глобальный провайдер, поэтому без t.Parallel
+
+func TestInjectExtract(t *testing.T) {
+	tracingtest.NewRecorder(t)
+
+	ctx, span := Start(context.Background(), "request")
+	carrier := Inject(ctx)
+	span.End()
+	require.Contains(t, carrier, "traceparent")
+
+	remote := trace.SpanContextFromContext(Extract(context.Background(), carrier))
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+
+	assert.Nil(t, Inject(context.Background()), "no trace - nothing to inject")
+}
+
+func TestQueryTracer(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+	tracer := QueryTracer{}
+
+	// без родителя запросы фоновых заданий спанов не пишут
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+	assert.Empty(t, recorder.Ended())
+
+	parentCtx, parent := Start(context.Background(), "request")
+	ctx = tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{
+		SQL:  "\n\t\tselect id from orders where user_id = $1",
+		Args: []any{"secret"},
+	})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")})
+
+	ctx = tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{SQL: "UPDATE orders SET status = $1"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("deadlock detected")})
+	parent.End()
+
+	query := tracingtest.Find(recorder, "postgres SELECT")
+	require.NotNil(t, query)
+	assert.Equal(t, parent.SpanContext().SpanID(), query.Parent().SpanID())
+	assert.Equal(t, trace.SpanKindClient, query.SpanKind())
+	for _, attr := range query.Attributes() {
+		assert.NotContains(t, attr.Value.Emit(), "secret", "query args must not be recorded")
+		if attr.Key == "db.rows_affected" {
+			assert.Equal(t, int64(3), attr.Value.AsInt64())
+		}
+	}
+
+	failed := tracingtest.Find(recorder, "postgres UPDATE")
+	require.NotNil(t, failed)
+	assert.Equal(t, codes.Error, failed.Status().Code)
+}
+
+func TestRedisHook(t *testing.T) {
+	recorder := tracingtest.NewRecorder(t)
+	hook := RedisHook{}
+
+	results := map[string]error{"get": redis.Nil, "set": errors.New("connection refused")}
+	process := hook.ProcessHook(func(ctx context.Context, cmd redis.Cmder) error {
+		return results[cmd.Name()]
+	})
+
+	// проверка здоровья без трассировки
+	require.NoError(t, process(context.Background(), redis.NewStatusCmd(context.Background(), "ping")))
+	assert.Empty(t, recorder.Ended())
+
+	ctx, parent := Start(context.Background(), "request")
+	assert.ErrorIs(t, process(ctx, redis.NewStringCmd(ctx, "get", "k")), redis.Nil)
+	assert.Error(t, process(ctx, redis.NewStatusCmd(ctx, "set", "k", "v")))
+	parent.End()
+
+	get := tracingtest.Find(recorder, "redis get")
+	require.NotNil(t, get)
+	assert.Equal(t, parent.SpanContext().SpanID(), get.Parent().SpanID())
+	assert.Equal(t, codes.Unset, get.Status().Code, "missing key is a cache miss, not an error")
+
+	set := tracingtest.Find(recorder, "redis set")
+	require.NotNil(t, set)
+	assert.Equal(t, codes.Error, set.Status().Code)
+}
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE outbox
+ADD COLUMN attempts        INT       NOT NULL DEFAULT 0,
+ADD COLUMN next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+ADD COLUMN updated_at      TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx
+    ON outbox (created_at)
+    WHERE status IN ('CREATED', 'PROCESSING', 'FAILED');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS outbox_pending_idx;
+
+ALTER TABLE outbox
+DROP COLUMN IF EXISTS attempts,
+DROP COLUMN IF EXISTS next_attempt_at,
+DROP COLUMN IF EXISTS updated_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS processed_events
+(
+    event_id     UUID PRIMARY KEY,
+    event_type   VARCHAR(64) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS processed_events;
+
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS notifications
+(
+    id           SERIAL PRIMARY KEY,
+    user_id      BIGINT,
+    order_id     BIGINT,
+    kind         VARCHAR(32)  NOT NULL,
+    title        VARCHAR(50)  NOT NULL DEFAULT '',
+    text         VARCHAR(200) NOT NULL,
+    comment      TEXT,
+    tags         TEXT[]       NOT NULL DEFAULT '{}',
+    priority     SMALLINT     NOT NULL,
+    status       VARCHAR(20)  NOT NULL DEFAULT 'PENDING',
+    attempts     INT          NOT NULL DEFAULT 0,
+    error        TEXT,
+    scheduled_at TIMESTAMP    NOT NULL DEFAULT now(),
+    created_at   TIMESTAMP    NOT NULL DEFAULT now(),
+    sent_at      TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS notifications_due_idx
+    ON notifications (priority DESC, scheduled_at)
+    WHERE status = 'PENDING';
+
+-- напоминания по заказу ставятся в очередь один раз
+CREATE UNIQUE INDEX IF NOT EXISTS notifications_order_kind_idx
+    ON notifications (order_id, kind)
+    WHERE order_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS notifications;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS orders_user_id_id_idx ON orders (user_id, id);
+CREATE INDEX IF NOT EXISTS orders_user_id_status_id_idx ON orders (user_id, status, id);
+CREATE INDEX IF NOT EXISTS orders_status_id_idx ON orders (status, id);
+CREATE INDEX IF NOT EXISTS orders_expires_at_id_idx ON orders (expires_at, id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS orders_expires_at_id_idx;
+DROP INDEX IF EXISTS orders_status_id_idx;
+DROP INDEX IF EXISTS orders_user_id_status_id_idx;
+DROP INDEX IF EXISTS orders_user_id_id_idx;
+
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS tariffs
+(
+    version     TEXT PRIMARY KEY,
+    valid_from  TIMESTAMPTZ NOT NULL,
+    body        JSONB NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- надбавки, которые были зашиты в код до появления тарифов
+INSERT INTO tariffs (version, valid_from, body)
+VALUES ('v1', '0001-01-01 00:00:00+00', '{
+    "version": "v1",
+    "valid_from": "0001-01-01T00:00:00Z",
+    "packages": {
+        "unspecified": {"surcharge": 0},
+        "bag": {"surcharge": 5, "weight_limit": 10},
+        "box": {"surcharge": 20, "weight_limit": 30},
+        "tape": {"surcharge": 1},
+        "bag+tape": {"surcharge": 6, "weight_limit": 10},
+        "box+tape": {"surcharge": 21, "weight_limit": 30}
+    }
+}')
+ON CONFLICT (version) DO NOTHING;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tariff_version TEXT REFERENCES tariffs (version);
+
+UPDATE orders SET tariff_version = 'v1' WHERE tariff_version IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE orders DROP COLUMN IF EXISTS tariff_version;
+
+DROP TABLE IF EXISTS tariffs;
+
+-- +goose StatementEnd
@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- вес в граммах, цена в копейках: REAL давал ошибки округления при сверке сумм
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_weight_check;
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_total_price_check;
+
+ALTER TABLE orders
+    ALTER COLUMN weight TYPE BIGINT USING GREATEST(1, round(weight::numeric * 1000)),
+    ALTER COLUMN total_price TYPE BIGINT USING round(total_price::numeric * 100);
+
+ALTER TABLE orders RENAME COLUMN weight TO weight_grams;
+ALTER TABLE orders RENAME COLUMN total_price TO price_minor;
+
+ALTER TABLE orders
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    ADD CONSTRAINT orders_weight_grams_check CHECK (weight_grams > 0),
+    ADD CONSTRAINT orders_price_minor_check CHECK (price_minor >= 0);
+
+-- надбавки тарифов тоже переводим в копейки и граммы
+UPDATE tariffs t
+SET body = (t.body - 'packages' - 'weight_tiers')
+    || jsonb_build_object('currency', COALESCE(t.body ->> 'currency', 'RUB'))
+    || jsonb_build_object('packages', (
+        SELECT COALESCE(jsonb_object_agg(p.key, jsonb_strip_nulls(jsonb_build_object(
+            'surcharge_minor', round((p.value ->> 'surcharge')::numeric * 100),
+            'weight_limit_g', round((p.value ->> 'weight_limit')::numeric * 1000)
+        ))), '{}'::jsonb)
+        FROM jsonb_each(t.body -> 'packages') p
+    ))
+    || jsonb_build_object('weight_tiers', (
+        SELECT COALESCE(jsonb_agg(jsonb_build_object(
+            'from_weight_g', round((w.value ->> 'from_weight')::numeric * 1000),
+            'surcharge_minor', round((w.value ->> 'surcharge')::numeric * 100)
+        )), '[]'::jsonb)
+        FROM jsonb_array_elements(COALESCE(t.body -> 'weight_tiers', '[]'::jsonb)) w
+    ));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE tariffs t
+SET body = (t.body - 'packages' - 'weight_tiers' - 'currency')
+    || jsonb_build_object('packages', (
+        SELECT COALESCE(jsonb_object_agg(p.key, jsonb_strip_nulls(jsonb_build_object(
+            'surcharge', (p.value ->> 'surcharge_minor')::numeric / 100,
+            'weight_limit', (p.value ->> 'weight_limit_g')::numeric / 1000
+        ))), '{}'::jsonb)
+        FROM jsonb_each(t.body -> 'packages') p
+    ))
+    || jsonb_build_object('weight_tiers', (
+        SELECT COALESCE(jsonb_agg(jsonb_build_object(
+            'from_weight', (w.value ->> 'from_weight_g')::numeric / 1000,
+            'surcharge', (w.value ->> 'surcharge_minor')::numeric / 100
+        )), '[]'::jsonb)
+        FROM jsonb_array_elements(COALESCE(t.body -> 'weight_tiers', '[]'::jsonb)) w
+    ));
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS orders_weight_grams_check,
+    DROP CONSTRAINT IF EXISTS orders_price_minor_check,
+    DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE orders RENAME COLUMN weight_grams TO weight;
+ALTER TABLE orders RENAME COLUMN price_minor TO total_price;
+
+ALTER TABLE orders
+    ALTER COLUMN weight TYPE REAL USING weight::numeric / 1000,
+    ALTER COLUMN total_price TYPE REAL USING total_price::numeric / 100,
+    ADD CONSTRAINT orders_weight_check CHECK (weight > 0),
+    ADD CONSTRAINT orders_total_price_check CHECK (total_price >= 0);
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- кто сменил статус; у записей до миграции автор неизвестен
+ALTER TABLE order_history
+    ADD COLUMN IF NOT EXISTS actor_type    VARCHAR(20) NOT NULL DEFAULT 'unknown',
+    ADD COLUMN IF NOT EXISTS actor_id      BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS actor_subject TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE order_history
+    DROP COLUMN IF EXISTS actor_type,
+    DROP COLUMN IF EXISTS actor_id,
+    DROP COLUMN IF EXISTS actor_subject;
+
+-- +goose StatementEnd
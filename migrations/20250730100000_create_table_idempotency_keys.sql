@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    key          TEXT PRIMARY KEY,
+    request_hash TEXT NOT NULL,
+    done         BOOLEAN NOT NULL DEFAULT false,
+    code         INT NOT NULL DEFAULT 0,
+    message      TEXT NOT NULL DEFAULT '',
+    response     BYTEA,
+    created_at   TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS idempotency_keys;
+
+-- +goose StatementEnd
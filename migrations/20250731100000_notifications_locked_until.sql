@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- аренда пачки планировщиком: сообщения отправляются вне транзакции,
+-- а если экземпляр упал, после locked_until их заберет другой
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS locked_until;
+
+-- +goose StatementEnd
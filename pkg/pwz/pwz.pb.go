@@ -26,6 +26,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MessageStatus int32
+
+const (
+	// не указан
+	MessageStatus_MESSAGE_STATUS_UNSPECIFIED MessageStatus = 0
+	// ждет отправки
+	MessageStatus_MESSAGE_STATUS_PENDING MessageStatus = 1
+	// отправлено
+	MessageStatus_MESSAGE_STATUS_SENT MessageStatus = 2
+	// отменено
+	MessageStatus_MESSAGE_STATUS_CANCELLED MessageStatus = 3
+	// не удалось отправить
+	MessageStatus_MESSAGE_STATUS_FAILED MessageStatus = 4
+)
+
+// Enum value maps for MessageStatus.
+var (
+	MessageStatus_name = map[int32]string{
+		0: "MESSAGE_STATUS_UNSPECIFIED",
+		1: "MESSAGE_STATUS_PENDING",
+		2: "MESSAGE_STATUS_SENT",
+		3: "MESSAGE_STATUS_CANCELLED",
+		4: "MESSAGE_STATUS_FAILED",
+	}
+	MessageStatus_value = map[string]int32{
+		"MESSAGE_STATUS_UNSPECIFIED": 0,
+		"MESSAGE_STATUS_PENDING":     1,
+		"MESSAGE_STATUS_SENT":        2,
+		"MESSAGE_STATUS_CANCELLED":   3,
+		"MESSAGE_STATUS_FAILED":      4,
+	}
+)
+
+func (x MessageStatus) Enum() *MessageStatus {
+	p := new(MessageStatus)
+	*p = x
+	return p
+}
+
+func (x MessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pwz_pwz_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageStatus) Type() protoreflect.EnumType {
+	return &file_pwz_pwz_proto_enumTypes[0]
+}
+
+func (x MessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStatus.Descriptor instead.
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{0}
+}
+
 type Priority int32
 
 const (
@@ -68,11 +128,11 @@ func (x Priority) String() string {
 }
 
 func (Priority) Descriptor() protoreflect.EnumDescriptor {
-	return file_pwz_pwz_proto_enumTypes[0].Descriptor()
+	return file_pwz_pwz_proto_enumTypes[1].Descriptor()
 }
 
 func (Priority) Type() protoreflect.EnumType {
-	return &file_pwz_pwz_proto_enumTypes[0]
+	return &file_pwz_pwz_proto_enumTypes[1]
 }
 
 func (x Priority) Number() protoreflect.EnumNumber {
@@ -81,7 +141,7 @@ func (x Priority) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Priority.Descriptor instead.
 func (Priority) EnumDescriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{0}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{1}
 }
 
 type ActionType int32
@@ -120,11 +180,11 @@ func (x ActionType) String() string {
 }
 
 func (ActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pwz_pwz_proto_enumTypes[1].Descriptor()
+	return file_pwz_pwz_proto_enumTypes[2].Descriptor()
 }
 
 func (ActionType) Type() protoreflect.EnumType {
-	return &file_pwz_pwz_proto_enumTypes[1]
+	return &file_pwz_pwz_proto_enumTypes[2]
 }
 
 func (x ActionType) Number() protoreflect.EnumNumber {
@@ -133,7 +193,7 @@ func (x ActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ActionType.Descriptor instead.
 func (ActionType) EnumDescriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{1}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{2}
 }
 
 type PackageType int32
@@ -184,11 +244,11 @@ func (x PackageType) String() string {
 }
 
 func (PackageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pwz_pwz_proto_enumTypes[2].Descriptor()
+	return file_pwz_pwz_proto_enumTypes[3].Descriptor()
 }
 
 func (PackageType) Type() protoreflect.EnumType {
-	return &file_pwz_pwz_proto_enumTypes[2]
+	return &file_pwz_pwz_proto_enumTypes[3]
 }
 
 func (x PackageType) Number() protoreflect.EnumNumber {
@@ -197,7 +257,7 @@ func (x PackageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PackageType.Descriptor instead.
 func (PackageType) EnumDescriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{2}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{3}
 }
 
 type OrderStatus int32
@@ -244,11 +304,11 @@ func (x OrderStatus) String() string {
 }
 
 func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pwz_pwz_proto_enumTypes[3].Descriptor()
+	return file_pwz_pwz_proto_enumTypes[4].Descriptor()
 }
 
 func (OrderStatus) Type() protoreflect.EnumType {
-	return &file_pwz_pwz_proto_enumTypes[3]
+	return &file_pwz_pwz_proto_enumTypes[4]
 }
 
 func (x OrderStatus) Number() protoreflect.EnumNumber {
@@ -257,18 +317,76 @@ func (x OrderStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrderStatus.Descriptor instead.
 func (OrderStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{3}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{4}
+}
+
+type ActorType int32
+
+const (
+	ActorType_ACTOR_TYPE_UNSPECIFIED ActorType = 0
+	ActorType_ACTOR_TYPE_COURIER     ActorType = 1
+	ActorType_ACTOR_TYPE_OPERATOR    ActorType = 2
+	ActorType_ACTOR_TYPE_ADMIN       ActorType = 3
+	// терминал ПВЗ по API-ключу
+	ActorType_ACTOR_TYPE_TERMINAL ActorType = 4
+)
+
+// Enum value maps for ActorType.
+var (
+	ActorType_name = map[int32]string{
+		0: "ACTOR_TYPE_UNSPECIFIED",
+		1: "ACTOR_TYPE_COURIER",
+		2: "ACTOR_TYPE_OPERATOR",
+		3: "ACTOR_TYPE_ADMIN",
+		4: "ACTOR_TYPE_TERMINAL",
+	}
+	ActorType_value = map[string]int32{
+		"ACTOR_TYPE_UNSPECIFIED": 0,
+		"ACTOR_TYPE_COURIER":     1,
+		"ACTOR_TYPE_OPERATOR":    2,
+		"ACTOR_TYPE_ADMIN":       3,
+		"ACTOR_TYPE_TERMINAL":    4,
+	}
+)
+
+func (x ActorType) Enum() *ActorType {
+	p := new(ActorType)
+	*p = x
+	return p
+}
+
+func (x ActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pwz_pwz_proto_enumTypes[5].Descriptor()
+}
+
+func (ActorType) Type() protoreflect.EnumType {
+	return &file_pwz_pwz_proto_enumTypes[5]
+}
+
+func (x ActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// было для тестов
+// Deprecated: Use ActorType.Descriptor instead.
+func (ActorType) EnumDescriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{5}
+}
+
+// Сообщение для очереди уведомлений
 type MessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Priority      Priority               `protobuf:"varint,2,opt,name=priority,proto3,enum=notifier.Priority" json:"priority,omitempty"`
-	Delay         *durationpb.Duration   `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Comment       *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
-	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Text     string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Priority Priority               `protobuf:"varint,2,opt,name=priority,proto3,enum=notifier.Priority" json:"priority,omitempty"`
+	Delay    *durationpb.Duration   `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	Tags     []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment  *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Title    string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// получатель, если сообщение адресовано клиенту
+	UserId        *uint64 `protobuf:"varint,7,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -345,6 +463,13 @@ func (x *MessageRequest) GetTitle() string {
 	return ""
 }
 
+func (x *MessageRequest) GetUserId() uint64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
 type MessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -389,27 +514,27 @@ func (x *MessageResponse) GetId() uint32 {
 	return 0
 }
 
-type OrderHistoryRequest struct {
+type MessageIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderHistoryRequest) Reset() {
-	*x = OrderHistoryRequest{}
+func (x *MessageIdRequest) Reset() {
+	*x = MessageIdRequest{}
 	mi := &file_pwz_pwz_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderHistoryRequest) String() string {
+func (x *MessageIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderHistoryRequest) ProtoMessage() {}
+func (*MessageIdRequest) ProtoMessage() {}
 
-func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *MessageIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,39 +546,44 @@ func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderHistoryRequest.ProtoReflect.Descriptor instead.
-func (*OrderHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageIdRequest.ProtoReflect.Descriptor instead.
+func (*MessageIdRequest) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *OrderHistoryRequest) GetOrderId() uint64 {
+func (x *MessageIdRequest) GetId() uint32 {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
 	return 0
 }
 
-type OrderHistoryResponse struct {
+type MessageStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	History       []*OrderHistory        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        MessageStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=notifier.MessageStatus" json:"status,omitempty"`
+	Priority      Priority               `protobuf:"varint,3,opt,name=priority,proto3,enum=notifier.Priority" json:"priority,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3,oneof" json:"sent_at,omitempty"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderHistoryResponse) Reset() {
-	*x = OrderHistoryResponse{}
+func (x *MessageStatusResponse) Reset() {
+	*x = MessageStatusResponse{}
 	mi := &file_pwz_pwz_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderHistoryResponse) String() string {
+func (x *MessageStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderHistoryResponse) ProtoMessage() {}
+func (*MessageStatusResponse) ProtoMessage() {}
 
-func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *MessageStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,44 +595,73 @@ func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
-func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageStatusResponse.ProtoReflect.Descriptor instead.
+func (*MessageStatusResponse) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OrderHistoryResponse) GetHistory() []*OrderHistory {
+func (x *MessageStatusResponse) GetId() uint32 {
 	if x != nil {
-		return x.History
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageStatusResponse) GetStatus() MessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *MessageStatusResponse) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNKNOWN
+}
+
+func (x *MessageStatusResponse) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
 	}
 	return nil
 }
 
-type AcceptOrderRequest struct {
+func (x *MessageStatusResponse) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *MessageStatusResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type GetTariffsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Package       *PackageType           `protobuf:"varint,4,opt,name=package,proto3,enum=notifier.PackageType,oneof" json:"package,omitempty"`
-	Weight        float32                `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	Price         float32                `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptOrderRequest) Reset() {
-	*x = AcceptOrderRequest{}
+func (x *GetTariffsRequest) Reset() {
+	*x = GetTariffsRequest{}
 	mi := &file_pwz_pwz_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptOrderRequest) String() string {
+func (x *GetTariffsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptOrderRequest) ProtoMessage() {}
+func (*GetTariffsRequest) ProtoMessage() {}
 
-func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTariffsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,74 +673,33 @@ func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptOrderRequest.ProtoReflect.Descriptor instead.
-func (*AcceptOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTariffsRequest.ProtoReflect.Descriptor instead.
+func (*GetTariffsRequest) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AcceptOrderRequest) GetOrderId() uint64 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
-}
-
-func (x *AcceptOrderRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AcceptOrderRequest) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-func (x *AcceptOrderRequest) GetPackage() PackageType {
-	if x != nil && x.Package != nil {
-		return *x.Package
-	}
-	return PackageType_PACKAGE_TYPE_UNSPECIFIED
-}
-
-func (x *AcceptOrderRequest) GetWeight() float32 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
-}
-
-func (x *AcceptOrderRequest) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type OrderIdRequest struct {
+type TariffsList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ActiveVersion string                 `protobuf:"bytes,1,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	Tariffs       []*Tariff              `protobuf:"bytes,2,rep,name=tariffs,proto3" json:"tariffs,omitempty"` // по возрастанию valid_from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderIdRequest) Reset() {
-	*x = OrderIdRequest{}
+func (x *TariffsList) Reset() {
+	*x = TariffsList{}
 	mi := &file_pwz_pwz_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderIdRequest) String() string {
+func (x *TariffsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderIdRequest) ProtoMessage() {}
+func (*TariffsList) ProtoMessage() {}
 
-func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
+func (x *TariffsList) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,41 +711,50 @@ func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderIdRequest.ProtoReflect.Descriptor instead.
-func (*OrderIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TariffsList.ProtoReflect.Descriptor instead.
+func (*TariffsList) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OrderIdRequest) GetOrderId() uint64 {
+func (x *TariffsList) GetActiveVersion() string {
 	if x != nil {
-		return x.OrderId
+		return x.ActiveVersion
 	}
-	return 0
+	return ""
 }
 
-type ProcessOrdersRequest struct {
+func (x *TariffsList) GetTariffs() []*Tariff {
+	if x != nil {
+		return x.Tariffs
+	}
+	return nil
+}
+
+type Tariff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Action        ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=notifier.ActionType" json:"action,omitempty"`
-	OrderIds      []uint64               `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	Packages      []*PackageTariff       `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
+	WeightTiers   []*WeightTier          `protobuf:"bytes,4,rep,name=weight_tiers,json=weightTiers,proto3" json:"weight_tiers,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // код ISO 4217, в нем указаны все надбавки тарифа
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOrdersRequest) Reset() {
-	*x = ProcessOrdersRequest{}
+func (x *Tariff) Reset() {
+	*x = Tariff{}
 	mi := &file_pwz_pwz_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOrdersRequest) String() string {
+func (x *Tariff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessOrdersRequest) ProtoMessage() {}
+func (*Tariff) ProtoMessage() {}
 
-func (x *ProcessOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *Tariff) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,56 +766,73 @@ func (x *ProcessOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ProcessOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ProcessOrdersRequest) GetUserId() uint64 {
+func (x *Tariff) GetVersion() string {
 	if x != nil {
-		return x.UserId
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *ProcessOrdersRequest) GetAction() ActionType {
+func (x *Tariff) GetValidFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Action
+		return x.ValidFrom
 	}
-	return ActionType_ACTION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *ProcessOrdersRequest) GetOrderIds() []uint64 {
+func (x *Tariff) GetPackages() []*PackageTariff {
 	if x != nil {
-		return x.OrderIds
+		return x.Packages
 	}
 	return nil
 }
 
-type ListOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	InPvz         bool                   `protobuf:"varint,2,opt,name=in_pvz,json=inPvz,proto3" json:"in_pvz,omitempty"` // если true, то будут заказы для выдачи клиенту, если false, то все
-	LastN         *uint32                `protobuf:"varint,3,opt,name=last_n,json=lastN,proto3,oneof" json:"last_n,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Tariff) GetWeightTiers() []*WeightTier {
+	if x != nil {
+		return x.WeightTiers
+	}
+	return nil
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
+func (x *Tariff) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type PackageTariff struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Package PackageType            `protobuf:"varint,1,opt,name=package,proto3,enum=notifier.PackageType" json:"package,omitempty"`
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	Surcharge float32 `protobuf:"fixed32,2,opt,name=surcharge,proto3" json:"surcharge,omitempty"` // в рублях, заменено на surcharge_minor
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	WeightLimit      float32 `protobuf:"fixed32,3,opt,name=weight_limit,json=weightLimit,proto3" json:"weight_limit,omitempty"`                 // в кг, заменено на weight_limit_grams
+	SurchargeMinor   int64   `protobuf:"varint,4,opt,name=surcharge_minor,json=surchargeMinor,proto3" json:"surcharge_minor,omitempty"`         // в копейках
+	WeightLimitGrams int64   `protobuf:"varint,5,opt,name=weight_limit_grams,json=weightLimitGrams,proto3" json:"weight_limit_grams,omitempty"` // вес должен быть строго меньше, 0 - без ограничения
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PackageTariff) Reset() {
+	*x = PackageTariff{}
 	mi := &file_pwz_pwz_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *PackageTariff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*PackageTariff) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *PackageTariff) ProtoReflect() protoreflect.Message {
 	mi := &file_pwz_pwz_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,39 +844,526 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PackageTariff.ProtoReflect.Descriptor instead.
+func (*PackageTariff) Descriptor() ([]byte, []int) {
 	return file_pwz_pwz_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListOrdersRequest) GetUserId() uint64 {
+func (x *PackageTariff) GetPackage() PackageType {
 	if x != nil {
-		return x.UserId
+		return x.Package
 	}
-	return 0
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
 }
 
-func (x *ListOrdersRequest) GetInPvz() bool {
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *PackageTariff) GetSurcharge() float32 {
 	if x != nil {
-		return x.InPvz
+		return x.Surcharge
 	}
-	return false
+	return 0
 }
 
-func (x *ListOrdersRequest) GetLastN() uint32 {
-	if x != nil && x.LastN != nil {
-		return *x.LastN
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *PackageTariff) GetWeightLimit() float32 {
+	if x != nil {
+		return x.WeightLimit
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetPagination() *Pagination {
+func (x *PackageTariff) GetSurchargeMinor() int64 {
+	if x != nil {
+		return x.SurchargeMinor
+	}
+	return 0
+}
+
+func (x *PackageTariff) GetWeightLimitGrams() int64 {
+	if x != nil {
+		return x.WeightLimitGrams
+	}
+	return 0
+}
+
+type WeightTier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	FromWeight float32 `protobuf:"fixed32,1,opt,name=from_weight,json=fromWeight,proto3" json:"from_weight,omitempty"` // в кг, заменено на from_weight_grams
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	Surcharge       float32 `protobuf:"fixed32,2,opt,name=surcharge,proto3" json:"surcharge,omitempty"` // в рублях, заменено на surcharge_minor
+	FromWeightGrams int64   `protobuf:"varint,3,opt,name=from_weight_grams,json=fromWeightGrams,proto3" json:"from_weight_grams,omitempty"`
+	SurchargeMinor  int64   `protobuf:"varint,4,opt,name=surcharge_minor,json=surchargeMinor,proto3" json:"surcharge_minor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WeightTier) Reset() {
+	*x = WeightTier{}
+	mi := &file_pwz_pwz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightTier) ProtoMessage() {}
+
+func (x *WeightTier) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightTier.ProtoReflect.Descriptor instead.
+func (*WeightTier) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{8}
+}
+
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *WeightTier) GetFromWeight() float32 {
+	if x != nil {
+		return x.FromWeight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *WeightTier) GetSurcharge() float32 {
+	if x != nil {
+		return x.Surcharge
+	}
+	return 0
+}
+
+func (x *WeightTier) GetFromWeightGrams() int64 {
+	if x != nil {
+		return x.FromWeightGrams
+	}
+	return 0
+}
+
+func (x *WeightTier) GetSurchargeMinor() int64 {
+	if x != nil {
+		return x.SurchargeMinor
+	}
+	return 0
+}
+
+type OrderHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryRequest) Reset() {
+	*x = OrderHistoryRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryRequest) ProtoMessage() {}
+
+func (x *OrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*OrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderHistoryRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type OrderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*OrderHistory        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistoryResponse) Reset() {
+	*x = OrderHistoryResponse{}
+	mi := &file_pwz_pwz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistoryResponse) ProtoMessage() {}
+
+func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderHistoryResponse) GetHistory() []*OrderHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type AcceptOrderRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId    uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Package   *PackageType           `protobuf:"varint,4,opt,name=package,proto3,enum=notifier.PackageType,oneof" json:"package,omitempty"`
+	// устаревшие поля, используются, только если не заполнены weight_grams и price_minor
+	//
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	Weight float32 `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"` // в кг
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	Price         float32 `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"` // в рублях
+	WeightGrams   int64   `protobuf:"varint,7,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	PriceMinor    int64   `protobuf:"varint,8,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"` // в копейках
+	Currency      string  `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`                        // по умолчанию RUB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrderRequest) Reset() {
+	*x = AcceptOrderRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrderRequest) ProtoMessage() {}
+
+func (x *AcceptOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrderRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AcceptOrderRequest) GetPackage() PackageType {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return PackageType_PACKAGE_TYPE_UNSPECIFIED
+}
+
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *AcceptOrderRequest) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
+func (x *AcceptOrderRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetWeightGrams() int64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *AcceptOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type OrderIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderIdRequest) Reset() {
+	*x = OrderIdRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderIdRequest) ProtoMessage() {}
+
+func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderIdRequest.ProtoReflect.Descriptor instead.
+func (*OrderIdRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderIdRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ProcessOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        ActionType             `protobuf:"varint,2,opt,name=action,proto3,enum=notifier.ActionType" json:"action,omitempty"`
+	OrderIds      []uint64               `protobuf:"varint,3,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessOrdersRequest) Reset() {
+	*x = ProcessOrdersRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOrdersRequest) ProtoMessage() {}
+
+func (x *ProcessOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ProcessOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProcessOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProcessOrdersRequest) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ProcessOrdersRequest) GetOrderIds() []uint64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 - все клиенты, только для admin
+	InPvz          bool                   `protobuf:"varint,2,opt,name=in_pvz,json=inPvz,proto3" json:"in_pvz,omitempty"`    // если true, то будут заказы для выдачи клиенту, если false, то все
+	LastN          *uint32                `protobuf:"varint,3,opt,name=last_n,json=lastN,proto3,oneof" json:"last_n,omitempty"`
+	Pagination     *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // если true, то в списке будут и заказы, возвращенные курьеру
+	Statuses       []OrderStatus          `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=notifier.OrderStatus" json:"statuses,omitempty"`  // вместе с in_pvz остаются только статусы, подходящие под оба условия
+	ExpiresFrom    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_from,json=expiresFrom,proto3,oneof" json:"expires_from,omitempty"`     // срок хранения не раньше, включительно
+	ExpiresTo      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_to,json=expiresTo,proto3,oneof" json:"expires_to,omitempty"`           // срок хранения раньше, не включительно
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetInPvz() bool {
+	if x != nil {
+		return x.InPvz
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetLastN() uint32 {
+	if x != nil && x.LastN != nil {
+		return *x.LastN
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPagination() *Pagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
+func (x *ListOrdersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetExpiresFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresFrom
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetExpiresTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresTo
+	}
+	return nil
+}
+
 type Pagination struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -743,7 +1374,7 @@ type Pagination struct {
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_pwz_pwz_proto_msgTypes[8]
+	mi := &file_pwz_pwz_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +1386,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[8]
+	mi := &file_pwz_pwz_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +1399,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{8}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Pagination) GetPage() uint32 {
@@ -778,35 +1409,197 @@ func (x *Pagination) GetPage() uint32 {
 	return 0
 }
 
-func (x *Pagination) GetCountOnPage() uint32 {
+func (x *Pagination) GetCountOnPage() uint32 {
+	if x != nil {
+		return x.CountOnPage
+	}
+	return 0
+}
+
+type ListReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReturnsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ImportOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*AcceptOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersRequest) Reset() {
+	*x = ImportOrdersRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersRequest) ProtoMessage() {}
+
+func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportOrdersRequest) GetOrders() []*AcceptOrderRequest {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ImportOrdersStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`     // берется из первого сообщения, пустой - новое задание
+	Seq           uint64                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`                     // номер заказа в импорте с 1, если 0 - следующий за предыдущим
+	Order         *AcceptOrderRequest    `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`                  // первое сообщение может быть без заказа, чтобы узнать, с какого seq продолжать
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // берется из первого сообщения: только проверить заказы, ничего не сохраняя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrdersStreamRequest) Reset() {
+	*x = ImportOrdersStreamRequest{}
+	mi := &file_pwz_pwz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrdersStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrdersStreamRequest) ProtoMessage() {}
+
+func (x *ImportOrdersStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrdersStreamRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrdersStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportOrdersStreamRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ImportOrdersStreamRequest) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ImportOrdersStreamRequest) GetOrder() *AcceptOrderRequest {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ImportOrdersStreamRequest) GetDryRun() bool {
 	if x != nil {
-		return x.CountOnPage
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type ListReturnsRequest struct {
+type ImportProgress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Processed     uint64                 `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"` // сколько заказов задания обработано, включая прошлые запуски
+	Imported      uint64                 `protobuf:"varint,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed        uint64                 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       uint64                 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"` // заказы из этого потока, уже обработанные прошлыми запусками
+	Errors        []*OrderError          `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`    // ошибки в последней пачке
+	Done          bool                   `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReturnsRequest) Reset() {
-	*x = ListReturnsRequest{}
-	mi := &file_pwz_pwz_proto_msgTypes[9]
+func (x *ImportProgress) Reset() {
+	*x = ImportProgress{}
+	mi := &file_pwz_pwz_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReturnsRequest) String() string {
+func (x *ImportProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReturnsRequest) ProtoMessage() {}
+func (*ImportProgress) ProtoMessage() {}
 
-func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[9]
+func (x *ImportProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,72 +1610,74 @@ func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
-func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportProgress.ProtoReflect.Descriptor instead.
+func (*ImportProgress) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListReturnsRequest) GetPagination() *Pagination {
+func (x *ImportProgress) GetJobId() string {
 	if x != nil {
-		return x.Pagination
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-type ImportOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*AcceptOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportProgress) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
 }
 
-func (x *ImportOrdersRequest) Reset() {
-	*x = ImportOrdersRequest{}
-	mi := &file_pwz_pwz_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ImportProgress) GetImported() uint64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
 }
 
-func (x *ImportOrdersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportProgress) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
 }
 
-func (*ImportOrdersRequest) ProtoMessage() {}
-
-func (x *ImportOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[10]
+func (x *ImportProgress) GetSkipped() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Skipped
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ImportOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ImportOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{10}
+func (x *ImportProgress) GetErrors() []*OrderError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
 }
 
-func (x *ImportOrdersRequest) GetOrders() []*AcceptOrderRequest {
+func (x *ImportProgress) GetDone() bool {
 	if x != nil {
-		return x.Orders
+		return x.Done
 	}
-	return nil
+	return false
 }
 
 type GetHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 - все клиенты
+	Statuses      []OrderStatus          `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=notifier.OrderStatus" json:"statuses,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3,oneof" json:"created_from,omitempty"` // включительно
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3,oneof" json:"created_to,omitempty"`       // не включительно
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetHistoryRequest) Reset() {
 	*x = GetHistoryRequest{}
-	mi := &file_pwz_pwz_proto_msgTypes[11]
+	mi := &file_pwz_pwz_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -894,7 +1689,7 @@ func (x *GetHistoryRequest) String() string {
 func (*GetHistoryRequest) ProtoMessage() {}
 
 func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[11]
+	mi := &file_pwz_pwz_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1702,7 @@ func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{11}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetHistoryRequest) GetPagination() *Pagination {
@@ -917,6 +1712,34 @@ func (x *GetHistoryRequest) GetPagination() *Pagination {
 	return nil
 }
 
+func (x *GetHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetHistoryRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetHistoryRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
 type OrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=notifier.OrderStatus" json:"status,omitempty"`
@@ -927,7 +1750,7 @@ type OrderResponse struct {
 
 func (x *OrderResponse) Reset() {
 	*x = OrderResponse{}
-	mi := &file_pwz_pwz_proto_msgTypes[12]
+	mi := &file_pwz_pwz_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1762,7 @@ func (x *OrderResponse) String() string {
 func (*OrderResponse) ProtoMessage() {}
 
 func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[12]
+	mi := &file_pwz_pwz_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1775,7 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
 func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{12}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OrderResponse) GetStatus() OrderStatus {
@@ -973,13 +1796,14 @@ type ProcessResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Processed     []uint64               `protobuf:"varint,1,rep,packed,name=processed,proto3" json:"processed,omitempty"`
 	Errors        []uint64               `protobuf:"varint,2,rep,packed,name=errors,proto3" json:"errors,omitempty"`
+	ErrorDetails  []*OrderError          `protobuf:"bytes,3,rep,name=error_details,json=errorDetails,proto3" json:"error_details,omitempty"` // причина ошибки по каждому заказу из errors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessResult) Reset() {
 	*x = ProcessResult{}
-	mi := &file_pwz_pwz_proto_msgTypes[13]
+	mi := &file_pwz_pwz_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -991,7 +1815,7 @@ func (x *ProcessResult) String() string {
 func (*ProcessResult) ProtoMessage() {}
 
 func (x *ProcessResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[13]
+	mi := &file_pwz_pwz_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1828,7 @@ func (x *ProcessResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessResult.ProtoReflect.Descriptor instead.
 func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{13}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProcessResult) GetProcessed() []uint64 {
@@ -1021,6 +1845,73 @@ func (x *ProcessResult) GetErrors() []uint64 {
 	return nil
 }
 
+func (x *ProcessResult) GetErrorDetails() []*OrderError {
+	if x != nil {
+		return x.ErrorDetails
+	}
+	return nil
+}
+
+type OrderError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // код из справочника ошибок, например STORAGE_EXPIRED
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderError) Reset() {
+	*x = OrderError{}
+	mi := &file_pwz_pwz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderError) ProtoMessage() {}
+
+func (x *OrderError) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderError.ProtoReflect.Descriptor instead.
+func (*OrderError) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OrderError) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OrderError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type OrdersList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -1031,7 +1922,7 @@ type OrdersList struct {
 
 func (x *OrdersList) Reset() {
 	*x = OrdersList{}
-	mi := &file_pwz_pwz_proto_msgTypes[14]
+	mi := &file_pwz_pwz_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1043,7 +1934,7 @@ func (x *OrdersList) String() string {
 func (*OrdersList) ProtoMessage() {}
 
 func (x *OrdersList) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[14]
+	mi := &file_pwz_pwz_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,7 +1947,7 @@ func (x *OrdersList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrdersList.ProtoReflect.Descriptor instead.
 func (*OrdersList) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{14}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OrdersList) GetOrders() []*Order {
@@ -1082,7 +1973,7 @@ type ReturnsList struct {
 
 func (x *ReturnsList) Reset() {
 	*x = ReturnsList{}
-	mi := &file_pwz_pwz_proto_msgTypes[15]
+	mi := &file_pwz_pwz_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1985,7 @@ func (x *ReturnsList) String() string {
 func (*ReturnsList) ProtoMessage() {}
 
 func (x *ReturnsList) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[15]
+	mi := &file_pwz_pwz_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1998,7 @@ func (x *ReturnsList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnsList.ProtoReflect.Descriptor instead.
 func (*ReturnsList) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{15}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReturnsList) GetReturns() []*Order {
@@ -1126,7 +2017,7 @@ type OrderHistoryList struct {
 
 func (x *OrderHistoryList) Reset() {
 	*x = OrderHistoryList{}
-	mi := &file_pwz_pwz_proto_msgTypes[16]
+	mi := &file_pwz_pwz_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1138,7 +2029,7 @@ func (x *OrderHistoryList) String() string {
 func (*OrderHistoryList) ProtoMessage() {}
 
 func (x *OrderHistoryList) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[16]
+	mi := &file_pwz_pwz_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +2042,7 @@ func (x *OrderHistoryList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderHistoryList.ProtoReflect.Descriptor instead.
 func (*OrderHistoryList) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{16}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *OrderHistoryList) GetHistory() []*OrderHistory {
@@ -1165,13 +2056,14 @@ type ImportResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
 	Errors        []uint64               `protobuf:"varint,2,rep,packed,name=errors,proto3" json:"errors,omitempty"`
+	ErrorDetails  []*OrderError          `protobuf:"bytes,3,rep,name=error_details,json=errorDetails,proto3" json:"error_details,omitempty"` // причина ошибки по каждому заказу из errors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ImportResult) Reset() {
 	*x = ImportResult{}
-	mi := &file_pwz_pwz_proto_msgTypes[17]
+	mi := &file_pwz_pwz_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1183,7 +2075,7 @@ func (x *ImportResult) String() string {
 func (*ImportResult) ProtoMessage() {}
 
 func (x *ImportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[17]
+	mi := &file_pwz_pwz_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,7 +2088,7 @@ func (x *ImportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
 func (*ImportResult) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{17}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ImportResult) GetImported() int32 {
@@ -1213,22 +2105,36 @@ func (x *ImportResult) GetErrors() []uint64 {
 	return nil
 }
 
+func (x *ImportResult) GetErrorDetails() []*OrderError {
+	if x != nil {
+		return x.ErrorDetails
+	}
+	return nil
+}
+
 type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=notifier.OrderStatus" json:"status,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Weight        float32                `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	TotalPrice    float32                `protobuf:"fixed32,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	Package       *PackageType           `protobuf:"varint,7,opt,name=package,proto3,enum=notifier.PackageType,oneof" json:"package,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId    uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=notifier.OrderStatus" json:"status,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	Weight float32 `protobuf:"fixed32,5,opt,name=weight,proto3" json:"weight,omitempty"` // в кг, заменено на weight_grams
+	// Deprecated: Marked as deprecated in pwz/pwz.proto.
+	TotalPrice      float32                `protobuf:"fixed32,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // в рублях, заменено на total_price_minor
+	Package         *PackageType           `protobuf:"varint,7,opt,name=package,proto3,enum=notifier.PackageType,oneof" json:"package,omitempty"`
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`       // только для заказов, возвращенных курьеру
+	TariffVersion   string                 `protobuf:"bytes,9,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"` // версия тарифа, по которому посчитана цена
+	WeightGrams     int64                  `protobuf:"varint,10,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	TotalPriceMinor int64                  `protobuf:"varint,11,opt,name=total_price_minor,json=totalPriceMinor,proto3" json:"total_price_minor,omitempty"` // в копейках
+	Currency        string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_pwz_pwz_proto_msgTypes[18]
+	mi := &file_pwz_pwz_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1240,7 +2146,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[18]
+	mi := &file_pwz_pwz_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +2159,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{18}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Order) GetOrderId() uint64 {
@@ -1284,6 +2190,7 @@ func (x *Order) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
 func (x *Order) GetWeight() float32 {
 	if x != nil {
 		return x.Weight
@@ -1291,6 +2198,7 @@ func (x *Order) GetWeight() float32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in pwz/pwz.proto.
 func (x *Order) GetTotalPrice() float32 {
 	if x != nil {
 		return x.TotalPrice
@@ -1305,18 +2213,55 @@ func (x *Order) GetPackage() PackageType {
 	return PackageType_PACKAGE_TYPE_UNSPECIFIED
 }
 
+func (x *Order) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Order) GetTariffVersion() string {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return ""
+}
+
+func (x *Order) GetWeightGrams() int64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Order) GetTotalPriceMinor() int64 {
+	if x != nil {
+		return x.TotalPriceMinor
+	}
+	return 0
+}
+
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type OrderHistory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=notifier.OrderStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status    OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=notifier.OrderStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// кто сменил статус, для записей до появления поля - ACTOR_TYPE_UNSPECIFIED
+	Actor         *Actor `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderHistory) Reset() {
 	*x = OrderHistory{}
-	mi := &file_pwz_pwz_proto_msgTypes[19]
+	mi := &file_pwz_pwz_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +2273,7 @@ func (x *OrderHistory) String() string {
 func (*OrderHistory) ProtoMessage() {}
 
 func (x *OrderHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_pwz_pwz_proto_msgTypes[19]
+	mi := &file_pwz_pwz_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +2286,7 @@ func (x *OrderHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderHistory.ProtoReflect.Descriptor instead.
 func (*OrderHistory) Descriptor() ([]byte, []int) {
-	return file_pwz_pwz_proto_rawDescGZIP(), []int{19}
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *OrderHistory) GetOrderId() uint64 {
@@ -1365,11 +2310,79 @@ func (x *OrderHistory) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OrderHistory) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type Actor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ActorType              `protobuf:"varint,1,opt,name=type,proto3,enum=notifier.ActorType" json:"type,omitempty"`
+	Id    uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sub токена или имя API-ключа
+	Subject       string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_pwz_pwz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_pwz_pwz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_pwz_pwz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Actor) GetType() ActorType {
+	if x != nil {
+		return x.Type
+	}
+	return ActorType_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *Actor) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Actor) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
 var File_pwz_pwz_proto protoreflect.FileDescriptor
 
 const file_pwz_pwz_proto_rawDesc = "" +
 	"\n" +
-	"\rpwz/pwz.proto\x12\bnotifier\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x83\x02\n" +
+	"\rpwz/pwz.proto\x12\bnotifier\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xad\x02\n" +
 	"\x0eMessageRequest\x12\x1e\n" +
 	"\x04text\x18\x01 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x04text\x128\n" +
@@ -1378,27 +2391,68 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"\x04tags\x18\x04 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\n" +
 	"R\x04tags\x12\x1d\n" +
 	"\acomment\x18\x05 \x01(\tH\x00R\acomment\x88\x01\x01\x12\x1d\n" +
-	"\x05title\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x182R\x05titleB\n" +
+	"\x05title\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x182R\x05title\x12\x1c\n" +
+	"\auser_id\x18\a \x01(\x04H\x01R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_commentB\n" +
 	"\n" +
-	"\b_comment\"!\n" +
+	"\b_user_id\"!\n" +
 	"\x0fMessageResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"0\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"+\n" +
+	"\x10MessageIdRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x02id\"\xb2\x02\n" +
+	"\x15MessageStatusResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.notifier.MessageStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x03 \x01(\x0e2\x12.notifier.PriorityR\bpriority\x12=\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x128\n" +
+	"\asent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06sentAt\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x01R\x05error\x88\x01\x01B\n" +
+	"\n" +
+	"\b_sent_atB\b\n" +
+	"\x06_error\"\x13\n" +
+	"\x11GetTariffsRequest\"`\n" +
+	"\vTariffsList\x12%\n" +
+	"\x0eactive_version\x18\x01 \x01(\tR\ractiveVersion\x12*\n" +
+	"\atariffs\x18\x02 \x03(\v2\x10.notifier.TariffR\atariffs\"\xe7\x01\n" +
+	"\x06Tariff\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x129\n" +
+	"\n" +
+	"valid_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x123\n" +
+	"\bpackages\x18\x03 \x03(\v2\x17.notifier.PackageTariffR\bpackages\x127\n" +
+	"\fweight_tiers\x18\x04 \x03(\v2\x14.notifier.WeightTierR\vweightTiers\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xe0\x01\n" +
+	"\rPackageTariff\x12/\n" +
+	"\apackage\x18\x01 \x01(\x0e2\x15.notifier.PackageTypeR\apackage\x12 \n" +
+	"\tsurcharge\x18\x02 \x01(\x02B\x02\x18\x01R\tsurcharge\x12%\n" +
+	"\fweight_limit\x18\x03 \x01(\x02B\x02\x18\x01R\vweightLimit\x12'\n" +
+	"\x0fsurcharge_minor\x18\x04 \x01(\x03R\x0esurchargeMinor\x12,\n" +
+	"\x12weight_limit_grams\x18\x05 \x01(\x03R\x10weightLimitGrams\"\xa8\x01\n" +
+	"\n" +
+	"WeightTier\x12#\n" +
+	"\vfrom_weight\x18\x01 \x01(\x02B\x02\x18\x01R\n" +
+	"fromWeight\x12 \n" +
+	"\tsurcharge\x18\x02 \x01(\x02B\x02\x18\x01R\tsurcharge\x12*\n" +
+	"\x11from_weight_grams\x18\x03 \x01(\x03R\x0ffromWeightGrams\x12'\n" +
+	"\x0fsurcharge_minor\x18\x04 \x01(\x03R\x0esurchargeMinor\"0\n" +
 	"\x13OrderHistoryRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\"H\n" +
 	"\x14OrderHistoryResponse\x120\n" +
-	"\ahistory\x18\x01 \x03(\v2\x16.notifier.OrderHistoryR\ahistory\"\x8b\x02\n" +
+	"\ahistory\x18\x01 \x03(\v2\x16.notifier.OrderHistoryR\ahistory\"\x8e\x03\n" +
 	"\x12AcceptOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x129\n" +
 	"\n" +
 	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x124\n" +
-	"\apackage\x18\x04 \x01(\x0e2\x15.notifier.PackageTypeH\x00R\apackage\x88\x01\x01\x12\"\n" +
-	"\x06weight\x18\x05 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\x06weight\x12 \n" +
-	"\x05price\x18\x06 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05-\x00\x00\x00\x00R\x05priceB\n" +
+	"\apackage\x18\x04 \x01(\x0e2\x15.notifier.PackageTypeH\x00R\apackage\x88\x01\x01\x12$\n" +
+	"\x06weight\x18\x05 \x01(\x02B\f\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00\x18\x01R\x06weight\x12\"\n" +
+	"\x05price\x18\x06 \x01(\x02B\f\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00\x18\x01R\x05price\x12*\n" +
+	"\fweight_grams\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vweightGrams\x12(\n" +
+	"\vprice_minor\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"priceMinor\x12'\n" +
+	"\bcurrency\x18\t \x01(\tB\v\xfaB\br\x06\x98\x01\x03\xd0\x01\x01R\bcurrencyB\n" +
 	"\n" +
 	"\b_package\"+\n" +
 	"\x0eOrderIdRequest\x12\x19\n" +
@@ -1406,16 +2460,23 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"\x14ProcessOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12,\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x14.notifier.ActionTypeR\x06action\x12\x1b\n" +
-	"\torder_ids\x18\x03 \x03(\x04R\borderIds\"\xb4\x01\n" +
+	"\torder_ids\x18\x03 \x03(\x04R\borderIds\"\xb4\x03\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
 	"\x06in_pvz\x18\x02 \x01(\bR\x05inPvz\x12\x1a\n" +
 	"\x06last_n\x18\x03 \x01(\rH\x00R\x05lastN\x88\x01\x01\x129\n" +
 	"\n" +
 	"pagination\x18\x04 \x01(\v2\x14.notifier.PaginationH\x01R\n" +
-	"pagination\x88\x01\x01B\t\n" +
+	"pagination\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\x121\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x15.notifier.OrderStatusR\bstatuses\x12B\n" +
+	"\fexpires_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vexpiresFrom\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x03R\texpiresTo\x88\x01\x01B\t\n" +
 	"\a_last_nB\r\n" +
-	"\v_pagination\"X\n" +
+	"\v_paginationB\x0f\n" +
+	"\r_expires_fromB\r\n" +
+	"\v_expires_to\"X\n" +
 	"\n" +
 	"Pagination\x12\x1b\n" +
 	"\x04page\x18\x01 \x01(\rB\a\xfaB\x04*\x02(\x00R\x04page\x12-\n" +
@@ -1425,17 +2486,43 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"pagination\x18\x01 \x01(\v2\x14.notifier.PaginationR\n" +
 	"pagination\"U\n" +
 	"\x13ImportOrdersRequest\x12>\n" +
-	"\x06orders\x18\x01 \x03(\v2\x1c.notifier.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"I\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1c.notifier.AcceptOrderRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\x06orders\"\x91\x01\n" +
+	"\x19ImportOrdersStreamRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x04R\x03seq\x122\n" +
+	"\x05order\x18\x03 \x01(\v2\x1c.notifier.AcceptOrderRequestR\x05order\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xd5\x01\n" +
+	"\x0eImportProgress\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1c\n" +
+	"\tprocessed\x18\x02 \x01(\x04R\tprocessed\x12\x1a\n" +
+	"\bimported\x18\x03 \x01(\x04R\bimported\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x04R\x06failed\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x04R\askipped\x12,\n" +
+	"\x06errors\x18\x06 \x03(\v2\x14.notifier.OrderErrorR\x06errors\x12\x12\n" +
+	"\x04done\x18\a \x01(\bR\x04done\"\xb9\x02\n" +
 	"\x11GetHistoryRequest\x124\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x14.notifier.PaginationR\n" +
-	"pagination\"Y\n" +
+	"pagination\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x121\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x15.notifier.OrderStatusR\bstatuses\x12B\n" +
+	"\fcreated_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vcreatedFrom\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tcreatedTo\x88\x01\x01B\x0f\n" +
+	"\r_created_fromB\r\n" +
+	"\v_created_to\"Y\n" +
 	"\rOrderResponse\x12-\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x15.notifier.OrderStatusR\x06status\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\x04R\aorderId\"E\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\"\x80\x01\n" +
 	"\rProcessResult\x12\x1c\n" +
 	"\tprocessed\x18\x01 \x03(\x04R\tprocessed\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\x04R\x06errors\"T\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\x129\n" +
+	"\rerror_details\x18\x03 \x03(\v2\x14.notifier.OrderErrorR\ferrorDetails\"U\n" +
+	"\n" +
+	"OrderError\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"T\n" +
 	"\n" +
 	"OrdersList\x12'\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0f.notifier.OrderR\x06orders\x12\x1d\n" +
@@ -1443,31 +2530,47 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"\vReturnsList\x12)\n" +
 	"\areturns\x18\x01 \x03(\v2\x0f.notifier.OrderR\areturns\"D\n" +
 	"\x10OrderHistoryList\x120\n" +
-	"\ahistory\x18\x01 \x03(\v2\x16.notifier.OrderHistoryR\ahistory\"K\n" +
+	"\ahistory\x18\x01 \x03(\v2\x16.notifier.OrderHistoryR\ahistory\"\x86\x01\n" +
 	"\fImportResult\x12#\n" +
 	"\bimported\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bimported\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\x04R\x06errors\"\xb8\x02\n" +
+	"\x06errors\x18\x02 \x03(\x04R\x06errors\x129\n" +
+	"\rerror_details\x18\x03 \x03(\v2\x14.notifier.OrderErrorR\ferrorDetails\"\x89\x04\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12-\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x15.notifier.OrderStatusR\x06status\x129\n" +
 	"\n" +
-	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
-	"\x06weight\x18\x05 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05%\x00\x00\x00\x00R\x06weight\x12+\n" +
-	"\vtotal_price\x18\x06 \x01(\x02B\n" +
-	"\xfaB\a\n" +
-	"\x05-\x00\x00\x00\x00R\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\x06weight\x18\x05 \x01(\x02B\x02\x18\x01R\x06weight\x12#\n" +
+	"\vtotal_price\x18\x06 \x01(\x02B\x02\x18\x01R\n" +
 	"totalPrice\x124\n" +
-	"\apackage\x18\a \x01(\x0e2\x15.notifier.PackageTypeH\x00R\apackage\x88\x01\x01B\n" +
+	"\apackage\x18\a \x01(\x0e2\x15.notifier.PackageTypeH\x00R\apackage\x88\x01\x01\x12>\n" +
 	"\n" +
-	"\b_package\"\x93\x01\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdeletedAt\x88\x01\x01\x12%\n" +
+	"\x0etariff_version\x18\t \x01(\tR\rtariffVersion\x12!\n" +
+	"\fweight_grams\x18\n" +
+	" \x01(\x03R\vweightGrams\x12*\n" +
+	"\x11total_price_minor\x18\v \x01(\x03R\x0ftotalPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrencyB\n" +
+	"\n" +
+	"\b_packageB\r\n" +
+	"\v_deleted_at\"\xba\x01\n" +
 	"\fOrderHistory\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.notifier.OrderStatusR\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*\x7f\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x05actor\x18\x04 \x01(\v2\x0f.notifier.ActorR\x05actor\"Z\n" +
+	"\x05Actor\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.notifier.ActorTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject*\x9d\x01\n" +
+	"\rMessageStatus\x12\x1e\n" +
+	"\x1aMESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MESSAGE_STATUS_PENDING\x10\x01\x12\x17\n" +
+	"\x13MESSAGE_STATUS_SENT\x10\x02\x12\x1c\n" +
+	"\x18MESSAGE_STATUS_CANCELLED\x10\x03\x12\x19\n" +
+	"\x15MESSAGE_STATUS_FAILED\x10\x04*\x7f\n" +
 	"\bPriority\x12\x14\n" +
 	"\x10PRIORITY_UNKNOWN\x10\x00\x12\x10\n" +
 	"\fPRIORITY_MIN\x10\x01\x12\x10\n" +
@@ -1492,9 +2595,17 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"\x14ORDER_STATUS_EXPECTS\x10\x01\x12\x19\n" +
 	"\x15ORDER_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
 	"\x15ORDER_STATUS_RETURNED\x10\x03\x12\x18\n" +
-	"\x14ORDER_STATUS_DELETED\x10\x042\xe6\f\n" +
-	"\bNotifier\x12s\n" +
-	"\vSendMessage\x12\x18.notifier.MessageRequest\x1a\x19.notifier.MessageResponse\"/\x92A\x15\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/SendMessage\x12\xa5\x01\n" +
+	"\x14ORDER_STATUS_DELETED\x10\x04*\xa0\x01\n" +
+	"\tActorType\x12\x1a\n" +
+	"\x16ACTOR_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ACTOR_TYPE_COURIER\x10\x01\x12\x17\n" +
+	"\x13ACTOR_TYPE_OPERATOR\x10\x02\x12\x14\n" +
+	"\x10ACTOR_TYPE_ADMIN\x10\x03\x12\x17\n" +
+	"\x13ACTOR_TYPE_TERMINAL\x10\x04\"\x04\b\x05\x10\x05*\x11ACTOR_TYPE_SYSTEM2\xe1\x12\n" +
+	"\bNotifier\x12\xbd\x01\n" +
+	"\vSendMessage\x12\x18.notifier.MessageRequest\x1a\x19.notifier.MessageResponse\"y\x92A_\x12HПоставить сообщение в очередь отправки\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/SendMessage\x12\x9f\x01\n" +
+	"\x10GetMessageStatus\x12\x1a.notifier.MessageIdRequest\x1a\x1f.notifier.MessageStatusResponse\"N\x92A6\x12\x1fСтатус сообщения\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x0f\x12\r/message/{id}\x12\xbb\x01\n" +
+	"\rCancelMessage\x12\x1a.notifier.MessageIdRequest\x1a\x1f.notifier.MessageStatusResponse\"m\x92AK\x124Отменить отправку сообщения\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/message/{id}/cancel\x12\xa5\x01\n" +
 	"\vAcceptOrder\x12\x1c.notifier.AcceptOrderRequest\x1a\x17.notifier.OrderResponse\"_\x92AD\x12-Принять заказ от курьера\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/accept_order\x12\xad\x01\n" +
 	"\vReturnOrder\x12\x18.notifier.OrderIdRequest\x1a\x17.notifier.OrderResponse\"k\x92A?\x12(Вернуть заказ курьеру\x1a\x13Описание...\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/order/{order_id}/return_order\x12\xcc\x01\n" +
 	"\rProcessOrders\x12\x1e.notifier.ProcessOrdersRequest\x1a\x17.notifier.ProcessResult\"\x81\x01\x92Ad\x12MВыдать заказы или принять возврат клиента\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/process_orders\x12\x9e\x01\n" +
@@ -1503,9 +2614,25 @@ const file_pwz_pwz_proto_rawDesc = "" +
 	"\vListReturns\x12\x1c.notifier.ListReturnsRequest\x1a\x15.notifier.ReturnsList\"b\x92AG\x120Получить список возвратов\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/list_returns\x12\xb9\x01\n" +
 	"\n" +
 	"GetHistory\x12\x1b.notifier.GetHistoryRequest\x1a\x1a.notifier.OrderHistoryList\"r\x92AX\x12AПолучить историю изменения заказов\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/get_history\x12\xf6\x01\n" +
-	"\fImportOrders\x12\x1d.notifier.ImportOrdersRequest\x1a\x16.notifier.ImportResult\"\xae\x01\x92A\x91\x01\x12zИмпорт заказов (если эта ручка делалась ранее в рамках доп заданий)\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/import_orders\x12\xbe\x01\n" +
-	"\x0fGetOrderHistory\x12\x1d.notifier.OrderHistoryRequest\x1a\x1e.notifier.OrderHistoryResponse\"l\x92AH\x121Получить историю по заказу\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x1b\x12\x19/order/{order_id}/historyB\x8a\x01\x92Aw\x12=\n" +
-	"&Пункт выдачи заказов\x12\fHTTP и gRPC2\x051.0.0\x1a\x0flocalhost:50052*\x01\x012\x10application/json:\x10application/jsonZ\x0ePWZ1.0/pkg/pwzb\x06proto3"
+	"\fImportOrders\x12\x1d.notifier.ImportOrdersRequest\x1a\x16.notifier.ImportResult\"\xae\x01\x92A\x91\x01\x12zИмпорт заказов (если эта ручка делалась ранее в рамках доп заданий)\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/import_orders\x12\xc1\x01\n" +
+	"\x12ImportOrdersStream\x12#.notifier.ImportOrdersStreamRequest\x1a\x18.notifier.ImportProgress\"h\x92AE\x12.Потоковый импорт заказов\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/import_orders/stream(\x010\x01\x12\xbe\x01\n" +
+	"\x0fGetOrderHistory\x12\x1d.notifier.OrderHistoryRequest\x1a\x1e.notifier.OrderHistoryResponse\"l\x92AH\x121Получить историю по заказу\x1a\x13Описание...\x82\xd3\xe4\x93\x02\x1b\x12\x19/order/{order_id}/history\x12\x89\x01\n" +
+	"\n" +
+	"GetTariffs\x12\x1b.notifier.GetTariffsRequest\x1a\x15.notifier.TariffsList\"G\x92A4\x12\x1dПолучить тарифы\x1a\x13Описание...\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/tariffsB\x88\x02\x92A\xf4\x01\x12=\n" +
+	"&Пункт выдачи заказов\x12\fHTTP и gRPC2\x051.0.0\x1a\x0flocalhost:50052*\x01\x012\x10application/json:\x10application/jsonZW\n" +
+	"\x1d\n" +
+	"\n" +
+	"ApiKeyAuth\x12\x0f\b\x02\x1a\tX-Api-Key \x02\n" +
+	"6\n" +
+	"\n" +
+	"BearerAuth\x12(\b\x02\x12\x13JWT: Bearer <token>\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"ApiKeyAuth\x12\x00Z\x0ePWZ1.0/pkg/pwzb\x06proto3"
 
 var (
 	file_pwz_pwz_proto_rawDescOnce sync.Once
@@ -1519,79 +2646,122 @@ func file_pwz_pwz_proto_rawDescGZIP() []byte {
 	return file_pwz_pwz_proto_rawDescData
 }
 
-var file_pwz_pwz_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pwz_pwz_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_pwz_pwz_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_pwz_pwz_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_pwz_pwz_proto_goTypes = []any{
-	(Priority)(0),                 // 0: notifier.Priority
-	(ActionType)(0),               // 1: notifier.ActionType
-	(PackageType)(0),              // 2: notifier.PackageType
-	(OrderStatus)(0),              // 3: notifier.OrderStatus
-	(*MessageRequest)(nil),        // 4: notifier.MessageRequest
-	(*MessageResponse)(nil),       // 5: notifier.MessageResponse
-	(*OrderHistoryRequest)(nil),   // 6: notifier.OrderHistoryRequest
-	(*OrderHistoryResponse)(nil),  // 7: notifier.OrderHistoryResponse
-	(*AcceptOrderRequest)(nil),    // 8: notifier.AcceptOrderRequest
-	(*OrderIdRequest)(nil),        // 9: notifier.OrderIdRequest
-	(*ProcessOrdersRequest)(nil),  // 10: notifier.ProcessOrdersRequest
-	(*ListOrdersRequest)(nil),     // 11: notifier.ListOrdersRequest
-	(*Pagination)(nil),            // 12: notifier.Pagination
-	(*ListReturnsRequest)(nil),    // 13: notifier.ListReturnsRequest
-	(*ImportOrdersRequest)(nil),   // 14: notifier.ImportOrdersRequest
-	(*GetHistoryRequest)(nil),     // 15: notifier.GetHistoryRequest
-	(*OrderResponse)(nil),         // 16: notifier.OrderResponse
-	(*ProcessResult)(nil),         // 17: notifier.ProcessResult
-	(*OrdersList)(nil),            // 18: notifier.OrdersList
-	(*ReturnsList)(nil),           // 19: notifier.ReturnsList
-	(*OrderHistoryList)(nil),      // 20: notifier.OrderHistoryList
-	(*ImportResult)(nil),          // 21: notifier.ImportResult
-	(*Order)(nil),                 // 22: notifier.Order
-	(*OrderHistory)(nil),          // 23: notifier.OrderHistory
-	(*durationpb.Duration)(nil),   // 24: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
+	(MessageStatus)(0),                // 0: notifier.MessageStatus
+	(Priority)(0),                     // 1: notifier.Priority
+	(ActionType)(0),                   // 2: notifier.ActionType
+	(PackageType)(0),                  // 3: notifier.PackageType
+	(OrderStatus)(0),                  // 4: notifier.OrderStatus
+	(ActorType)(0),                    // 5: notifier.ActorType
+	(*MessageRequest)(nil),            // 6: notifier.MessageRequest
+	(*MessageResponse)(nil),           // 7: notifier.MessageResponse
+	(*MessageIdRequest)(nil),          // 8: notifier.MessageIdRequest
+	(*MessageStatusResponse)(nil),     // 9: notifier.MessageStatusResponse
+	(*GetTariffsRequest)(nil),         // 10: notifier.GetTariffsRequest
+	(*TariffsList)(nil),               // 11: notifier.TariffsList
+	(*Tariff)(nil),                    // 12: notifier.Tariff
+	(*PackageTariff)(nil),             // 13: notifier.PackageTariff
+	(*WeightTier)(nil),                // 14: notifier.WeightTier
+	(*OrderHistoryRequest)(nil),       // 15: notifier.OrderHistoryRequest
+	(*OrderHistoryResponse)(nil),      // 16: notifier.OrderHistoryResponse
+	(*AcceptOrderRequest)(nil),        // 17: notifier.AcceptOrderRequest
+	(*OrderIdRequest)(nil),            // 18: notifier.OrderIdRequest
+	(*ProcessOrdersRequest)(nil),      // 19: notifier.ProcessOrdersRequest
+	(*ListOrdersRequest)(nil),         // 20: notifier.ListOrdersRequest
+	(*Pagination)(nil),                // 21: notifier.Pagination
+	(*ListReturnsRequest)(nil),        // 22: notifier.ListReturnsRequest
+	(*ImportOrdersRequest)(nil),       // 23: notifier.ImportOrdersRequest
+	(*ImportOrdersStreamRequest)(nil), // 24: notifier.ImportOrdersStreamRequest
+	(*ImportProgress)(nil),            // 25: notifier.ImportProgress
+	(*GetHistoryRequest)(nil),         // 26: notifier.GetHistoryRequest
+	(*OrderResponse)(nil),             // 27: notifier.OrderResponse
+	(*ProcessResult)(nil),             // 28: notifier.ProcessResult
+	(*OrderError)(nil),                // 29: notifier.OrderError
+	(*OrdersList)(nil),                // 30: notifier.OrdersList
+	(*ReturnsList)(nil),               // 31: notifier.ReturnsList
+	(*OrderHistoryList)(nil),          // 32: notifier.OrderHistoryList
+	(*ImportResult)(nil),              // 33: notifier.ImportResult
+	(*Order)(nil),                     // 34: notifier.Order
+	(*OrderHistory)(nil),              // 35: notifier.OrderHistory
+	(*Actor)(nil),                     // 36: notifier.Actor
+	(*durationpb.Duration)(nil),       // 37: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 38: google.protobuf.Timestamp
 }
 var file_pwz_pwz_proto_depIdxs = []int32{
-	0,  // 0: notifier.MessageRequest.priority:type_name -> notifier.Priority
-	24, // 1: notifier.MessageRequest.delay:type_name -> google.protobuf.Duration
-	23, // 2: notifier.OrderHistoryResponse.history:type_name -> notifier.OrderHistory
-	25, // 3: notifier.AcceptOrderRequest.expires_at:type_name -> google.protobuf.Timestamp
-	2,  // 4: notifier.AcceptOrderRequest.package:type_name -> notifier.PackageType
-	1,  // 5: notifier.ProcessOrdersRequest.action:type_name -> notifier.ActionType
-	12, // 6: notifier.ListOrdersRequest.pagination:type_name -> notifier.Pagination
-	12, // 7: notifier.ListReturnsRequest.pagination:type_name -> notifier.Pagination
-	8,  // 8: notifier.ImportOrdersRequest.orders:type_name -> notifier.AcceptOrderRequest
-	12, // 9: notifier.GetHistoryRequest.pagination:type_name -> notifier.Pagination
-	3,  // 10: notifier.OrderResponse.status:type_name -> notifier.OrderStatus
-	22, // 11: notifier.OrdersList.orders:type_name -> notifier.Order
-	22, // 12: notifier.ReturnsList.returns:type_name -> notifier.Order
-	23, // 13: notifier.OrderHistoryList.history:type_name -> notifier.OrderHistory
-	3,  // 14: notifier.Order.status:type_name -> notifier.OrderStatus
-	25, // 15: notifier.Order.expires_at:type_name -> google.protobuf.Timestamp
-	2,  // 16: notifier.Order.package:type_name -> notifier.PackageType
-	3,  // 17: notifier.OrderHistory.status:type_name -> notifier.OrderStatus
-	25, // 18: notifier.OrderHistory.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 19: notifier.Notifier.SendMessage:input_type -> notifier.MessageRequest
-	8,  // 20: notifier.Notifier.AcceptOrder:input_type -> notifier.AcceptOrderRequest
-	9,  // 21: notifier.Notifier.ReturnOrder:input_type -> notifier.OrderIdRequest
-	10, // 22: notifier.Notifier.ProcessOrders:input_type -> notifier.ProcessOrdersRequest
-	11, // 23: notifier.Notifier.ListOrders:input_type -> notifier.ListOrdersRequest
-	13, // 24: notifier.Notifier.ListReturns:input_type -> notifier.ListReturnsRequest
-	15, // 25: notifier.Notifier.GetHistory:input_type -> notifier.GetHistoryRequest
-	14, // 26: notifier.Notifier.ImportOrders:input_type -> notifier.ImportOrdersRequest
-	6,  // 27: notifier.Notifier.GetOrderHistory:input_type -> notifier.OrderHistoryRequest
-	5,  // 28: notifier.Notifier.SendMessage:output_type -> notifier.MessageResponse
-	16, // 29: notifier.Notifier.AcceptOrder:output_type -> notifier.OrderResponse
-	16, // 30: notifier.Notifier.ReturnOrder:output_type -> notifier.OrderResponse
-	17, // 31: notifier.Notifier.ProcessOrders:output_type -> notifier.ProcessResult
-	18, // 32: notifier.Notifier.ListOrders:output_type -> notifier.OrdersList
-	19, // 33: notifier.Notifier.ListReturns:output_type -> notifier.ReturnsList
-	20, // 34: notifier.Notifier.GetHistory:output_type -> notifier.OrderHistoryList
-	21, // 35: notifier.Notifier.ImportOrders:output_type -> notifier.ImportResult
-	7,  // 36: notifier.Notifier.GetOrderHistory:output_type -> notifier.OrderHistoryResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	1,  // 0: notifier.MessageRequest.priority:type_name -> notifier.Priority
+	37, // 1: notifier.MessageRequest.delay:type_name -> google.protobuf.Duration
+	0,  // 2: notifier.MessageStatusResponse.status:type_name -> notifier.MessageStatus
+	1,  // 3: notifier.MessageStatusResponse.priority:type_name -> notifier.Priority
+	38, // 4: notifier.MessageStatusResponse.scheduled_at:type_name -> google.protobuf.Timestamp
+	38, // 5: notifier.MessageStatusResponse.sent_at:type_name -> google.protobuf.Timestamp
+	12, // 6: notifier.TariffsList.tariffs:type_name -> notifier.Tariff
+	38, // 7: notifier.Tariff.valid_from:type_name -> google.protobuf.Timestamp
+	13, // 8: notifier.Tariff.packages:type_name -> notifier.PackageTariff
+	14, // 9: notifier.Tariff.weight_tiers:type_name -> notifier.WeightTier
+	3,  // 10: notifier.PackageTariff.package:type_name -> notifier.PackageType
+	35, // 11: notifier.OrderHistoryResponse.history:type_name -> notifier.OrderHistory
+	38, // 12: notifier.AcceptOrderRequest.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 13: notifier.AcceptOrderRequest.package:type_name -> notifier.PackageType
+	2,  // 14: notifier.ProcessOrdersRequest.action:type_name -> notifier.ActionType
+	21, // 15: notifier.ListOrdersRequest.pagination:type_name -> notifier.Pagination
+	4,  // 16: notifier.ListOrdersRequest.statuses:type_name -> notifier.OrderStatus
+	38, // 17: notifier.ListOrdersRequest.expires_from:type_name -> google.protobuf.Timestamp
+	38, // 18: notifier.ListOrdersRequest.expires_to:type_name -> google.protobuf.Timestamp
+	21, // 19: notifier.ListReturnsRequest.pagination:type_name -> notifier.Pagination
+	17, // 20: notifier.ImportOrdersRequest.orders:type_name -> notifier.AcceptOrderRequest
+	17, // 21: notifier.ImportOrdersStreamRequest.order:type_name -> notifier.AcceptOrderRequest
+	29, // 22: notifier.ImportProgress.errors:type_name -> notifier.OrderError
+	21, // 23: notifier.GetHistoryRequest.pagination:type_name -> notifier.Pagination
+	4,  // 24: notifier.GetHistoryRequest.statuses:type_name -> notifier.OrderStatus
+	38, // 25: notifier.GetHistoryRequest.created_from:type_name -> google.protobuf.Timestamp
+	38, // 26: notifier.GetHistoryRequest.created_to:type_name -> google.protobuf.Timestamp
+	4,  // 27: notifier.OrderResponse.status:type_name -> notifier.OrderStatus
+	29, // 28: notifier.ProcessResult.error_details:type_name -> notifier.OrderError
+	34, // 29: notifier.OrdersList.orders:type_name -> notifier.Order
+	34, // 30: notifier.ReturnsList.returns:type_name -> notifier.Order
+	35, // 31: notifier.OrderHistoryList.history:type_name -> notifier.OrderHistory
+	29, // 32: notifier.ImportResult.error_details:type_name -> notifier.OrderError
+	4,  // 33: notifier.Order.status:type_name -> notifier.OrderStatus
+	38, // 34: notifier.Order.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 35: notifier.Order.package:type_name -> notifier.PackageType
+	38, // 36: notifier.Order.deleted_at:type_name -> google.protobuf.Timestamp
+	4,  // 37: notifier.OrderHistory.status:type_name -> notifier.OrderStatus
+	38, // 38: notifier.OrderHistory.created_at:type_name -> google.protobuf.Timestamp
+	36, // 39: notifier.OrderHistory.actor:type_name -> notifier.Actor
+	5,  // 40: notifier.Actor.type:type_name -> notifier.ActorType
+	6,  // 41: notifier.Notifier.SendMessage:input_type -> notifier.MessageRequest
+	8,  // 42: notifier.Notifier.GetMessageStatus:input_type -> notifier.MessageIdRequest
+	8,  // 43: notifier.Notifier.CancelMessage:input_type -> notifier.MessageIdRequest
+	17, // 44: notifier.Notifier.AcceptOrder:input_type -> notifier.AcceptOrderRequest
+	18, // 45: notifier.Notifier.ReturnOrder:input_type -> notifier.OrderIdRequest
+	19, // 46: notifier.Notifier.ProcessOrders:input_type -> notifier.ProcessOrdersRequest
+	20, // 47: notifier.Notifier.ListOrders:input_type -> notifier.ListOrdersRequest
+	22, // 48: notifier.Notifier.ListReturns:input_type -> notifier.ListReturnsRequest
+	26, // 49: notifier.Notifier.GetHistory:input_type -> notifier.GetHistoryRequest
+	23, // 50: notifier.Notifier.ImportOrders:input_type -> notifier.ImportOrdersRequest
+	24, // 51: notifier.Notifier.ImportOrdersStream:input_type -> notifier.ImportOrdersStreamRequest
+	15, // 52: notifier.Notifier.GetOrderHistory:input_type -> notifier.OrderHistoryRequest
+	10, // 53: notifier.Notifier.GetTariffs:input_type -> notifier.GetTariffsRequest
+	7,  // 54: notifier.Notifier.SendMessage:output_type -> notifier.MessageResponse
+	9,  // 55: notifier.Notifier.GetMessageStatus:output_type -> notifier.MessageStatusResponse
+	9,  // 56: notifier.Notifier.CancelMessage:output_type -> notifier.MessageStatusResponse
+	27, // 57: notifier.Notifier.AcceptOrder:output_type -> notifier.OrderResponse
+	27, // 58: notifier.Notifier.ReturnOrder:output_type -> notifier.OrderResponse
+	28, // 59: notifier.Notifier.ProcessOrders:output_type -> notifier.ProcessResult
+	30, // 60: notifier.Notifier.ListOrders:output_type -> notifier.OrdersList
+	31, // 61: notifier.Notifier.ListReturns:output_type -> notifier.ReturnsList
+	32, // 62: notifier.Notifier.GetHistory:output_type -> notifier.OrderHistoryList
+	33, // 63: notifier.Notifier.ImportOrders:output_type -> notifier.ImportResult
+	25, // 64: notifier.Notifier.ImportOrdersStream:output_type -> notifier.ImportProgress
+	16, // 65: notifier.Notifier.GetOrderHistory:output_type -> notifier.OrderHistoryResponse
+	11, // 66: notifier.Notifier.GetTariffs:output_type -> notifier.TariffsList
+	54, // [54:67] is the sub-list for method output_type
+	41, // [41:54] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_pwz_pwz_proto_init() }
@@ -1600,16 +2770,18 @@ func file_pwz_pwz_proto_init() {
 		return
 	}
 	file_pwz_pwz_proto_msgTypes[0].OneofWrappers = []any{}
-	file_pwz_pwz_proto_msgTypes[4].OneofWrappers = []any{}
-	file_pwz_pwz_proto_msgTypes[7].OneofWrappers = []any{}
-	file_pwz_pwz_proto_msgTypes[18].OneofWrappers = []any{}
+	file_pwz_pwz_proto_msgTypes[3].OneofWrappers = []any{}
+	file_pwz_pwz_proto_msgTypes[11].OneofWrappers = []any{}
+	file_pwz_pwz_proto_msgTypes[14].OneofWrappers = []any{}
+	file_pwz_pwz_proto_msgTypes[20].OneofWrappers = []any{}
+	file_pwz_pwz_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pwz_pwz_proto_rawDesc), len(file_pwz_pwz_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   20,
+			NumEnums:      6,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -59,6 +59,85 @@ func local_request_Notifier_SendMessage_0(ctx context.Context, marshaler runtime
 	return msg, metadata, err
 }
 
+func request_Notifier_GetMessageStatus_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MessageIdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	io.Copy(io.Discard, req.Body)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Uint32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.GetMessageStatus(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Notifier_GetMessageStatus_0(ctx context.Context, marshaler runtime.Marshaler, server NotifierServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MessageIdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Uint32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.GetMessageStatus(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Notifier_CancelMessage_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MessageIdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Uint32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.CancelMessage(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Notifier_CancelMessage_0(ctx context.Context, marshaler runtime.Marshaler, server NotifierServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq MessageIdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Uint32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.CancelMessage(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Notifier_AcceptOrder_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq AcceptOrderRequest
@@ -245,6 +324,49 @@ func local_request_Notifier_ImportOrders_0(ctx context.Context, marshaler runtim
 	return msg, metadata, err
 }
 
+func request_Notifier_ImportOrdersStream_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (Notifier_ImportOrdersStreamClient, runtime.ServerMetadata, error) {
+	var metadata runtime.ServerMetadata
+	stream, err := client.ImportOrdersStream(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to start streaming: %v", err)
+		return nil, metadata, err
+	}
+	dec := marshaler.NewDecoder(req.Body)
+	handleSend := func() error {
+		var protoReq ImportOrdersStreamRequest
+		err := dec.Decode(&protoReq)
+		if errors.Is(err, io.EOF) {
+			return err
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to decode request: %v", err)
+			return status.Errorf(codes.InvalidArgument, "Failed to decode request: %v", err)
+		}
+		if err := stream.Send(&protoReq); err != nil {
+			grpclog.Errorf("Failed to send request: %v", err)
+			return err
+		}
+		return nil
+	}
+	go func() {
+		for {
+			if err := handleSend(); err != nil {
+				break
+			}
+		}
+		if err := stream.CloseSend(); err != nil {
+			grpclog.Errorf("Failed to terminate client stream: %v", err)
+		}
+	}()
+	header, err := stream.Header()
+	if err != nil {
+		grpclog.Errorf("Failed to get header from client: %v", err)
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Notifier_GetOrderHistory_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq OrderHistoryRequest
@@ -282,6 +404,25 @@ func local_request_Notifier_GetOrderHistory_0(ctx context.Context, marshaler run
 	return msg, metadata, err
 }
 
+func request_Notifier_GetTariffs_0(ctx context.Context, marshaler runtime.Marshaler, client NotifierClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetTariffsRequest
+		metadata runtime.ServerMetadata
+	)
+	io.Copy(io.Discard, req.Body)
+	msg, err := client.GetTariffs(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Notifier_GetTariffs_0(ctx context.Context, marshaler runtime.Marshaler, server NotifierServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetTariffsRequest
+		metadata runtime.ServerMetadata
+	)
+	msg, err := server.GetTariffs(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterNotifierHandlerServer registers the http handlers for service Notifier to "mux".
 // UnaryRPC     :call NotifierServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -308,6 +449,46 @@ func RegisterNotifierHandlerServer(ctx context.Context, mux *runtime.ServeMux, s
 		}
 		forward_Notifier_SendMessage_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Notifier_GetMessageStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/notifier.Notifier/GetMessageStatus", runtime.WithHTTPPathPattern("/message/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Notifier_GetMessageStatus_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_GetMessageStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Notifier_CancelMessage_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/notifier.Notifier/CancelMessage", runtime.WithHTTPPathPattern("/message/{id}/cancel"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Notifier_CancelMessage_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_CancelMessage_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Notifier_AcceptOrder_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -448,6 +629,13 @@ func RegisterNotifierHandlerServer(ctx context.Context, mux *runtime.ServeMux, s
 		}
 		forward_Notifier_ImportOrders_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodPost, pattern_Notifier_ImportOrdersStream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodGet, pattern_Notifier_GetOrderHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -468,6 +656,26 @@ func RegisterNotifierHandlerServer(ctx context.Context, mux *runtime.ServeMux, s
 		}
 		forward_Notifier_GetOrderHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Notifier_GetTariffs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/notifier.Notifier/GetTariffs", runtime.WithHTTPPathPattern("/tariffs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Notifier_GetTariffs_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_GetTariffs_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -525,6 +733,40 @@ func RegisterNotifierHandlerClient(ctx context.Context, mux *runtime.ServeMux, c
 		}
 		forward_Notifier_SendMessage_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Notifier_GetMessageStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/notifier.Notifier/GetMessageStatus", runtime.WithHTTPPathPattern("/message/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Notifier_GetMessageStatus_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_GetMessageStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Notifier_CancelMessage_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/notifier.Notifier/CancelMessage", runtime.WithHTTPPathPattern("/message/{id}/cancel"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Notifier_CancelMessage_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_CancelMessage_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Notifier_AcceptOrder_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -644,6 +886,23 @@ func RegisterNotifierHandlerClient(ctx context.Context, mux *runtime.ServeMux, c
 		}
 		forward_Notifier_ImportOrders_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Notifier_ImportOrdersStream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/notifier.Notifier/ImportOrdersStream", runtime.WithHTTPPathPattern("/import_orders/stream"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Notifier_ImportOrdersStream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_ImportOrdersStream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Notifier_GetOrderHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -661,29 +920,54 @@ func RegisterNotifierHandlerClient(ctx context.Context, mux *runtime.ServeMux, c
 		}
 		forward_Notifier_GetOrderHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Notifier_GetTariffs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/notifier.Notifier/GetTariffs", runtime.WithHTTPPathPattern("/tariffs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Notifier_GetTariffs_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Notifier_GetTariffs_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_Notifier_SendMessage_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"SendMessage"}, ""))
-	pattern_Notifier_AcceptOrder_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"accept_order"}, ""))
-	pattern_Notifier_ReturnOrder_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2}, []string{"order", "order_id", "return_order"}, ""))
-	pattern_Notifier_ProcessOrders_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"process_orders"}, ""))
-	pattern_Notifier_ListOrders_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"list_orders"}, ""))
-	pattern_Notifier_ListReturns_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"list_returns"}, ""))
-	pattern_Notifier_GetHistory_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"get_history"}, ""))
-	pattern_Notifier_ImportOrders_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"import_orders"}, ""))
-	pattern_Notifier_GetOrderHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2}, []string{"order", "order_id", "history"}, ""))
+	pattern_Notifier_SendMessage_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"SendMessage"}, ""))
+	pattern_Notifier_GetMessageStatus_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1}, []string{"message", "id"}, ""))
+	pattern_Notifier_CancelMessage_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2}, []string{"message", "id", "cancel"}, ""))
+	pattern_Notifier_AcceptOrder_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"accept_order"}, ""))
+	pattern_Notifier_ReturnOrder_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2}, []string{"order", "order_id", "return_order"}, ""))
+	pattern_Notifier_ProcessOrders_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"process_orders"}, ""))
+	pattern_Notifier_ListOrders_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"list_orders"}, ""))
+	pattern_Notifier_ListReturns_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"list_returns"}, ""))
+	pattern_Notifier_GetHistory_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"get_history"}, ""))
+	pattern_Notifier_ImportOrders_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"import_orders"}, ""))
+	pattern_Notifier_ImportOrdersStream_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"import_orders", "stream"}, ""))
+	pattern_Notifier_GetOrderHistory_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2}, []string{"order", "order_id", "history"}, ""))
+	pattern_Notifier_GetTariffs_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"tariffs"}, ""))
 )
 
 var (
-	forward_Notifier_SendMessage_0     = runtime.ForwardResponseMessage
-	forward_Notifier_AcceptOrder_0     = runtime.ForwardResponseMessage
-	forward_Notifier_ReturnOrder_0     = runtime.ForwardResponseMessage
-	forward_Notifier_ProcessOrders_0   = runtime.ForwardResponseMessage
-	forward_Notifier_ListOrders_0      = runtime.ForwardResponseMessage
-	forward_Notifier_ListReturns_0     = runtime.ForwardResponseMessage
-	forward_Notifier_GetHistory_0      = runtime.ForwardResponseMessage
-	forward_Notifier_ImportOrders_0    = runtime.ForwardResponseMessage
-	forward_Notifier_GetOrderHistory_0 = runtime.ForwardResponseMessage
+	forward_Notifier_SendMessage_0        = runtime.ForwardResponseMessage
+	forward_Notifier_GetMessageStatus_0   = runtime.ForwardResponseMessage
+	forward_Notifier_CancelMessage_0      = runtime.ForwardResponseMessage
+	forward_Notifier_AcceptOrder_0        = runtime.ForwardResponseMessage
+	forward_Notifier_ReturnOrder_0        = runtime.ForwardResponseMessage
+	forward_Notifier_ProcessOrders_0      = runtime.ForwardResponseMessage
+	forward_Notifier_ListOrders_0         = runtime.ForwardResponseMessage
+	forward_Notifier_ListReturns_0        = runtime.ForwardResponseMessage
+	forward_Notifier_GetHistory_0         = runtime.ForwardResponseMessage
+	forward_Notifier_ImportOrders_0       = runtime.ForwardResponseMessage
+	forward_Notifier_ImportOrdersStream_0 = runtime.ForwardResponseStream
+	forward_Notifier_GetOrderHistory_0    = runtime.ForwardResponseMessage
+	forward_Notifier_GetTariffs_0         = runtime.ForwardResponseMessage
 )
@@ -134,6 +134,10 @@ func (m *MessageRequest) validate(all bool) error {
 		// no validation rules for Comment
 	}
 
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
 	if len(errors) > 0 {
 		return MessageRequestMultiError(errors)
 	}
@@ -314,44 +318,53 @@ var _ interface {
 	ErrorName() string
 } = MessageResponseValidationError{}
 
-// Validate checks the field values on OrderHistoryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrderHistoryRequest) Validate() error {
+// Validate checks the field values on MessageIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageIdRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderHistoryRequest with the rules
+// ValidateAll checks the field values on MessageIdRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderHistoryRequestMultiError, or nil if none found.
-func (m *OrderHistoryRequest) ValidateAll() error {
+// MessageIdRequestMultiError, or nil if none found.
+func (m *MessageIdRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderHistoryRequest) validate(all bool) error {
+func (m *MessageIdRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
+	if m.GetId() <= 0 {
+		err := MessageIdRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return OrderHistoryRequestMultiError(errors)
+		return MessageIdRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderHistoryRequestMultiError is an error wrapping multiple validation
-// errors returned by OrderHistoryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type OrderHistoryRequestMultiError []error
+// MessageIdRequestMultiError is an error wrapping multiple validation errors
+// returned by MessageIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MessageIdRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderHistoryRequestMultiError) Error() string {
+func (m MessageIdRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -360,11 +373,11 @@ func (m OrderHistoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderHistoryRequestMultiError) AllErrors() []error { return m }
+func (m MessageIdRequestMultiError) AllErrors() []error { return m }
 
-// OrderHistoryRequestValidationError is the validation error returned by
-// OrderHistoryRequest.Validate if the designated constraints aren't met.
-type OrderHistoryRequestValidationError struct {
+// MessageIdRequestValidationError is the validation error returned by
+// MessageIdRequest.Validate if the designated constraints aren't met.
+type MessageIdRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -372,24 +385,22 @@ type OrderHistoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderHistoryRequestValidationError) Field() string { return e.field }
+func (e MessageIdRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderHistoryRequestValidationError) Reason() string { return e.reason }
+func (e MessageIdRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderHistoryRequestValidationError) Cause() error { return e.cause }
+func (e MessageIdRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderHistoryRequestValidationError) Key() bool { return e.key }
+func (e MessageIdRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderHistoryRequestValidationError) ErrorName() string {
-	return "OrderHistoryRequestValidationError"
-}
+func (e MessageIdRequestValidationError) ErrorName() string { return "MessageIdRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderHistoryRequestValidationError) Error() string {
+func (e MessageIdRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -401,14 +412,14 @@ func (e OrderHistoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderHistoryRequest.%s: %s%s",
+		"invalid %sMessageIdRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderHistoryRequestValidationError{}
+var _ error = MessageIdRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -416,56 +427,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderHistoryRequestValidationError{}
+} = MessageIdRequestValidationError{}
 
-// Validate checks the field values on OrderHistoryResponse with the rules
+// Validate checks the field values on MessageStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrderHistoryResponse) Validate() error {
+func (m *MessageStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderHistoryResponse with the rules
+// ValidateAll checks the field values on MessageStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderHistoryResponseMultiError, or nil if none found.
-func (m *OrderHistoryResponse) ValidateAll() error {
+// MessageStatusResponseMultiError, or nil if none found.
+func (m *MessageStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderHistoryResponse) validate(all bool) error {
+func (m *MessageStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetHistory() {
-		_, _ = idx, item
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetScheduledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageStatusResponseValidationError{
+					field:  "ScheduledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageStatusResponseValidationError{
+					field:  "ScheduledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageStatusResponseValidationError{
+				field:  "ScheduledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SentAt != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetSentAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, OrderHistoryResponseValidationError{
-						field:  fmt.Sprintf("History[%v]", idx),
+					errors = append(errors, MessageStatusResponseValidationError{
+						field:  "SentAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, OrderHistoryResponseValidationError{
-						field:  fmt.Sprintf("History[%v]", idx),
+					errors = append(errors, MessageStatusResponseValidationError{
+						field:  "SentAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return OrderHistoryResponseValidationError{
-					field:  fmt.Sprintf("History[%v]", idx),
+				return MessageStatusResponseValidationError{
+					field:  "SentAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -474,20 +519,24 @@ func (m *OrderHistoryResponse) validate(all bool) error {
 
 	}
 
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
 	if len(errors) > 0 {
-		return OrderHistoryResponseMultiError(errors)
+		return MessageStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderHistoryResponseMultiError is an error wrapping multiple validation
-// errors returned by OrderHistoryResponse.ValidateAll() if the designated
+// MessageStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by MessageStatusResponse.ValidateAll() if the designated
 // constraints aren't met.
-type OrderHistoryResponseMultiError []error
+type MessageStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderHistoryResponseMultiError) Error() string {
+func (m MessageStatusResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -496,11 +545,11 @@ func (m OrderHistoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderHistoryResponseMultiError) AllErrors() []error { return m }
+func (m MessageStatusResponseMultiError) AllErrors() []error { return m }
 
-// OrderHistoryResponseValidationError is the validation error returned by
-// OrderHistoryResponse.Validate if the designated constraints aren't met.
-type OrderHistoryResponseValidationError struct {
+// MessageStatusResponseValidationError is the validation error returned by
+// MessageStatusResponse.Validate if the designated constraints aren't met.
+type MessageStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -508,24 +557,24 @@ type OrderHistoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderHistoryResponseValidationError) Field() string { return e.field }
+func (e MessageStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderHistoryResponseValidationError) Reason() string { return e.reason }
+func (e MessageStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderHistoryResponseValidationError) Cause() error { return e.cause }
+func (e MessageStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderHistoryResponseValidationError) Key() bool { return e.key }
+func (e MessageStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderHistoryResponseValidationError) ErrorName() string {
-	return "OrderHistoryResponseValidationError"
+func (e MessageStatusResponseValidationError) ErrorName() string {
+	return "MessageStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OrderHistoryResponseValidationError) Error() string {
+func (e MessageStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -537,14 +586,14 @@ func (e OrderHistoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderHistoryResponse.%s: %s%s",
+		"invalid %sMessageStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderHistoryResponseValidationError{}
+var _ error = MessageStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -552,103 +601,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderHistoryResponseValidationError{}
+} = MessageStatusResponseValidationError{}
 
-// Validate checks the field values on AcceptOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptOrderRequest) Validate() error {
+// Validate checks the field values on GetTariffsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTariffsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptOrderRequest with the rules
+// ValidateAll checks the field values on GetTariffsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptOrderRequestMultiError, or nil if none found.
-func (m *AcceptOrderRequest) ValidateAll() error {
+// GetTariffsRequestMultiError, or nil if none found.
+func (m *GetTariffsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptOrderRequest) validate(all bool) error {
+func (m *GetTariffsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
-
-	// no validation rules for UserId
-
-	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AcceptOrderRequestValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AcceptOrderRequestValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AcceptOrderRequestValidationError{
-				field:  "ExpiresAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetWeight() <= 0 {
-		err := AcceptOrderRequestValidationError{
-			field:  "Weight",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPrice() < 0 {
-		err := AcceptOrderRequestValidationError{
-			field:  "Price",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.Package != nil {
-		// no validation rules for Package
-	}
-
 	if len(errors) > 0 {
-		return AcceptOrderRequestMultiError(errors)
+		return GetTariffsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by AcceptOrderRequest.ValidateAll() if the designated constraints
+// GetTariffsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTariffsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AcceptOrderRequestMultiError []error
+type GetTariffsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptOrderRequestMultiError) Error() string {
+func (m GetTariffsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -657,11 +647,11 @@ func (m AcceptOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptOrderRequestMultiError) AllErrors() []error { return m }
+func (m GetTariffsRequestMultiError) AllErrors() []error { return m }
 
-// AcceptOrderRequestValidationError is the validation error returned by
-// AcceptOrderRequest.Validate if the designated constraints aren't met.
-type AcceptOrderRequestValidationError struct {
+// GetTariffsRequestValidationError is the validation error returned by
+// GetTariffsRequest.Validate if the designated constraints aren't met.
+type GetTariffsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -669,24 +659,24 @@ type AcceptOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptOrderRequestValidationError) Field() string { return e.field }
+func (e GetTariffsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptOrderRequestValidationError) Reason() string { return e.reason }
+func (e GetTariffsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptOrderRequestValidationError) Cause() error { return e.cause }
+func (e GetTariffsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptOrderRequestValidationError) Key() bool { return e.key }
+func (e GetTariffsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptOrderRequestValidationError) ErrorName() string {
-	return "AcceptOrderRequestValidationError"
+func (e GetTariffsRequestValidationError) ErrorName() string {
+	return "GetTariffsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptOrderRequestValidationError) Error() string {
+func (e GetTariffsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -698,14 +688,14 @@ func (e AcceptOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptOrderRequest.%s: %s%s",
+		"invalid %sGetTariffsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptOrderRequestValidationError{}
+var _ error = GetTariffsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -713,46 +703,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptOrderRequestValidationError{}
+} = GetTariffsRequestValidationError{}
 
-// Validate checks the field values on OrderIdRequest with the rules defined in
+// Validate checks the field values on TariffsList with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *OrderIdRequest) Validate() error {
+func (m *TariffsList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderIdRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrderIdRequestMultiError,
-// or nil if none found.
-func (m *OrderIdRequest) ValidateAll() error {
+// ValidateAll checks the field values on TariffsList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TariffsListMultiError, or
+// nil if none found.
+func (m *TariffsList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderIdRequest) validate(all bool) error {
+func (m *TariffsList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
+	// no validation rules for ActiveVersion
+
+	for idx, item := range m.GetTariffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TariffsListValidationError{
+						field:  fmt.Sprintf("Tariffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TariffsListValidationError{
+						field:  fmt.Sprintf("Tariffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TariffsListValidationError{
+					field:  fmt.Sprintf("Tariffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return OrderIdRequestMultiError(errors)
+		return TariffsListMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderIdRequestMultiError is an error wrapping multiple validation errors
-// returned by OrderIdRequest.ValidateAll() if the designated constraints
-// aren't met.
-type OrderIdRequestMultiError []error
+// TariffsListMultiError is an error wrapping multiple validation errors
+// returned by TariffsList.ValidateAll() if the designated constraints aren't met.
+type TariffsListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderIdRequestMultiError) Error() string {
+func (m TariffsListMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -761,11 +784,11 @@ func (m OrderIdRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderIdRequestMultiError) AllErrors() []error { return m }
+func (m TariffsListMultiError) AllErrors() []error { return m }
 
-// OrderIdRequestValidationError is the validation error returned by
-// OrderIdRequest.Validate if the designated constraints aren't met.
-type OrderIdRequestValidationError struct {
+// TariffsListValidationError is the validation error returned by
+// TariffsList.Validate if the designated constraints aren't met.
+type TariffsListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -773,22 +796,22 @@ type OrderIdRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderIdRequestValidationError) Field() string { return e.field }
+func (e TariffsListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderIdRequestValidationError) Reason() string { return e.reason }
+func (e TariffsListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderIdRequestValidationError) Cause() error { return e.cause }
+func (e TariffsListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderIdRequestValidationError) Key() bool { return e.key }
+func (e TariffsListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderIdRequestValidationError) ErrorName() string { return "OrderIdRequestValidationError" }
+func (e TariffsListValidationError) ErrorName() string { return "TariffsListValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderIdRequestValidationError) Error() string {
+func (e TariffsListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -800,14 +823,14 @@ func (e OrderIdRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderIdRequest.%s: %s%s",
+		"invalid %sTariffsList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderIdRequestValidationError{}
+var _ error = TariffsListValidationError{}
 
 var _ interface {
 	Field() string
@@ -815,24 +838,1087 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderIdRequestValidationError{}
+} = TariffsListValidationError{}
 
-// Validate checks the field values on ProcessOrdersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProcessOrdersRequest) Validate() error {
+// Validate checks the field values on Tariff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tariff) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessOrdersRequest with the rules
+// ValidateAll checks the field values on Tariff with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TariffMultiError, or nil if none found.
+func (m *Tariff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tariff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TariffValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TariffValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TariffValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TariffValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TariffValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TariffValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetWeightTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TariffValidationError{
+						field:  fmt.Sprintf("WeightTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TariffValidationError{
+						field:  fmt.Sprintf("WeightTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TariffValidationError{
+					field:  fmt.Sprintf("WeightTiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return TariffMultiError(errors)
+	}
+
+	return nil
+}
+
+// TariffMultiError is an error wrapping multiple validation errors returned by
+// Tariff.ValidateAll() if the designated constraints aren't met.
+type TariffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TariffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TariffMultiError) AllErrors() []error { return m }
+
+// TariffValidationError is the validation error returned by Tariff.Validate if
+// the designated constraints aren't met.
+type TariffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TariffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TariffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TariffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TariffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TariffValidationError) ErrorName() string { return "TariffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TariffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTariff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TariffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TariffValidationError{}
+
+// Validate checks the field values on PackageTariff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackageTariff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageTariff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackageTariffMultiError, or
+// nil if none found.
+func (m *PackageTariff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageTariff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Package
+
+	// no validation rules for Surcharge
+
+	// no validation rules for WeightLimit
+
+	// no validation rules for SurchargeMinor
+
+	// no validation rules for WeightLimitGrams
+
+	if len(errors) > 0 {
+		return PackageTariffMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageTariffMultiError is an error wrapping multiple validation errors
+// returned by PackageTariff.ValidateAll() if the designated constraints
+// aren't met.
+type PackageTariffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageTariffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageTariffMultiError) AllErrors() []error { return m }
+
+// PackageTariffValidationError is the validation error returned by
+// PackageTariff.Validate if the designated constraints aren't met.
+type PackageTariffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageTariffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageTariffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageTariffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageTariffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageTariffValidationError) ErrorName() string { return "PackageTariffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackageTariffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageTariff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageTariffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageTariffValidationError{}
+
+// Validate checks the field values on WeightTier with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeightTier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeightTier with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WeightTierMultiError, or
+// nil if none found.
+func (m *WeightTier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeightTier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromWeight
+
+	// no validation rules for Surcharge
+
+	// no validation rules for FromWeightGrams
+
+	// no validation rules for SurchargeMinor
+
+	if len(errors) > 0 {
+		return WeightTierMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeightTierMultiError is an error wrapping multiple validation errors
+// returned by WeightTier.ValidateAll() if the designated constraints aren't met.
+type WeightTierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeightTierMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeightTierMultiError) AllErrors() []error { return m }
+
+// WeightTierValidationError is the validation error returned by
+// WeightTier.Validate if the designated constraints aren't met.
+type WeightTierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeightTierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeightTierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeightTierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeightTierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeightTierValidationError) ErrorName() string { return "WeightTierValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeightTierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeightTier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeightTierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeightTierValidationError{}
+
+// Validate checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryRequestMultiError, or nil if none found.
+func (m *OrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return OrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// OrderHistoryRequestValidationError is the validation error returned by
+// OrderHistoryRequest.Validate if the designated constraints aren't met.
+type OrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryRequestValidationError) ErrorName() string {
+	return "OrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryRequestValidationError{}
+
+// Validate checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderHistoryResponseMultiError, or nil if none found.
+func (m *OrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by OrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// OrderHistoryResponseValidationError is the validation error returned by
+// OrderHistoryResponse.Validate if the designated constraints aren't met.
+type OrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderHistoryResponseValidationError) ErrorName() string {
+	return "OrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderHistoryResponseValidationError{}
+
+// Validate checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrderRequestMultiError, or nil if none found.
+func (m *AcceptOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetWeight() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeightGrams() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "WeightGrams",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPriceMinor() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "PriceMinor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCurrency() != "" {
+
+		if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+			err := AcceptOrderRequestValidationError{
+				field:  "Currency",
+				reason: "value length must be 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+	}
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return AcceptOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by AcceptOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AcceptOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrderRequestMultiError) AllErrors() []error { return m }
+
+// AcceptOrderRequestValidationError is the validation error returned by
+// AcceptOrderRequest.Validate if the designated constraints aren't met.
+type AcceptOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrderRequestValidationError) ErrorName() string {
+	return "AcceptOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrderRequestValidationError{}
+
+// Validate checks the field values on OrderIdRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderIdRequestMultiError,
+// or nil if none found.
+func (m *OrderIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return OrderIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderIdRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderIdRequestMultiError) AllErrors() []error { return m }
+
+// OrderIdRequestValidationError is the validation error returned by
+// OrderIdRequest.Validate if the designated constraints aren't met.
+type OrderIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderIdRequestValidationError) ErrorName() string { return "OrderIdRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderIdRequestValidationError{}
+
+// Validate checks the field values on ProcessOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessOrdersRequestMultiError, or nil if none found.
+func (m *ProcessOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return ProcessOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ProcessOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ProcessOrdersRequestValidationError is the validation error returned by
+// ProcessOrdersRequest.Validate if the designated constraints aren't met.
+type ProcessOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessOrdersRequestValidationError) ErrorName() string {
+	return "ProcessOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessOrdersRequestValidationError{}
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProcessOrdersRequestMultiError, or nil if none found.
-func (m *ProcessOrdersRequest) ValidateAll() error {
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessOrdersRequest) validate(all bool) error {
+func (m *ListOrdersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -841,22 +1927,127 @@ func (m *ProcessOrdersRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	// no validation rules for Action
+	// no validation rules for InPvz
+
+	// no validation rules for IncludeDeleted
+
+	if m.LastN != nil {
+		// no validation rules for LastN
+	}
+
+	if m.Pagination != nil {
+
+		if all {
+			switch v := interface{}(m.GetPagination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ExpiresFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "ExpiresFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "ExpiresFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersRequestValidationError{
+					field:  "ExpiresFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ExpiresTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "ExpiresTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersRequestValidationError{
+						field:  "ExpiresTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersRequestValidationError{
+					field:  "ExpiresTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ProcessOrdersRequestMultiError(errors)
+		return ListOrdersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessOrdersRequestMultiError is an error wrapping multiple validation
-// errors returned by ProcessOrdersRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ProcessOrdersRequestMultiError []error
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessOrdersRequestMultiError) Error() string {
+func (m ListOrdersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -865,11 +2056,11 @@ func (m ProcessOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessOrdersRequestMultiError) AllErrors() []error { return m }
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
 
-// ProcessOrdersRequestValidationError is the validation error returned by
-// ProcessOrdersRequest.Validate if the designated constraints aren't met.
-type ProcessOrdersRequestValidationError struct {
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -877,24 +2068,145 @@ type ProcessOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessOrdersRequestValidationError) Field() string { return e.field }
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+// Validate checks the field values on Pagination with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Pagination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Pagination with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaginationMultiError, or
+// nil if none found.
+func (m *Pagination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Pagination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := PaginationValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCountOnPage(); val < 0 || val > 100 {
+		err := PaginationValidationError{
+			field:  "CountOnPage",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PaginationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaginationMultiError is an error wrapping multiple validation errors
+// returned by Pagination.ValidateAll() if the designated constraints aren't met.
+type PaginationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaginationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaginationMultiError) AllErrors() []error { return m }
+
+// PaginationValidationError is the validation error returned by
+// Pagination.Validate if the designated constraints aren't met.
+type PaginationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaginationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessOrdersRequestValidationError) Reason() string { return e.reason }
+func (e PaginationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessOrdersRequestValidationError) Cause() error { return e.cause }
+func (e PaginationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessOrdersRequestValidationError) Key() bool { return e.key }
+func (e PaginationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessOrdersRequestValidationError) ErrorName() string {
-	return "ProcessOrdersRequestValidationError"
-}
+func (e PaginationValidationError) ErrorName() string { return "PaginationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProcessOrdersRequestValidationError) Error() string {
+func (e PaginationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -906,14 +2218,14 @@ func (e ProcessOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessOrdersRequest.%s: %s%s",
+		"invalid %sPagination.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessOrdersRequestValidationError{}
+var _ error = PaginationValidationError{}
 
 var _ interface {
 	Field() string
@@ -921,85 +2233,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessOrdersRequestValidationError{}
+} = PaginationValidationError{}
 
-// Validate checks the field values on ListOrdersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListOrdersRequest) Validate() error {
+// Validate checks the field values on ListReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReturnsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrdersRequest with the rules
+// ValidateAll checks the field values on ListReturnsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListOrdersRequestMultiError, or nil if none found.
-func (m *ListOrdersRequest) ValidateAll() error {
+// ListReturnsRequestMultiError, or nil if none found.
+func (m *ListReturnsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrdersRequest) validate(all bool) error {
+func (m *ListReturnsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for InPvz
-
-	if m.LastN != nil {
-		// no validation rules for LastN
-	}
-
-	if m.Pagination != nil {
-
-		if all {
-			switch v := interface{}(m.GetPagination()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrdersRequestValidationError{
-						field:  "Pagination",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrdersRequestValidationError{
-						field:  "Pagination",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListReturnsRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListOrdersRequestValidationError{
+				errors = append(errors, ListReturnsRequestValidationError{
 					field:  "Pagination",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListReturnsRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListOrdersRequestMultiError(errors)
+		return ListReturnsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrdersRequestMultiError is an error wrapping multiple validation errors
-// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// ListReturnsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReturnsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListOrdersRequestMultiError []error
+type ListReturnsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrdersRequestMultiError) Error() string {
+func (m ListReturnsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1008,11 +2308,11 @@ func (m ListOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+func (m ListReturnsRequestMultiError) AllErrors() []error { return m }
 
-// ListOrdersRequestValidationError is the validation error returned by
-// ListOrdersRequest.Validate if the designated constraints aren't met.
-type ListOrdersRequestValidationError struct {
+// ListReturnsRequestValidationError is the validation error returned by
+// ListReturnsRequest.Validate if the designated constraints aren't met.
+type ListReturnsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1020,24 +2320,24 @@ type ListOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrdersRequestValidationError) Field() string { return e.field }
+func (e ListReturnsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+func (e ListReturnsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+func (e ListReturnsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+func (e ListReturnsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrdersRequestValidationError) ErrorName() string {
-	return "ListOrdersRequestValidationError"
+func (e ListReturnsRequestValidationError) ErrorName() string {
+	return "ListReturnsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrdersRequestValidationError) Error() string {
+func (e ListReturnsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1049,14 +2349,14 @@ func (e ListOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrdersRequest.%s: %s%s",
+		"invalid %sListReturnsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrdersRequestValidationError{}
+var _ error = ListReturnsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1064,34 +2364,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrdersRequestValidationError{}
+} = ListReturnsRequestValidationError{}
 
-// Validate checks the field values on Pagination with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Pagination) Validate() error {
+// Validate checks the field values on ImportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrdersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Pagination with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PaginationMultiError, or
-// nil if none found.
-func (m *Pagination) ValidateAll() error {
+// ValidateAll checks the field values on ImportOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOrdersRequestMultiError, or nil if none found.
+func (m *ImportOrdersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Pagination) validate(all bool) error {
+func (m *ImportOrdersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPage() < 0 {
-		err := PaginationValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 0",
+	if len(m.GetOrders()) < 1 {
+		err := ImportOrdersRequestValidationError{
+			field:  "Orders",
+			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
 			return err
@@ -1099,30 +2399,54 @@ func (m *Pagination) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetCountOnPage(); val < 0 || val > 100 {
-		err := PaginationValidationError{
-			field:  "CountOnPage",
-			reason: "value must be inside range [0, 100]",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrdersRequestValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOrdersRequestValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return PaginationMultiError(errors)
+		return ImportOrdersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PaginationMultiError is an error wrapping multiple validation errors
-// returned by Pagination.ValidateAll() if the designated constraints aren't met.
-type PaginationMultiError []error
+// ImportOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportOrdersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PaginationMultiError) Error() string {
+func (m ImportOrdersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1131,11 +2455,11 @@ func (m PaginationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PaginationMultiError) AllErrors() []error { return m }
+func (m ImportOrdersRequestMultiError) AllErrors() []error { return m }
 
-// PaginationValidationError is the validation error returned by
-// Pagination.Validate if the designated constraints aren't met.
-type PaginationValidationError struct {
+// ImportOrdersRequestValidationError is the validation error returned by
+// ImportOrdersRequest.Validate if the designated constraints aren't met.
+type ImportOrdersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1143,22 +2467,24 @@ type PaginationValidationError struct {
 }
 
 // Field function returns field value.
-func (e PaginationValidationError) Field() string { return e.field }
+func (e ImportOrdersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PaginationValidationError) Reason() string { return e.reason }
+func (e ImportOrdersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PaginationValidationError) Cause() error { return e.cause }
+func (e ImportOrdersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PaginationValidationError) Key() bool { return e.key }
+func (e ImportOrdersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PaginationValidationError) ErrorName() string { return "PaginationValidationError" }
+func (e ImportOrdersRequestValidationError) ErrorName() string {
+	return "ImportOrdersRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PaginationValidationError) Error() string {
+func (e ImportOrdersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1170,14 +2496,14 @@ func (e PaginationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPagination.%s: %s%s",
+		"invalid %sImportOrdersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PaginationValidationError{}
+var _ error = ImportOrdersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1185,73 +2511,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PaginationValidationError{}
+} = ImportOrdersRequestValidationError{}
 
-// Validate checks the field values on ListReturnsRequest with the rules
+// Validate checks the field values on ImportOrdersStreamRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReturnsRequest) Validate() error {
+func (m *ImportOrdersStreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReturnsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportOrdersStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReturnsRequestMultiError, or nil if none found.
-func (m *ListReturnsRequest) ValidateAll() error {
+// ImportOrdersStreamRequestMultiError, or nil if none found.
+func (m *ImportOrdersStreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReturnsRequest) validate(all bool) error {
+func (m *ImportOrdersStreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for JobId
+
+	// no validation rules for Seq
+
 	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
+		switch v := interface{}(m.GetOrder()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListReturnsRequestValidationError{
-					field:  "Pagination",
+				errors = append(errors, ImportOrdersStreamRequestValidationError{
+					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListReturnsRequestValidationError{
-					field:  "Pagination",
+				errors = append(errors, ImportOrdersStreamRequestValidationError{
+					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListReturnsRequestValidationError{
-				field:  "Pagination",
+			return ImportOrdersStreamRequestValidationError{
+				field:  "Order",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return ListReturnsRequestMultiError(errors)
+		return ImportOrdersStreamRequestMultiError(errors)
 	}
 
 	return nil
-}
-
-// ListReturnsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListReturnsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListReturnsRequestMultiError []error
+}
+
+// ImportOrdersStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportOrdersStreamRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportOrdersStreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReturnsRequestMultiError) Error() string {
+func (m ImportOrdersStreamRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1260,11 +2592,11 @@ func (m ListReturnsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReturnsRequestMultiError) AllErrors() []error { return m }
+func (m ImportOrdersStreamRequestMultiError) AllErrors() []error { return m }
 
-// ListReturnsRequestValidationError is the validation error returned by
-// ListReturnsRequest.Validate if the designated constraints aren't met.
-type ListReturnsRequestValidationError struct {
+// ImportOrdersStreamRequestValidationError is the validation error returned by
+// ImportOrdersStreamRequest.Validate if the designated constraints aren't met.
+type ImportOrdersStreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1272,24 +2604,24 @@ type ListReturnsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReturnsRequestValidationError) Field() string { return e.field }
+func (e ImportOrdersStreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReturnsRequestValidationError) Reason() string { return e.reason }
+func (e ImportOrdersStreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReturnsRequestValidationError) Cause() error { return e.cause }
+func (e ImportOrdersStreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReturnsRequestValidationError) Key() bool { return e.key }
+func (e ImportOrdersStreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReturnsRequestValidationError) ErrorName() string {
-	return "ListReturnsRequestValidationError"
+func (e ImportOrdersStreamRequestValidationError) ErrorName() string {
+	return "ImportOrdersStreamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReturnsRequestValidationError) Error() string {
+func (e ImportOrdersStreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1301,14 +2633,14 @@ func (e ListReturnsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReturnsRequest.%s: %s%s",
+		"invalid %sImportOrdersStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReturnsRequestValidationError{}
+var _ error = ImportOrdersStreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1316,58 +2648,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReturnsRequestValidationError{}
+} = ImportOrdersStreamRequestValidationError{}
 
-// Validate checks the field values on ImportOrdersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ImportOrdersRequest) Validate() error {
+// Validate checks the field values on ImportProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportProgress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImportOrdersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ImportOrdersRequestMultiError, or nil if none found.
-func (m *ImportOrdersRequest) ValidateAll() error {
+// ValidateAll checks the field values on ImportProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportProgressMultiError,
+// or nil if none found.
+func (m *ImportProgress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImportOrdersRequest) validate(all bool) error {
+func (m *ImportProgress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetOrders()) < 1 {
-		err := ImportOrdersRequestValidationError{
-			field:  "Orders",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for JobId
 
-	for idx, item := range m.GetOrders() {
+	// no validation rules for Processed
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	// no validation rules for Skipped
+
+	for idx, item := range m.GetErrors() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ImportOrdersRequestValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, ImportProgressValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ImportOrdersRequestValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, ImportProgressValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1375,8 +2706,8 @@ func (m *ImportOrdersRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ImportOrdersRequestValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				return ImportProgressValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1385,20 +2716,22 @@ func (m *ImportOrdersRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Done
+
 	if len(errors) > 0 {
-		return ImportOrdersRequestMultiError(errors)
+		return ImportProgressMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImportOrdersRequestMultiError is an error wrapping multiple validation
-// errors returned by ImportOrdersRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ImportOrdersRequestMultiError []error
+// ImportProgressMultiError is an error wrapping multiple validation errors
+// returned by ImportProgress.ValidateAll() if the designated constraints
+// aren't met.
+type ImportProgressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImportOrdersRequestMultiError) Error() string {
+func (m ImportProgressMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1407,11 +2740,11 @@ func (m ImportOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImportOrdersRequestMultiError) AllErrors() []error { return m }
+func (m ImportProgressMultiError) AllErrors() []error { return m }
 
-// ImportOrdersRequestValidationError is the validation error returned by
-// ImportOrdersRequest.Validate if the designated constraints aren't met.
-type ImportOrdersRequestValidationError struct {
+// ImportProgressValidationError is the validation error returned by
+// ImportProgress.Validate if the designated constraints aren't met.
+type ImportProgressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1419,24 +2752,22 @@ type ImportOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImportOrdersRequestValidationError) Field() string { return e.field }
+func (e ImportProgressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImportOrdersRequestValidationError) Reason() string { return e.reason }
+func (e ImportProgressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImportOrdersRequestValidationError) Cause() error { return e.cause }
+func (e ImportProgressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImportOrdersRequestValidationError) Key() bool { return e.key }
+func (e ImportProgressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImportOrdersRequestValidationError) ErrorName() string {
-	return "ImportOrdersRequestValidationError"
-}
+func (e ImportProgressValidationError) ErrorName() string { return "ImportProgressValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ImportOrdersRequestValidationError) Error() string {
+func (e ImportProgressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1448,14 +2779,14 @@ func (e ImportOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImportOrdersRequest.%s: %s%s",
+		"invalid %sImportProgress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImportOrdersRequestValidationError{}
+var _ error = ImportProgressValidationError{}
 
 var _ interface {
 	Field() string
@@ -1463,7 +2794,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImportOrdersRequestValidationError{}
+} = ImportProgressValidationError{}
 
 // Validate checks the field values on GetHistoryRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -1516,6 +2847,74 @@ func (m *GetHistoryRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for UserId
+
+	if m.CreatedFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetHistoryRequestValidationError{
+						field:  "CreatedFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetHistoryRequestValidationError{
+						field:  "CreatedFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetHistoryRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedTo != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetHistoryRequestValidationError{
+						field:  "CreatedTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetHistoryRequestValidationError{
+						field:  "CreatedTo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetHistoryRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GetHistoryRequestMultiError(errors)
 	}
@@ -1611,31 +3010,165 @@ func (m *OrderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *OrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return OrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderResponseMultiError) AllErrors() []error { return m }
+
+// OrderResponseValidationError is the validation error returned by
+// OrderResponse.Validate if the designated constraints aren't met.
+type OrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderResponseValidationError) ErrorName() string { return "OrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderResponseValidationError{}
+
+// Validate checks the field values on ProcessResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProcessResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProcessResultMultiError, or
+// nil if none found.
+func (m *ProcessResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetErrorDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessResultValidationError{
+						field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessResultValidationError{
+					field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for Status
-
-	// no validation rules for OrderId
-
 	if len(errors) > 0 {
-		return OrderResponseMultiError(errors)
+		return ProcessResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderResponseMultiError is an error wrapping multiple validation errors
-// returned by OrderResponse.ValidateAll() if the designated constraints
+// ProcessResultMultiError is an error wrapping multiple validation errors
+// returned by ProcessResult.ValidateAll() if the designated constraints
 // aren't met.
-type OrderResponseMultiError []error
+type ProcessResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderResponseMultiError) Error() string {
+func (m ProcessResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1644,11 +3177,11 @@ func (m OrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderResponseMultiError) AllErrors() []error { return m }
+func (m ProcessResultMultiError) AllErrors() []error { return m }
 
-// OrderResponseValidationError is the validation error returned by
-// OrderResponse.Validate if the designated constraints aren't met.
-type OrderResponseValidationError struct {
+// ProcessResultValidationError is the validation error returned by
+// ProcessResult.Validate if the designated constraints aren't met.
+type ProcessResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1656,22 +3189,22 @@ type OrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderResponseValidationError) Field() string { return e.field }
+func (e ProcessResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderResponseValidationError) Reason() string { return e.reason }
+func (e ProcessResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderResponseValidationError) Cause() error { return e.cause }
+func (e ProcessResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderResponseValidationError) Key() bool { return e.key }
+func (e ProcessResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderResponseValidationError) ErrorName() string { return "OrderResponseValidationError" }
+func (e ProcessResultValidationError) ErrorName() string { return "ProcessResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderResponseValidationError) Error() string {
+func (e ProcessResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1683,14 +3216,14 @@ func (e OrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderResponse.%s: %s%s",
+		"invalid %sProcessResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderResponseValidationError{}
+var _ error = ProcessResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1698,44 +3231,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderResponseValidationError{}
+} = ProcessResultValidationError{}
 
-// Validate checks the field values on ProcessResult with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on OrderError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ProcessResult) Validate() error {
+func (m *OrderError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProcessResult with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ProcessResultMultiError, or
+// ValidateAll checks the field values on OrderError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderErrorMultiError, or
 // nil if none found.
-func (m *ProcessResult) ValidateAll() error {
+func (m *OrderError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProcessResult) validate(all bool) error {
+func (m *OrderError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for OrderId
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
 	if len(errors) > 0 {
-		return ProcessResultMultiError(errors)
+		return OrderErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProcessResultMultiError is an error wrapping multiple validation errors
-// returned by ProcessResult.ValidateAll() if the designated constraints
-// aren't met.
-type ProcessResultMultiError []error
+// OrderErrorMultiError is an error wrapping multiple validation errors
+// returned by OrderError.ValidateAll() if the designated constraints aren't met.
+type OrderErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProcessResultMultiError) Error() string {
+func (m OrderErrorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1744,11 +3282,11 @@ func (m ProcessResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProcessResultMultiError) AllErrors() []error { return m }
+func (m OrderErrorMultiError) AllErrors() []error { return m }
 
-// ProcessResultValidationError is the validation error returned by
-// ProcessResult.Validate if the designated constraints aren't met.
-type ProcessResultValidationError struct {
+// OrderErrorValidationError is the validation error returned by
+// OrderError.Validate if the designated constraints aren't met.
+type OrderErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1756,22 +3294,22 @@ type ProcessResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProcessResultValidationError) Field() string { return e.field }
+func (e OrderErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProcessResultValidationError) Reason() string { return e.reason }
+func (e OrderErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProcessResultValidationError) Cause() error { return e.cause }
+func (e OrderErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProcessResultValidationError) Key() bool { return e.key }
+func (e OrderErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProcessResultValidationError) ErrorName() string { return "ProcessResultValidationError" }
+func (e OrderErrorValidationError) ErrorName() string { return "OrderErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProcessResultValidationError) Error() string {
+func (e OrderErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1783,14 +3321,14 @@ func (e ProcessResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProcessResult.%s: %s%s",
+		"invalid %sOrderError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProcessResultValidationError{}
+var _ error = OrderErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -1798,7 +3336,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProcessResultValidationError{}
+} = OrderErrorValidationError{}
 
 // Validate checks the field values on OrdersList with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -2244,6 +3782,40 @@ func (m *ImportResult) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetErrorDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportResultValidationError{
+					field:  fmt.Sprintf("ErrorDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ImportResultMultiError(errors)
 	}
@@ -2377,32 +3949,55 @@ func (m *Order) validate(all bool) error {
 		}
 	}
 
-	if m.GetWeight() <= 0 {
-		err := OrderValidationError{
-			field:  "Weight",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Weight
 
-	if m.GetTotalPrice() < 0 {
-		err := OrderValidationError{
-			field:  "TotalPrice",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for TotalPrice
+
+	// no validation rules for TariffVersion
+
+	// no validation rules for WeightGrams
+
+	// no validation rules for TotalPriceMinor
+
+	// no validation rules for Currency
 
 	if m.Package != nil {
 		// no validation rules for Package
 	}
 
+	if m.DeletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  "DeletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  "DeletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return OrderMultiError(errors)
 	}
@@ -2535,6 +4130,35 @@ func (m *OrderHistory) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetActor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderHistoryValidationError{
+				field:  "Actor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderHistoryMultiError(errors)
 	}
@@ -2611,3 +4235,107 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = OrderHistoryValidationError{}
+
+// Validate checks the field values on Actor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Actor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Actor with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ActorMultiError, or nil if none found.
+func (m *Actor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Actor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	// no validation rules for Subject
+
+	if len(errors) > 0 {
+		return ActorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActorMultiError is an error wrapping multiple validation errors returned by
+// Actor.ValidateAll() if the designated constraints aren't met.
+type ActorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActorMultiError) AllErrors() []error { return m }
+
+// ActorValidationError is the validation error returned by Actor.Validate if
+// the designated constraints aren't met.
+type ActorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActorValidationError) ErrorName() string { return "ActorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActorValidationError{}
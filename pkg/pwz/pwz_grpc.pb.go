@@ -19,23 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Notifier_SendMessage_FullMethodName     = "/notifier.Notifier/SendMessage"
-	Notifier_AcceptOrder_FullMethodName     = "/notifier.Notifier/AcceptOrder"
-	Notifier_ReturnOrder_FullMethodName     = "/notifier.Notifier/ReturnOrder"
-	Notifier_ProcessOrders_FullMethodName   = "/notifier.Notifier/ProcessOrders"
-	Notifier_ListOrders_FullMethodName      = "/notifier.Notifier/ListOrders"
-	Notifier_ListReturns_FullMethodName     = "/notifier.Notifier/ListReturns"
-	Notifier_GetHistory_FullMethodName      = "/notifier.Notifier/GetHistory"
-	Notifier_ImportOrders_FullMethodName    = "/notifier.Notifier/ImportOrders"
-	Notifier_GetOrderHistory_FullMethodName = "/notifier.Notifier/GetOrderHistory"
+	Notifier_SendMessage_FullMethodName        = "/notifier.Notifier/SendMessage"
+	Notifier_GetMessageStatus_FullMethodName   = "/notifier.Notifier/GetMessageStatus"
+	Notifier_CancelMessage_FullMethodName      = "/notifier.Notifier/CancelMessage"
+	Notifier_AcceptOrder_FullMethodName        = "/notifier.Notifier/AcceptOrder"
+	Notifier_ReturnOrder_FullMethodName        = "/notifier.Notifier/ReturnOrder"
+	Notifier_ProcessOrders_FullMethodName      = "/notifier.Notifier/ProcessOrders"
+	Notifier_ListOrders_FullMethodName         = "/notifier.Notifier/ListOrders"
+	Notifier_ListReturns_FullMethodName        = "/notifier.Notifier/ListReturns"
+	Notifier_GetHistory_FullMethodName         = "/notifier.Notifier/GetHistory"
+	Notifier_ImportOrders_FullMethodName       = "/notifier.Notifier/ImportOrders"
+	Notifier_ImportOrdersStream_FullMethodName = "/notifier.Notifier/ImportOrdersStream"
+	Notifier_GetOrderHistory_FullMethodName    = "/notifier.Notifier/GetOrderHistory"
+	Notifier_GetTariffs_FullMethodName         = "/notifier.Notifier/GetTariffs"
 )
 
 // NotifierClient is the client API for Notifier service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NotifierClient interface {
-	// было для тестов
+	// Поставить сообщение в очередь отправки
 	SendMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// Статус сообщения
+	GetMessageStatus(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	// Отменить отправку сообщения
+	CancelMessage(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 	// Принять заказ от курьера
 	AcceptOrder(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	// Вернуть заказ курьеру
@@ -50,7 +58,11 @@ type NotifierClient interface {
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryList, error)
 	// Импорт заказов (если эта ручка делалась ранее в рамках доп заданий)
 	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportResult, error)
+	// Потоковый импорт заказов с прогрессом по пачкам, повторный запуск с тем же job_id продолжает импорт
+	ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportProgress], error)
 	GetOrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error)
+	// Тарифы: действующий и все версии
+	GetTariffs(ctx context.Context, in *GetTariffsRequest, opts ...grpc.CallOption) (*TariffsList, error)
 }
 
 type notifierClient struct {
@@ -71,6 +83,26 @@ func (c *notifierClient) SendMessage(ctx context.Context, in *MessageRequest, op
 	return out, nil
 }
 
+func (c *notifierClient) GetMessageStatus(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, Notifier_GetMessageStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notifierClient) CancelMessage(ctx context.Context, in *MessageIdRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, Notifier_CancelMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notifierClient) AcceptOrder(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderResponse)
@@ -141,6 +173,19 @@ func (c *notifierClient) ImportOrders(ctx context.Context, in *ImportOrdersReque
 	return out, nil
 }
 
+func (c *notifierClient) ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Notifier_ServiceDesc.Streams[0], Notifier_ImportOrdersStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportOrdersStreamRequest, ImportProgress]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Notifier_ImportOrdersStreamClient = grpc.BidiStreamingClient[ImportOrdersStreamRequest, ImportProgress]
+
 func (c *notifierClient) GetOrderHistory(ctx context.Context, in *OrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderHistoryResponse)
@@ -151,12 +196,26 @@ func (c *notifierClient) GetOrderHistory(ctx context.Context, in *OrderHistoryRe
 	return out, nil
 }
 
+func (c *notifierClient) GetTariffs(ctx context.Context, in *GetTariffsRequest, opts ...grpc.CallOption) (*TariffsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffsList)
+	err := c.cc.Invoke(ctx, Notifier_GetTariffs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotifierServer is the server API for Notifier service.
 // All implementations must embed UnimplementedNotifierServer
 // for forward compatibility.
 type NotifierServer interface {
-	// было для тестов
+	// Поставить сообщение в очередь отправки
 	SendMessage(context.Context, *MessageRequest) (*MessageResponse, error)
+	// Статус сообщения
+	GetMessageStatus(context.Context, *MessageIdRequest) (*MessageStatusResponse, error)
+	// Отменить отправку сообщения
+	CancelMessage(context.Context, *MessageIdRequest) (*MessageStatusResponse, error)
 	// Принять заказ от курьера
 	AcceptOrder(context.Context, *AcceptOrderRequest) (*OrderResponse, error)
 	// Вернуть заказ курьеру
@@ -171,7 +230,11 @@ type NotifierServer interface {
 	GetHistory(context.Context, *GetHistoryRequest) (*OrderHistoryList, error)
 	// Импорт заказов (если эта ручка делалась ранее в рамках доп заданий)
 	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error)
+	// Потоковый импорт заказов с прогрессом по пачкам, повторный запуск с тем же job_id продолжает импорт
+	ImportOrdersStream(grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportProgress]) error
 	GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error)
+	// Тарифы: действующий и все версии
+	GetTariffs(context.Context, *GetTariffsRequest) (*TariffsList, error)
 	mustEmbedUnimplementedNotifierServer()
 }
 
@@ -185,6 +248,12 @@ type UnimplementedNotifierServer struct{}
 func (UnimplementedNotifierServer) SendMessage(context.Context, *MessageRequest) (*MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedNotifierServer) GetMessageStatus(context.Context, *MessageIdRequest) (*MessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageStatus not implemented")
+}
+func (UnimplementedNotifierServer) CancelMessage(context.Context, *MessageIdRequest) (*MessageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMessage not implemented")
+}
 func (UnimplementedNotifierServer) AcceptOrder(context.Context, *AcceptOrderRequest) (*OrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrder not implemented")
 }
@@ -206,9 +275,15 @@ func (UnimplementedNotifierServer) GetHistory(context.Context, *GetHistoryReques
 func (UnimplementedNotifierServer) ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
 }
+func (UnimplementedNotifierServer) ImportOrdersStream(grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOrdersStream not implemented")
+}
 func (UnimplementedNotifierServer) GetOrderHistory(context.Context, *OrderHistoryRequest) (*OrderHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
 }
+func (UnimplementedNotifierServer) GetTariffs(context.Context, *GetTariffsRequest) (*TariffsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTariffs not implemented")
+}
 func (UnimplementedNotifierServer) mustEmbedUnimplementedNotifierServer() {}
 func (UnimplementedNotifierServer) testEmbeddedByValue()                  {}
 
@@ -248,6 +323,42 @@ func _Notifier_SendMessage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notifier_GetMessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifierServer).GetMessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifier_GetMessageStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifierServer).GetMessageStatus(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifier_CancelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifierServer).CancelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifier_CancelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifierServer).CancelMessage(ctx, req.(*MessageIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Notifier_AcceptOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AcceptOrderRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +485,13 @@ func _Notifier_ImportOrders_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notifier_ImportOrdersStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotifierServer).ImportOrdersStream(&grpc.GenericServerStream[ImportOrdersStreamRequest, ImportProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Notifier_ImportOrdersStreamServer = grpc.BidiStreamingServer[ImportOrdersStreamRequest, ImportProgress]
+
 func _Notifier_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderHistoryRequest)
 	if err := dec(in); err != nil {
@@ -392,6 +510,24 @@ func _Notifier_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notifier_GetTariffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTariffsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotifierServer).GetTariffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifier_GetTariffs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotifierServer).GetTariffs(ctx, req.(*GetTariffsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Notifier_ServiceDesc is the grpc.ServiceDesc for Notifier service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -403,6 +539,14 @@ var Notifier_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _Notifier_SendMessage_Handler,
 		},
+		{
+			MethodName: "GetMessageStatus",
+			Handler:    _Notifier_GetMessageStatus_Handler,
+		},
+		{
+			MethodName: "CancelMessage",
+			Handler:    _Notifier_CancelMessage_Handler,
+		},
 		{
 			MethodName: "AcceptOrder",
 			Handler:    _Notifier_AcceptOrder_Handler,
@@ -435,7 +579,18 @@ var Notifier_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderHistory",
 			Handler:    _Notifier_GetOrderHistory_Handler,
 		},
+		{
+			MethodName: "GetTariffs",
+			Handler:    _Notifier_GetTariffs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportOrdersStream",
+			Handler:       _Notifier_ImportOrdersStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pwz/pwz.proto",
 }